@@ -79,8 +79,6 @@ func NewAnteHandlerNonEVM(
 		// TODO: spike(security): Does minimum gas price of 0 pose a risk?
 		// ticket: https://github.com/NibiruChain/nibiru/issues/1916
 		authante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
-		// ----------- Ante Handlers:  devgas
-		devgasante.NewDevGasPayoutDecorator(opts.DevGasBankKeeper, opts.DevGasKeeper),
 		// ----------- Ante Handlers:  Keys and signatures
 		// NOTE: SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewSetPubKeyDecorator(opts.AccountKeeper),
@@ -92,3 +90,14 @@ func NewAnteHandlerNonEVM(
 		ante.AnteDecoratorGasWanted{},
 	)
 }
+
+// NewPostHandler returns a PostHandler that runs after the messages of a
+// transaction execute successfully. It pays the developers of the executed
+// wasm contracts their share of the transaction fees.
+func NewPostHandler(
+	opts ante.AnteHandlerOptions,
+) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		devgasante.NewDevGasPayoutDecorator(opts.DevGasBankKeeper, opts.DevGasKeeper),
+	)
+}
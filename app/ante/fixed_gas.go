@@ -30,7 +30,8 @@ func (gd AnteDecoratorEnsureSinglePostPriceMessage) AnteHandle(
 		switch msg.(type) {
 		case *oracletypes.MsgAggregateExchangeRatePrevote:
 			hasOraclePreVoteMsg = true
-		case *oracletypes.MsgAggregateExchangeRateVote:
+		case *oracletypes.MsgAggregateExchangeRateVote,
+			*oracletypes.MsgAggregateExchangeRateDirectVote:
 			hasOracleVoteMsg = true
 		}
 	}
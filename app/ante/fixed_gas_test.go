@@ -54,6 +54,36 @@ func (suite *AnteTestSuite) TestOraclePostPriceTransactionsHaveFixedPrice() {
 			expectedGas: ante.OracleMessageGas,
 			expectedErr: nil,
 		},
+		{
+			name: "Oracle Direct Vote Transaction",
+			messages: []sdk.Msg{
+				&oracletypes.MsgAggregateExchangeRateDirectVote{
+					ExchangeRates: "someData",
+					Feeder:        addr.String(),
+					Validator:     addr.String(),
+				},
+			},
+			expectedGas: ante.OracleMessageGas,
+			expectedErr: nil,
+		},
+		{
+			name: "Oracle Direct Vote and Vote in a transaction: should fail",
+			messages: []sdk.Msg{
+				&oracletypes.MsgAggregateExchangeRateDirectVote{
+					ExchangeRates: "someData",
+					Feeder:        addr.String(),
+					Validator:     addr.String(),
+				},
+				&oracletypes.MsgAggregateExchangeRateVote{
+					Salt:          "dummySalt",
+					ExchangeRates: "someData",
+					Feeder:        addr.String(),
+					Validator:     addr.String(),
+				},
+			},
+			expectedGas: 1042,
+			expectedErr: sdkioerrors.Wrap(ante.ErrOracleAnte, "a transaction that includes an oracle vote or prevote message cannot have more than those two messages"),
+		},
 		{
 			name: "Two messages in a transaction, one of them is an oracle vote message should fail (with MsgAggregateExchangeRatePrevote)",
 			messages: []sdk.Msg{
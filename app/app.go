@@ -13,6 +13,8 @@ import (
 
 	"github.com/NibiruChain/nibiru/app/ante"
 	"github.com/NibiruChain/nibiru/app/wasmext"
+	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
+	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
 	"github.com/NibiruChain/nibiru/x/evm/precompile"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -120,6 +122,10 @@ func GetWasmOpts(nibiru NibiruApp, appOpts servertypes.AppOptions) []wasmkeeper.
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
 	}
 
+	// Record the gas of each contract execution to weight DevGas payouts.
+	gasTracker := devgaskeeper.NewWasmGasTracker(nibiru.tkeys[devgastypes.TStoreKey])
+	wasmOpts = append(wasmOpts, wasmkeeper.WithWasmEngineDecorator(gasTracker.WasmEngineDecorator))
+
 	return append(wasmOpts, wasmext.NibiruWasmOptions(
 		nibiru.GRPCQueryRouter(),
 		nibiru.appCodec,
@@ -151,12 +157,9 @@ func NewNibiruApp(
 	legacyAmino := encodingConfig.Amino
 	interfaceRegistry := encodingConfig.InterfaceRegistry
 	txConfig := encodingConfig.TxConfig
+	mp := mempool.NoOpMempool{}
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mp := mempool.NoOpMempool{}
 		app.SetMempool(mp)
-		handler := baseapp.NewDefaultProposalHandler(mp, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
 	})
 
 	bApp := baseapp.NewBaseApp(
@@ -209,7 +212,7 @@ func NewNibiruApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	anteHandler := NewAnteHandler(app.AppKeepers, ante.AnteHandlerOptions{
+	anteHandlerOpts := ante.AnteHandlerOptions{
 		HandlerOptions: authante.HandlerOptions{
 			AccountKeeper:          app.AccountKeeper,
 			BankKeeper:             app.BankKeeper,
@@ -227,11 +230,22 @@ func NewNibiruApp(
 		MaxTxGasWanted: DefaultMaxTxGasWanted,
 		EvmKeeper:      app.EvmKeeper,
 		AccountKeeper:  app.AccountKeeper,
-	})
+	}
 
-	app.SetAnteHandler(anteHandler)
+	app.SetAnteHandler(NewAnteHandler(app.AppKeepers, anteHandlerOpts))
+	app.SetPostHandler(NewPostHandler(anteHandlerOpts))
 	app.SetEndBlocker(app.EndBlocker)
 
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(mp, app.BaseApp)
+	proposalHandler := NewOracleProposalHandler(
+		txConfig.TxDecoder(),
+		app.OracleKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+
 	if snapshotManager := app.SnapshotManager(); snapshotManager != nil {
 		if err := snapshotManager.RegisterExtensions(
 			wasmkeeper.NewWasmSnapshotter(
@@ -1,6 +1,7 @@
 package evmante_test
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/app/evmante"
@@ -64,6 +65,29 @@ func (s *TestSuite) TestCanTransferDecorator() {
 			},
 			wantErr: "insufficient funds",
 		},
+		{
+			name: "sad: gas fee cap below base fee",
+			ctxSetup: func(deps *evmtest.TestDeps) {
+				params := deps.EvmKeeper.GetParams(deps.Ctx)
+				params.MinBaseFee = math.NewInt(1_000_000)
+				deps.Chain.EvmKeeper.SetParams(deps.Ctx, params)
+			},
+			txSetup: func(deps *evmtest.TestDeps) sdk.FeeTx {
+				txMsg := evmtest.HappyTransferTx(deps, 0)
+				txBuilder := deps.EncCfg.TxConfig.NewTxBuilder()
+
+				gethSigner := deps.Sender.GethSigner(deps.Chain.EvmKeeper.EthChainID(deps.Ctx))
+				keyringSigner := deps.Sender.KeyringSigner
+				err := txMsg.Sign(gethSigner, keyringSigner)
+				s.Require().NoError(err)
+
+				tx, err := txMsg.BuildTx(txBuilder, eth.EthBaseDenom)
+				s.Require().NoError(err)
+
+				return tx
+			},
+			wantErr: "base fee",
+		},
 		{
 			name: "sad: unsigned tx",
 			txSetup: func(deps *evmtest.TestDeps) sdk.FeeTx {
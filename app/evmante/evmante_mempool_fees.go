@@ -29,6 +29,7 @@ func NewMempoolGasPriceDecorator(k EVMKeeper) MempoolGasPriceDecorator {
 
 // AnteHandle ensures that the effective fee from the transaction is greater than the
 // local mempool gas prices, which is defined by the  MinGasPrice (parameter) * GasLimit (tx argument).
+// The gas fee cap is checked against the base fee by the CanTransferDecorator.
 func (d MempoolGasPriceDecorator) AnteHandle(
 	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
 ) (newCtx sdk.Context, err error) {
@@ -3,15 +3,21 @@ package app_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
 )
 
 // init changes the value of 'DefaultTestingAppInit' to use custom initialization.
@@ -203,3 +209,121 @@ func (suite *IBCTestSuite) TestHandleMsgTransfer() {
 	balance = chainCApp.BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
 	suite.Require().Zero(balance.Amount.Int64())
 }
+
+// sends a transfer from chainA to chainB with the IBC transfer precompile and
+// relays the packet.
+func (suite *IBCTestSuite) TestPrecompileIbcTransfer() {
+	path := NewIBCTestingTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainAApp, ok := suite.chainA.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	// The EVM uses the block proposer as the coinbase.
+	ctx := suite.chainA.GetContext()
+	proposer, err := chainAApp.StakingKeeper.GetAllValidators(ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	ctx = ctx.WithProposer(proposer)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 420)
+	timeout := uint64(suite.chainB.CurrentHeader.Time.Add(time.Hour).UnixNano())
+
+	abi := embeds.Contract_IbcTransfer.ABI
+	input, err := abi.Pack(string(precompile.IbcTransferMethod_Transfer),
+		precompile.ArgsIbcTransfer(path.EndpointA.ChannelID, coin, receiver.String(), timeout)...,
+	)
+	suite.Require().NoError(err)
+	contractAddr := precompile.PrecompileAddr_IbcTransfer.ToAddr()
+
+	// sad: the channel is not an EVM channel
+	_, err = chainAApp.EvmKeeper.CallContractWithInput(
+		ctx, eth.NibiruAddrToEthAddr(sender), &contractAddr, true, input,
+	)
+	suite.Require().ErrorContains(err, "is not an EVM channel")
+
+	params := chainAApp.EvmKeeper.GetParams(ctx)
+	params.EVMChannels = []string{path.EndpointA.ChannelID}
+	chainAApp.EvmKeeper.SetParams(ctx, params)
+
+	evmResp, err := chainAApp.EvmKeeper.CallContractWithInput(
+		ctx, eth.NibiruAddrToEthAddr(sender), &contractAddr, true, input,
+	)
+	suite.Require().NoError(err)
+	out, err := abi.Unpack(string(precompile.IbcTransferMethod_Transfer), evmResp.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(evmResp.Logs, 1)
+	suite.Require().Equal(abi.Events["IbcTransfer"].ID.Hex(), evmResp.Logs[0].Topics[0])
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+	suite.Require().Equal(packet.GetSequence(), out[0].(uint64))
+
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(coin, chainAApp.BankKeeper.GetBalance(ctx, escrowAddress, coin.Denom))
+
+	// relay send
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	chainBApp, ok := suite.chainB.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	voucher := transfertypes.GetTransferCoin(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin.Denom, coin.Amount,
+	)
+	suite.Require().Equal(voucher,
+		chainBApp.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom))
+}
+
+// the IBC transfer precompile leaves no packet or escrowed funds behind when
+// the EVM call that ran it reverts.
+func (suite *IBCTestSuite) TestPrecompileIbcTransferReverted() {
+	path := NewIBCTestingTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	chainAApp, ok := suite.chainA.App.(*app.NibiruApp)
+	suite.Require().True(ok)
+	ctx := suite.chainA.GetContext()
+	proposer, err := chainAApp.StakingKeeper.GetAllValidators(ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	ctx = ctx.WithProposer(proposer)
+	params := chainAApp.EvmKeeper.GetParams(ctx)
+	params.EVMChannels = []string{path.EndpointA.ChannelID}
+	chainAApp.EvmKeeper.SetParams(ctx, params)
+
+	caller := evmtest.NewEthAccInfo().EthAddr
+	callerAcc := eth.EthAddrToNibiruAddr(caller)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 420)
+	suite.Require().NoError(testapp.FundAccount(
+		chainAApp.BankKeeper, ctx, callerAcc, sdk.NewCoins(coin),
+	))
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	timeout := uint64(suite.chainB.CurrentHeader.Time.Add(time.Hour).UnixNano())
+	input, err := embeds.Contract_IbcTransfer.ABI.Pack(string(precompile.IbcTransferMethod_Transfer),
+		precompile.ArgsIbcTransfer(path.EndpointA.ChannelID, coin, receiver.String(), timeout)...,
+	)
+	suite.Require().NoError(err)
+	channelKeeper := chainAApp.GetIBCKeeper().ChannelKeeper
+	seqBefore, found := channelKeeper.GetNextSequenceSend(
+		ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+	)
+	suite.Require().True(found)
+
+	evmResp, err := evmtest.CallThenRevert(
+		ctx, &chainAApp.EvmKeeper,
+		eth.NibiruAddrToEthAddr(suite.chainA.SenderAccount.GetAddress()),
+		caller, precompile.PrecompileAddr_IbcTransfer.ToAddr(), input,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Contains(evmResp.VmError, vm.ErrExecutionReverted.Error())
+	suite.Require().Equal(byte(1), evmResp.Ret[31], "precompile call should succeed")
+
+	suite.Require().Equal(coin, chainAApp.BankKeeper.GetBalance(ctx, callerAcc, coin.Denom))
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(chainAApp.BankKeeper.GetBalance(ctx, escrowAddress, coin.Denom).IsZero())
+	seqAfter, _ := channelKeeper.GetNextSequenceSend(
+		ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+	)
+	suite.Require().Equal(seqBefore, seqAfter)
+	_, err = ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().Error(err, "no packet should be sent")
+}
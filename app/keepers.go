@@ -34,7 +34,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
-	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
@@ -157,10 +156,8 @@ type privateKeepers struct {
 
 	/* ibcKeeper defines each ICS keeper for IBC. ibcKeeper must be a pointer in
 	   the app, so we can SetRouter on it correctly. */
-	ibcKeeper    *ibckeeper.Keeper
-	ibcFeeKeeper ibcfeekeeper.Keeper
-	/* ibcTransferKeeper is for cross-chain fungible token transfers. */
-	ibcTransferKeeper   ibctransferkeeper.Keeper
+	ibcKeeper           *ibckeeper.Keeper
+	ibcFeeKeeper        ibcfeekeeper.Keeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
 	icaHostKeeper       icahostkeeper.Keeper
 }
@@ -204,7 +201,9 @@ func initStoreKeys() (
 
 		evm.StoreKey,
 	)
-	tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evm.TransientKey)
+	tkeys = sdk.NewTransientStoreKeys(
+		paramstypes.TStoreKey, evm.TransientKey, devgastypes.TStoreKey, oracletypes.TStoreKey,
+	)
 	memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 	return keys, tkeys, memKeys
 }
@@ -262,13 +261,13 @@ func (app *NibiruApp) InitKeepers(
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 		govModuleAddr,
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	app.BankKeeper = keepers.NewBankKeeperWithHooks(bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
 		BlockedAddresses(),
 		govModuleAddr,
-	)
+	))
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -342,7 +341,7 @@ func (app *NibiruApp) InitKeepers(
 		appCodec, keys[sudotypes.StoreKey],
 	)
 
-	app.OracleKeeper = oraclekeeper.NewKeeper(appCodec, keys[oracletypes.StoreKey],
+	app.OracleKeeper = oraclekeeper.NewKeeper(appCodec, keys[oracletypes.StoreKey], tkeys[oracletypes.TStoreKey],
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.StakingKeeper, app.slashingKeeper,
 		app.SudoKeeper,
 		distrtypes.ModuleName,
@@ -372,6 +371,7 @@ func (app *NibiruApp) InitKeepers(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.SudoKeeper,
 		cast.ToString(appOpts.Get("evm.tracer")),
 	)
 
@@ -396,7 +396,7 @@ func (app *NibiruApp) InitKeepers(
 		app.BankKeeper,
 	)
 
-	app.ibcTransferKeeper = ibctransferkeeper.NewKeeper(
+	app.IBCTransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		/* paramSubspace */ app.GetSubspace(ibctransfertypes.ModuleName),
@@ -458,7 +458,7 @@ func (app *NibiruApp) InitKeepers(
 		app.ibcKeeper.ChannelKeeper,
 		&app.ibcKeeper.PortKeeper,
 		app.ScopedWasmKeeper,
-		app.ibcTransferKeeper,
+		app.IBCTransferKeeper,
 		app.MsgServiceRouter(),
 		app.GRPCQueryRouter(),
 		wasmDir,
@@ -468,16 +468,19 @@ func (app *NibiruApp) InitKeepers(
 		GetWasmOpts(*app, appOpts)...,
 	)
 
-	// DevGas uses WasmKeeper
+	// DevGas uses WasmKeeper and EvmKeeper
 	app.DevGasKeeper = devgaskeeper.NewKeeper(
 		keys[devgastypes.StoreKey],
+		tkeys[devgastypes.TStoreKey],
 		appCodec,
 		app.BankKeeper,
 		app.WasmKeeper,
 		app.AccountKeeper,
+		&app.EvmKeeper,
 		authtypes.FeeCollectorName,
 		govModuleAddr,
 	)
+	app.EvmKeeper.SetHooks(app.DevGasKeeper.EvmHooks())
 
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		keys[tokenfactorytypes.StoreKey],
@@ -487,6 +490,7 @@ func (app *NibiruApp) InitKeepers(
 		app.DistrKeeper,
 		govModuleAddr,
 	)
+	app.BankKeeper.SetHooks(app.TokenFactoryKeeper)
 
 	// register the proposal types
 
@@ -517,7 +521,7 @@ func (app *NibiruApp) InitKeepers(
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
-	transferStack = ibctransfer.NewIBCModule(app.ibcTransferKeeper)
+	transferStack = ibctransfer.NewIBCModule(app.IBCTransferKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.ibcFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -605,7 +609,7 @@ func (app *NibiruApp) initAppModules(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		NewBankAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper, false),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
@@ -626,7 +630,7 @@ func (app *NibiruApp) initAppModules(
 		// ibc
 		evidence.NewAppModule(app.evidenceKeeper),
 		ibc.NewAppModule(app.ibcKeeper),
-		ibctransfer.NewAppModule(app.ibcTransferKeeper),
+		ibctransfer.NewAppModule(app.IBCTransferKeeper),
 		ibcfee.NewAppModule(app.ibcFeeKeeper),
 		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
 
@@ -690,6 +694,10 @@ func orderedModuleNames() []string {
 		// --------------------------------------------------------------------
 		// Native x/ Modules
 		epochstypes.ModuleName,
+		// NOTE (BeginBlocker requirement): x/oracle must come after
+		//   x/distribution and x/epochs, which move coins out of and into the
+		//   fee collector, so that it only takes its share of the
+		//   transaction fees of the block.
 		oracletypes.ModuleName,
 		inflationtypes.ModuleName,
 		sudotypes.ModuleName,
@@ -822,16 +830,17 @@ func ModuleBasicManager() module.BasicManager {
 
 func ModuleAccPerms() map[string][]string {
 	return map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		inflationtypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		oracletypes.ModuleName:         {},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		ibcfeetypes.ModuleName:         {},
-		icatypes.ModuleName:            {},
+		authtypes.FeeCollectorName:       nil,
+		distrtypes.ModuleName:            nil,
+		inflationtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:              {authtypes.Burner},
+		oracletypes.ModuleName:           {},
+		oracletypes.FeePoolModuleAccount: {},
+		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:           {},
+		icatypes.ModuleName:              {},
 
 		evm.ModuleName:                   {authtypes.Minter, authtypes.Burner},
 		epochstypes.ModuleName:           {},
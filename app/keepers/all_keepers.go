@@ -4,7 +4,6 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -16,6 +15,7 @@ import (
 	// ---------------------------------------------------------------
 	// IBC imports
 
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
 	ibcmock "github.com/cosmos/ibc-go/v7/testing/mock"
 
 	// ---------------------------------------------------------------
@@ -36,7 +36,7 @@ type PublicKeepers struct {
 	// AccountKeeper encodes/decodes accounts using the go-amino (binary) encoding/decoding library
 	AccountKeeper authkeeper.AccountKeeper
 	// BankKeeper defines a module interface that facilitates the transfer of coins between accounts
-	BankKeeper    bankkeeper.Keeper
+	BankKeeper    *BankKeeperWithHooks
 	StakingKeeper *stakingkeeper.Keeper
 	/* DistrKeeper is the keeper of the distribution store */
 	DistrKeeper           distrkeeper.Keeper
@@ -50,6 +50,9 @@ type PublicKeepers struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 
+	/* IBCTransferKeeper is for cross-chain fungible token transfers. */
+	IBCTransferKeeper ibctransferkeeper.Keeper
+
 	// make IBC modules public for test purposes
 	// these modules are never directly routed to by the IBC Router
 	FeeMockModule ibcmock.IBCModule
@@ -0,0 +1,113 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankSendHooks: Hooks that run before the x/bank keeper moves coins between
+// addresses. An error from a hook aborts the transfer. The inputs and outputs
+// of a multi-send have no single counterparty, so the hooks run once for each
+// of them with an empty address on the other side.
+type BankSendHooks interface {
+	BeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
+var _ bankkeeper.Keeper = (*BankKeeperWithHooks)(nil)
+
+// BankKeeperWithHooks: The x/bank keeper with send hooks. The Cosmos-SDK v0.47
+// bank keeper has no extension point to restrict transfers, so this wrapper
+// runs the hooks before each of its send methods. Every module that holds it
+// is covered, including the bank Msg service, IBC transfers, and the EVM.
+type BankKeeperWithHooks struct {
+	bankkeeper.BaseKeeper
+	hooks BankSendHooks
+}
+
+// NewBankKeeperWithHooks wraps the x/bank keeper. Set the hooks with
+// [BankKeeperWithHooks.SetHooks].
+func NewBankKeeperWithHooks(base bankkeeper.BaseKeeper) *BankKeeperWithHooks {
+	return &BankKeeperWithHooks{BaseKeeper: base}
+}
+
+// SetHooks sets the send hooks. The hooks are set after construction because
+// the keepers that implement them depend on the bank keeper.
+func (k *BankKeeperWithHooks) SetHooks(hooks BankSendHooks) *BankKeeperWithHooks {
+	k.hooks = hooks
+	return k
+}
+
+func (k *BankKeeperWithHooks) beforeSend(
+	ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins,
+) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.BeforeSend(ctx, from, to, amt)
+}
+
+func (k *BankKeeperWithHooks) SendCoins(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func (k *BankKeeperWithHooks) InputOutputCoins(
+	ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output,
+) error {
+	for _, in := range inputs {
+		fromAddr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.beforeSend(ctx, fromAddr, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		toAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.beforeSend(ctx, nil, toAddr, out.Coins); err != nil {
+			return err
+		}
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+func (k *BankKeeperWithHooks) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	fromAddr := authtypes.NewModuleAddress(senderModule)
+	if err := k.beforeSend(ctx, fromAddr, recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+func (k *BankKeeperWithHooks) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	fromAddr := authtypes.NewModuleAddress(senderModule)
+	toAddr := authtypes.NewModuleAddress(recipientModule)
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+func (k *BankKeeperWithHooks) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	toAddr := authtypes.NewModuleAddress(recipientModule)
+	if err := k.beforeSend(ctx, senderAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -20,6 +22,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/NibiruChain/nibiru/app/appconst"
+	"github.com/NibiruChain/nibiru/app/keepers"
 )
 
 // BankModule defines a custom wrapper around the x/bank module's AppModuleBasic
@@ -57,6 +60,50 @@ func (BankModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BankAppModule wraps the x/bank AppModule so that its Msg service runs the
+// bank send hooks. The x/bank AppModule asserts that its keeper is a
+// bankkeeper.BaseKeeper to register store migrations, which would leave
+// MsgSend and MsgMultiSend without the hooks.
+type BankAppModule struct {
+	bank.AppModule
+	keeper         *keepers.BankKeeperWithHooks
+	legacySubspace bankexported.Subspace
+}
+
+// NewBankAppModule creates the x/bank AppModule for a bank keeper with send
+// hooks.
+func NewBankAppModule(
+	cdc codec.Codec,
+	keeper *keepers.BankKeeperWithHooks,
+	accountKeeper banktypes.AccountKeeper,
+	legacySubspace bankexported.Subspace,
+) BankAppModule {
+	return BankAppModule{
+		AppModule:      bank.NewAppModule(cdc, keeper.BaseKeeper, accountKeeper, legacySubspace),
+		keeper:         keeper,
+		legacySubspace: legacySubspace,
+	}
+}
+
+// RegisterServices registers the x/bank services with the hooked keeper and
+// the x/bank store migrations with the underlying base keeper.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper, am.legacySubspace)
+	for fromVersion, migrate := range []module.MigrationHandler{
+		m.Migrate1to2, m.Migrate2to3, m.Migrate3to4,
+	} {
+		if err := cfg.RegisterMigration(banktypes.ModuleName, uint64(fromVersion+1), migrate); err != nil {
+			panic(fmt.Sprintf(
+				"failed to migrate x/bank from version %d to %d: %v",
+				fromVersion+1, fromVersion+2, err,
+			))
+		}
+	}
+}
+
 // StakingModule defines a custom wrapper around the x/staking module's
 // AppModuleBasic implementation to provide custom default genesis state.
 type StakingModule struct {
@@ -0,0 +1,149 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// OracleProposalHandler wraps the PrepareProposal and ProcessProposal handlers
+// of the app so that direct oracle votes
+// ("MsgAggregateExchangeRateDirectVote") lead every block.
+//
+// When the "direct_vote_enabled" param of x/oracle is set, the proposer moves
+// the direct votes to the top of the proposal and keeps at most one per
+// validator, so the prices are recorded before any other transaction of the
+// block executes. Validators reject proposals that break this ordering, that
+// repeat a validator, or that carry an invalid direct vote. A direct vote is
+// invalid if its feeder may not vote for the validator or if the validator is
+// not in the active set. When the param is
+// not set, direct votes are dropped from proposals and rejected in them.
+type OracleProposalHandler struct {
+	txDecoder       sdk.TxDecoder
+	oracleKeeper    oraclekeeper.Keeper
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewOracleProposalHandler: Creates an OracleProposalHandler that runs the
+// given handlers after its own checks.
+func NewOracleProposalHandler(
+	txDecoder sdk.TxDecoder,
+	oracleKeeper oraclekeeper.Keeper,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) OracleProposalHandler {
+	return OracleProposalHandler{
+		txDecoder:       txDecoder,
+		oracleKeeper:    oracleKeeper,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler: Reorders the transactions proposed by CometBFT so
+// that the direct oracle votes come first. Invalid and duplicate direct votes
+// are dropped. The block size limit is applied by the wrapped handler.
+func (h OracleProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		enabled := h.directVoteEnabled(ctx)
+		voters := make(map[string]bool)
+		var voteTxs, otherTxs [][]byte
+		for _, txBz := range req.Txs {
+			votes, isVoteTx := h.directVotes(txBz)
+			if !isVoteTx {
+				otherTxs = append(otherTxs, txBz)
+				continue
+			}
+			if enabled && h.validDirectVotes(ctx, votes, voters) {
+				voteTxs = append(voteTxs, txBz)
+			}
+		}
+		req.Txs = append(voteTxs, otherTxs...)
+		return h.prepareProposal(ctx, req)
+	}
+}
+
+// ProcessProposalHandler: Rejects proposals in which a direct oracle vote
+// follows another kind of transaction, in which a validator has more than one
+// direct vote, or that carry an invalid direct vote. Direct votes are rejected
+// altogether while they are disabled.
+func (h OracleProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		reject := abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		enabled := h.directVoteEnabled(ctx)
+		voters := make(map[string]bool)
+		seenOtherTx := false
+		for _, txBz := range req.Txs {
+			votes, isVoteTx := h.directVotes(txBz)
+			if !isVoteTx {
+				seenOtherTx = true
+				continue
+			}
+			if !enabled || seenOtherTx || !h.validDirectVotes(ctx, votes, voters) {
+				return reject
+			}
+		}
+		return h.processProposal(ctx, req)
+	}
+}
+
+// directVotes: Returns the direct oracle votes of a transaction and whether it
+// has any. Transactions that fail to decode are not vote transactions.
+func (h OracleProposalHandler) directVotes(
+	txBz []byte,
+) (votes []*oracletypes.MsgAggregateExchangeRateDirectVote, isVoteTx bool) {
+	tx, err := h.txDecoder(txBz)
+	if err != nil {
+		return nil, false
+	}
+	for _, msg := range tx.GetMsgs() {
+		if vote, ok := msg.(*oracletypes.MsgAggregateExchangeRateDirectVote); ok {
+			votes = append(votes, vote)
+		}
+	}
+	return votes, len(votes) > 0
+}
+
+func (h OracleProposalHandler) directVoteEnabled(ctx sdk.Context) bool {
+	params, err := h.oracleKeeper.Params.Get(ctx)
+	return err == nil && params.DirectVoteEnabled
+}
+
+// validDirectVotes: Checks the votes of a transaction and records their
+// validators in "voters". Returns false if a vote is invalid or its validator
+// already has a direct vote in the proposal.
+//
+// A vote is only valid if its feeder may vote for a validator in the active
+// set. Without this check, anyone could name a validator in a vote of their own
+// and crowd out the real vote of that validator from the proposal.
+func (h OracleProposalHandler) validDirectVotes(
+	ctx sdk.Context,
+	votes []*oracletypes.MsgAggregateExchangeRateDirectVote,
+	voters map[string]bool,
+) bool {
+	txVoters := make(map[string]bool)
+	for _, vote := range votes {
+		if vote.ValidateBasic() != nil || voters[vote.Validator] || txVoters[vote.Validator] {
+			return false
+		}
+		feederAddr, err := sdk.AccAddressFromBech32(vote.Feeder)
+		if err != nil {
+			return false
+		}
+		valAddr, err := sdk.ValAddressFromBech32(vote.Validator)
+		if err != nil {
+			return false
+		}
+		if h.oracleKeeper.ValidateFeeder(ctx, feederAddr, valAddr) != nil {
+			return false
+		}
+		txVoters[vote.Validator] = true
+	}
+	for validator := range txVoters {
+		voters[validator] = true
+	}
+	return true
+}
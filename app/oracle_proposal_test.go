@@ -0,0 +1,112 @@
+package app_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func (s *TestSuite) TestOracleProposalHandler() {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	handler := app.NewOracleProposalHandler(
+		s.encCfg.TxConfig.TxDecoder(),
+		nibiru.OracleKeeper,
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+	)
+	prepare := handler.PrepareProposalHandler()
+	process := handler.ProcessProposalHandler()
+
+	encodeTx := func(msgs ...sdk.Msg) []byte {
+		txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		txBz, err := s.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		return txBz
+	}
+	feeder := testutil.AccAddress()
+	directVoteTx := func(validator sdk.ValAddress) []byte {
+		return encodeTx(oracletypes.NewMsgAggregateExchangeRateDirectVote(
+			"(ubtc:uusd,69420.0)", feeder, validator,
+		))
+	}
+
+	// Both validators are in the active set and delegate their votes to "feeder".
+	genesisVal := nibiru.StakingKeeper.GetValidators(ctx, 1)[0]
+	valA, valB := genesisVal.GetOperator(), sdk.ValAddress(testutil.AccAddress())
+	validatorB := genesisVal
+	validatorB.OperatorAddress = valB.String()
+	nibiru.StakingKeeper.SetValidator(ctx, validatorB)
+	for _, val := range []sdk.ValAddress{valA, valB} {
+		nibiru.OracleKeeper.FeederDelegations.Insert(ctx, val, feeder)
+	}
+	inactiveVal := validatorB
+	inactiveVal.OperatorAddress = sdk.ValAddress(testutil.AccAddress()).String()
+	inactiveVal.Status = stakingtypes.Unbonded
+	nibiru.StakingKeeper.SetValidator(ctx, inactiveVal)
+
+	sendTx := encodeTx(banktypes.NewMsgSend(
+		feeder, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin("unibi", 1)),
+	))
+	voteTxA, voteTxB := directVoteTx(valA), directVoteTx(valB)
+	duplicateVoteTxA := encodeTx(oracletypes.NewMsgAggregateExchangeRateDirectVote(
+		"(ueth:uusd,1000.0)", feeder, valA,
+	))
+	invalidVoteTx := encodeTx(&oracletypes.MsgAggregateExchangeRateDirectVote{
+		ExchangeRates: "", Feeder: feeder.String(), Validator: valB.String(),
+	})
+	// A vote for valA from an account that is not its feeder. It must not
+	// shadow the real vote of valA that comes after it.
+	shadowVoteTxA := encodeTx(oracletypes.NewMsgAggregateExchangeRateDirectVote(
+		"(ubtc:uusd,1.0)", testutil.AccAddress(), valA,
+	))
+	inactiveVoteTx := directVoteTx(inactiveVal.GetOperator())
+
+	s.T().Log("direct votes disabled: dropped by the proposer, rejected by validators")
+	resp := prepare(ctx, abci.RequestPrepareProposal{Txs: [][]byte{sendTx, voteTxA}})
+	s.Equal([][]byte{sendTx}, resp.Txs)
+	s.Equal(abci.ResponseProcessProposal_REJECT,
+		process(ctx, abci.RequestProcessProposal{Txs: [][]byte{voteTxA, sendTx}}).Status)
+	s.Equal(abci.ResponseProcessProposal_ACCEPT,
+		process(ctx, abci.RequestProcessProposal{Txs: [][]byte{sendTx}}).Status)
+
+	params, err := nibiru.OracleKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.DirectVoteEnabled = true
+	nibiru.OracleKeeper.Params.Set(ctx, params)
+
+	s.T().Log("direct votes enabled: moved to the top, invalid and duplicate votes dropped")
+	resp = prepare(ctx, abci.RequestPrepareProposal{Txs: [][]byte{
+		sendTx, voteTxA, invalidVoteTx, duplicateVoteTxA, voteTxB,
+	}})
+	s.Equal([][]byte{voteTxA, voteTxB, sendTx}, resp.Txs)
+	s.Equal(abci.ResponseProcessProposal_ACCEPT,
+		process(ctx, abci.RequestProcessProposal{Txs: resp.Txs}).Status)
+
+	s.T().Log("direct votes enabled: votes from non-feeders and inactive validators dropped")
+	resp = prepare(ctx, abci.RequestPrepareProposal{Txs: [][]byte{
+		shadowVoteTxA, inactiveVoteTx, sendTx, voteTxA,
+	}})
+	s.Equal([][]byte{voteTxA, sendTx}, resp.Txs)
+	s.Equal(abci.ResponseProcessProposal_ACCEPT,
+		process(ctx, abci.RequestProcessProposal{Txs: resp.Txs}).Status)
+
+	s.T().Log("direct votes enabled: proposals breaking the rules are rejected")
+	for _, txs := range [][][]byte{
+		{sendTx, voteTxA},
+		{voteTxA, duplicateVoteTxA},
+		{invalidVoteTx, sendTx},
+		{shadowVoteTxA, sendTx},
+		{inactiveVoteTx, sendTx},
+	} {
+		s.Equal(abci.ResponseProcessProposal_REJECT,
+			process(ctx, abci.RequestProcessProposal{Txs: txs}).Status)
+	}
+}
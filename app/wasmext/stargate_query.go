@@ -119,26 +119,36 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.inflation.v1.Query/Params":             new(inflation.QueryParamsResponse),
 
 		// nibiru oracle
-		"/nibiru.oracle.v1.Query/ExchangeRate":      new(oracle.QueryExchangeRateResponse),
-		"/nibiru.oracle.v1.Query/ExchangeRateTwap":  new(oracle.QueryExchangeRateResponse),
-		"/nibiru.oracle.v1.Query/ExchangeRates":     new(oracle.QueryExchangeRatesResponse),
-		"/nibiru.oracle.v1.Query/Actives":           new(oracle.QueryActivesResponse),
-		"/nibiru.oracle.v1.Query/VoteTargets":       new(oracle.QueryVoteTargetsResponse),
-		"/nibiru.oracle.v1.Query/FeederDelegation":  new(oracle.QueryFeederDelegationResponse),
-		"/nibiru.oracle.v1.Query/MissCounter":       new(oracle.QueryMissCounterResponse),
-		"/nibiru.oracle.v1.Query/AggregatePrevote":  new(oracle.QueryAggregatePrevoteResponse),
-		"/nibiru.oracle.v1.Query/AggregatePrevotes": new(oracle.QueryAggregatePrevotesResponse),
-		"/nibiru.oracle.v1.Query/AggregateVote":     new(oracle.QueryAggregateVoteResponse),
-		"/nibiru.oracle.v1.Query/AggregateVotes":    new(oracle.QueryAggregateVotesResponse),
-		"/nibiru.oracle.v1.Query/Params":            new(oracle.QueryParamsResponse),
+		"/nibiru.oracle.v1.Query/ExchangeRate":         new(oracle.QueryExchangeRateResponse),
+		"/nibiru.oracle.v1.Query/ExchangeRateTwap":     new(oracle.QueryExchangeRateResponse),
+		"/nibiru.oracle.v1.Query/ExchangeRates":        new(oracle.QueryExchangeRatesResponse),
+		"/nibiru.oracle.v1.Query/Actives":              new(oracle.QueryActivesResponse),
+		"/nibiru.oracle.v1.Query/VoteTargets":          new(oracle.QueryVoteTargetsResponse),
+		"/nibiru.oracle.v1.Query/FeederDelegation":     new(oracle.QueryFeederDelegationResponse),
+		"/nibiru.oracle.v1.Query/MissCounter":          new(oracle.QueryMissCounterResponse),
+		"/nibiru.oracle.v1.Query/AggregatePrevote":     new(oracle.QueryAggregatePrevoteResponse),
+		"/nibiru.oracle.v1.Query/AggregatePrevotes":    new(oracle.QueryAggregatePrevotesResponse),
+		"/nibiru.oracle.v1.Query/AggregateVote":        new(oracle.QueryAggregateVoteResponse),
+		"/nibiru.oracle.v1.Query/AggregateVotes":       new(oracle.QueryAggregateVotesResponse),
+		"/nibiru.oracle.v1.Query/Params":               new(oracle.QueryParamsResponse),
+		"/nibiru.oracle.v1.Query/Rewards":              new(oracle.QueryRewardsResponse),
+		"/nibiru.oracle.v1.Query/PriceHistory":         new(oracle.QueryPriceHistoryResponse),
+		"/nibiru.oracle.v1.Query/TwapOverWindow":       new(oracle.QueryTwapOverWindowResponse),
+		"/nibiru.oracle.v1.Query/Ohlc":                 new(oracle.QueryOhlcResponse),
+		"/nibiru.oracle.v1.Query/PairParams":           new(oracle.QueryPairParamsResponse),
+		"/nibiru.oracle.v1.Query/ValidatorPerformance": new(oracle.QueryValidatorPerformanceResponse),
+		"/nibiru.oracle.v1.Query/SlashPreview":         new(oracle.QuerySlashPreviewResponse),
 
 		// nibiru sudo
-		"/nibiru.sudo.v1.Query/QuerySudoers": new(sudotypes.QuerySudoersResponse),
+		"/nibiru.sudo.v1.Query/QuerySudoers":       new(sudotypes.QuerySudoersResponse),
+		"/nibiru.sudo.v1.Query/QueryPermissions":   new(sudotypes.QueryPermissionsResponse),
+		"/nibiru.sudo.v1.Query/PendingSudoActions": new(sudotypes.QueryPendingSudoActionsResponse),
 
 		// nibiru devgas
 		"/nibiru.devgas.v1.Query/FeeShares":             new(devgas.QueryFeeSharesResponse),
 		"/nibiru.devgas.v1.Query/FeeShare":              new(devgas.QueryFeeShareResponse),
 		"/nibiru.devgas.v1.Query/Params":                new(devgas.QueryParamsResponse),
 		"/nibiru.devgas.v1.Query/FeeSharesByWithdrawer": new(devgas.QueryFeeSharesByWithdrawerResponse),
+		"/nibiru.devgas.v1.Query/FeeShareEarnings":      new(devgas.QueryFeeShareEarningsResponse),
 	}
 }
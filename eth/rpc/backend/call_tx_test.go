@@ -93,6 +93,7 @@ func (s *BackendSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			evm.JsonTxArgs{
 				Nonce: &txNonce,
@@ -480,6 +481,7 @@ func (s *BackendSuite) TestGasPrice() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFee(queryClient, math.NewInt(1))
+				RegisterParamsWithoutHeader(queryClient, 1)
 			},
 			defaultGasPrice,
 			true,
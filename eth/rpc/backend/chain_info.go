@@ -6,9 +6,11 @@ import (
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -50,19 +52,43 @@ func (b *Backend) ChainConfig() *params.ChainConfig {
 	return evm.EthereumConfig(b.chainID)
 }
 
-// BaseFee returns the base fee tracked by the Fee Market module.
-// If the base fee is not enabled globally, the query returns nil.
+// BaseFee returns the EIP-1559 base fee of the given block. The base fee is
+// queried from the EVM module at the block height. If the state for that height
+// is unavailable (e.g. on a pruned node), the base fee is parsed from the
+// "EventBlockBaseFee" emitted at the start of the block. If neither is
+// available, the base fee is nil.
 func (b *Backend) BaseFee(
 	blockRes *tmrpctypes.ResultBlockResults,
 ) (baseFee *big.Int, err error) {
-	// return BaseFee if feemarket is enabled
 	res, err := b.queryClient.BaseFee(rpc.NewContextWithHeight(blockRes.Height), &evm.QueryBaseFeeRequest{})
-	if err != nil || res.BaseFee == nil {
-		baseFee = nil
-		// TODO: feat: dynamic fee handling on events
-		return baseFee, nil
+	if err == nil && res.BaseFee != nil {
+		return res.BaseFee.BigInt(), nil
 	}
-	return res.BaseFee.BigInt(), nil
+	return BaseFeeFromEvents(blockRes.BeginBlockEvents), nil
+}
+
+// BaseFeeFromEvents parses the base fee from the "EventBlockBaseFee" emitted
+// by the EVM module in BeginBlock. Returns nil if the event is not found.
+func BaseFeeFromEvents(events []abci.Event) *big.Int {
+	for _, event := range events {
+		if event.Type != proto.MessageName(new(evm.EventBlockBaseFee)) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		eventBaseFee, ok := typedEvent.(*evm.EventBlockBaseFee)
+		if !ok {
+			continue
+		}
+		baseFee, ok := new(big.Int).SetString(eventBaseFee.BaseFee, 10)
+		if !ok {
+			continue
+		}
+		return baseFee
+	}
+	return nil
 }
 
 // CurrentHeader returns the latest block header
@@ -194,18 +220,36 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap: Not yet supported. Returns 0 as the suggested tip cap. After
-// implementing tx prioritization, this function can come to life.
+// SuggestGasTipCap returns the suggested priority fee (tip) for a transaction
+// to be included in the next block. The suggestion is the maximum amount the
+// base fee can increase in one block, which happens when a block consumes its
+// entire gas limit:
+//
+//	MaxDelta = BaseFee * (ElasticityMultiplier - 1) / BaseFeeChangeDenominator
+//
+// A tip of this size guarantees that the transaction stays includable even if
+// the next block is full. Returns 0 if the base fee is nil or static.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
-	maxDelta := big.NewInt(0)
-	return maxDelta, nil
+	if baseFee == nil {
+		return big.NewInt(0), nil
+	}
+	res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return evm.MaxBaseFeeDelta(res.Params, baseFee), nil
 }
 
 func DefaultMinGasPrice() sdkmath.LegacyDec { return sdkmath.LegacyZeroDec() }
 
 // GlobalMinGasPrice returns the minimum gas price for all nodes. This is
-// distinct from the individual configuration set by the validator set.
+// distinct from the individual configuration set by the validator set. The
+// global minimum is the lower bound for the EIP-1559 base fee.
 func (b *Backend) GlobalMinGasPrice() (sdkmath.LegacyDec, error) {
-	// TODO: feat(eth): dynamic fees
-	return DefaultMinGasPrice(), nil
+	res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+	if err != nil {
+		return DefaultMinGasPrice(), err
+	}
+	minBaseFee := res.Params.MinBaseFeeBigInt()
+	return sdkmath.LegacyNewDecFromBigInt(minBaseFee), nil
 }
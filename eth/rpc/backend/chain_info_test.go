@@ -32,17 +32,33 @@ func (s *BackendSuite) TestBaseFee() {
 		expBaseFee   *big.Int
 		expPass      bool
 	}{
-		// TODO: test(eth): Test base fee query after it's enabled.
-		// {
-		// 	"fail - grpc BaseFee error",
-		// 	&tmrpctypes.ResultBlockResults{Height: 1},
-		// 	func() {
-		// 		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
-		// 		RegisterBaseFeeError(queryClient)
-		// 	},
-		// 	nil,
-		// 	false,
-		// },
+		{
+			name: "pass - grpc BaseFee error - with base fee block event",
+			blockRes: &tmrpctypes.ResultBlockResults{
+				Height: 1,
+				BeginBlockEvents: []types.Event{
+					newEventBlockBaseFee(s, "42"),
+				},
+			},
+			registerMock: func() {
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFeeError(queryClient)
+			},
+			expBaseFee: big.NewInt(42),
+			expPass:    true,
+		},
+		{
+			name: "pass - grpc BaseFee error - without base fee block event",
+			blockRes: &tmrpctypes.ResultBlockResults{
+				Height: 1,
+			},
+			registerMock: func() {
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFeeError(queryClient)
+			},
+			expBaseFee: nil,
+			expPass:    true,
+		},
 		{
 			name: "pass - grpc BaseFee error - with non feemarket block event",
 			blockRes: &tmrpctypes.ResultBlockResults{
@@ -98,6 +114,12 @@ func (s *BackendSuite) TestBaseFee() {
 	}
 }
 
+func newEventBlockBaseFee(s *BackendSuite, baseFee string) types.Event {
+	event, err := sdk.TypedEventToEvent(&evm.EventBlockBaseFee{BaseFee: baseFee})
+	s.Require().NoError(err)
+	return types.Event(event)
+}
+
 func (s *BackendSuite) TestChainId() {
 	expChainIDNumber, err := eth.ParseEthChainID(eth.EIP155ChainID_Testnet)
 	s.Require().NoError(err)
@@ -153,9 +175,12 @@ func (s *BackendSuite) TestSuggestGasTipCap() {
 		},
 		{
 			"pass - Gets the suggest gas tip cap ",
-			func() {},
-			nil,
-			big.NewInt(0),
+			func() {
+				queryClient := s.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			big.NewInt(8_000),
+			big.NewInt(1_000), // 8_000 * (2 - 1) / 8
 			true,
 		},
 	}
@@ -257,11 +282,9 @@ func (s *BackendSuite) TestFeeHistory() {
 				s.Require().NoError(err)
 
 				RegisterBaseFee(queryClient, baseFee)
+				RegisterBaseFeeUncommittedHeight(queryClient, blockHeight+1)
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, blockHeight)
-
-				header := new(metadata.MD)
-				RegisterParams(queryClient, header, blockHeight)
 				RegisterParamsWithoutHeader(queryClient, blockHeight)
 			},
 			userBlockCount: 1,
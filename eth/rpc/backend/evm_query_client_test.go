@@ -207,6 +207,12 @@ func RegisterBaseFeeDisabled(queryClient *mocks.EVMQueryClient) {
 		Return(&evm.QueryBaseFeeResponse{}, nil)
 }
 
+// Base fee query for a block that has not been committed yet
+func RegisterBaseFeeUncommittedHeight(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("BaseFee", rpc.NewContextWithHeight(height), &evm.QueryBaseFeeRequest{}).
+		Return(nil, errortypes.ErrInvalidHeight)
+}
+
 func TestRegisterBaseFee(t *testing.T) {
 	baseFee := math.NewInt(1)
 	queryClient := mocks.NewEVMQueryClient(t)
@@ -409,6 +409,66 @@ func (_m *EVMQueryClient) FunTokenMapping(ctx context.Context, in *evm.QueryFunT
 	return r0, r1
 }
 
+// VerifiedFunTokens provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) VerifiedFunTokens(ctx context.Context, in *evm.QueryVerifiedFunTokensRequest, opts ...grpc.CallOption) (*evm.QueryVerifiedFunTokensResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryVerifiedFunTokensResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.QueryVerifiedFunTokensRequest, ...grpc.CallOption) *evm.QueryVerifiedFunTokensResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryVerifiedFunTokensResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.QueryVerifiedFunTokensRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FunTokenSupplyDiscrepancies provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) FunTokenSupplyDiscrepancies(ctx context.Context, in *evm.QueryFunTokenSupplyDiscrepanciesRequest, opts ...grpc.CallOption) (*evm.QueryFunTokenSupplyDiscrepanciesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *evm.QueryFunTokenSupplyDiscrepanciesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *evm.QueryFunTokenSupplyDiscrepanciesRequest, ...grpc.CallOption) *evm.QueryFunTokenSupplyDiscrepanciesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evm.QueryFunTokenSupplyDiscrepanciesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *evm.QueryFunTokenSupplyDiscrepanciesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewEVMQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -15,7 +15,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -103,6 +102,28 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// nextBaseFee returns the base fee of the block after the given block height.
+// If the next block has not been committed yet, the base fee is computed from
+// the EVM module params and the gas used by the given block.
+func (b *Backend) nextBaseFee(
+	blockHeight int64, blockBaseFee *big.Int, gasUsed, gasLimit uint64,
+) (*big.Int, error) {
+	nextBaseFeeRes, err := b.queryClient.BaseFee(
+		rpc.NewContextWithHeight(blockHeight+1), &evm.QueryBaseFeeRequest{},
+	)
+	if err == nil && nextBaseFeeRes.BaseFee != nil {
+		return nextBaseFeeRes.BaseFee.BigInt(), nil
+	}
+
+	paramsRes, err := b.queryClient.Params(
+		rpc.NewContextWithHeight(blockHeight), &evm.QueryParamsRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return evm.CalcBaseFee(paramsRes.Params, blockBaseFee, gasUsed, gasLimit), nil
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
@@ -119,12 +140,6 @@ func (b *Backend) processBlock(
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	header, err := b.CurrentHeader()
-	if err != nil {
-		return err
-	}
-	targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
 
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
@@ -143,6 +158,14 @@ func (b *Backend) processBlock(
 		return fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
 	}
 
+	nextBaseFee, err := b.nextBaseFee(
+		blockHeight, blockBaseFee, gasUsedBig.ToInt().Uint64(), uint64(gasLimitUint64),
+	)
+	if err != nil {
+		return err
+	}
+	targetOneFeeHistory.NextBaseFee = nextBaseFee
+
 	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
 	blockGasUsed := gasusedfloat
 	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
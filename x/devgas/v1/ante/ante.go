@@ -3,20 +3,24 @@ package ante
 import (
 	"encoding/json"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/NibiruChain/collections"
+
 	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/x/evm"
 )
 
-var _ sdk.AnteDecorator = (*DevGasPayoutDecorator)(nil)
+var _ sdk.PostDecorator = (*DevGasPayoutDecorator)(nil)
 
-// DevGasPayoutDecorator Run his after we already deduct the fee from the
-// account with the ante.NewDeductFeeDecorator() decorator. We pull funds from
-// the FeeCollector ModuleAccount
+// DevGasPayoutDecorator runs as a post handler, after the messages of the
+// transaction execute and the fee was deducted from the account by the
+// ante.NewDeductFeeDecorator() decorator. We pull funds from the FeeCollector
+// ModuleAccount and pay them out in proportion to the gas that each contract
+// consumed.
 type DevGasPayoutDecorator struct {
 	bankKeeper   BankKeeper
 	devgasKeeper IDevGasKeeper
@@ -31,46 +35,62 @@ func NewDevGasPayoutDecorator(
 	}
 }
 
-func (a DevGasPayoutDecorator) AnteHandle(
-	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+func (a DevGasPayoutDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler,
 ) (newCtx sdk.Context, err error) {
+	// Clear the gas records of the transaction whether or not they are paid.
+	contractGas := a.devgasKeeper.PopContractGas(ctx)
+
+	// Ethereum transactions pay developers through the x/evm hooks.
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evm.MsgEthereumTx); ok {
+			return next(ctx, tx, simulate, success)
+		}
+	}
+
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return ctx, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
 	}
 
-	err = a.devGasPayout(
-		ctx, feeTx,
-	)
-	if err != nil {
-		return ctx, sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
+	// The payout is best-effort, the same as for Ethereum transactions: if it
+	// fails, its state changes are discarded and the failure is logged and
+	// emitted as an event, but the transaction still succeeds.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := a.devGasPayout(cacheCtx, feeTx, contractGas); err != nil {
+		ctx.Logger().Error("failed to pay out dev gas", "error", err)
+		_ = ctx.EventManager().EmitTypedEvent(
+			&devgastypes.EventPayoutDevGasFailed{Error: err.Error()},
+		)
+	} else {
+		writeCache()
 	}
 
-	return next(ctx, tx, simulate)
+	return next(ctx, tx, simulate, success)
 }
 
 // devGasPayout takes the total fees and redistributes 50% (or param set) to
-// the contract developers provided they opted-in to payments.
+// the contract developers provided they opted-in to payments. Each developer
+// gets a part of the share weighted by the gas its contract consumed out of
+// the gas consumed by all the contracts the transaction executed.
 func (a DevGasPayoutDecorator) devGasPayout(
 	ctx sdk.Context,
 	tx sdk.FeeTx,
+	contractGas []collections.KeyValue[sdk.AccAddress, uint64],
 ) error {
 	params := a.devgasKeeper.GetParams(ctx)
 	if !params.EnableFeeShare {
 		return nil
 	}
 
-	toPay, err := a.getWithdrawAddressesFromMsgs(ctx, tx.GetMsgs())
-	if err != nil {
-		return err
-	}
+	toPay, totalGas := a.getWithdrawAddresses(ctx, contractGas)
 
 	// Do nothing if no one needs payment
 	if len(toPay) == 0 {
 		return nil
 	}
 
-	feesPaidOutput, err := a.settleFeePayments(ctx, toPay, params, tx.GetFee())
+	feesPaidOutput, err := a.settleFeePayments(ctx, toPay, params, tx.GetFee(), totalGas)
 	if err != nil {
 		return err
 	}
@@ -85,35 +105,36 @@ func (a DevGasPayoutDecorator) devGasPayout(
 	)
 }
 
-type FeeSharePayoutEventOutput struct {
-	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
-	FeesPaid        sdk.Coins      `json:"fees_paid"`
-}
+type FeeSharePayoutEventOutput = devgastypes.FeeSharePayoutEventOutput
 
-// settleFeePayments sends the funds to the contract developers
+// settleFeePayments sends the funds to the contract developers. The entries
+// of toPay carry the contract and the gas it consumed, while totalGas is the
+// gas consumed by all executed contracts.
 func (a DevGasPayoutDecorator) settleFeePayments(
-	ctx sdk.Context, toPay []sdk.AccAddress, params devgastypes.ModuleParams, totalFees sdk.Coins,
+	ctx sdk.Context,
+	toPay []FeeSharePayoutEventOutput,
+	params devgastypes.ModuleParams,
+	totalFees sdk.Coins,
+	totalGas uint64,
 ) ([]FeeSharePayoutEventOutput, error) {
 	allowedFees := getAllowedFees(params, totalFees)
 
-	numPairs := len(toPay)
-	feesPaidOutput := make([]FeeSharePayoutEventOutput, numPairs)
-	if numPairs > 0 {
-		govPercent := params.DeveloperShares
-		splitFees := FeePayLogic(allowedFees, govPercent, numPairs)
-
-		// pay fees evenly between all withdraw addresses
-		for i, withdrawAddr := range toPay {
-			err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawAddr, splitFees)
-			feesPaidOutput[i] = FeeSharePayoutEventOutput{
-				WithdrawAddress: withdrawAddr,
-				FeesPaid:        splitFees,
-			}
+	feesPaidOutput := make([]FeeSharePayoutEventOutput, 0, len(toPay))
+	govPercent := params.DeveloperShares
+	for _, payout := range toPay {
+		payout.FeesPaid = FeePayLogic(allowedFees, govPercent, payout.GasUsed, totalGas)
+		if payout.FeesPaid.IsZero() {
+			continue
+		}
 
-			if err != nil {
-				return nil, devgastypes.ErrFeeSharePayment.Wrapf("failed to pay allowedFees to contract developer: %s", err.Error())
-			}
+		err := a.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, authtypes.FeeCollectorName, payout.WithdrawAddress, payout.FeesPaid,
+		)
+		if err != nil {
+			return nil, devgastypes.ErrFeeSharePayment.Wrapf("failed to pay allowedFees to contract developer: %s", err.Error())
 		}
+		a.devgasKeeper.AddFeeShareEarnings(ctx, payout.ContractAddress, payout.FeesPaid)
+		feesPaidOutput = append(feesPaidOutput, payout)
 	}
 
 	return feesPaidOutput, nil
@@ -140,38 +161,44 @@ func getAllowedFees(params devgastypes.ModuleParams, totalFees sdk.Coins) sdk.Co
 	return allowedFees
 }
 
-// getWithdrawAddressesFromMsgs returns a list of all contract addresses that
-// have opted-in to receiving payments
-func (a DevGasPayoutDecorator) getWithdrawAddressesFromMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.AccAddress, error) {
-	toPay := make([]sdk.AccAddress, 0)
-	for _, msg := range msgs {
-		if _, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
-			contractAddr, err := sdk.AccAddressFromBech32(
-				msg.(*wasmtypes.MsgExecuteContract).Contract,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			shareData, _ := a.devgasKeeper.GetFeeShare(ctx, contractAddr)
-
-			withdrawAddr := shareData.GetWithdrawerAddr()
-			if withdrawAddr != nil && !withdrawAddr.Empty() {
-				toPay = append(toPay, withdrawAddr)
-			}
+// getWithdrawAddresses returns the contracts that have opted-in to receiving
+// payments, with their withdraw addresses and the gas they consumed, along
+// with the gas consumed by all the executed contracts.
+func (a DevGasPayoutDecorator) getWithdrawAddresses(
+	ctx sdk.Context, contractGas []collections.KeyValue[sdk.AccAddress, uint64],
+) (toPay []FeeSharePayoutEventOutput, totalGas uint64) {
+	toPay = make([]FeeSharePayoutEventOutput, 0)
+	for _, kv := range contractGas {
+		totalGas += kv.Value
+
+		shareData, _ := a.devgasKeeper.GetFeeShare(ctx, kv.Key)
+		withdrawAddr := shareData.GetWithdrawerAddr()
+		if withdrawAddr != nil && !withdrawAddr.Empty() {
+			toPay = append(toPay, FeeSharePayoutEventOutput{
+				ContractAddress: kv.Key,
+				WithdrawAddress: withdrawAddr,
+				GasUsed:         kv.Value,
+			})
 		}
 	}
 
-	return toPay, nil
+	return toPay, totalGas
 }
 
 // FeePayLogic takes the total fees and splits them based on the governance
-// params and the number of contracts we are executing on. This returns the
-// amount of fees each contract developer should get. tested in ante_test.go
-func FeePayLogic(fees sdk.Coins, govPercent sdk.Dec, numPairs int) sdk.Coins {
+// params and the share of the gas that a contract consumed out of the gas
+// consumed by all contracts we are executing on. This returns the amount of
+// fees the contract developer should get. tested in ante_test.go
+func FeePayLogic(fees sdk.Coins, govPercent sdk.Dec, gasUsed, totalGas uint64) sdk.Coins {
 	var splitFees sdk.Coins
+	if totalGas == 0 {
+		return splitFees
+	}
 	for _, c := range fees.Sort() {
-		rewardAmount := govPercent.MulInt(c.Amount).QuoInt64(int64(numPairs)).RoundInt()
+		rewardAmount := govPercent.MulInt(c.Amount).
+			MulInt(math.NewIntFromUint64(gasUsed)).
+			QuoInt(math.NewIntFromUint64(totalGas)).
+			RoundInt()
 		if !rewardAmount.IsZero() {
 			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
 		}
@@ -1,18 +1,20 @@
 package ante_test
 
 import (
-	"fmt"
-	"strings"
+	"encoding/json"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdkclienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
@@ -38,83 +40,106 @@ func (suite *AnteTestSuite) TestFeeLogic() {
 		name               string
 		incomingFee        sdk.Coins
 		govPercent         sdk.Dec
-		numContracts       int
+		gasUsed            uint64
+		totalGas           uint64
 		expectedFeePayment sdk.Coins
 	}{
 		{
 			"100% fee / 1 contract",
 			feeCoins,
 			math.LegacyNewDecWithPrec(100, 2),
-			1,
+			1, 1,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(500)), sdk.NewCoin("utoken", math.NewInt(250))),
 		},
 		{
 			"100% fee / 2 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(100, 2),
-			2,
+			1, 2,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(250)), sdk.NewCoin("utoken", math.NewInt(125))),
 		},
 		{
 			"100% fee / 10 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(100, 2),
-			10,
+			1, 10,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(50)), sdk.NewCoin("utoken", math.NewInt(25))),
 		},
 		{
 			"67% fee / 7 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(67, 2),
-			7,
+			1, 7,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(48)), sdk.NewCoin("utoken", math.NewInt(24))),
 		},
 		{
 			"50% fee / 1 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(50, 2),
-			1,
+			1, 1,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(250)), sdk.NewCoin("utoken", math.NewInt(125))),
 		},
 		{
 			"50% fee / 2 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(50, 2),
-			2,
+			1, 2,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(125)), sdk.NewCoin("utoken", math.NewInt(62))),
 		},
 		{
 			"50% fee / 3 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(50, 2),
-			3,
+			1, 3,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(83)), sdk.NewCoin("utoken", math.NewInt(42))),
 		},
 		{
 			"25% fee / 2 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(25, 2),
-			2,
+			1, 2,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(62)), sdk.NewCoin("utoken", math.NewInt(31))),
 		},
 		{
 			"15% fee / 3 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(15, 2),
-			3,
+			1, 3,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(25)), sdk.NewCoin("utoken", math.NewInt(12))),
 		},
 		{
 			"1% fee / 2 contracts",
 			feeCoins,
 			math.LegacyNewDecWithPrec(1, 2),
-			2,
+			1, 2,
 			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(2)), sdk.NewCoin("utoken", math.NewInt(1))),
 		},
+		{
+			"50% fee / 3 of 4 gas",
+			feeCoins,
+			math.LegacyNewDecWithPrec(50, 2),
+			3, 4,
+			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(188)), sdk.NewCoin("utoken", math.NewInt(94))),
+		},
+		{
+			"50% fee / 1 of 4 gas",
+			feeCoins,
+			math.LegacyNewDecWithPrec(50, 2),
+			1, 4,
+			sdk.NewCoins(sdk.NewCoin("unibi", math.NewInt(62)), sdk.NewCoin("utoken", math.NewInt(31))),
+		},
+		{
+			"50% fee / no gas",
+			feeCoins,
+			math.LegacyNewDecWithPrec(50, 2),
+			0, 0,
+			sdk.NewCoins(),
+		},
 	}
 
 	for _, tc := range testCases {
-		coins := devgasante.FeePayLogic(tc.incomingFee, tc.govPercent, tc.numContracts)
+		coins := devgasante.FeePayLogic(tc.incomingFee, tc.govPercent, tc.gasUsed, tc.totalGas)
+		suite.Require().Len(coins, len(tc.expectedFeePayment), tc.name)
 
 		for _, coin := range coins {
 			for _, expectedCoin := range tc.expectedFeePayment {
@@ -136,13 +161,6 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 	contracts := addrs[:5]
 	withdrawAddrs := addrs[5:10]
 	deployerAddr := addrs[10]
-	wasmExecMsgs := []*wasmtypes.MsgExecuteContract{
-		{Contract: contracts[0].String()},
-		{Contract: contracts[1].String()},
-		{Contract: contracts[2].String()},
-		{Contract: contracts[3].String()},
-		{Contract: contracts[4].String()},
-	}
 	devGasForWithdrawer := func(
 		contractIdx int, withdrawerIdx int,
 	) devgastypes.FeeShare {
@@ -152,76 +170,105 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 			WithdrawerAddress: withdrawAddrs[withdrawerIdx].String(),
 		}
 	}
+	fundFeeCollector := func() (*app.NibiruApp, sdk.Context) {
+		bapp, ctx := testapp.NewNibiruTestAppAndContext()
+		err := testapp.FundModuleAccount(
+			bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins)
+		suite.NoError(err)
+		return bapp, ctx
+	}
 
 	testCases := []struct {
-		name                    string
-		devGasState             []devgastypes.FeeShare
-		wantWithdrawerRoyalties sdk.Coins
-		wantErr                 bool
-		setup                   func() (*app.NibiruApp, sdk.Context)
+		name        string
+		devGasState []devgastypes.FeeShare
+		// contractGas: gas consumed by each of the contracts in the tx
+		contractGas []uint64
+		// wantWithdrawerRoyalties: royalties of each withdrawer. The
+		// developer share is 50%.
+		wantWithdrawerRoyalties []sdk.Coins
+		// wantPayoutFailed: the payout fails without failing the tx.
+		wantPayoutFailed bool
+		setup            func() (*app.NibiruApp, sdk.Context)
 	}{
 		{
-			name: "1 contract, 1 exec, 1 withdrawer",
+			name: "1 contract, 1 withdrawer",
 			devGasState: []devgastypes.FeeShare{
 				devGasForWithdrawer(0, 0),
 			},
-			// The expected royalty is gas / num_withdrawers / 2. Thus, We
-			// divide gas by (num_withdrawers * 2). The 2 comes from 50% split.
-			// wantWithdrawerRoyalties: num_withdrawers * 2 = 2
-			wantWithdrawerRoyalties: txGasCoins.QuoInt(math.NewInt(2)),
-			wantErr:                 false,
-			setup: func() (*app.NibiruApp, sdk.Context) {
-				bapp, ctx := testapp.NewNibiruTestAppAndContext()
-				err := testapp.FundModuleAccount(
-					bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins)
-				suite.NoError(err)
-				return bapp, ctx
-			},
+			contractGas:             []uint64{100},
+			wantWithdrawerRoyalties: []sdk.Coins{txGasCoins.QuoInt(math.NewInt(2))},
+			setup:                   fundFeeCollector,
 		},
 		{
-			name: "1 contract, 4 exec, 2 withdrawer",
+			name: "4 contracts with equal gas, 2 withdrawers",
 			devGasState: []devgastypes.FeeShare{
 				devGasForWithdrawer(0, 0),
 				devGasForWithdrawer(1, 0),
 				devGasForWithdrawer(2, 1),
 				devGasForWithdrawer(3, 1),
 			},
-			// The expected royalty is gas / num_withdrawers / 2. Thus, We
-			// divide gas by (num_withdrawers * 2). The 2 comes from 50% split.
-			// wantWithdrawerRoyalties: num_withdrawers * 2 = 4
-			wantWithdrawerRoyalties: txGasCoins.QuoInt(math.NewInt(4)),
-			wantErr:                 false,
-			setup: func() (*app.NibiruApp, sdk.Context) {
-				bapp, ctx := testapp.NewNibiruTestAppAndContext()
-				err := testapp.FundModuleAccount(
-					bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins)
-				suite.NoError(err)
-				return bapp, ctx
+			contractGas: []uint64{100, 100, 100, 100},
+			// Each contract is paid separately, so 62.5utoken rounds to 62.
+			wantWithdrawerRoyalties: []sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("unibi", 250), sdk.NewInt64Coin("utoken", 124)),
+				sdk.NewCoins(sdk.NewInt64Coin("unibi", 250), sdk.NewInt64Coin("utoken", 124)),
 			},
+			setup: fundFeeCollector,
 		},
 		{
-			name: "err: empty fee collector module account",
+			name: "payouts are weighted by gas",
 			devGasState: []devgastypes.FeeShare{
 				devGasForWithdrawer(0, 0),
+				devGasForWithdrawer(1, 1),
+			},
+			contractGas: []uint64{300, 100},
+			wantWithdrawerRoyalties: []sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("unibi", 375), sdk.NewInt64Coin("utoken", 188)),
+				sdk.NewCoins(sdk.NewInt64Coin("unibi", 125), sdk.NewInt64Coin("utoken", 62)),
 			},
-			// The expected royalty is gas / num_withdrawers / 2. Thus, We
-			// divide gas by (num_withdrawers * 2). The 2 comes from 50% split.
-			// wantWithdrawerRoyalties: num_withdrawers * 2 = 2
-			wantWithdrawerRoyalties: txGasCoins.QuoInt(math.NewInt(2)),
-			wantErr:                 true,
+			setup: fundFeeCollector,
+		},
+		{
+			name: "gas of unregistered contracts is not paid",
+			devGasState: []devgastypes.FeeShare{
+				devGasForWithdrawer(0, 0),
+			},
+			contractGas:             []uint64{100, 100},
+			wantWithdrawerRoyalties: []sdk.Coins{txGasCoins.QuoInt(math.NewInt(4))},
+			setup:                   fundFeeCollector,
+		},
+		{
+			name: "failed payout: empty fee collector module account",
+			devGasState: []devgastypes.FeeShare{
+				devGasForWithdrawer(0, 0),
+			},
+			contractGas:             []uint64{100},
+			wantWithdrawerRoyalties: []sdk.Coins{sdk.NewCoins()},
+			wantPayoutFailed:        true,
 			setup: func() (*app.NibiruApp, sdk.Context) {
 				bapp, ctx := testapp.NewNibiruTestAppAndContext()
 				return bapp, ctx
 			},
 		},
+		{
+			name: "failed payout: no withdrawer is paid if one payment fails",
+			devGasState: []devgastypes.FeeShare{
+				devGasForWithdrawer(0, 0),
+				{
+					ContractAddress:   contracts[1].String(),
+					DeployerAddress:   deployerAddr.String(),
+					WithdrawerAddress: authtypes.NewModuleAddress(distrtypes.ModuleName).String(),
+				},
+			},
+			contractGas:             []uint64{100, 100},
+			wantWithdrawerRoyalties: []sdk.Coins{sdk.NewCoins()},
+			wantPayoutFailed:        true,
+			setup:                   fundFeeCollector,
+		},
 		{
 			name:        "happy: no registered dev gas contracts",
 			devGasState: []devgastypes.FeeShare{},
-			// The expected royalty is gas / num_withdrawers / 2. Thus, We
-			// divide gas by (num_withdrawers * 2). The 2 comes from 50% split.
-			// wantWithdrawerRoyalties: num_withdrawers * 2 = 2
-			wantWithdrawerRoyalties: txGasCoins.QuoInt(math.NewInt(2)),
-			wantErr:                 false,
+			contractGas: []uint64{100},
 			setup: func() (*app.NibiruApp, sdk.Context) {
 				bapp, ctx := testapp.NewNibiruTestAppAndContext()
 				return bapp, ctx
@@ -229,8 +276,8 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 		},
 	}
 
-	var nextMockAnteHandler sdk.AnteHandler = func(
-		ctx sdk.Context, tx sdk.Tx, simulate bool,
+	var nextMockPostHandler sdk.PostHandler = func(
+		ctx sdk.Context, tx sdk.Tx, simulate, success bool,
 	) (newCtx sdk.Context, err error) {
 		return ctx, nil
 	}
@@ -238,8 +285,8 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			bapp, ctx := tc.setup()
-			ctx = ctx.WithChainID("mock-chain-id")
-			anteDecorator := devgasante.NewDevGasPayoutDecorator(
+			ctx = ctx.WithChainID("mock-chain-id").WithEventManager(sdk.NewEventManager())
+			postDecorator := devgasante.NewDevGasPayoutDecorator(
 				bapp.BankKeeper, bapp.DevGasKeeper,
 			)
 
@@ -248,12 +295,17 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 				bapp.DevGasKeeper.SetFeeShare(ctx, devGas)
 			}
 
-			t.Log("build tx and call AnteHandle")
-			encCfg := app.MakeEncodingConfig()
+			t.Log("record the gas of the executed contracts")
 			txMsgs := []sdk.Msg{}
-			for _, wasmExecMsg := range wasmExecMsgs {
-				txMsgs = append(txMsgs, wasmExecMsg)
+			for idx, gas := range tc.contractGas {
+				bapp.DevGasKeeper.GasTracker.AddContractGas(ctx, contracts[idx], gas)
+				txMsgs = append(txMsgs, &wasmtypes.MsgExecuteContract{
+					Contract: contracts[idx].String(),
+				})
 			}
+
+			t.Log("build tx and call PostHandle")
+			encCfg := app.MakeEncodingConfig()
 			txBuilder, err := sdkclienttx.Factory{}.
 				WithFees(txGasCoins.String()).
 				WithChainID(ctx.ChainID()).
@@ -261,31 +313,48 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 				BuildUnsignedTx(txMsgs...)
 			suite.NoError(err)
 			tx := txBuilder.GetTx()
-			simulate := true
-			ctx, err = anteDecorator.AnteHandle(
-				ctx, tx, simulate, nextMockAnteHandler,
+			simulate, success := true, true
+			ctx, err = postDecorator.PostHandle(
+				ctx, tx, simulate, success, nextMockPostHandler,
 			)
-			if tc.wantErr {
-				suite.Error(err)
-				return
-			}
 			suite.NoError(err)
+			suite.Empty(bapp.DevGasKeeper.PopContractGas(ctx))
 
 			t.Log("tc withdrawers should have the expected funds")
-			for _, devGas := range tc.devGasState {
-				withdrawerCoins := bapp.BankKeeper.SpendableCoins(
-					ctx, devGas.GetWithdrawerAddr(),
-				)
-				wantWithdrawerRoyalties := tc.wantWithdrawerRoyalties.Sub(
-					sdk.NewInt64Coin(txGasCoins[0].Denom, 1),
-					sdk.NewInt64Coin(txGasCoins[1].Denom, 1),
-				)
-				suite.True(
-					withdrawerCoins.IsAllGTE(wantWithdrawerRoyalties),
-					strings.Join([]string{
-						fmt.Sprintf("withdrawerCoins: %v\n", withdrawerCoins),
-						fmt.Sprintf("tc.wantWithdrawerRoyalties: %v\n", tc.wantWithdrawerRoyalties),
-					}, " "),
+			for idx, wantRoyalties := range tc.wantWithdrawerRoyalties {
+				withdrawerCoins := bapp.BankKeeper.SpendableCoins(ctx, withdrawAddrs[idx])
+				suite.Equal(wantRoyalties.String(), withdrawerCoins.String())
+			}
+
+			t.Log("the event should carry the gas of each paid contract")
+			var payouts []devgasante.FeeSharePayoutEventOutput
+			payoutFailed := false
+			for _, event := range ctx.EventManager().Events() {
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				if err != nil {
+					continue
+				}
+				switch typedEvent := typedEvent.(type) {
+				case *devgastypes.EventPayoutDevGas:
+					suite.NoError(json.Unmarshal([]byte(typedEvent.Payouts), &payouts))
+				case *devgastypes.EventPayoutDevGasFailed:
+					payoutFailed = true
+				}
+			}
+			suite.Equal(tc.wantPayoutFailed, payoutFailed)
+			if tc.wantPayoutFailed {
+				suite.Empty(payouts)
+				return
+			}
+			suite.Len(payouts, len(tc.devGasState))
+			for _, payout := range payouts {
+				idx := slices.IndexFunc(contracts, func(contract sdk.AccAddress) bool {
+					return contract.Equals(payout.ContractAddress)
+				})
+				suite.Equal(tc.contractGas[idx], payout.GasUsed)
+				suite.Equal(
+					payout.FeesPaid,
+					bapp.DevGasKeeper.GetFeeShareEarnings(ctx, payout.ContractAddress),
 				)
 			}
 		})
@@ -5,6 +5,8 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/collections"
+
 	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
 
@@ -22,4 +24,6 @@ type BankKeeper interface {
 type IDevGasKeeper interface {
 	GetParams(ctx sdk.Context) devgastypes.ModuleParams
 	GetFeeShare(ctx sdk.Context, contract sdk.Address) (devgastypes.FeeShare, bool)
+	PopContractGas(ctx sdk.Context) []collections.KeyValue[sdk.AccAddress, uint64]
+	AddFeeShareEarnings(ctx sdk.Context, contract sdk.AccAddress, feesPaid sdk.Coins)
 }
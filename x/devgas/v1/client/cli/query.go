@@ -13,6 +13,8 @@ import (
 	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
 
+const FlagWithdrawer = "withdrawer"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	feesQueryCmd := &cobra.Command{
@@ -28,20 +30,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeShare(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeeSharesByWithdrawer(),
+		GetCmdQueryFeeShareEarnings(),
 	)
 
 	return feesQueryCmd
 }
 
-// GetCmdQueryFeeShares implements a command to return all registered contracts
-// for fee distribution
+// GetCmdQueryFeeShares implements a command to return the registered contracts
+// for fee distribution, filtered by deployer and withdrawer
 func GetCmdQueryFeeShares() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contracts [deployer_addr]",
 		Short: "Query dev gas contracts registered with a deployer",
-		Long:  "Query dev gas contracts registered with a deployer",
-		Args:  cobra.ExactArgs(1),
-		Example: fmt.Sprintf("%s query %s deployer-contracts <deployer-address>",
+		Long: `Query dev gas contracts registered with a deployer. Without a
+deployer, all registered contracts are returned. The results can be filtered by
+withdrawer with the --withdrawer flag.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query %s contracts <deployer-address> --withdrawer <withdrawer-address>",
 			version.AppName, types.ModuleName,
 		),
 
@@ -52,9 +57,21 @@ func GetCmdQueryFeeShares() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			deployer := args[0]
+			withdrawer, err := cmd.Flags().GetString(FlagWithdrawer)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryFeeSharesRequest{
-				Deployer: deployer,
+				Withdrawer: withdrawer,
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.Deployer = args[0]
 			}
 			if err := req.ValidateBasic(); err != nil {
 				return err
@@ -69,6 +86,8 @@ func GetCmdQueryFeeShares() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagWithdrawer, "", "Only return the contracts with this withdrawer")
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -178,3 +197,34 @@ func GetCmdQueryFeeSharesByWithdrawer() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryFeeShareEarnings implements a command that returns the fees paid
+// out for a contract since it was first registered
+func GetCmdQueryFeeShareEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "earnings [contract_bech32_or_hex]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the fees paid out for a contract since it was first registered",
+		Long:    "Query the fees paid out for a contract since it was first registered",
+		Example: fmt.Sprintf("%s query %s earnings <contract-address>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeShareEarnings(context.Background(), &types.QueryFeeShareEarningsRequest{
+				ContractAddress: contractAddress(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,10 +9,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
 
+// FlagDeployerNonce is the nonce of the deployer account in the transaction
+// that created an EVM contract.
+const FlagDeployerNonce = "deployer-nonce"
+
+// contractAddress returns the bech32 form of a contract address. EVM contract
+// addresses may be given in hex.
+func contractAddress(addr string) string {
+	if gethcommon.IsHexAddress(addr) {
+		return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(addr)).String()
+	}
+	return addr
+}
+
 // NewTxCmd returns a root CLI command handler for certain modules/FeeShare
 // transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -36,9 +51,9 @@ func NewTxCmd() *cobra.Command {
 // contract for fee distribution
 func CmdRegisterFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [contract_bech32] [withdraw_bech32]",
+		Use:   "register [contract_bech32_or_hex] [withdraw_bech32]",
 		Short: "Register a contract for fee distribution. Only the contract admin can register a contract.",
-		Long:  "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
+		Long:  "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.\nEVM contracts are registered by the account that deployed them, which passes the nonce of the deployment transaction with --deployer-nonce.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -48,13 +63,18 @@ func CmdRegisterFeeShare() *cobra.Command {
 
 			deployer := cliCtx.GetFromAddress()
 
-			contract := args[0]
+			contract := contractAddress(args[0])
 			withdrawer := args[1]
+			deployerNonce, err := cmd.Flags().GetUint64(FlagDeployerNonce)
+			if err != nil {
+				return err
+			}
 
 			msg := &types.MsgRegisterFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				DeployerNonce:     deployerNonce,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -65,6 +85,7 @@ func CmdRegisterFeeShare() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagDeployerNonce, 0, "nonce of the deployer in the transaction that created the EVM contract")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -73,7 +94,7 @@ func CmdRegisterFeeShare() *cobra.Command {
 // contract for fee distribution
 func CmdCancelFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cancel [contract_bech32]",
+		Use:   "cancel [contract_bech32_or_hex]",
 		Short: "Cancel a contract from feeshare distribution",
 		Long:  "Cancel a contract from feeshare distribution. The withdraw address will no longer receive fees from users interacting with the contract.\nOnly the contract admin can cancel a contract.",
 		Args:  cobra.ExactArgs(1),
@@ -85,7 +106,7 @@ func CmdCancelFeeShare() *cobra.Command {
 
 			deployer := cliCtx.GetFromAddress()
 
-			contract := args[0]
+			contract := contractAddress(args[0])
 
 			msg := &types.MsgCancelFeeShare{
 				ContractAddress: contract,
@@ -108,7 +129,7 @@ func CmdCancelFeeShare() *cobra.Command {
 // address of a contract for fee distribution
 func CmdUpdateFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update [contract_bech32] [new_withdraw_bech32]",
+		Use:   "update [contract_bech32_or_hex] [new_withdraw_bech32]",
 		Short: "Update withdrawer address for a contract registered for feeshare distribution.",
 		Long:  "Update withdrawer address for a contract registered for feeshare distribution. \nOnly the contract admin can update the withdrawer address.",
 		Args:  cobra.ExactArgs(2),
@@ -120,7 +141,7 @@ func CmdUpdateFeeShare() *cobra.Command {
 
 			deployer := cliCtx.GetFromAddress()
 
-			contract := args[0]
+			contract := contractAddress(args[0])
 			if _, err := sdk.AccAddressFromBech32(contract); err != nil {
 				return fmt.Errorf("invalid contract bech32 address %w", err)
 			}
@@ -25,6 +25,10 @@ func InitGenesis(
 		// Set initial contracts receiving transaction fees
 		k.SetFeeShare(ctx, share)
 	}
+
+	for _, earnings := range data.Earnings {
+		k.Earnings.Insert(ctx, earnings.ContractAddress, earnings)
+	}
 }
 
 // ExportGenesis export module state
@@ -32,5 +36,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:   k.GetParams(ctx),
 		FeeShare: k.DevGasStore.Iterate(ctx, collections.Range[string]{}).Values(),
+		Earnings: k.Earnings.Iterate(ctx, collections.Range[string]{}).Values(),
 	}
 }
@@ -101,6 +101,19 @@ func (s *GenesisTestSuite) TestGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name: "custom genesis - with earnings",
+			genesis: devgastypes.GenesisState{
+				Params: devgastypes.DefaultParams(),
+				Earnings: []devgastypes.FeeShareEarnings{
+					{
+						ContractAddress: randomAddr,
+						Earnings:        sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+					},
+				},
+			},
+			expPanic: false,
+		},
 		{
 			name:     "empty genesis",
 			genesis:  devgastypes.GenesisState{},
@@ -126,6 +139,7 @@ func (s *GenesisTestSuite) TestGenesis() {
 
 				gen := devgas.ExportGenesis(s.ctx, s.app.DevGasKeeper)
 				s.NoError(gen.Validate())
+				s.Require().Len(gen.Earnings, len(tc.genesis.Earnings))
 			}
 		})
 	}
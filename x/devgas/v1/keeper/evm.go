@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// IsEvmContract returns true if the address holds the code of an EVM
+// contract.
+func (k Keeper) IsEvmContract(ctx sdk.Context, contract sdk.AccAddress) bool {
+	account := k.evmKeeper.GetAccount(ctx, eth.NibiruAddrToEthAddr(contract))
+	return account != nil && account.IsContract()
+}
+
+// GetEvmContractDeployer ensures the deployer created the EVM contract. The
+// proof is that the contract lives at the address that an account creates
+// when it deploys a contract with the given nonce.
+func (k Keeper) GetEvmContractDeployer(
+	ctx sdk.Context, contract sdk.AccAddress, deployer string, deployerNonce uint64,
+) (sdk.AccAddress, error) {
+	deployerAddr, err := sdk.AccAddressFromBech32(deployer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid deployer address %s", deployer)
+	}
+
+	if !k.IsEvmContract(ctx, contract) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"evm contract with address %s not found in state", contract,
+		)
+	}
+
+	created := crypto.CreateAddress(eth.NibiruAddrToEthAddr(deployerAddr), deployerNonce)
+	if !contract.Equals(eth.EthAddrToNibiruAddr(created)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s did not create evm contract %s with nonce %d",
+			deployer, contract, deployerNonce,
+		)
+	}
+	return deployerAddr, nil
+}
+
+// checkFeeShareDeployer ensures the deployer may edit the FeeShare of a
+// contract. The deployer of an EVM contract proved that it created the
+// contract on registration, so it must be the registered deployer. The deployer
+// of a wasm contract must be its admin, or its creator if it has no admin.
+func (k Keeper) checkFeeShareDeployer(
+	ctx sdk.Context, contract sdk.AccAddress, feeshare types.FeeShare, deployer string,
+) error {
+	if k.IsEvmContract(ctx, contract) {
+		if feeshare.DeployerAddress != deployer {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"you are not the deployer of this contract %s", deployer,
+			)
+		}
+		return nil
+	}
+	_, err := k.GetContractAdminOrCreatorAddress(ctx, contract, deployer)
+	return err
+}
+
+var _ evm.EvmHooks = EvmHooks{}
+
+// EvmHooks pays the developer share of the fees of Ethereum transactions to
+// the withdrawers of the EVM contracts they call.
+type EvmHooks struct {
+	k Keeper
+}
+
+// EvmHooks returns the hooks to register with the x/evm keeper.
+func (k Keeper) EvmHooks() EvmHooks {
+	return EvmHooks{k}
+}
+
+// PostTxProcessing implements evm.EvmHooks. The fees of the transaction are
+// the gas it used after the refund, at the gas price it paid.
+func (h EvmHooks) PostTxProcessing(
+	ctx sdk.Context, msg core.Message, receipt *gethcore.Receipt,
+) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableFeeShare || msg.To() == nil {
+		return nil
+	}
+
+	contract := eth.EthAddrToNibiruAddr(*msg.To())
+	feeshare, found := h.k.GetFeeShare(ctx, contract)
+	if !found {
+		return nil
+	}
+	withdrawer := feeshare.GetWithdrawerAddr()
+	if withdrawer == nil || withdrawer.Empty() {
+		return nil
+	}
+
+	denom := h.k.evmKeeper.GetParams(ctx).EvmDenom
+	if len(params.AllowedDenoms) > 0 && !slices.Contains(params.AllowedDenoms, denom) {
+		return nil
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), msg.GasPrice())
+	devFee := params.DeveloperShares.MulInt(math.NewIntFromBigInt(fee)).RoundInt()
+	if !devFee.IsPositive() {
+		return nil
+	}
+
+	feesPaid := sdk.NewCoins(sdk.NewCoin(denom, devFee))
+	if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, h.k.feeCollectorName, withdrawer, feesPaid,
+	); err != nil {
+		return types.ErrFeeSharePayment.Wrapf(
+			"failed to pay fees to evm contract developer: %s", err.Error(),
+		)
+	}
+	h.k.AddFeeShareEarnings(ctx, contract, feesPaid)
+
+	bz, err := json.Marshal([]types.FeeSharePayoutEventOutput{{
+		ContractAddress: contract,
+		WithdrawAddress: withdrawer,
+		FeesPaid:        feesPaid,
+		GasUsed:         receipt.GasUsed,
+	}})
+	if err != nil {
+		return types.ErrFeeSharePayment.Wrapf("failed to marshal feesPaidOutput: %s", err.Error())
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventPayoutDevGas{Payouts: string(bz)})
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
+	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+func TestEvmFeeShare(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	devgas := deps.Chain.DevGasKeeper
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20Minter, t, "erc20name", "TOKEN", uint8(18),
+	)
+	require.NoError(t, err)
+	contract := eth.EthAddrToNibiruAddr(deployResp.ContractAddr)
+	deployer := deps.Sender.NibiruAddr
+	withdrawer := testutil.AccAddress()
+
+	t.Log("only the account that created the contract may register it")
+	msg := &types.MsgRegisterFeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+		DeployerNonce:     deployResp.Nonce + 1,
+	}
+	_, err = devgas.RegisterFeeShare(deps.GoCtx(), msg)
+	require.ErrorContains(t, err, "did not create evm contract")
+	msg.DeployerAddress = testutil.AccAddress().String()
+	msg.DeployerNonce = deployResp.Nonce
+	_, err = devgas.RegisterFeeShare(deps.GoCtx(), msg)
+	require.ErrorContains(t, err, "did not create evm contract")
+	msg.DeployerAddress = deployer.String()
+	_, err = devgas.RegisterFeeShare(deps.GoCtx(), msg)
+	require.NoError(t, err)
+
+	res, err := devgaskeeper.NewQuerier(devgas).FeeShares(deps.GoCtx(), &types.QueryFeeSharesRequest{
+		Deployer: deployer.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeeShare{
+		types.NewFeeShare(contract, deployer, withdrawer),
+	}, res.Feeshare)
+
+	t.Log("only the registered deployer may update the withdrawer")
+	_, err = devgas.UpdateFeeShare(deps.GoCtx(), &types.MsgUpdateFeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   testutil.AccAddress().String(),
+		WithdrawerAddress: testutil.AccAddress().String(),
+	})
+	require.ErrorContains(t, err, "not the deployer")
+
+	t.Log("calls to the contract pay the developer share of their fees")
+	params := devgas.GetParams(deps.Ctx)
+	params.DeveloperShares = math.LegacyNewDecWithPrec(5, 1)
+	devgas.ModuleParams.Set(deps.Ctx, params)
+	require.NoError(t, testapp.FundModuleAccount(
+		deps.Chain.BankKeeper, deps.Ctx, authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewInt64Coin(evm.DefaultEVMDenom, 10_000)),
+	))
+
+	to := deployResp.ContractAddr
+	ethMsg := gethcore.NewMessage(
+		deps.Sender.EthAddr, &to, 1, big.NewInt(0), 100_000,
+		big.NewInt(10), big.NewInt(10), big.NewInt(0), nil, nil, false,
+	)
+	err = devgas.EvmHooks().PostTxProcessing(deps.Ctx, ethMsg, &gethcore.Receipt{GasUsed: 500})
+	require.NoError(t, err)
+	require.Equal(t,
+		sdk.NewInt64Coin(evm.DefaultEVMDenom, 2_500),
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, withdrawer, evm.DefaultEVMDenom),
+	)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(evm.DefaultEVMDenom, 2_500)),
+		devgas.GetFeeShareEarnings(deps.Ctx, contract),
+	)
+
+	t.Log("a failed payout does not fail the transaction")
+	blockedWithdrawer := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	devgas.SetFeeShare(deps.Ctx, types.NewFeeShare(contract, deployer, blockedWithdrawer))
+	input, err := deployResp.ContractData.ABI.Pack("mint", deps.Sender.EthAddr, big.NewInt(1))
+	require.NoError(t, err)
+	nonce := deps.StateDB().GetNonce(deps.Sender.EthAddr)
+	ethTxMsg, err := evmtest.GenerateAndSignEthTxMsg(evm.JsonTxArgs{
+		From:     &deps.Sender.EthAddr,
+		To:       &to,
+		Nonce:    (*hexutil.Uint64)(&nonce),
+		Data:     (*hexutil.Bytes)(&input),
+		GasPrice: (*hexutil.Big)(big.NewInt(1)),
+	}, &deps)
+	require.NoError(t, err)
+	resp, err := deps.Chain.EvmKeeper.EthereumTx(deps.GoCtx(), ethTxMsg)
+	require.NoError(t, err)
+	require.Empty(t, resp.VmError)
+	evmtest.AssertERC20BalanceEqual(t, deps, to, deps.Sender.EthAddr, big.NewInt(1))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(evm.DefaultEVMDenom, 2_500)),
+		devgas.GetFeeShareEarnings(deps.Ctx, contract),
+	)
+	require.True(t, deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, blockedWithdrawer).IsZero())
+	testutil.RequireContainsTypedEvent(t, deps.Ctx, &evm.EventPostTxProcessingFailed{
+		TxHash: resp.Hash,
+		Error: fmt.Sprintf(
+			"failed to pay fees to evm contract developer: %s is not allowed to receive funds: unauthorized: %s",
+			blockedWithdrawer, types.ErrFeeSharePayment),
+	})
+}
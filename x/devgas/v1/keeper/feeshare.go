@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/NibiruChain/collections"
 
 	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
@@ -35,3 +39,76 @@ func (k Keeper) IsFeeShareRegistered(
 	_, err := k.DevGasStore.Get(ctx, contract.String())
 	return err == nil
 }
+
+// GetFeeShares returns a page of the registered FeeShares, filtered by
+// deployer and withdrawer when they are not empty. Filtering by deployer or
+// withdrawer paginates over the keys of its index, so that the page does not
+// depend on the number of unrelated FeeShares.
+func (k Keeper) GetFeeShares(
+	ctx sdk.Context, deployer, withdrawer string, pageReq *sdkquery.PageRequest,
+) (feeshares []types.FeeShare, pageRes *sdkquery.PageResponse, err error) {
+	feeshares = []types.FeeShare{}
+	store := ctx.KVStore(k.storeKey)
+
+	if deployer == "" && withdrawer == "" {
+		feeShareStore := prefix.NewStore(store, types.KeyPrefixFeeShare.Prefix())
+		pageRes, err = sdkquery.Paginate(feeShareStore, pageReq, func(_, value []byte) error {
+			var feeshare types.FeeShare
+			if err := k.cdc.Unmarshal(value, &feeshare); err != nil {
+				return err
+			}
+			feeshares = append(feeshares, feeshare)
+			return nil
+		})
+		return feeshares, pageRes, err
+	}
+
+	// The keys of the index store are the contract addresses.
+	indexStore := prefix.NewStore(store, append(
+		types.KeyPrefixDeployer.Prefix(), collections.StringKeyEncoder.Encode(deployer)...,
+	))
+	if deployer == "" {
+		indexStore = prefix.NewStore(store, append(
+			types.KeyPrefixWithdrawer.Prefix(), collections.StringKeyEncoder.Encode(withdrawer)...,
+		))
+	}
+	pageRes, err = sdkquery.FilteredPaginate(
+		indexStore, pageReq,
+		func(key, _ []byte, accumulate bool) (bool, error) {
+			_, contract := collections.StringKeyEncoder.Decode(key)
+			feeshare, err := k.DevGasStore.Get(ctx, contract)
+			if err != nil {
+				return false, err
+			}
+			if withdrawer != "" && feeshare.WithdrawerAddress != withdrawer {
+				return false, nil
+			}
+			if accumulate {
+				feeshares = append(feeshares, feeshare)
+			}
+			return true, nil
+		},
+	)
+	return feeshares, pageRes, err
+}
+
+// AddFeeShareEarnings adds fees paid out for a contract to its earnings.
+func (k Keeper) AddFeeShareEarnings(
+	ctx sdk.Context, contract sdk.AccAddress, feesPaid sdk.Coins,
+) {
+	earnings := k.GetFeeShareEarnings(ctx, contract)
+	k.Earnings.Insert(ctx, contract.String(), types.FeeShareEarnings{
+		ContractAddress: contract.String(),
+		Earnings:        earnings.Add(feesPaid...),
+	})
+}
+
+// GetFeeShareEarnings returns the fees paid out for a contract since it was
+// first registered.
+func (k Keeper) GetFeeShareEarnings(ctx sdk.Context, contract sdk.AccAddress) sdk.Coins {
+	earnings, err := k.Earnings.Get(ctx, contract.String())
+	if err != nil {
+		return sdk.NewCoins()
+	}
+	return earnings.Earnings
+}
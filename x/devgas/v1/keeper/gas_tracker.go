@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
+)
+
+// WasmGasTracker records the gas that each wasm contract consumes while the
+// messages of a transaction execute. The records live in a transient store,
+// and the DevGasPayoutDecorator clears them once it pays the developers after
+// message execution.
+type WasmGasTracker struct {
+	// ContractGas: Gas consumed by each executed contract in the current
+	// transaction, in Cosmos SDK gas units.
+	ContractGas collections.Map[sdk.AccAddress, uint64]
+
+	gasRegister wasmtypes.GasRegister
+}
+
+// NewWasmGasTracker creates a WasmGasTracker that keeps its records in the
+// transient store of the x/devgas module.
+func NewWasmGasTracker(tStoreKey storetypes.StoreKey) WasmGasTracker {
+	return WasmGasTracker{
+		ContractGas: collections.NewMap(
+			tStoreKey, devgastypes.KeyPrefixContractGas,
+			collections.AccAddressKeyEncoder, collections.Uint64ValueEncoder,
+		),
+		gasRegister: wasmtypes.NewDefaultWasmGasRegister(),
+	}
+}
+
+// AddContractGas adds to the gas consumed by a contract in the current
+// transaction. The records are bookkeeping of the chain, so the sender of the
+// transaction is not charged gas for them.
+func (t WasmGasTracker) AddContractGas(ctx sdk.Context, contract sdk.AccAddress, gas uint64) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	t.ContractGas.Insert(ctx, contract, t.ContractGas.GetOr(ctx, contract, 0)+gas)
+}
+
+// PopContractGas returns the gas consumed by each contract in the current
+// transaction, ordered by contract address, and clears the records.
+func (t WasmGasTracker) PopContractGas(
+	ctx sdk.Context,
+) (kvs []collections.KeyValue[sdk.AccAddress, uint64]) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	kvs = t.ContractGas.Iterate(ctx, collections.Range[sdk.AccAddress]{}).KeyValues()
+	for _, kv := range kvs {
+		_ = t.ContractGas.Delete(ctx, kv.Key)
+	}
+	return kvs
+}
+
+// PopContractGas returns the gas consumed by each wasm contract in the
+// current transaction and clears the records.
+func (k Keeper) PopContractGas(
+	ctx sdk.Context,
+) []collections.KeyValue[sdk.AccAddress, uint64] {
+	return k.GasTracker.PopContractGas(ctx)
+}
+
+// WasmEngineDecorator wraps the wasm engine of the x/wasm keeper so that
+// contract executions are recorded. Use it with
+// wasmkeeper.WithWasmEngineDecorator.
+func (t WasmGasTracker) WasmEngineDecorator(engine wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+	return gasTrackingWasmEngine{WasmEngine: engine, tracker: t}
+}
+
+var _ wasmtypes.WasmEngine = gasTrackingWasmEngine{}
+
+type gasTrackingWasmEngine struct {
+	wasmtypes.WasmEngine
+	tracker WasmGasTracker
+}
+
+// Execute runs the contract and records the gas it consumed: the gas of the
+// VM plus the gas charged to the context for store access during the call.
+// The x/wasm keeper passes the context of the call through its query handler.
+// Gas of the messages that the contract dispatches is recorded when they
+// execute, under the contracts they call.
+func (e gasTrackingWasmEngine) Execute(
+	code wasmvm.Checksum,
+	env wasmvmtypes.Env,
+	info wasmvmtypes.MessageInfo,
+	executeMsg []byte,
+	store wasmvm.KVStore,
+	goapi wasmvm.GoAPI,
+	querier wasmvm.Querier,
+	gasMeter wasmvm.GasMeter,
+	gasLimit uint64,
+	deserCost wasmvmtypes.UFraction,
+) (*wasmvmtypes.Response, uint64, error) {
+	queryHandler, ok := querier.(wasmkeeper.QueryHandler)
+	if !ok {
+		return e.WasmEngine.Execute(
+			code, env, info, executeMsg, store, goapi, querier, gasMeter, gasLimit, deserCost,
+		)
+	}
+
+	ctx := queryHandler.Ctx
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, gasUsed, err := e.WasmEngine.Execute(
+		code, env, info, executeMsg, store, goapi, querier, gasMeter, gasLimit, deserCost,
+	)
+	if err != nil {
+		return res, gasUsed, err
+	}
+
+	contract, addrErr := sdk.AccAddressFromBech32(env.Contract.Address)
+	if addrErr == nil {
+		gas := ctx.GasMeter().GasConsumed() - gasBefore + e.tracker.gasRegister.FromWasmVMGas(gasUsed)
+		e.tracker.AddContractGas(ctx, contract, gas)
+	}
+	return res, gasUsed, err
+}
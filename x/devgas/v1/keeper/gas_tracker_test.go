@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+)
+
+func (s *KeeperTestSuite) TestWasmGasTracker() {
+	s.SetupTest()
+	sender := testutil.AccAddress()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000_000)))
+	contract := sdk.MustAccAddressFromBech32(s.InstantiateContract(sender.String(), ""))
+	tracker := s.app.DevGasKeeper.GasTracker
+
+	s.T().Log("instantiation is not an execution")
+	s.Require().Empty(s.app.DevGasKeeper.PopContractGas(s.ctx))
+
+	execute := func() {
+		msg := &wasmtypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: contract.String(),
+			Msg:      []byte(fmt.Sprintf(`{"change_owner":{"owner":"%s"}}`, sender)),
+		}
+		_, err := s.wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(s.ctx), msg)
+		s.Require().NoError(err)
+	}
+
+	s.T().Log("executions record the gas of the contract")
+	execute()
+	gasOnce := tracker.ContractGas.GetOr(s.ctx, contract, 0)
+	s.Require().Positive(gasOnce)
+
+	s.T().Log("repeated executions add up")
+	execute()
+	s.Require().Greater(tracker.ContractGas.GetOr(s.ctx, contract, 0), gasOnce)
+
+	s.T().Log("popping the gas clears the records")
+	contractGas := s.app.DevGasKeeper.PopContractGas(s.ctx)
+	s.Require().Len(contractGas, 1)
+	s.Require().Equal(contract, contractGas[0].Key)
+	s.Require().Empty(s.app.DevGasKeeper.PopContractGas(s.ctx))
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
 
@@ -25,8 +26,8 @@ func NewQuerier(k Keeper) Querier {
 
 // Fee
 
-// TODO FeeSharesAll returns all FeeShares that have been registered for fee
-// distribution
+// FeeShares returns a page of the FeeShares that have been registered for fee
+// distribution, filtered by deployer and withdrawer
 func (q Querier) FeeShares(
 	goCtx context.Context,
 	req *types.QueryFeeSharesRequest,
@@ -34,10 +35,22 @@ func (q Querier) FeeShares(
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	iter := q.DevGasStore.Indexes.Deployer.ExactMatch(ctx, req.Deployer)
+	feeshares, pageRes, err := q.GetFeeShares(ctx, req.Deployer, req.Withdrawer, pageReq)
+	if err != nil {
+		return nil, err
+	}
 	return &types.QueryFeeSharesResponse{
-		Feeshare: q.DevGasStore.Collect(ctx, iter),
+		Feeshare:   feeshares,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -98,3 +111,26 @@ func (q Querier) FeeSharesByWithdrawer( // nolint: dupl
 		Feeshare: q.DevGasStore.Collect(ctx, iter),
 	}, nil
 }
+
+// FeeShareEarnings returns the fees paid out for a contract since it was first
+// registered
+func (q Querier) FeeShareEarnings(
+	goCtx context.Context,
+	req *types.QueryFeeShareEarningsRequest,
+) (*types.QueryFeeShareEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be bech32 ('nibi...')", req.ContractAddress,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryFeeShareEarningsResponse{
+		Earnings: q.GetFeeShareEarnings(ctx, contract),
+	}, nil
+}
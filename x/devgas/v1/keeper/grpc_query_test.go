@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"cosmossdk.io/math"
 
@@ -70,6 +71,56 @@ func (s *KeeperTestSuite) TestQueryFeeShares() {
 		s.NoError(err)
 		s.Len(resp.Feeshare, 0)
 	})
+	s.Run("from withdrawer", func() {
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		resp, err := s.queryClient.FeeShares(goCtx, &devgastypes.QueryFeeSharesRequest{
+			Withdrawer: withdrawer.String(),
+		})
+		s.NoError(err)
+		s.Len(resp.Feeshare, len(feeShares))
+
+		resp, err = s.queryClient.FeeShares(goCtx, &devgastypes.QueryFeeSharesRequest{
+			Deployer:   sender.String(),
+			Withdrawer: testutil.AccAddress().String(),
+		})
+		s.NoError(err)
+		s.Len(resp.Feeshare, 0)
+	})
+	s.Run("all", func() {
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		resp, err := s.queryClient.FeeShares(goCtx, &devgastypes.QueryFeeSharesRequest{})
+		s.NoError(err)
+		s.ElementsMatch(feeShares, resp.Feeshare)
+	})
+	s.Run("paginated", func() {
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		var pages [][]devgastypes.FeeShare
+		var all []devgastypes.FeeShare
+		pageReq := &sdkquery.PageRequest{Limit: 2}
+		for {
+			resp, err := s.queryClient.FeeShares(goCtx, &devgastypes.QueryFeeSharesRequest{
+				Deployer:   sender.String(),
+				Pagination: pageReq,
+			})
+			s.Require().NoError(err)
+			pages = append(pages, resp.Feeshare)
+			all = append(all, resp.Feeshare...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			pageReq = &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 2}
+		}
+		s.Len(pages, 3)
+		s.Len(pages[2], 1)
+		s.ElementsMatch(feeShares, all)
+	})
+	s.Run("invalid filter", func() {
+		goCtx := sdk.WrapSDKContext(s.ctx)
+		_, err := s.queryClient.FeeShares(goCtx, &devgastypes.QueryFeeSharesRequest{
+			Withdrawer: "invalid",
+		})
+		s.Error(err)
+	})
 }
 
 func (s *KeeperTestSuite) TestFeeShare() {
@@ -143,6 +194,36 @@ func (s *KeeperTestSuite) TestFeeSharesByWithdrawer() {
 	})
 }
 
+func (s *KeeperTestSuite) TestFeeShareEarnings() {
+	s.SetupTest()
+	contract := testutil.AccAddress()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	resp, err := s.queryClient.FeeShareEarnings(goCtx, &devgastypes.QueryFeeShareEarningsRequest{
+		ContractAddress: contract.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Earnings)
+
+	s.app.DevGasKeeper.AddFeeShareEarnings(s.ctx, contract, sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)))
+	s.app.DevGasKeeper.AddFeeShareEarnings(s.ctx, contract, sdk.NewCoins(
+		sdk.NewInt64Coin("unibi", 50), sdk.NewInt64Coin("utoken", 10),
+	))
+	resp, err = s.queryClient.FeeShareEarnings(goCtx, &devgastypes.QueryFeeShareEarningsRequest{
+		ContractAddress: contract.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 150), sdk.NewInt64Coin("utoken", 10)),
+		resp.Earnings,
+	)
+
+	_, err = s.queryClient.FeeShareEarnings(goCtx, &devgastypes.QueryFeeShareEarningsRequest{
+		ContractAddress: "invalid",
+	})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestQueryParams() {
 	s.SetupTest()
 	goCtx := sdk.WrapSDKContext(s.ctx)
@@ -164,4 +245,7 @@ func (s *KeeperTestSuite) TestNilRequests() {
 
 	_, err = querier.FeeSharesByWithdrawer(goCtx, nil)
 	s.Error(err)
+
+	_, err = querier.FeeShareEarnings(goCtx, nil)
+	s.Error(err)
 }
@@ -25,6 +25,7 @@ type Keeper struct {
 	bankKeeper    devgastypes.BankKeeper
 	wasmKeeper    wasmkeeper.Keeper
 	accountKeeper devgastypes.AccountKeeper
+	evmKeeper     devgastypes.EvmKeeper
 
 	// feeCollectorName is the name of x/auth module's fee collector module
 	// account, "fee_collector", which collects transaction fees for distribution
@@ -43,6 +44,14 @@ type Keeper struct {
 
 	ModuleParams collections.Item[devgastypes.ModuleParams]
 
+	// Earnings: Map from contract address to the fees paid out for the
+	// contract since it was first registered.
+	Earnings collections.Map[string, devgastypes.FeeShareEarnings]
+
+	// GasTracker: Gas consumed by each wasm contract in the current
+	// transaction, used to weight the payouts of the DevGasPayoutDecorator.
+	GasTracker WasmGasTracker
+
 	// the address capable of executing a MsgUpdateParams message. Typically,
 	// this should be the x/gov module account.
 	authority string
@@ -51,10 +60,12 @@ type Keeper struct {
 // NewKeeper creates new instances of the fees Keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	bk devgastypes.BankKeeper,
 	wk wasmkeeper.Keeper,
 	ak devgastypes.AccountKeeper,
+	ek devgastypes.EvmKeeper,
 	feeCollector string,
 	authority string,
 ) Keeper {
@@ -64,6 +75,7 @@ func NewKeeper(
 		bankKeeper:       bk,
 		wasmKeeper:       wk,
 		accountKeeper:    ak,
+		evmKeeper:        ek,
 		feeCollectorName: feeCollector,
 		authority:        authority,
 		DevGasStore:      NewDevGasStore(storeKey, cdc),
@@ -71,6 +83,12 @@ func NewKeeper(
 			storeKey, devgastypes.KeyPrefixParams,
 			collections.ProtoValueEncoder[devgastypes.ModuleParams](cdc),
 		),
+		Earnings: collections.NewMap(
+			storeKey, devgastypes.KeyPrefixEarnings,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[devgastypes.FeeShareEarnings](cdc),
+		),
+		GasTracker: NewWasmGasTracker(tStoreKey),
 	}
 }
 
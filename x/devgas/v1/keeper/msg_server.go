@@ -142,7 +142,15 @@ func (k Keeper) RegisterFeeShare(
 
 	var deployer sdk.AccAddress
 
-	if k.isContractCreatedFromFactory(ctx, k.wasmKeeper.GetContractInfo(ctx, contract), msgSender) {
+	switch {
+	case k.IsEvmContract(ctx, contract):
+		// Check that the person who signed the message created the EVM
+		// contract
+		deployer, err = k.GetEvmContractDeployer(ctx, contract, msg.DeployerAddress, msg.DeployerNonce)
+		if err != nil {
+			return nil, err
+		}
+	case k.isContractCreatedFromFactory(ctx, k.wasmKeeper.GetContractInfo(ctx, contract), msgSender):
 		// Anyone is allowed to register the dev gas withdrawer for a smart
 		// contract to be the contract itself, so long as the contract was
 		// created from the "factory" (gov module or if contract admin or creator is another contract)
@@ -160,7 +168,7 @@ func (k Keeper) RegisterFeeShare(
 		if err != nil {
 			return nil, err
 		}
-	} else {
+	default:
 		// Check that the person who signed the message is the wasm contract
 		// admin or creator (if no admin)
 		deployer, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
@@ -225,8 +233,8 @@ func (k Keeper) UpdateFeeShare(
 		)
 	}
 
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
+	// Check that the person who signed the message is the contract deployer
+	err = k.checkFeeShareDeployer(ctx, contract, feeshare, msg.DeployerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +283,8 @@ func (k Keeper) CancelFeeShare(
 		)
 	}
 
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
+	// Check that the person who signed the message is the contract deployer
+	err = k.checkFeeShareDeployer(ctx, contract, fee, msg.DeployerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -64,3 +64,21 @@ func (fs FeeShare) Validate() error {
 
 	return nil
 }
+
+// Validate performs a stateless validation of a FeeShareEarnings
+func (e FeeShareEarnings) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.ContractAddress); err != nil {
+		return err
+	}
+	return e.Earnings.Validate()
+}
+
+// FeeSharePayoutEventOutput is an entry of the JSON payouts in the
+// EventPayoutDevGas event.
+type FeeSharePayoutEventOutput struct {
+	ContractAddress sdk.AccAddress `json:"contract_address"`
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
+	FeesPaid        sdk.Coins      `json:"fees_paid"`
+	// GasUsed: Gas consumed by the contract in the transaction.
+	GasUsed uint64 `json:"gas_used"`
+}
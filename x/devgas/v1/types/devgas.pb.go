@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -90,28 +93,91 @@ func (m *FeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+// FeeShareEarnings holds the fees paid out for a contract since it was first
+// registered for fee distribution.
+type FeeShareEarnings struct {
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// earnings: Cumulative fees paid to the withdrawers of the contract.
+	Earnings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
+}
+
+func (m *FeeShareEarnings) Reset()         { *m = FeeShareEarnings{} }
+func (m *FeeShareEarnings) String() string { return proto.CompactTextString(m) }
+func (*FeeShareEarnings) ProtoMessage()    {}
+func (*FeeShareEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71dc4524d1e4ffb, []int{1}
+}
+func (m *FeeShareEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeShareEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShareEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeShareEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShareEarnings.Merge(m, src)
+}
+func (m *FeeShareEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeShareEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShareEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeShareEarnings proto.InternalMessageInfo
+
+func (m *FeeShareEarnings) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FeeShareEarnings) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeeShare)(nil), "nibiru.devgas.v1.FeeShare")
+	proto.RegisterType((*FeeShareEarnings)(nil), "nibiru.devgas.v1.FeeShareEarnings")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/devgas.proto", fileDescriptor_f71dc4524d1e4ffb) }
 
 var fileDescriptor_f71dc4524d1e4ffb = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x49, 0x2d, 0x4b, 0x4f, 0x2c, 0xd6, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0xa5, 0x7e, 0x46,
-	0x2e, 0x0e, 0xb7, 0xd4, 0xd4, 0xe0, 0x8c, 0xc4, 0xa2, 0x54, 0x21, 0x4d, 0x2e, 0x81, 0xe4, 0xfc,
-	0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0xce, 0x20, 0x7e, 0x98, 0xb8, 0x23, 0x44, 0x18, 0xa4, 0x34, 0x25, 0xb5, 0x20,
-	0x27, 0xbf, 0x32, 0xb5, 0x08, 0xae, 0x94, 0x09, 0xa2, 0x14, 0x26, 0x0e, 0x53, 0xaa, 0xcb, 0x25,
-	0x54, 0x9e, 0x59, 0x92, 0x91, 0x52, 0x94, 0x58, 0x8e, 0xa4, 0x98, 0x19, 0xac, 0x58, 0x10, 0x21,
-	0x03, 0x55, 0xee, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0x60, 0x8f, 0x38, 0x67,
-	0x24, 0x66, 0xe6, 0xe9, 0x43, 0xfd, 0x5c, 0x81, 0xe4, 0xeb, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0xb0, 0xaf, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xbb, 0x00, 0xe8, 0x16, 0x01,
-	0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0xaf, 0x90, 0x18, 0x3c, 0x07, 0x91, 0x38, 0x20, 0x89, 0x85, 0x30, 0xe1, 0x40, 0xeb,
+	0xe9, 0x13, 0x08, 0xd1, 0xc4, 0xc5, 0x01, 0x37, 0x17, 0xd3, 0xbb, 0x36, 0xbd, 0x46, 0x69, 0x49,
+	0x5b, 0x0e, 0x79, 0x0a, 0x7d, 0x0a, 0x07, 0x9f, 0x84, 0x91, 0xd1, 0x49, 0x0d, 0xbc, 0x88, 0xa1,
+	0xed, 0x21, 0xab, 0x53, 0xff, 0xf9, 0xfa, 0xfb, 0x7f, 0xf9, 0xe7, 0xfb, 0xe2, 0x53, 0x29, 0x52,
+	0xa1, 0xa7, 0x98, 0xb2, 0x82, 0x13, 0x83, 0x8b, 0x24, 0x4c, 0x68, 0xa2, 0x95, 0x55, 0x8d, 0xba,
+	0xff, 0x46, 0x41, 0x2c, 0x92, 0x16, 0xcc, 0x94, 0x19, 0x2b, 0x83, 0x53, 0x62, 0x18, 0x2e, 0x92,
+	0x94, 0x59, 0x92, 0xe0, 0x4c, 0x09, 0xe9, 0x37, 0x5a, 0xc7, 0x5c, 0x71, 0xe5, 0x46, 0xbc, 0x99,
+	0xbc, 0xda, 0x7d, 0x05, 0x71, 0xed, 0x86, 0xb1, 0xfb, 0x9c, 0x68, 0xd6, 0x38, 0x8b, 0xeb, 0x99,
+	0x92, 0x56, 0x93, 0xcc, 0x3e, 0x12, 0x4a, 0x35, 0x33, 0xa6, 0x09, 0x3a, 0xa0, 0xb7, 0x3f, 0x3a,
+	0x2c, 0xf5, 0x2b, 0x2f, 0x6f, 0x50, 0xca, 0x26, 0xcf, 0x6a, 0xce, 0xf4, 0x16, 0xad, 0x78, 0xb4,
+	0xd4, 0x4b, 0xb4, 0x1f, 0x37, 0x66, 0xc2, 0xe6, 0x54, 0x93, 0xd9, 0x0e, 0x5c, 0x75, 0xf0, 0xd1,
+	0xdf, 0x4f, 0xc0, 0xbb, 0xef, 0x20, 0xae, 0x97, 0x17, 0x5d, 0x13, 0x2d, 0x85, 0xe4, 0xe6, 0x3f,
+	0x97, 0xf1, 0xb8, 0xc6, 0xc2, 0x5a, 0xb3, 0xd2, 0xa9, 0xf6, 0x0e, 0x2e, 0x4e, 0x90, 0x8f, 0x06,
+	0x6d, 0xa2, 0x41, 0x21, 0x1a, 0x34, 0x54, 0x42, 0x0e, 0xce, 0x17, 0x5f, 0xed, 0xe8, 0xe3, 0xbb,
+	0xdd, 0xe3, 0xc2, 0xe6, 0xd3, 0x14, 0x65, 0x6a, 0x8c, 0x43, 0x8e, 0xfe, 0xe9, 0x1b, 0xfa, 0x84,
+	0xed, 0x7c, 0xc2, 0x8c, 0x5b, 0x30, 0xa3, 0xad, 0xf9, 0xe0, 0x76, 0xb1, 0x82, 0x60, 0xb9, 0x82,
+	0xe0, 0x67, 0x05, 0xc1, 0xdb, 0x1a, 0x46, 0xcb, 0x35, 0x8c, 0x3e, 0xd7, 0x30, 0x7a, 0xc0, 0x3b,
+	0x6e, 0x77, 0xae, 0xa7, 0x61, 0x4e, 0x84, 0xc4, 0xa1, 0xd2, 0x97, 0x9d, 0x52, 0x9d, 0x75, 0xba,
+	0xe7, 0xca, 0xb8, 0xfc, 0x1d, 0x00, 0x30, 0x5f, 0x07, 0x40, 0xf5, 0x01, 0x00, 0x00,
 }
 
 func (m *FeeShare) Marshal() (dAtA []byte, err error) {
@@ -158,6 +224,50 @@ func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeShareEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShareEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShareEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevgas(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintDevgas(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDevgas(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDevgas(v)
 	base := offset
@@ -190,6 +300,25 @@ func (m *FeeShare) Size() (n int) {
 	return n
 }
 
+func (m *FeeShareEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovDevgas(uint64(l))
+	}
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovDevgas(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDevgas(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -342,6 +471,122 @@ func (m *FeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeShareEarnings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevgas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeShareEarnings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeShareEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevgas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDevgas(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
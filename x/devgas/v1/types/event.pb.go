@@ -219,7 +219,8 @@ func (m *EventUpdateDevGas) GetWithdrawer() string {
 }
 
 // ABCI event emitted when fee sharing payouts are made, containing details on
-// the payouts in JSON format.
+// the payouts in JSON format. Each payout holds the contract, its withdraw
+// address, the fees paid, and the gas the contract consumed.
 type EventPayoutDevGas struct {
 	Payouts string `protobuf:"bytes,1,opt,name=payouts,proto3" json:"payouts,omitempty"`
 }
@@ -264,33 +265,82 @@ func (m *EventPayoutDevGas) GetPayouts() string {
 	return ""
 }
 
+// ABCI event emitted when the fee sharing payout of a transaction fails. The
+// transaction keeps its result, and no fees are paid out.
+type EventPayoutDevGasFailed struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventPayoutDevGasFailed) Reset()         { *m = EventPayoutDevGasFailed{} }
+func (m *EventPayoutDevGasFailed) String() string { return proto.CompactTextString(m) }
+func (*EventPayoutDevGasFailed) ProtoMessage()    {}
+func (*EventPayoutDevGasFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd3ce94d3a226edf, []int{4}
+}
+func (m *EventPayoutDevGasFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPayoutDevGasFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPayoutDevGasFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPayoutDevGasFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPayoutDevGasFailed.Merge(m, src)
+}
+func (m *EventPayoutDevGasFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPayoutDevGasFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPayoutDevGasFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPayoutDevGasFailed proto.InternalMessageInfo
+
+func (m *EventPayoutDevGasFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventRegisterDevGas)(nil), "nibiru.devgas.v1.EventRegisterDevGas")
 	proto.RegisterType((*EventCancelDevGas)(nil), "nibiru.devgas.v1.EventCancelDevGas")
 	proto.RegisterType((*EventUpdateDevGas)(nil), "nibiru.devgas.v1.EventUpdateDevGas")
 	proto.RegisterType((*EventPayoutDevGas)(nil), "nibiru.devgas.v1.EventPayoutDevGas")
+	proto.RegisterType((*EventPayoutDevGasFailed)(nil), "nibiru.devgas.v1.EventPayoutDevGasFailed")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/event.proto", fileDescriptor_dd3ce94d3a226edf) }
 
 var fileDescriptor_dd3ce94d3a226edf = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x49, 0x2d, 0x4b, 0x4f, 0x2c, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0x95, 0x72, 0xb9, 0x84, 0x5d, 0x41, 0x0a, 0x82, 0x52, 0xd3, 0x33, 0x8b, 0x4b, 0x52,
-	0x8b, 0x5c, 0x52, 0xcb, 0xdc, 0x13, 0x8b, 0x85, 0xa4, 0xb8, 0x38, 0x52, 0x52, 0x0b, 0x72, 0xf2,
-	0x2b, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x90, 0x5c, 0x72, 0x7e,
-	0x5e, 0x49, 0x51, 0x62, 0x72, 0x89, 0x04, 0x13, 0x44, 0x0e, 0xc6, 0x17, 0x92, 0xe3, 0xe2, 0x2a,
-	0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x4f, 0x2d, 0x92, 0x60, 0x06, 0xcb, 0x22, 0x89, 0x28,
-	0x79, 0x73, 0x09, 0x82, 0xad, 0x73, 0x4e, 0xcc, 0x4b, 0x4e, 0xcd, 0xa1, 0xcc, 0x32, 0xa5, 0x6c,
-	0xa8, 0x61, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x34, 0x76, 0xb9, 0x2e, 0xd4, 0xb2, 0x80, 0xc4,
-	0xca, 0xfc, 0xd2, 0x12, 0xa8, 0x65, 0x12, 0x5c, 0xec, 0x05, 0x60, 0x7e, 0x31, 0xd4, 0x2e, 0x18,
-	0xd7, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33,
-	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xfd, 0xc0, 0xd1, 0xe1, 0x9c, 0x91, 0x98,
-	0x99, 0xa7, 0x0f, 0x8d, 0xb8, 0x0a, 0xa4, 0xa8, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0xc7, 0x9d, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x32, 0xd6, 0x7e, 0xdb, 0x01, 0x00, 0x00,
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0xcd, 0x4a, 0xc4, 0x30,
+	0x14, 0x85, 0x5b, 0xc5, 0xbf, 0xac, 0xb4, 0x0a, 0x16, 0x91, 0x20, 0x5d, 0xb9, 0xb1, 0x61, 0xf0,
+	0x0d, 0x1c, 0x7f, 0x10, 0x41, 0x64, 0xc0, 0x8d, 0xbb, 0xb4, 0xbd, 0xb4, 0xc1, 0x4e, 0x53, 0x92,
+	0xdb, 0x8c, 0x7d, 0x0b, 0x1f, 0xcb, 0xe5, 0x2c, 0x5d, 0x4a, 0xfb, 0x22, 0x32, 0x69, 0x2a, 0x03,
+	0x2e, 0xc5, 0xe5, 0xc9, 0x77, 0xc9, 0x77, 0xe0, 0x90, 0xd3, 0x4a, 0x24, 0x42, 0x35, 0x2c, 0x03,
+	0x93, 0x73, 0xcd, 0xcc, 0x84, 0x81, 0x81, 0x0a, 0xe3, 0x5a, 0x49, 0x94, 0xc1, 0xfe, 0x40, 0xe3,
+	0x81, 0xc6, 0x66, 0x12, 0xcd, 0xc9, 0xe1, 0xcd, 0xea, 0x60, 0x06, 0xb9, 0xd0, 0x08, 0xea, 0x1a,
+	0xcc, 0x1d, 0xd7, 0xc1, 0x09, 0xd9, 0xcd, 0xa0, 0x2e, 0x65, 0x0b, 0x2a, 0xf4, 0xcf, 0xfc, 0xf3,
+	0xbd, 0xd9, 0x4f, 0x5e, 0xb1, 0x54, 0x56, 0xa8, 0x78, 0x8a, 0xe1, 0xc6, 0xc0, 0xc6, 0x1c, 0x50,
+	0x42, 0x16, 0x02, 0x8b, 0x4c, 0xf1, 0x05, 0xa8, 0x70, 0xd3, 0xd2, 0xb5, 0x97, 0xe8, 0x81, 0x1c,
+	0x58, 0xdd, 0x94, 0x57, 0x29, 0x94, 0x7f, 0x93, 0x45, 0xaf, 0xee, 0xb3, 0xe7, 0x3a, 0xe3, 0x08,
+	0xff, 0xdc, 0xfc, 0xc2, 0xc9, 0x9e, 0x78, 0x2b, 0x1b, 0x74, 0xb2, 0x90, 0xec, 0xd4, 0x36, 0x6b,
+	0xe7, 0x1a, 0x63, 0xc4, 0xc8, 0xf1, 0xaf, 0xf3, 0x5b, 0x2e, 0x4a, 0xc8, 0x82, 0x23, 0xb2, 0x05,
+	0x4a, 0xc9, 0xb1, 0xde, 0x10, 0xae, 0xee, 0x3f, 0x3a, 0xea, 0x2f, 0x3b, 0xea, 0x7f, 0x75, 0xd4,
+	0x7f, 0xef, 0xa9, 0xb7, 0xec, 0xa9, 0xf7, 0xd9, 0x53, 0xef, 0x85, 0xe5, 0x02, 0x8b, 0x26, 0x89,
+	0x53, 0x39, 0x67, 0x8f, 0x76, 0xbf, 0x69, 0xc1, 0x45, 0xc5, 0xdc, 0xd2, 0x6f, 0x6b, 0x5b, 0x63,
+	0x5b, 0x83, 0x4e, 0xb6, 0xed, 0xd8, 0x97, 0xdf, 0x03, 0x00, 0xf8, 0x0c, 0xbf, 0x3b, 0x0c, 0x02,
+	0x00, 0x00,
 }
 
 func (m *EventRegisterDevGas) Marshal() (dAtA []byte, err error) {
@@ -448,6 +498,36 @@ func (m *EventPayoutDevGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPayoutDevGasFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPayoutDevGasFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPayoutDevGasFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -531,6 +611,19 @@ func (m *EventPayoutDevGas) Size() (n int) {
 	return n
 }
 
+func (m *EventPayoutDevGasFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1025,6 +1118,88 @@ func (m *EventPayoutDevGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPayoutDevGasFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPayoutDevGasFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPayoutDevGasFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,10 @@ import (
 	// "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acctypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -30,3 +34,9 @@ type BankKeeper interface {
 type WasmKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddr sdk.AccAddress) (wasmtypes.ContractInfo, error)
 }
+
+// EvmKeeper defines the expected interface needed to retrieve EVM contracts.
+type EvmKeeper interface {
+	GetAccount(ctx sdk.Context, addr gethcommon.Address) *statedb.Account
+	GetParams(ctx sdk.Context) evm.Params
+}
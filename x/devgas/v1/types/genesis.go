@@ -16,6 +16,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:   DefaultParams(),
 		FeeShare: []FeeShare{},
+		Earnings: []FeeShareEarnings{},
 	}
 }
 
@@ -36,5 +37,16 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenEarnings := make(map[string]bool)
+	for _, earnings := range gs.Earnings {
+		if seenEarnings[earnings.ContractAddress] {
+			return fmt.Errorf("contract earnings duplicated on genesis '%s'", earnings.ContractAddress)
+		}
+		if err := earnings.Validate(); err != nil {
+			return err
+		}
+		seenEarnings[earnings.ContractAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
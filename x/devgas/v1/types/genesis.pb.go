@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params ModuleParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// FeeShare is a slice of active registered contracts for fee distribution
 	FeeShare []FeeShare `protobuf:"bytes,2,rep,name=fee_share,json=feeShare,proto3" json:"fee_share"`
+	// earnings: Fees paid out for each contract
+	Earnings []FeeShareEarnings `protobuf:"bytes,3,rep,name=earnings,proto3" json:"earnings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,13 @@ func (m *GenesisState) GetFeeShare() []FeeShare {
 	return nil
 }
 
+func (m *GenesisState) GetEarnings() []FeeShareEarnings {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
 // ModuleParams defines the params for the devgas module
 type ModuleParams struct {
 	// enable_feeshare defines a parameter to enable the feeshare module
@@ -148,30 +157,31 @@ func init() {
 func init() { proto.RegisterFile("nibiru/devgas/v1/genesis.proto", fileDescriptor_86a5066ce5bd7311) }
 
 var fileDescriptor_86a5066ce5bd7311 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x6e, 0xe2, 0x40,
-	0x10, 0x86, 0xbd, 0x70, 0x42, 0xb0, 0x70, 0x1c, 0xb2, 0xae, 0xb0, 0x90, 0x6e, 0xb1, 0x90, 0xee,
-	0xe4, 0xe6, 0x76, 0x05, 0xd7, 0x5e, 0x1a, 0x82, 0x12, 0xa5, 0x48, 0x14, 0x99, 0x2a, 0x69, 0xd0,
-	0x1a, 0x0f, 0xc6, 0x8a, 0xed, 0xb5, 0xbc, 0xc6, 0x49, 0x9e, 0x21, 0x4d, 0x9e, 0x27, 0x4f, 0x40,
-	0x49, 0x19, 0xa5, 0x40, 0x11, 0xbc, 0x48, 0xe4, 0xb5, 0x93, 0xa0, 0x50, 0x79, 0xfc, 0xcf, 0xfc,
-	0xdf, 0x8c, 0xf6, 0xc7, 0x24, 0xf2, 0x1d, 0x3f, 0x59, 0x32, 0x17, 0x32, 0x8f, 0x4b, 0x96, 0x0d,
-	0x98, 0x07, 0x11, 0x48, 0x5f, 0xd2, 0x38, 0x11, 0xa9, 0xd0, 0x3b, 0x45, 0x9f, 0x16, 0x7d, 0x9a,
-	0x0d, 0xba, 0xbf, 0x0e, 0x1c, 0x65, 0x4f, 0x19, 0xba, 0x3f, 0x3d, 0xe1, 0x09, 0x55, 0xb2, 0xbc,
-	0x2a, 0xd4, 0xfe, 0x03, 0xc2, 0xad, 0xd3, 0x02, 0x3c, 0x49, 0x79, 0x0a, 0xfa, 0x7f, 0x5c, 0x8b,
-	0x79, 0xc2, 0x43, 0x69, 0x20, 0x13, 0x59, 0xcd, 0x21, 0xa1, 0x5f, 0x17, 0xd1, 0x73, 0xe1, 0x2e,
-	0x03, 0xb8, 0x54, 0x53, 0xa3, 0x6f, 0xab, 0x4d, 0x4f, 0xb3, 0x4b, 0x8f, 0x7e, 0x84, 0x1b, 0x73,
-	0x80, 0xa9, 0x5c, 0xf0, 0x04, 0x8c, 0x8a, 0x59, 0xb5, 0x9a, 0xc3, 0xee, 0x21, 0xe0, 0x04, 0x60,
-	0x92, 0x4f, 0x94, 0xe6, 0xfa, 0xbc, 0xfc, 0xef, 0x3f, 0x21, 0xdc, 0xda, 0xa7, 0xeb, 0x16, 0xee,
-	0x40, 0xc4, 0x9d, 0x00, 0xa6, 0x9f, 0xd8, 0xfc, 0xae, 0xba, 0xdd, 0x2e, 0xf4, 0x77, 0x94, 0x7e,
-	0x85, 0x3b, 0x2e, 0x64, 0x10, 0x88, 0x18, 0x92, 0x62, 0x50, 0x1a, 0x15, 0x13, 0x59, 0x8d, 0x11,
-	0xcd, 0x97, 0xbc, 0x6c, 0x7a, 0x7f, 0x3c, 0x3f, 0x5d, 0x2c, 0x1d, 0x3a, 0x13, 0x21, 0x9b, 0x09,
-	0x19, 0x0a, 0x59, 0x7e, 0xfe, 0x4a, 0xf7, 0x86, 0xa5, 0xf7, 0x31, 0x48, 0x3a, 0x86, 0x99, 0xfd,
-	0xe3, 0x83, 0xa3, 0xc8, 0x52, 0xff, 0x8d, 0xdb, 0x3c, 0x08, 0xc4, 0x2d, 0xb8, 0x53, 0x17, 0x22,
-	0x11, 0x4a, 0xa3, 0x6a, 0x56, 0xad, 0x86, 0xfd, 0xbd, 0x54, 0xc7, 0x4a, 0x1c, 0x9d, 0xad, 0xb6,
-	0x04, 0xad, 0xb7, 0x04, 0xbd, 0x6e, 0x09, 0x7a, 0xdc, 0x11, 0x6d, 0xbd, 0x23, 0xda, 0xf3, 0x8e,
-	0x68, 0xd7, 0x6c, 0x6f, 0xf3, 0x85, 0x7a, 0x8c, 0xe3, 0x05, 0xf7, 0x23, 0x56, 0x06, 0x76, 0xb7,
-	0x17, 0x99, 0x3a, 0xc3, 0xa9, 0xa9, 0x70, 0xfe, 0xbd, 0x05, 0x00, 0x00, 0xff, 0xff, 0x24, 0xf2,
-	0x7e, 0x8d, 0x05, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x6f, 0xda, 0x30,
+	0x14, 0xc7, 0x63, 0x98, 0x10, 0x18, 0xc6, 0x50, 0xb4, 0x43, 0x84, 0x34, 0x83, 0x90, 0x36, 0xe5,
+	0x32, 0x5b, 0xb0, 0xeb, 0x76, 0x61, 0x6c, 0xd3, 0x0e, 0x9b, 0xa6, 0x70, 0x5a, 0x2f, 0xc8, 0x21,
+	0x8f, 0x10, 0x35, 0x89, 0xa3, 0x38, 0xa4, 0xed, 0xb7, 0xe8, 0xe7, 0xe9, 0x27, 0xe0, 0x48, 0x6f,
+	0x55, 0x0f, 0xa8, 0x82, 0x2f, 0x52, 0xc5, 0x31, 0x2d, 0x2a, 0xea, 0x29, 0xce, 0xff, 0xfd, 0x7f,
+	0xff, 0xf7, 0xec, 0x87, 0x49, 0x1c, 0xb8, 0x41, 0xba, 0x62, 0x1e, 0xe4, 0x3e, 0x97, 0x2c, 0x1f,
+	0x32, 0x1f, 0x62, 0x90, 0x81, 0xa4, 0x49, 0x2a, 0x32, 0x61, 0x76, 0xca, 0x3a, 0x2d, 0xeb, 0x34,
+	0x1f, 0x76, 0x3f, 0x9c, 0x10, 0xba, 0xa6, 0x80, 0xee, 0x7b, 0x5f, 0xf8, 0x42, 0x1d, 0x59, 0x71,
+	0x2a, 0xd5, 0xc1, 0x2d, 0xc2, 0xad, 0x5f, 0x65, 0xf0, 0x34, 0xe3, 0x19, 0x98, 0x5f, 0x71, 0x2d,
+	0xe1, 0x29, 0x8f, 0xa4, 0x85, 0xfa, 0xc8, 0x6e, 0x8e, 0x08, 0x7d, 0xd9, 0x88, 0xfe, 0x11, 0xde,
+	0x2a, 0x84, 0x7f, 0xca, 0x35, 0x7e, 0xb3, 0xde, 0xf6, 0x0c, 0x47, 0x33, 0xe6, 0x37, 0xdc, 0x58,
+	0x00, 0xcc, 0xe4, 0x92, 0xa7, 0x60, 0x55, 0xfa, 0x55, 0xbb, 0x39, 0xea, 0x9e, 0x06, 0xfc, 0x04,
+	0x98, 0x16, 0x0e, 0x0d, 0xd7, 0x17, 0xfa, 0xdf, 0x9c, 0xe0, 0x3a, 0xf0, 0x34, 0x0e, 0x62, 0x5f,
+	0x5a, 0x55, 0x45, 0x0f, 0x5e, 0xa7, 0x7f, 0x68, 0xe7, 0x21, 0xe5, 0x40, 0x0e, 0x6e, 0x10, 0x6e,
+	0x1d, 0xcf, 0x68, 0xda, 0xb8, 0x03, 0x31, 0x77, 0x43, 0x98, 0x3d, 0x0f, 0x57, 0xdc, 0xae, 0xee,
+	0xb4, 0x4b, 0xfd, 0x10, 0x69, 0xfe, 0xc7, 0x1d, 0x0f, 0x72, 0x08, 0x45, 0x02, 0x69, 0x69, 0x94,
+	0x56, 0xa5, 0x8f, 0xec, 0xc6, 0x98, 0x16, 0x4d, 0xee, 0xb7, 0xbd, 0x4f, 0x7e, 0x90, 0x2d, 0x57,
+	0x2e, 0x9d, 0x8b, 0x88, 0xcd, 0x85, 0x8c, 0x84, 0xd4, 0x9f, 0xcf, 0xd2, 0x3b, 0x67, 0xd9, 0x55,
+	0x02, 0x92, 0x4e, 0x60, 0xee, 0xbc, 0x7b, 0xca, 0x51, 0xc9, 0xd2, 0xfc, 0x88, 0xdb, 0x3c, 0x0c,
+	0xc5, 0x05, 0x78, 0x33, 0x0f, 0x62, 0x11, 0x95, 0x37, 0x6c, 0x38, 0x6f, 0xb5, 0x3a, 0x51, 0xe2,
+	0xf8, 0xf7, 0x7a, 0x47, 0xd0, 0x66, 0x47, 0xd0, 0xc3, 0x8e, 0xa0, 0xeb, 0x3d, 0x31, 0x36, 0x7b,
+	0x62, 0xdc, 0xed, 0x89, 0x71, 0xc6, 0x8e, 0x3a, 0xff, 0x55, 0x8f, 0xf2, 0x7d, 0xc9, 0x83, 0x98,
+	0xe9, 0xb5, 0x5f, 0x1e, 0x2d, 0x5e, 0x8d, 0xe1, 0xd6, 0xd4, 0x8a, 0xbf, 0x3c, 0x0e, 0x00, 0x63,
+	0xed, 0xb0, 0xc5, 0x4b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +204,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeeShare) > 0 {
 		for iNdEx := len(m.FeeShare) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -298,6 +322,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -423,6 +453,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earnings = append(m.Earnings, FeeShareEarnings{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -111,6 +111,30 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with earnings",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Earnings: []FeeShareEarnings{
+					{
+						ContractAddress: suite.contractA,
+						Earnings:        sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated earnings",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Earnings: []FeeShareEarnings{
+					{ContractAddress: suite.contractA},
+					{ContractAddress: suite.contractA},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis - invalid withdrawer address",
 			genState: &GenesisState{
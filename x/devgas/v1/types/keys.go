@@ -14,6 +14,9 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// TStoreKey to be used when creating the transient store
+	TStoreKey = "transient_" + ModuleName
 )
 
 // KVStore key and mutli-index prefixes
@@ -23,4 +26,10 @@ const (
 	KeyPrefixDeployer
 	KeyPrefixWithdrawer
 	KeyPrefixParams
+	KeyPrefixEarnings
+)
+
+// Transient store prefixes
+const (
+	KeyPrefixContractGas collections.Namespace = iota + 1
 )
@@ -262,6 +262,12 @@ func (s *MsgsTestSuite) TestQuery_ValidateBasic() {
 
 				queryMsg.Deployer = invalidAddr
 				s.Error(queryMsg.ValidateBasic())
+
+				queryMsg = &QueryFeeSharesRequest{}
+				s.NoError(queryMsg.ValidateBasic())
+
+				queryMsg.Withdrawer = invalidAddr
+				s.Error(queryMsg.ValidateBasic())
 			},
 		},
 		{
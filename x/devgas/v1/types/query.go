@@ -14,10 +14,18 @@ func (q QueryFeeShareRequest) ValidateBasic() error {
 	return nil
 }
 
-// ValidateBasic runs stateless checks on the query requests
+// ValidateBasic runs stateless checks on the query requests. The deployer and
+// withdrawer filters are optional.
 func (q QueryFeeSharesRequest) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(q.Deployer); err != nil {
-		return errorsmod.Wrapf(err, "invalid deployer address %s", q.Deployer)
+	if q.Deployer != "" {
+		if _, err := sdk.AccAddressFromBech32(q.Deployer); err != nil {
+			return errorsmod.Wrapf(err, "invalid deployer address %s", q.Deployer)
+		}
+	}
+	if q.Withdrawer != "" {
+		if _, err := sdk.AccAddressFromBech32(q.Withdrawer); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", q.Withdrawer)
+		}
 	}
 	return nil
 }
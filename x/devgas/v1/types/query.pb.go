@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,9 +34,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // QueryFeeSharesRequest is the request type for the Query/FeeShares RPC method.
 type QueryFeeSharesRequest struct {
-	// TODO feat(devgas): re-implement the paginated version
-	// TODO feat(colletions): add automatic pagination generation
+	// deployer: bech32 address of the deployer. Optional.
 	Deployer string `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// withdrawer: bech32 address of the withdrawer. Optional.
+	Withdrawer string `protobuf:"bytes,2,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFeeSharesRequest) Reset()         { *m = QueryFeeSharesRequest{} }
@@ -77,11 +82,27 @@ func (m *QueryFeeSharesRequest) GetDeployer() string {
 	return ""
 }
 
+func (m *QueryFeeSharesRequest) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
+	}
+	return ""
+}
+
+func (m *QueryFeeSharesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFeeSharesResponse is the response type for the Query/FeeShares RPC
 // method.
 type QueryFeeSharesResponse struct {
 	// FeeShare is the slice of all stored Reveneue for the deployer
 	Feeshare []FeeShare `protobuf:"bytes,1,rep,name=feeshare,proto3" json:"feeshare"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFeeSharesResponse) Reset()         { *m = QueryFeeSharesResponse{} }
@@ -124,6 +145,13 @@ func (m *QueryFeeSharesResponse) GetFeeshare() []FeeShare {
 	return nil
 }
 
+func (m *QueryFeeSharesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFeeShareRequest is the request type for the Query/FeeShare RPC method.
 type QueryFeeShareRequest struct {
 	// contract_address of a registered contract in bech32 format
@@ -392,6 +420,100 @@ func (m *QueryFeeSharesByWithdrawerResponse) GetFeeshare() []FeeShare {
 	return nil
 }
 
+// QueryFeeShareEarningsRequest is the request type for the
+// Query/FeeShareEarnings RPC method.
+type QueryFeeShareEarningsRequest struct {
+	// contract_address of a contract in bech32 format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryFeeShareEarningsRequest) Reset()         { *m = QueryFeeShareEarningsRequest{} }
+func (m *QueryFeeShareEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeShareEarningsRequest) ProtoMessage()    {}
+func (*QueryFeeShareEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68d3a02185e7c52, []int{8}
+}
+func (m *QueryFeeShareEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeShareEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeShareEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeShareEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeShareEarningsRequest.Merge(m, src)
+}
+func (m *QueryFeeShareEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeShareEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeShareEarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeShareEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeShareEarningsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// QueryFeeShareEarningsResponse is the response type for the
+// Query/FeeShareEarnings RPC method.
+type QueryFeeShareEarningsResponse struct {
+	// earnings: Fees paid out for the contract since it was first registered.
+	Earnings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
+}
+
+func (m *QueryFeeShareEarningsResponse) Reset()         { *m = QueryFeeShareEarningsResponse{} }
+func (m *QueryFeeShareEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeShareEarningsResponse) ProtoMessage()    {}
+func (*QueryFeeShareEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68d3a02185e7c52, []int{9}
+}
+func (m *QueryFeeShareEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeShareEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeShareEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeShareEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeShareEarningsResponse.Merge(m, src)
+}
+func (m *QueryFeeShareEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeShareEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeShareEarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeShareEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeShareEarningsResponse) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeSharesRequest)(nil), "nibiru.devgas.v1.QueryFeeSharesRequest")
 	proto.RegisterType((*QueryFeeSharesResponse)(nil), "nibiru.devgas.v1.QueryFeeSharesResponse")
@@ -401,48 +523,60 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.devgas.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryFeeSharesByWithdrawerRequest)(nil), "nibiru.devgas.v1.QueryFeeSharesByWithdrawerRequest")
 	proto.RegisterType((*QueryFeeSharesByWithdrawerResponse)(nil), "nibiru.devgas.v1.QueryFeeSharesByWithdrawerResponse")
+	proto.RegisterType((*QueryFeeShareEarningsRequest)(nil), "nibiru.devgas.v1.QueryFeeShareEarningsRequest")
+	proto.RegisterType((*QueryFeeShareEarningsResponse)(nil), "nibiru.devgas.v1.QueryFeeShareEarningsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/query.proto", fileDescriptor_b68d3a02185e7c52) }
 
 var fileDescriptor_b68d3a02185e7c52 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8b, 0xd3, 0x5c,
-	0x14, 0xc6, 0x9b, 0xf7, 0x1d, 0x4b, 0xe7, 0xba, 0x70, 0xbc, 0x76, 0xa4, 0x84, 0x31, 0xd6, 0xa0,
-	0xb6, 0x23, 0x98, 0x4b, 0xa6, 0x82, 0x08, 0x6e, 0xa6, 0x82, 0xe0, 0x42, 0xd1, 0x0e, 0x2a, 0xb8,
-	0x19, 0x6e, 0x9a, 0x33, 0x69, 0xa0, 0xcd, 0xcd, 0xe4, 0xa6, 0xad, 0x45, 0xba, 0xf1, 0x0b, 0x28,
-	0xe8, 0xd7, 0x71, 0x3f, 0xcb, 0x01, 0x37, 0xae, 0x44, 0x5a, 0x37, 0x7e, 0x0b, 0xe9, 0xfd, 0x53,
-	0x6d, 0xd3, 0x69, 0x18, 0x70, 0x97, 0x9e, 0x73, 0x9e, 0xf3, 0xfc, 0x7a, 0xf2, 0xb4, 0x68, 0x27,
-	0x0a, 0xbd, 0x30, 0xe9, 0x13, 0x1f, 0x06, 0x01, 0xe5, 0x64, 0xe0, 0x92, 0xe3, 0x3e, 0x24, 0x23,
-	0x27, 0x4e, 0x58, 0xca, 0xf0, 0x96, 0xec, 0x3a, 0xb2, 0xeb, 0x0c, 0x5c, 0xf3, 0x4e, 0x9b, 0xf1,
-	0x1e, 0xe3, 0xc4, 0xa3, 0x1c, 0xe4, 0x28, 0x19, 0xb8, 0x1e, 0xa4, 0xd4, 0x25, 0x31, 0x0d, 0xc2,
-	0x88, 0xa6, 0x21, 0x8b, 0xa4, 0xda, 0xb4, 0x32, 0xbb, 0x03, 0x88, 0x80, 0x87, 0x5c, 0xf5, 0xaf,
-	0x65, 0xfa, 0xca, 0x47, 0xb6, 0xcb, 0x01, 0x0b, 0x98, 0x78, 0x24, 0xb3, 0x27, 0x55, 0xdd, 0x09,
-	0x18, 0x0b, 0xba, 0x40, 0x68, 0x1c, 0x12, 0x1a, 0x45, 0x2c, 0x15, 0x8e, 0x4a, 0x63, 0x37, 0xd0,
-	0xf6, 0x8b, 0x19, 0xd4, 0x63, 0x80, 0x83, 0x0e, 0x4d, 0x80, 0xb7, 0xe0, 0xb8, 0x0f, 0x3c, 0xc5,
-	0x26, 0x2a, 0xf9, 0x10, 0x77, 0xd9, 0x08, 0x92, 0x8a, 0x51, 0x35, 0xea, 0x9b, 0xad, 0xf9, 0x67,
-	0xfb, 0x15, 0xba, 0xba, 0x2c, 0xe2, 0x31, 0x8b, 0x38, 0xe0, 0x87, 0xa8, 0x74, 0x04, 0xc0, 0x67,
-	0xc5, 0x8a, 0x51, 0xfd, 0xbf, 0x7e, 0x71, 0xcf, 0x74, 0x96, 0x4f, 0xe2, 0x68, 0x59, 0x73, 0xe3,
-	0xe4, 0xfb, 0xf5, 0x42, 0x6b, 0xae, 0xb0, 0xf7, 0x51, 0x79, 0x61, 0xaf, 0x66, 0xd9, 0x45, 0x5b,
-	0x6d, 0x16, 0xa5, 0x09, 0x6d, 0xa7, 0x87, 0xd4, 0xf7, 0x13, 0xe0, 0x5c, 0x31, 0x5d, 0xd2, 0xf5,
-	0x7d, 0x59, 0xb6, 0x5f, 0x2e, 0x7d, 0x9f, 0x33, 0xc8, 0x8c, 0x73, 0x92, 0x95, 0x11, 0x16, 0x6b,
-	0x9f, 0xd3, 0x84, 0xf6, 0xf4, 0x8d, 0xec, 0x03, 0x74, 0x65, 0xa1, 0x3a, 0xb7, 0x2a, 0xc6, 0xa2,
-	0xa2, 0x8c, 0xac, 0xac, 0xd1, 0x53, 0xe6, 0xf7, 0xbb, 0x20, 0x75, 0xca, 0x4c, 0x69, 0xec, 0x16,
-	0xba, 0xb1, 0x78, 0xdc, 0xe6, 0xe8, 0x75, 0x98, 0x76, 0xfc, 0x84, 0x0e, 0x21, 0xd1, 0x17, 0xb9,
-	0x8b, 0xf0, 0x70, 0x5e, 0x5c, 0xba, 0xc9, 0xe5, 0x3f, 0x1d, 0x7d, 0x15, 0x0f, 0xd9, 0xeb, 0x76,
-	0xfe, 0x8b, 0x97, 0xb7, 0xf7, 0x6b, 0x03, 0x5d, 0x10, 0x26, 0xf8, 0x83, 0x81, 0x36, 0xe7, 0x4e,
-	0xb8, 0x96, 0xdd, 0xb1, 0x32, 0x71, 0x66, 0x3d, 0x7f, 0x50, 0x82, 0xda, 0xe4, 0xfd, 0xd7, 0x9f,
-	0x9f, 0xfe, 0xdb, 0xc5, 0x35, 0x92, 0xf9, 0x41, 0x1c, 0x01, 0x1c, 0x0a, 0x1e, 0x4e, 0xde, 0xe9,
-	0xbc, 0x8e, 0xf1, 0x67, 0x03, 0x95, 0xf4, 0x1a, 0x7c, 0x3b, 0xc7, 0x47, 0xf3, 0xd4, 0x72, 0xe7,
-	0x14, 0xce, 0x7d, 0x81, 0xe3, 0x62, 0xb2, 0x1e, 0x67, 0x39, 0xc2, 0x63, 0x3c, 0x44, 0x45, 0x19,
-	0x01, 0x7c, 0xf3, 0x0c, 0xaf, 0x85, 0xbc, 0x99, 0xb7, 0x72, 0xa6, 0x14, 0x4f, 0x55, 0xf0, 0x98,
-	0xb8, 0x92, 0xe5, 0x91, 0x19, 0xc3, 0x5f, 0x0c, 0xb4, 0xbd, 0x32, 0x0b, 0xb8, 0x91, 0xf7, 0x12,
-	0x56, 0xa4, 0xd1, 0xbc, 0x77, 0x3e, 0x91, 0xc2, 0x7c, 0x20, 0x30, 0x1b, 0xd8, 0x5d, 0x7f, 0xb6,
-	0x6c, 0xce, 0xc7, 0xcd, 0x27, 0x27, 0x13, 0xcb, 0x38, 0x9d, 0x58, 0xc6, 0x8f, 0x89, 0x65, 0x7c,
-	0x9c, 0x5a, 0x85, 0xd3, 0xa9, 0x55, 0xf8, 0x36, 0xb5, 0x0a, 0x6f, 0x48, 0x10, 0xa6, 0x9d, 0xbe,
-	0xe7, 0xb4, 0x59, 0x8f, 0x3c, 0x13, 0x6b, 0x1f, 0x75, 0x68, 0x18, 0x69, 0x8b, 0xb7, 0x7f, 0x99,
-	0xa4, 0xa3, 0x18, 0xb8, 0x57, 0x14, 0xff, 0x83, 0x8d, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0x1e, 0xa0, 0x89, 0xd8, 0x05, 0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbd, 0x6f, 0xd3, 0x4c,
+	0x1c, 0xc7, 0x73, 0xed, 0xf3, 0x44, 0xe9, 0x75, 0xa0, 0x1c, 0x2d, 0x0a, 0x56, 0xea, 0x06, 0x0b,
+	0xda, 0x80, 0x54, 0x1f, 0x69, 0x41, 0x08, 0x89, 0xa5, 0xa9, 0x28, 0xea, 0x00, 0x94, 0x54, 0x08,
+	0xa9, 0x4b, 0x75, 0x89, 0xaf, 0x8e, 0x45, 0xeb, 0x73, 0x7d, 0x4e, 0x4a, 0x84, 0xba, 0x30, 0x31,
+	0x22, 0xc1, 0xc6, 0xc0, 0xde, 0x85, 0x8d, 0x8d, 0xbd, 0x63, 0x25, 0x16, 0x26, 0x40, 0x2d, 0x7f,
+	0x08, 0xca, 0xdd, 0xd9, 0x8d, 0xed, 0x34, 0xa6, 0x88, 0x29, 0xce, 0xef, 0xe5, 0xbe, 0x9f, 0xfb,
+	0xbd, 0xd8, 0xb0, 0xe4, 0x3a, 0x0d, 0xc7, 0x6f, 0x63, 0x8b, 0x76, 0x6c, 0xc2, 0x71, 0xa7, 0x8a,
+	0x77, 0xdb, 0xd4, 0xef, 0x9a, 0x9e, 0xcf, 0x02, 0x86, 0x26, 0xa4, 0xd7, 0x94, 0x5e, 0xb3, 0x53,
+	0xd5, 0x6e, 0x36, 0x19, 0xdf, 0x61, 0x1c, 0x37, 0x08, 0xa7, 0x32, 0x14, 0x77, 0xaa, 0x0d, 0x1a,
+	0x90, 0x2a, 0xf6, 0x88, 0xed, 0xb8, 0x24, 0x70, 0x98, 0x2b, 0xb3, 0x35, 0xbd, 0x3f, 0x36, 0x8c,
+	0x6a, 0x32, 0x27, 0xf2, 0xa7, 0xb4, 0x6d, 0xea, 0x52, 0xee, 0x70, 0xe5, 0x9f, 0x4e, 0xf9, 0x15,
+	0x87, 0x74, 0x4f, 0xda, 0xcc, 0x66, 0xe2, 0x11, 0xf7, 0x9e, 0x94, 0xb5, 0x64, 0x33, 0x66, 0x6f,
+	0x53, 0x4c, 0x3c, 0x07, 0x13, 0xd7, 0x65, 0x81, 0x20, 0x52, 0x39, 0xc6, 0x07, 0x00, 0xa7, 0x9e,
+	0xf6, 0xa8, 0x57, 0x28, 0x5d, 0x6f, 0x11, 0x9f, 0xf2, 0x3a, 0xdd, 0x6d, 0x53, 0x1e, 0x20, 0x0d,
+	0x16, 0x2c, 0xea, 0x6d, 0xb3, 0x2e, 0xf5, 0x8b, 0xa0, 0x0c, 0x2a, 0x63, 0xf5, 0xe8, 0x3f, 0xd2,
+	0x21, 0xdc, 0x73, 0x82, 0x96, 0xe5, 0x93, 0x3d, 0xea, 0x17, 0x47, 0x84, 0xb7, 0xcf, 0x82, 0x56,
+	0x20, 0x3c, 0xbd, 0x7c, 0x71, 0xb4, 0x0c, 0x2a, 0xe3, 0x0b, 0xb3, 0xa6, 0xbc, 0xbd, 0xd9, 0xbb,
+	0xbd, 0x29, 0x8b, 0xaa, 0x6a, 0x60, 0xae, 0x11, 0x9b, 0x2a, 0xdd, 0x7a, 0x5f, 0xa6, 0xf1, 0x11,
+	0xc0, 0xcb, 0x49, 0x3a, 0xee, 0x31, 0x97, 0x53, 0x74, 0x1f, 0x16, 0xb6, 0x28, 0xe5, 0x3d, 0x63,
+	0x11, 0x94, 0x47, 0x2b, 0xe3, 0x0b, 0x9a, 0x99, 0x6c, 0x8e, 0x19, 0xa6, 0xd5, 0xfe, 0x3b, 0xfc,
+	0x3e, 0x93, 0xab, 0x47, 0x19, 0xe8, 0x61, 0x0c, 0x70, 0x44, 0x00, 0xce, 0x65, 0x02, 0x4a, 0xe9,
+	0x18, 0xe1, 0x12, 0x9c, 0x8c, 0x01, 0x86, 0xd5, 0xbb, 0x01, 0x27, 0x9a, 0xcc, 0x0d, 0x7c, 0xd2,
+	0x0c, 0x36, 0x89, 0x65, 0xf9, 0x94, 0x73, 0x55, 0xc5, 0x0b, 0xa1, 0x7d, 0x49, 0x9a, 0x8d, 0x67,
+	0x89, 0x0e, 0x9c, 0x71, 0x45, 0x70, 0xbe, 0x2b, 0x1a, 0x93, 0x10, 0x89, 0x63, 0xd7, 0x88, 0x4f,
+	0x76, 0xc2, 0xae, 0x1a, 0xeb, 0xf0, 0x52, 0xcc, 0x1a, 0x49, 0xe5, 0x3d, 0x61, 0x51, 0x42, 0x7a,
+	0x5a, 0xe8, 0x11, 0xb3, 0xda, 0xdb, 0x54, 0xe6, 0x29, 0x31, 0x95, 0x63, 0xd4, 0xe1, 0xd5, 0x78,
+	0x97, 0x6a, 0xdd, 0xe7, 0xd1, 0x30, 0x84, 0x15, 0x99, 0x87, 0xe8, 0x74, 0x42, 0x12, 0x35, 0xb9,
+	0x78, 0xea, 0x09, 0xab, 0xd2, 0x80, 0xc6, 0xb0, 0x33, 0xff, 0xc5, 0x14, 0x18, 0xab, 0xb0, 0x14,
+	0xd3, 0x78, 0x40, 0x7c, 0xd7, 0x71, 0x6d, 0xfe, 0x17, 0x4d, 0x7c, 0x03, 0xe0, 0xf4, 0x19, 0x67,
+	0x29, 0x54, 0x1b, 0x16, 0xa8, 0xb2, 0x29, 0xd4, 0x2b, 0xb1, 0x81, 0x0b, 0x47, 0x6d, 0x99, 0x39,
+	0x6e, 0xed, 0x56, 0x8f, 0xf4, 0xe0, 0xc7, 0x4c, 0xc5, 0x76, 0x82, 0x56, 0xbb, 0x61, 0x36, 0xd9,
+	0x0e, 0x96, 0xc1, 0xea, 0x67, 0x9e, 0x5b, 0x2f, 0x70, 0xd0, 0xf5, 0x28, 0x17, 0x09, 0xbc, 0x1e,
+	0x1d, 0xbe, 0xf0, 0x39, 0x0f, 0xff, 0x17, 0x28, 0xe8, 0x13, 0x80, 0x63, 0x51, 0xfd, 0xd0, 0x5c,
+	0xba, 0x32, 0x03, 0x37, 0x5f, 0xab, 0x64, 0x07, 0xca, 0x3b, 0x19, 0x4f, 0x5e, 0x7f, 0xfd, 0xf5,
+	0x6e, 0x64, 0x75, 0x43, 0x47, 0x25, 0x9c, 0x7a, 0x37, 0x6d, 0x51, 0xba, 0xc9, 0x95, 0xf4, 0x30,
+	0x2f, 0x7e, 0x15, 0xbe, 0x57, 0xf6, 0xd1, 0x7b, 0x00, 0x0b, 0xa1, 0x0c, 0x9a, 0xcd, 0xe0, 0x08,
+	0x79, 0xe7, 0x32, 0xe3, 0x14, 0xee, 0x5d, 0x81, 0x5b, 0x45, 0x78, 0x38, 0x4e, 0xb2, 0xe7, 0xfb,
+	0x68, 0x0f, 0xe6, 0xe5, 0xe0, 0xa3, 0x6b, 0x67, 0x68, 0xc5, 0xb6, 0x4c, 0xbb, 0x9e, 0x11, 0xa5,
+	0x78, 0xca, 0x82, 0x47, 0x43, 0xc5, 0x34, 0x8f, 0xdc, 0x2c, 0xf4, 0x05, 0xc0, 0xa9, 0x81, 0x1b,
+	0x80, 0x16, 0xb3, 0x9a, 0x34, 0x60, 0x07, 0xb5, 0xdb, 0xe7, 0x4b, 0x52, 0x98, 0xf7, 0x04, 0xe6,
+	0x22, 0xaa, 0x0e, 0x2f, 0x5b, 0x7a, 0xbb, 0xf7, 0xd1, 0x01, 0x80, 0x13, 0xc9, 0x8d, 0x40, 0x66,
+	0x06, 0x45, 0x62, 0x0d, 0x35, 0xfc, 0xc7, 0xf1, 0x0a, 0xf8, 0x8e, 0x00, 0xc6, 0x68, 0x3e, 0x0d,
+	0x1c, 0x6e, 0xc9, 0x80, 0x2e, 0xd7, 0x56, 0x0f, 0x8f, 0x75, 0x70, 0x74, 0xac, 0x83, 0x9f, 0xc7,
+	0x3a, 0x78, 0x7b, 0xa2, 0xe7, 0x8e, 0x4e, 0xf4, 0xdc, 0xb7, 0x13, 0x3d, 0xb7, 0x81, 0xfb, 0xd6,
+	0xf0, 0xb1, 0x38, 0x72, 0xb9, 0x45, 0x1c, 0x37, 0x3c, 0xfe, 0x65, 0x9f, 0x80, 0xd8, 0xc9, 0x46,
+	0x5e, 0x7c, 0x5d, 0x17, 0x7f, 0x0f, 0x00, 0xa5, 0x1f, 0xc4, 0x79, 0x4e, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -458,7 +592,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	// FeeShares retrieves all FeeShares that a deployer has
-	// registered
+	// registered. The results can be filtered by withdrawer and are paginated.
+	// Without a deployer or withdrawer, all FeeShares are returned.
 	FeeShares(ctx context.Context, in *QueryFeeSharesRequest, opts ...grpc.CallOption) (*QueryFeeSharesResponse, error)
 	// FeeShare retrieves a registered FeeShare for a given contract address
 	FeeShare(ctx context.Context, in *QueryFeeShareRequest, opts ...grpc.CallOption) (*QueryFeeShareResponse, error)
@@ -467,6 +602,9 @@ type QueryClient interface {
 	// FeeSharesByWithdrawer retrieves all FeeShares with a given withdrawer
 	// address
 	FeeSharesByWithdrawer(ctx context.Context, in *QueryFeeSharesByWithdrawerRequest, opts ...grpc.CallOption) (*QueryFeeSharesByWithdrawerResponse, error)
+	// FeeShareEarnings retrieves the fees paid out to the withdrawers of a
+	// contract since it was first registered
+	FeeShareEarnings(ctx context.Context, in *QueryFeeShareEarningsRequest, opts ...grpc.CallOption) (*QueryFeeShareEarningsResponse, error)
 }
 
 type queryClient struct {
@@ -513,10 +651,20 @@ func (c *queryClient) FeeSharesByWithdrawer(ctx context.Context, in *QueryFeeSha
 	return out, nil
 }
 
+func (c *queryClient) FeeShareEarnings(ctx context.Context, in *QueryFeeShareEarningsRequest, opts ...grpc.CallOption) (*QueryFeeShareEarningsResponse, error) {
+	out := new(QueryFeeShareEarningsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.devgas.v1.Query/FeeShareEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeShares retrieves all FeeShares that a deployer has
-	// registered
+	// registered. The results can be filtered by withdrawer and are paginated.
+	// Without a deployer or withdrawer, all FeeShares are returned.
 	FeeShares(context.Context, *QueryFeeSharesRequest) (*QueryFeeSharesResponse, error)
 	// FeeShare retrieves a registered FeeShare for a given contract address
 	FeeShare(context.Context, *QueryFeeShareRequest) (*QueryFeeShareResponse, error)
@@ -525,6 +673,9 @@ type QueryServer interface {
 	// FeeSharesByWithdrawer retrieves all FeeShares with a given withdrawer
 	// address
 	FeeSharesByWithdrawer(context.Context, *QueryFeeSharesByWithdrawerRequest) (*QueryFeeSharesByWithdrawerResponse, error)
+	// FeeShareEarnings retrieves the fees paid out to the withdrawers of a
+	// contract since it was first registered
+	FeeShareEarnings(context.Context, *QueryFeeShareEarningsRequest) (*QueryFeeShareEarningsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -543,6 +694,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) FeeSharesByWithdrawer(ctx context.Context, req *QueryFeeSharesByWithdrawerRequest) (*QueryFeeSharesByWithdrawerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeSharesByWithdrawer not implemented")
 }
+func (*UnimplementedQueryServer) FeeShareEarnings(ctx context.Context, req *QueryFeeShareEarningsRequest) (*QueryFeeShareEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeShareEarnings not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -620,6 +774,24 @@ func _Query_FeeSharesByWithdrawer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeShareEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeShareEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeShareEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.devgas.v1.Query/FeeShareEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeShareEarnings(ctx, req.(*QueryFeeShareEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.devgas.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -640,6 +812,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeSharesByWithdrawer",
 			Handler:    _Query_FeeSharesByWithdrawer_Handler,
 		},
+		{
+			MethodName: "FeeShareEarnings",
+			Handler:    _Query_FeeShareEarnings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/devgas/v1/query.proto",
@@ -665,6 +841,25 @@ func (m *QueryFeeSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Deployer) > 0 {
 		i -= len(m.Deployer)
 		copy(dAtA[i:], m.Deployer)
@@ -695,6 +890,18 @@ func (m *QueryFeeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Feeshare) > 0 {
 		for iNdEx := len(m.Feeshare) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -898,6 +1105,73 @@ func (m *QueryFeeSharesByWithdrawerResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeShareEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeShareEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeShareEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeShareEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeShareEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeShareEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -919,6 +1193,14 @@ func (m *QueryFeeSharesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -934,6 +1216,10 @@ func (m *QueryFeeSharesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1009,6 +1295,34 @@ func (m *QueryFeeSharesByWithdrawerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeShareEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeShareEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1076,6 +1390,74 @@ func (m *QueryFeeSharesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Deployer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1160,6 +1542,42 @@ func (m *QueryFeeSharesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1645,6 +2063,172 @@ func (m *QueryFeeSharesByWithdrawerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeShareEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeShareEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeShareEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeShareEarningsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeShareEarningsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeShareEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
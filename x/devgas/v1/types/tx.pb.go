@@ -41,6 +41,10 @@ type MsgRegisterFeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// deployer_nonce is the nonce of the deployer account in the transaction
+	// that created the contract. It is only used for EVM contracts, where it
+	// proves that the deployer created the contract.
+	DeployerNonce uint64 `protobuf:"varint,4,opt,name=deployer_nonce,json=deployerNonce,proto3" json:"deployer_nonce,omitempty"`
 }
 
 func (m *MsgRegisterFeeShare) Reset()         { *m = MsgRegisterFeeShare{} }
@@ -97,6 +101,13 @@ func (m *MsgRegisterFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterFeeShare) GetDeployerNonce() uint64 {
+	if m != nil {
+		return m.DeployerNonce
+	}
+	return 0
+}
+
 // MsgRegisterFeeShareResponse defines the MsgRegisterFeeShare response type
 type MsgRegisterFeeShareResponse struct {
 }
@@ -445,43 +456,44 @@ func init() {
 func init() { proto.RegisterFile("nibiru/devgas/v1/tx.proto", fileDescriptor_72949c99a02cd615) }
 
 var fileDescriptor_72949c99a02cd615 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x41, 0x6b, 0x13, 0x4d,
-	0x18, 0xc7, 0xb3, 0x6d, 0x28, 0x74, 0xde, 0x97, 0x36, 0x5d, 0x0b, 0x6d, 0x52, 0xdd, 0xea, 0xaa,
-	0xa5, 0x55, 0xb3, 0x43, 0x2b, 0x78, 0x28, 0x5e, 0x4c, 0x41, 0xf0, 0x10, 0x91, 0x14, 0x2f, 0x22,
-	0x84, 0xc9, 0xee, 0x30, 0x19, 0x48, 0x76, 0x96, 0x99, 0xd9, 0xb4, 0xb9, 0xf6, 0x13, 0x14, 0x3c,
-	0xe8, 0x49, 0x7a, 0xf0, 0x03, 0x78, 0xf0, 0x43, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xf4,
-	0x63, 0x48, 0x66, 0x66, 0x37, 0xdd, 0x64, 0x5b, 0x7b, 0x11, 0xbc, 0x25, 0xf3, 0xff, 0x3d, 0xcf,
-	0xf3, 0xdb, 0xe5, 0x99, 0x05, 0xe5, 0x90, 0xb6, 0x28, 0x8f, 0x61, 0x80, 0x7b, 0x04, 0x09, 0xd8,
-	0xdb, 0x86, 0xf2, 0xd0, 0x8b, 0x38, 0x93, 0xcc, 0x2e, 0xe9, 0xc8, 0xd3, 0x91, 0xd7, 0xdb, 0xae,
-	0x2c, 0x13, 0x46, 0x98, 0x0a, 0xe1, 0xe8, 0x97, 0xe6, 0x2a, 0xd7, 0x09, 0x63, 0xa4, 0x83, 0x21,
-	0x8a, 0x28, 0x44, 0x61, 0xc8, 0x24, 0x92, 0x94, 0x85, 0xc2, 0xa4, 0x2b, 0x3e, 0x13, 0x5d, 0x26,
-	0x60, 0x57, 0x90, 0x51, 0xf7, 0xae, 0x20, 0x26, 0x28, 0xeb, 0xa0, 0xa9, 0xfb, 0xe9, 0x3f, 0x26,
-	0x72, 0xa6, 0xa4, 0x08, 0x0e, 0xb1, 0xa0, 0x26, 0x77, 0x4f, 0x2c, 0x70, 0xad, 0x2e, 0x48, 0x03,
-	0x13, 0x2a, 0x24, 0xe6, 0x4f, 0x31, 0xde, 0x6f, 0x23, 0x8e, 0xed, 0x2d, 0x50, 0xf2, 0x59, 0x28,
-	0x39, 0xf2, 0x65, 0x13, 0x05, 0x01, 0xc7, 0x42, 0xac, 0x5a, 0x37, 0xad, 0xcd, 0xf9, 0xc6, 0x62,
-	0x72, 0xfe, 0x44, 0x1f, 0x8f, 0xd0, 0x00, 0x47, 0x1d, 0xd6, 0xc7, 0x3c, 0x45, 0x67, 0x34, 0x9a,
-	0x9c, 0x27, 0x68, 0x15, 0xd8, 0x07, 0x54, 0xb6, 0x03, 0x8e, 0x0e, 0xce, 0xc1, 0xb3, 0x0a, 0x5e,
-	0x1a, 0x27, 0x06, 0xdf, 0x2d, 0xfe, 0x3a, 0x59, 0x2f, 0xb8, 0x37, 0xc0, 0x5a, 0x8e, 0x61, 0x03,
-	0x8b, 0x88, 0x85, 0x02, 0xbb, 0xef, 0x2d, 0xb0, 0x54, 0x17, 0xe4, 0x65, 0x14, 0x20, 0x89, 0xff,
-	0x45, 0xff, 0x35, 0x50, 0x9e, 0xf2, 0x4b, 0xed, 0x99, 0x92, 0xdf, 0x43, 0xa1, 0x8f, 0x3b, 0x7f,
-	0x57, 0x3e, 0x63, 0x93, 0x1d, 0x98, 0xda, 0xbc, 0xb5, 0xc0, 0x62, 0xea, 0xfa, 0x02, 0x71, 0xd4,
-	0x15, 0xf6, 0x23, 0x30, 0x8f, 0x62, 0xd9, 0x66, 0x9c, 0xca, 0xbe, 0xb6, 0xa8, 0xad, 0x7e, 0xfe,
-	0x54, 0x5d, 0x36, 0x6b, 0x66, 0xba, 0xef, 0x4b, 0x4e, 0x43, 0xd2, 0x18, 0xa3, 0xf6, 0x63, 0x30,
-	0x17, 0xa9, 0x0e, 0xca, 0xe7, 0xbf, 0x1d, 0xc7, 0x9b, 0xbc, 0x04, 0x5e, 0x9d, 0x05, 0x71, 0xc7,
-	0xcc, 0xa9, 0x15, 0x4f, 0xbf, 0xad, 0x17, 0x1a, 0xa6, 0x66, 0x77, 0xe1, 0xe8, 0xe7, 0xc7, 0x7b,
-	0xe3, 0x6e, 0x6e, 0x19, 0xac, 0x4c, 0x88, 0x25, 0xd2, 0x3b, 0x1f, 0x8a, 0x60, 0xb6, 0x2e, 0x88,
-	0xfd, 0xce, 0x02, 0xa5, 0xa9, 0x3d, 0xbe, 0x9b, 0x33, 0x75, 0x7a, 0x99, 0x2a, 0xd5, 0x2b, 0x61,
-	0xe9, 0x7b, 0xf2, 0x8e, 0xbe, 0xfc, 0x78, 0x33, 0xb3, 0xe9, 0x6e, 0xc0, 0x9c, 0x3b, 0x0f, 0xb9,
-	0x29, 0x6b, 0xa6, 0x16, 0xc7, 0x16, 0x58, 0x98, 0x58, 0xd0, 0xdb, 0xb9, 0x13, 0xb3, 0x50, 0xe5,
-	0xfe, 0x15, 0xa0, 0x54, 0xea, 0x81, 0x92, 0xda, 0x70, 0xef, 0xe4, 0x4a, 0xc5, 0xaa, 0x28, 0xab,
-	0x34, 0xb1, 0x76, 0xf9, 0x4a, 0x59, 0xe8, 0x02, 0xa5, 0x0b, 0xf6, 0xe9, 0x72, 0x25, 0x5f, 0x15,
-	0x8d, 0x95, 0x5e, 0x83, 0xff, 0x33, 0x9b, 0x77, 0xeb, 0x92, 0xa7, 0xd7, 0x48, 0x65, 0xeb, 0x8f,
-	0x48, 0xe2, 0x52, 0x7b, 0x76, 0x3a, 0x70, 0xac, 0xb3, 0x81, 0x63, 0x7d, 0x1f, 0x38, 0xd6, 0xf1,
-	0xd0, 0x29, 0x9c, 0x0d, 0x9d, 0xc2, 0xd7, 0xa1, 0x53, 0x78, 0x05, 0x09, 0x95, 0xed, 0xb8, 0xe5,
-	0xf9, 0xac, 0x0b, 0x9f, 0xab, 0x76, 0x7b, 0x6d, 0x44, 0xc3, 0xc4, 0xf9, 0xf0, 0xbc, 0x75, 0x3f,
-	0xc2, 0xa2, 0x35, 0xa7, 0xbe, 0x9d, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x24, 0xc1,
-	0x45, 0xf2, 0x05, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0x87, 0xb3, 0x6d, 0x28, 0x74, 0xd4, 0x34, 0x5d, 0x0b, 0x4d, 0xb6, 0xba, 0xad, 0xab, 0x2d,
+	0xa9, 0x35, 0x3b, 0xb4, 0x82, 0x87, 0xe2, 0xc5, 0x14, 0x04, 0x0f, 0x29, 0xb2, 0xc5, 0x8b, 0x08,
+	0x61, 0xb2, 0x3b, 0x4c, 0x06, 0x92, 0x99, 0x65, 0x66, 0x92, 0x36, 0xd7, 0x7e, 0x82, 0x82, 0x07,
+	0x3d, 0x89, 0x07, 0x3f, 0x80, 0x07, 0xbf, 0x83, 0x3d, 0x16, 0xbd, 0x78, 0x12, 0x49, 0x04, 0xfd,
+	0x18, 0x92, 0xfd, 0x97, 0x6e, 0xb2, 0x68, 0x2e, 0x42, 0x6f, 0xc9, 0xfb, 0x7b, 0xe6, 0x7d, 0x9f,
+	0x09, 0x6f, 0x06, 0x94, 0x19, 0x6d, 0x52, 0xd1, 0x85, 0x1e, 0xee, 0x11, 0x24, 0x61, 0x6f, 0x17,
+	0xaa, 0x13, 0xdb, 0x17, 0x5c, 0x71, 0xbd, 0x18, 0x46, 0x76, 0x18, 0xd9, 0xbd, 0x5d, 0x63, 0x85,
+	0x70, 0xc2, 0x83, 0x10, 0x8e, 0x3e, 0x85, 0x9c, 0x71, 0x8b, 0x70, 0x4e, 0xda, 0x18, 0x22, 0x9f,
+	0x42, 0xc4, 0x18, 0x57, 0x48, 0x51, 0xce, 0x64, 0x94, 0xae, 0xba, 0x5c, 0x76, 0xb8, 0x84, 0x1d,
+	0x49, 0x46, 0xdd, 0x3b, 0x92, 0x44, 0x41, 0x39, 0x0c, 0x1a, 0x61, 0xbf, 0xf0, 0x4b, 0x14, 0x99,
+	0x53, 0x52, 0x04, 0x33, 0x2c, 0x69, 0x94, 0x5b, 0x9f, 0x35, 0x70, 0xb3, 0x2e, 0x89, 0x83, 0x09,
+	0x95, 0x0a, 0x8b, 0xa7, 0x18, 0x1f, 0xb5, 0x90, 0xc0, 0xfa, 0x36, 0x28, 0xba, 0x9c, 0x29, 0x81,
+	0x5c, 0xd5, 0x40, 0x9e, 0x27, 0xb0, 0x94, 0x25, 0x6d, 0x43, 0xab, 0x2c, 0x3a, 0x4b, 0x71, 0xfd,
+	0x49, 0x58, 0x1e, 0xa1, 0x1e, 0xf6, 0xdb, 0xbc, 0x8f, 0x45, 0x82, 0xce, 0x85, 0x68, 0x5c, 0x8f,
+	0xd1, 0x2a, 0xd0, 0x8f, 0xa9, 0x6a, 0x79, 0x02, 0x1d, 0x5f, 0x82, 0xe7, 0x03, 0x78, 0x79, 0x9c,
+	0xc4, 0xf8, 0x26, 0x28, 0x24, 0x9d, 0x19, 0x67, 0x2e, 0x2e, 0xe5, 0x37, 0xb4, 0x4a, 0xde, 0xb9,
+	0x11, 0x57, 0x0f, 0x47, 0xc5, 0xfd, 0xfc, 0xef, 0xf7, 0xeb, 0x39, 0xeb, 0x36, 0x58, 0xcb, 0xb8,
+	0x88, 0x83, 0xa5, 0xcf, 0x99, 0xc4, 0xd6, 0x3b, 0x0d, 0x2c, 0xd7, 0x25, 0x79, 0xe1, 0x7b, 0x48,
+	0xe1, 0x2b, 0x75, 0xcd, 0xc8, 0x7f, 0x0d, 0x94, 0xa7, 0xfc, 0x12, 0x7b, 0x1e, 0xc8, 0x1f, 0x20,
+	0xe6, 0xe2, 0xf6, 0xff, 0x95, 0x4f, 0xd9, 0xa4, 0x07, 0x26, 0x36, 0x6f, 0x34, 0xb0, 0x94, 0xb8,
+	0x3e, 0x47, 0x02, 0x75, 0xa4, 0xfe, 0x08, 0x2c, 0xa2, 0xae, 0x6a, 0x71, 0x41, 0x55, 0x3f, 0xb4,
+	0xa8, 0x95, 0xbe, 0x7c, 0xaa, 0xae, 0x44, 0xdb, 0x18, 0x75, 0x3f, 0x52, 0x82, 0x32, 0xe2, 0x8c,
+	0x51, 0xfd, 0x31, 0x58, 0xf0, 0x83, 0x0e, 0x81, 0xcf, 0xb5, 0x3d, 0xd3, 0x9e, 0xfc, 0xaf, 0xd8,
+	0x75, 0xee, 0x75, 0xdb, 0xd1, 0x9c, 0x5a, 0xfe, 0xfc, 0xfb, 0x7a, 0xce, 0x89, 0xce, 0xec, 0x17,
+	0x4e, 0x7f, 0x7d, 0xbc, 0x3f, 0xee, 0x66, 0x95, 0xc1, 0xea, 0x84, 0x58, 0x2c, 0xbd, 0xf7, 0x21,
+	0x0f, 0xe6, 0xeb, 0x92, 0xe8, 0x6f, 0x35, 0x50, 0x9c, 0x5a, 0xf7, 0xcd, 0x8c, 0xa9, 0xd3, 0xcb,
+	0x64, 0x54, 0x67, 0xc2, 0x92, 0xdf, 0xc9, 0x3e, 0xfd, 0xfa, 0xf3, 0xf5, 0x5c, 0xc5, 0xda, 0x82,
+	0x19, 0x4f, 0x03, 0x14, 0xd1, 0xb1, 0x46, 0x62, 0x71, 0xa6, 0x81, 0xc2, 0xc4, 0x82, 0xde, 0xcd,
+	0x9c, 0x98, 0x86, 0x8c, 0x9d, 0x19, 0xa0, 0x44, 0xea, 0x41, 0x20, 0xb5, 0x65, 0xdd, 0xcb, 0x94,
+	0xea, 0x06, 0x87, 0xd2, 0x4a, 0x13, 0x6b, 0x97, 0xad, 0x94, 0x86, 0x8c, 0x9d, 0x19, 0xa0, 0x19,
+	0x95, 0xdc, 0xe0, 0xd0, 0x58, 0xe9, 0x15, 0xb8, 0x9e, 0xda, 0xbc, 0x3b, 0x7f, 0xb9, 0x7d, 0x88,
+	0x18, 0xdb, 0xff, 0x44, 0x62, 0x97, 0xda, 0xb3, 0xf3, 0x81, 0xa9, 0x5d, 0x0c, 0x4c, 0xed, 0xc7,
+	0xc0, 0xd4, 0xce, 0x86, 0x66, 0xee, 0x62, 0x68, 0xe6, 0xbe, 0x0d, 0xcd, 0xdc, 0x4b, 0x48, 0xa8,
+	0x6a, 0x75, 0x9b, 0xb6, 0xcb, 0x3b, 0xf0, 0x30, 0x68, 0x77, 0xd0, 0x42, 0x94, 0xc5, 0xce, 0x27,
+	0x97, 0xad, 0xfb, 0x3e, 0x96, 0xcd, 0x85, 0xe0, 0x89, 0x7d, 0xf8, 0x67, 0x00, 0x12, 0xfb, 0xb3,
+	0x25, 0x19, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -702,6 +714,11 @@ func (m *MsgRegisterFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeployerNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeployerNonce))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -968,6 +985,9 @@ func (m *MsgRegisterFeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.DeployerNonce != 0 {
+		n += 1 + sovTx(uint64(m.DeployerNonce))
+	}
 	return n
 }
 
@@ -1191,6 +1211,25 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerNonce", wireType)
+			}
+			m.DeployerNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeployerNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
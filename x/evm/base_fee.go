@@ -0,0 +1,118 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"math"
+	"math/big"
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator: Bounds the amount the base fee can change
+	// between blocks. Matches the EIP-1559 value used by Ethereum.
+	DefaultBaseFeeChangeDenominator uint32 = 8
+
+	// DefaultElasticityMultiplier: Bounds the maximum gas limit an EIP-1559
+	// block may have relative to its gas target. Matches the EIP-1559 value
+	// used by Ethereum.
+	DefaultElasticityMultiplier uint32 = 2
+)
+
+// IsDynamicBaseFee returns true if the base fee adjusts from block to block
+// according to EIP-1559. Otherwise, the base fee stays fixed at the
+// "MinBaseFee".
+func (p Params) IsDynamicBaseFee() bool {
+	return p.BaseFeeChangeDenominator != 0
+}
+
+// MinBaseFeeBigInt returns the lower bound for the base fee. The zero value
+// is returned if the parameter is unset.
+func (p Params) MinBaseFeeBigInt() *big.Int {
+	if p.MinBaseFee.IsNil() {
+		return big.NewInt(0)
+	}
+	return p.MinBaseFee.BigInt()
+}
+
+// CalcBaseFee computes the EIP-1559 base fee for a block given the base fee
+// and EVM gas used of its parent block. The gas target is the block gas limit
+// divided by the elasticity multiplier.
+//
+//   - If the parent used exactly the target, the base fee is unchanged.
+//   - If the parent used more than the target, the base fee increases by at
+//     least 1.
+//   - If the parent used less than the target, the base fee decreases.
+//
+// The result is never below "Params.MinBaseFee". A block gas limit of 0 is
+// treated as unlimited, in which case the base fee can only decrease.
+func CalcBaseFee(
+	params Params,
+	parentBaseFee *big.Int,
+	parentGasUsed uint64,
+	blockGasLimit uint64,
+) *big.Int {
+	minBaseFee := params.MinBaseFeeBigInt()
+	if !params.IsDynamicBaseFee() || parentBaseFee == nil {
+		return minBaseFee
+	}
+
+	elasticity := params.ElasticityMultiplier
+	if elasticity == 0 {
+		elasticity = DefaultElasticityMultiplier
+	}
+	if blockGasLimit == 0 {
+		blockGasLimit = math.MaxUint64
+	}
+	gasTarget := blockGasLimit / uint64(elasticity)
+	if gasTarget == 0 {
+		return maxBigInt(parentBaseFee, minBaseFee)
+	}
+
+	var (
+		gasTargetBig = new(big.Int).SetUint64(gasTarget)
+		denominator  = new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
+		baseFee      = new(big.Int).Set(parentBaseFee)
+	)
+	switch {
+	case parentGasUsed == gasTarget:
+		// Base fee stays the same
+	case parentGasUsed > gasTarget:
+		// delta = max(parentBaseFee * gasUsedDelta / gasTarget / denominator, 1)
+		gasUsedDelta := new(big.Int).SetUint64(parentGasUsed - gasTarget)
+		delta := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		delta.Quo(delta, gasTargetBig)
+		delta.Quo(delta, denominator)
+		baseFee.Add(baseFee, maxBigInt(delta, big.NewInt(1)))
+	default:
+		// delta = parentBaseFee * gasUsedDelta / gasTarget / denominator
+		gasUsedDelta := new(big.Int).SetUint64(gasTarget - parentGasUsed)
+		delta := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
+		delta.Quo(delta, gasTargetBig)
+		delta.Quo(delta, denominator)
+		baseFee.Sub(baseFee, delta)
+	}
+
+	return maxBigInt(baseFee, minBaseFee)
+}
+
+// MaxBaseFeeDelta returns the maximum amount the base fee can increase in a
+// single block, which happens when the parent block consumes its entire gas
+// limit:
+//
+//	MaxDelta = BaseFee * (GasLimit - GasTarget) / GasTarget / Denominator
+//	         = BaseFee * (ElasticityMultiplier - 1) / Denominator
+func MaxBaseFeeDelta(params Params, baseFee *big.Int) *big.Int {
+	if !params.IsDynamicBaseFee() || baseFee == nil || params.ElasticityMultiplier <= 1 {
+		return big.NewInt(0)
+	}
+	delta := new(big.Int).Mul(
+		baseFee, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier-1)),
+	)
+	return delta.Quo(delta, new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator)))
+}
+
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,116 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	params := evm.DefaultParams()
+	params.MinBaseFee = math.NewInt(100)
+
+	staticParams := evm.DefaultParams()
+	staticParams.BaseFeeChangeDenominator = 0
+	staticParams.MinBaseFee = math.NewInt(100)
+
+	const gasLimit uint64 = 20_000_000 // gas target = 10_000_000
+
+	for _, tc := range []struct {
+		name          string
+		params        evm.Params
+		parentBaseFee *big.Int
+		parentGasUsed uint64
+		gasLimit      uint64
+		want          *big.Int
+	}{
+		{
+			name:          "gas used equals target: unchanged",
+			params:        params,
+			parentBaseFee: big.NewInt(1_000),
+			parentGasUsed: 10_000_000,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(1_000),
+		},
+		{
+			name:          "full block: increase by 1/8",
+			params:        params,
+			parentBaseFee: big.NewInt(1_000),
+			parentGasUsed: 20_000_000,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(1_125),
+		},
+		{
+			name:          "slightly above target: increase by at least 1",
+			params:        params,
+			parentBaseFee: big.NewInt(100),
+			parentGasUsed: 10_000_001,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(101),
+		},
+		{
+			name:          "empty block: decrease by 1/8",
+			params:        params,
+			parentBaseFee: big.NewInt(1_000),
+			parentGasUsed: 0,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(875),
+		},
+		{
+			name:          "empty block: floored at min base fee",
+			params:        params,
+			parentBaseFee: big.NewInt(105),
+			parentGasUsed: 0,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(100),
+		},
+		{
+			name:          "unlimited block gas: decrease only",
+			params:        params,
+			parentBaseFee: big.NewInt(1_000),
+			parentGasUsed: 20_000_000,
+			gasLimit:      0,
+			want:          big.NewInt(876),
+		},
+		{
+			name:          "static base fee",
+			params:        staticParams,
+			parentBaseFee: big.NewInt(1_000),
+			parentGasUsed: 20_000_000,
+			gasLimit:      gasLimit,
+			want:          big.NewInt(100),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := evm.CalcBaseFee(tc.params, tc.parentBaseFee, tc.parentGasUsed, tc.gasLimit)
+			require.Equal(t, tc.want.String(), got.String())
+		})
+	}
+}
+
+func TestMaxBaseFeeDelta(t *testing.T) {
+	params := evm.DefaultParams()
+	require.Equal(t, "125", evm.MaxBaseFeeDelta(params, big.NewInt(1_000)).String())
+
+	params.BaseFeeChangeDenominator = 0
+	require.Equal(t, "0", evm.MaxBaseFeeDelta(params, big.NewInt(1_000)).String())
+}
+
+func TestParamsValidateBaseFee(t *testing.T) {
+	params := evm.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.MinBaseFee = math.NewInt(-1)
+	require.ErrorContains(t, params.Validate(), "min base fee")
+
+	params = evm.DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.ErrorContains(t, params.Validate(), "elasticity multiplier")
+
+	params.BaseFeeChangeDenominator = 0
+	require.NoError(t, params.Validate())
+}
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		GetCmdFunToken(),
+		GetCmdVerifiedFunTokens(),
+		GetCmdFunTokenSupplyDiscrepancies(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -69,3 +71,62 @@ $ %s query %s get-fun-token 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdVerifiedFunTokens returns the fungible token mappings verified by
+// governance or the x/sudo module
+func GetCmdVerifiedFunTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verified-funtokens",
+		Short: "Query the evm fungible token mappings with verified bank metadata",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.VerifiedFunTokens(cmd.Context(), &evm.QueryVerifiedFunTokensRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "verified-funtokens")
+	return cmd
+}
+
+// GetCmdFunTokenSupplyDiscrepancies returns a supply report for every
+// fungible token mapping
+func GetCmdFunTokenSupplyDiscrepancies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtoken-discrepancies",
+		Short: "Query the escrowed and circulating supply of every evm fungible token mapping",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenSupplyDiscrepancies(
+				cmd.Context(), &evm.QueryFunTokenSupplyDiscrepanciesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
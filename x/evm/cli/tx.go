@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/evm"
@@ -27,7 +29,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdCreateFunTokenFromBankCoin(),
 		CmdCreateFunTokenFromERC20(),
-		SendFunTokenToEvm(),
+		ConvertCoinToEvm(),
+		CmdUpdateFunTokenMetadata(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -99,12 +102,14 @@ func CmdCreateFunTokenFromERC20() *cobra.Command {
 	return cmd
 }
 
-// SendFunTokenToEvm broadcast MsgSendFunTokenToEvm
-func SendFunTokenToEvm() *cobra.Command {
+// ConvertCoinToEvm broadcast MsgConvertCoinToEvm. The command keeps its old
+// name, "send-funtoken-to-erc20", as an alias.
+func ConvertCoinToEvm() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "send-funtoken-to-erc20 [to_eth_addr] [coin] [flags]",
-		Short: `Send bank [coin] to its erc20 representation for the user [to_eth_addr]"`,
-		Args:  cobra.ExactArgs(2),
+		Use:     "convert-coin-to-evm [to_eth_addr] [coin] [flags]",
+		Aliases: []string{"send-funtoken-to-erc20"},
+		Short:   `Send bank [coin] to its erc20 representation for the user [to_eth_addr]"`,
+		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -122,7 +127,7 @@ func SendFunTokenToEvm() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			msg := &evm.MsgSendFunTokenToEvm{
+			msg := &evm.MsgConvertCoinToEvm{
 				Sender:    clientCtx.GetFromAddress().String(),
 				BankCoin:  coin,
 				ToEthAddr: eth.MustNewHexAddrFromStr(args[0]),
@@ -133,3 +138,65 @@ func SendFunTokenToEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagVerified = "verified"
+	FlagMetadata = "metadata"
+)
+
+// CmdUpdateFunTokenMetadata broadcast MsgUpdateFunTokenMetadata
+func CmdUpdateFunTokenMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-funtoken-metadata [bank-denom] [flags]",
+		Short: `Set the verification flag of the fungible token mapping for [bank-denom] and optionally override its bank metadata`,
+		Long: `Set the verification flag of the fungible token mapping for [bank-denom].
+The signer must be a sudoer from the x/sudo module. Use --metadata with a path
+to a JSON file of bank metadata to override the metadata of a fungible token
+created from an ERC20.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.
+				WithTxConfig(clientCtx.TxConfig).
+				WithAccountRetriever(clientCtx.AccountRetriever)
+
+			verified, err := cmd.Flags().GetBool(FlagVerified)
+			if err != nil {
+				return err
+			}
+			msg := &evm.MsgUpdateFunTokenMetadata{
+				Sender:    clientCtx.GetFromAddress().String(),
+				BankDenom: args[0],
+				Verified:  verified,
+			}
+
+			metadataPath, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+			if metadataPath != "" {
+				metadataJSON, err := os.ReadFile(metadataPath)
+				if err != nil {
+					return err
+				}
+				metadata := new(bank.Metadata)
+				if err = clientCtx.Codec.UnmarshalJSON(metadataJSON, metadata); err != nil {
+					return fmt.Errorf("failed to parse bank metadata: %w", err)
+				}
+				msg.Metadata = metadata
+			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+	cmd.Flags().Bool(FlagVerified, false, "mark the fungible token mapping as verified")
+	cmd.Flags().String(FlagMetadata, "", "path to a JSON file with the bank metadata to set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -39,6 +39,10 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+	// KV store prefix for the EIP-1559 base fee of the current block
+	KeyPrefixBaseFee
+	// KV store prefix for the EVM gas used by the previous block
+	KeyPrefixParentBlockGasUsed
 )
 
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
@@ -48,6 +52,7 @@ const (
 	NamespaceBlockTxIndex
 	NamespaceBlockLogSize
 	NamespaceBlockGasUsed
+	NamespaceBlockGasUsedTotal
 )
 
 var KeyPrefixBzAccState = KeyPrefixAccState.Prefix()
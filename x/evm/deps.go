@@ -6,6 +6,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 )
 
 // AccountKeeper defines the expected account keeper interface
@@ -36,6 +39,7 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	authtypes.BankKeeper
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
@@ -49,3 +53,21 @@ type StakingKeeper interface {
 	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
+
+// SudoKeeper defines the expected interface of the x/sudo keeper.
+type SudoKeeper interface {
+	// CheckMsgPermissions Checks if an address may execute the
+	// sudo-permissioned message with the given type URL, either as a sudoer
+	// or through a permission scope granted in the x/sudo module.
+	CheckMsgPermissions(sender sdk.AccAddress, ctx sdk.Context, msgTypeUrl string) error
+}
+
+// EvmHooks defines the hooks that other modules may register to run after the
+// EVM executes a transaction.
+type EvmHooks interface {
+	// PostTxProcessing runs after a successful MsgEthereumTx has refunded its
+	// leftover gas, so the fee collector holds exactly the fees paid for the
+	// gas in "receipt.GasUsed". An error discards the state changes of the
+	// hooks but does not fail the transaction.
+	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *gethcore.Receipt) error
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Implements access to the Cosmos-SDK bank module ("x/bank") from the
+/// EVM. This covers any bank coin, including IBC and tokenfactory coins that
+/// have no "FunToken" mapping to an ERC20.
+interface IBank {
+  /// @dev balance returns the bank balance of an account in the given denom
+  /// @param account the EVM address of the account
+  /// @param denom the bank denomination
+  function balance(
+    address account,
+    string memory denom
+  ) external view returns (uint256);
+
+  /// @dev supply returns the total supply of the given bank denom
+  /// @param denom the bank denomination
+  function supply(string memory denom) external view returns (uint256);
+
+  /// @dev send transfers bank coins from the caller to another account
+  /// @param to the EVM address of the receiving account
+  /// @param denom the bank denomination
+  /// @param amount the amount of coins to send
+  function send(address to, string memory denom, uint256 amount) external;
+
+  /// @dev metadata returns the bank metadata registered for the given denom
+  /// @param denom the bank denomination
+  /// @return name the name of the token
+  /// @return symbol the token symbol, usually shown on exchanges
+  /// @return decimals the exponent of the display denom unit
+  function metadata(
+    string memory denom
+  )
+    external
+    view
+    returns (string memory name, string memory symbol, uint8 decimals);
+}
+
+address constant BANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
+
+IBank constant BANK_PRECOMPILE = IBank(BANK_PRECOMPILE_ADDRESS);
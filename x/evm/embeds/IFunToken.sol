@@ -5,6 +5,18 @@ pragma solidity >=0.8.19;
 /// coins to a Nibiru bech32 address using the "FunToken" mapping between the
 /// ERC20 and bank.
 interface IFunToken {
+  /// @dev Emitted when ERC20 tokens are sent as bank coins with "bankSend"
+  /// @param erc20 the address of the ERC20 token contract
+  /// @param from the EVM address of the caller that sent the ERC20 tokens
+  /// @param to the receiving Nibiru base account address as a string
+  /// @param amount the amount of tokens sent
+  event BankSend(
+    address indexed erc20,
+    address indexed from,
+    string to,
+    uint256 amount
+  );
+
   /// @dev bankSend sends ERC20 tokens as coins to a Nibiru base account
   /// @param erc20 the address of the ERC20 token contract
   /// @param amount the amount of tokens to send
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Implements ICS-20 fungible token transfers from the EVM over IBC
+/// ("ibc-go/modules/apps/transfer"). Transfers are only allowed over the
+/// channels listed in the "evm_channels" param of the EVM module. The caller
+/// of each method is the sender of the transfer.
+interface IIbcTransfer {
+  /// @dev Emitted when an IBC transfer packet is sent
+  /// @param sender the EVM address of the caller
+  /// @param channel the source channel of the transfer
+  /// @param denom the bank denom sent
+  /// @param amount the amount sent
+  /// @param receiver the address of the recipient on the counterparty chain
+  /// @param sequence the sequence number of the IBC packet
+  event IbcTransfer(
+    address indexed sender,
+    string channel,
+    string denom,
+    uint256 amount,
+    string receiver,
+    uint64 sequence
+  );
+
+  /// @dev transfer sends coins from the caller to a receiver on the
+  /// counterparty chain of an IBC channel
+  /// @param channel the source channel, which must be an EVM channel
+  /// @param denom the bank denom to send
+  /// @param amount the amount to send
+  /// @param receiver the address of the recipient on the counterparty chain
+  /// @param timeoutTimestamp the absolute timeout of the packet in unix
+  /// nanoseconds on the counterparty chain
+  /// @return sequence the sequence number of the IBC packet
+  function transfer(
+    string memory channel,
+    string memory denom,
+    uint256 amount,
+    string memory receiver,
+    uint64 timeoutTimestamp
+  ) external returns (uint64 sequence);
+}
+
+address constant IBC_TRANSFER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+IIbcTransfer constant IBC_TRANSFER_PRECOMPILE = IIbcTransfer(
+  IBC_TRANSFER_PRECOMPILE_ADDRESS
+);
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Implements read-only queries of the prices posted by the validators
+/// to the oracle module ("x/oracle"). Pairs have the form "base:quote", like
+/// "ubtc:uusd". Prices are scaled to 18 decimals, so a price of 1.5 is
+/// returned as 1.5e18.
+interface IOracle {
+  /// @dev getExchangeRate returns the latest exchange rate of a pair
+  /// @param pair the pair, like "ubtc:uusd"
+  /// @return price the exchange rate with 18 decimals
+  function getExchangeRate(
+    string memory pair
+  ) external view returns (uint256 price);
+
+  /// @dev getExchangeRateTwap returns the time-weighted average price of a
+  /// pair over the "twap_lookback_window" param of the oracle module
+  /// @param pair the pair, like "ubtc:uusd"
+  /// @return price the time-weighted average price with 18 decimals
+  function getExchangeRateTwap(
+    string memory pair
+  ) external view returns (uint256 price);
+
+  /// @dev getDatedPrice returns the latest exchange rate of a pair along with
+  /// the block height at which it was posted
+  /// @param pair the pair, like "ubtc:uusd"
+  /// @return price the exchange rate with 18 decimals
+  /// @return createdBlock the block height of the last vote that set the
+  /// price, which stays behind while the circuit breaker rejects votes
+  function getDatedPrice(
+    string memory pair
+  ) external view returns (uint256 price, uint64 createdBlock);
+}
+
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+IOracle constant ORACLE_PRECOMPILE = IOracle(ORACLE_PRECOMPILE_ADDRESS);
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Implements staking and distribution actions for the EVM. The caller of
+/// each method is the delegator, so a smart contract calling this precompile
+/// manages its own stake. Validators are given by their bech32 operator
+/// address ("nibivaloper...") and amounts are in the bond denom ("unibi").
+interface IStaking {
+  /// @dev delegate bonds coins from the caller to a validator
+  /// @param validator the bech32 operator address of the validator
+  /// @param amount the amount of coins to delegate
+  function delegate(string memory validator, uint256 amount) external;
+
+  /// @dev undelegate begins unbonding coins of the caller from a validator
+  /// @param validator the bech32 operator address of the validator
+  /// @param amount the amount of coins to undelegate
+  /// @return completionTime the unix time in seconds when unbonding completes
+  function undelegate(
+    string memory validator,
+    uint256 amount
+  ) external returns (int64 completionTime);
+
+  /// @dev redelegate moves bonded coins of the caller between validators
+  /// @param srcValidator the bech32 operator address of the source validator
+  /// @param dstValidator the bech32 operator address of the destination validator
+  /// @param amount the amount of coins to redelegate
+  /// @return completionTime the unix time in seconds when redelegation completes
+  function redelegate(
+    string memory srcValidator,
+    string memory dstValidator,
+    uint256 amount
+  ) external returns (int64 completionTime);
+
+  /// @dev withdrawRewards withdraws the staking rewards of the caller from a
+  /// validator to the caller's withdraw address
+  /// @param validator the bech32 operator address of the validator
+  /// @return amount the amount of rewards in the bond denom
+  function withdrawRewards(
+    string memory validator
+  ) external returns (uint256 amount);
+
+  /// @dev delegation returns the delegation of an account to a validator
+  /// @param delegator the EVM address of the delegator
+  /// @param validator the bech32 operator address of the validator
+  /// @return shares the delegation shares, scaled by 10^18
+  /// @return balance the amount of coins the shares are worth
+  function delegation(
+    address delegator,
+    string memory validator
+  ) external view returns (uint256 shares, uint256 balance);
+
+  /// @dev validator returns information about a validator
+  /// @param validator the bech32 operator address of the validator
+  /// @return jailed whether the validator is jailed
+  /// @return status the bond status: 1 (unbonded), 2 (unbonding), 3 (bonded)
+  /// @return tokens the amount of coins delegated to the validator
+  /// @return delegatorShares the total delegation shares, scaled by 10^18
+  /// @return commissionRate the commission rate, scaled by 10^18
+  function validator(
+    string memory validator
+  )
+    external
+    view
+    returns (
+      bool jailed,
+      uint8 status,
+      uint256 tokens,
+      uint256 delegatorShares,
+      uint256 commissionRate
+    );
+
+  event Delegate(address indexed delegator, string validator, uint256 amount);
+  event Undelegate(
+    address indexed delegator,
+    string validator,
+    uint256 amount,
+    int64 completionTime
+  );
+  event Redelegate(
+    address indexed delegator,
+    string srcValidator,
+    string dstValidator,
+    uint256 amount,
+    int64 completionTime
+  );
+  event WithdrawRewards(
+    address indexed delegator,
+    string validator,
+    uint256 amount
+  );
+}
+
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+IStaking constant STAKING_PRECOMPILE = IStaking(STAKING_PRECOMPILE_ADDRESS);
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @dev Implements calls from the EVM to CosmWasm smart contracts ("x/wasm").
+/// The caller of each method is the sender of the Wasm message, so coins
+/// sent as "funds" come from the caller's bank balance.
+interface IWasm {
+  /// @dev A bank coin, which can be any denom held by the caller.
+  struct BankCoin {
+    string denom;
+    uint256 amount;
+  }
+
+  /// @dev execute runs a Wasm contract's execute entry point
+  /// @param contractAddr the bech32 address of the Wasm contract
+  /// @param msgJson the JSON-encoded execute message
+  /// @param funds the coins to send to the contract
+  /// @return response the data returned by the contract
+  function execute(
+    string memory contractAddr,
+    bytes memory msgJson,
+    BankCoin[] memory funds
+  ) external returns (bytes memory response);
+
+  /// @dev query runs a Wasm contract's smart query entry point
+  /// @param contractAddr the bech32 address of the Wasm contract
+  /// @param reqJson the JSON-encoded query request
+  /// @return response the JSON-encoded query response
+  function query(
+    string memory contractAddr,
+    bytes memory reqJson
+  ) external view returns (bytes memory response);
+
+  /// @dev instantiate creates a new Wasm contract from stored code
+  /// @param admin the bech32 address of the contract admin, or "" for none
+  /// @param codeID the ID of the stored Wasm code
+  /// @param msgJson the JSON-encoded instantiate message
+  /// @param label a human-readable label for the contract
+  /// @param funds the coins to send to the new contract
+  /// @return contractAddr the bech32 address of the new contract
+  /// @return data the data returned by the contract
+  function instantiate(
+    string memory admin,
+    uint64 codeID,
+    bytes memory msgJson,
+    string memory label,
+    BankCoin[] memory funds
+  ) external returns (string memory contractAddr, bytes memory data);
+}
+
+address constant WASM_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+IWasm constant WASM_PRECOMPILE = IWasm(WASM_PRECOMPILE_ADDRESS);
@@ -34,11 +34,51 @@ var (
 	Contract_Funtoken CompiledEvmContract
 	//go:embed IFunTokenCompiled.json
 	funtokenContractJSON []byte
+
+	// Contract_Bank: Precompile contract interface for "IBank.sol". This
+	// precompile enables EVM contracts to read balances and send any bank
+	// coin. Only the ABI is used.
+	Contract_Bank CompiledEvmContract
+	//go:embed IBankCompiled.json
+	bankContractJSON []byte
+
+	// Contract_Staking: Precompile contract interface for "IStaking.sol". This
+	// precompile enables EVM contracts to delegate, undelegate, redelegate,
+	// and withdraw staking rewards. Only the ABI is used.
+	Contract_Staking CompiledEvmContract
+	//go:embed IStakingCompiled.json
+	stakingContractJSON []byte
+
+	// Contract_Wasm: Precompile contract interface for "IWasm.sol". This
+	// precompile enables EVM contracts to execute, query, and instantiate
+	// CosmWasm contracts. Only the ABI is used.
+	Contract_Wasm CompiledEvmContract
+	//go:embed IWasmCompiled.json
+	wasmContractJSON []byte
+
+	// Contract_IbcTransfer: Precompile contract interface for
+	// "IIbcTransfer.sol". This precompile enables EVM contracts to send
+	// ICS-20 transfers over IBC. Only the ABI is used.
+	Contract_IbcTransfer CompiledEvmContract
+	//go:embed IIbcTransferCompiled.json
+	ibcTransferContractJSON []byte
+
+	// Contract_Oracle: Precompile contract interface for "IOracle.sol". This
+	// precompile enables EVM contracts to read the prices of the oracle
+	// module. Only the ABI is used.
+	Contract_Oracle CompiledEvmContract
+	//go:embed IOracleCompiled.json
+	oracleContractJSON []byte
 )
 
 func init() {
 	Contract_ERC20Minter = SmartContract_ERC20Minter.MustLoad()
 	Contract_Funtoken = SmartContract_FunToken.MustLoad()
+	Contract_Bank = SmartContract_Bank.MustLoad()
+	Contract_Staking = SmartContract_Staking.MustLoad()
+	Contract_Wasm = SmartContract_Wasm.MustLoad()
+	Contract_IbcTransfer = SmartContract_IbcTransfer.MustLoad()
+	Contract_Oracle = SmartContract_Oracle.MustLoad()
 }
 
 var (
@@ -57,6 +97,31 @@ var (
 		FixtureType: FixtueType_Prod,
 		EmbedJSON:   &funtokenContractJSON,
 	}
+	SmartContract_Bank = SmartContractFixture{
+		Name:        "Bank.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &bankContractJSON,
+	}
+	SmartContract_Staking = SmartContractFixture{
+		Name:        "Staking.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &stakingContractJSON,
+	}
+	SmartContract_Wasm = SmartContractFixture{
+		Name:        "Wasm.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &wasmContractJSON,
+	}
+	SmartContract_IbcTransfer = SmartContractFixture{
+		Name:        "IbcTransfer.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &ibcTransferContractJSON,
+	}
+	SmartContract_Oracle = SmartContractFixture{
+		Name:        "Oracle.sol",
+		FixtureType: FixtueType_Prod,
+		EmbedJSON:   &oracleContractJSON,
+	}
 )
 
 // CompiledEvmContract: EVM contract that can be deployed into the EVM state and
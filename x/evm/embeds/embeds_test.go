@@ -13,6 +13,11 @@ func TestLoadContracts(t *testing.T) {
 		embeds.SmartContract_TestERC20,
 		embeds.SmartContract_ERC20Minter,
 		embeds.SmartContract_FunToken,
+		embeds.SmartContract_Bank,
+		embeds.SmartContract_Staking,
+		embeds.SmartContract_Wasm,
+		embeds.SmartContract_IbcTransfer,
+		embeds.SmartContract_Oracle,
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			_, err := tc.Load()
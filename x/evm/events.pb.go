@@ -280,6 +280,52 @@ func (m *EventBlockBloom) GetBloom() string {
 	return ""
 }
 
+// EventBlockBaseFee defines the EIP-1559 base fee set at the start of a block
+type EventBlockBaseFee struct {
+	// base_fee is the base fee in units of "evm_denom" per unit gas
+	BaseFee string `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+}
+
+func (m *EventBlockBaseFee) Reset()         { *m = EventBlockBaseFee{} }
+func (m *EventBlockBaseFee) String() string { return proto.CompactTextString(m) }
+func (*EventBlockBaseFee) ProtoMessage()    {}
+func (*EventBlockBaseFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{4}
+}
+func (m *EventBlockBaseFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockBaseFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockBaseFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockBaseFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockBaseFee.Merge(m, src)
+}
+func (m *EventBlockBaseFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockBaseFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockBaseFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockBaseFee proto.InternalMessageInfo
+
+func (m *EventBlockBaseFee) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
 // EventFunTokenCreated defines a fun token creation event.
 type EventFunTokenCreated struct {
 	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
@@ -292,7 +338,7 @@ func (m *EventFunTokenCreated) Reset()         { *m = EventFunTokenCreated{} }
 func (m *EventFunTokenCreated) String() string { return proto.CompactTextString(m) }
 func (*EventFunTokenCreated) ProtoMessage()    {}
 func (*EventFunTokenCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{4}
+	return fileDescriptor_f8bc26b53c788f17, []int{5}
 }
 func (m *EventFunTokenCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +395,80 @@ func (m *EventFunTokenCreated) GetIsMadeFromCoin() bool {
 	return false
 }
 
-// SendFunTokenToEvm defines sending fun token to erc20 event.
+// EventConvertCoinToEvm defines the event for converting a bank coin to its
+// ERC20 representation.
+type EventConvertCoinToEvm struct {
+	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToEthAddr            string     `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventConvertCoinToEvm) Reset()         { *m = EventConvertCoinToEvm{} }
+func (m *EventConvertCoinToEvm) String() string { return proto.CompactTextString(m) }
+func (*EventConvertCoinToEvm) ProtoMessage()    {}
+func (*EventConvertCoinToEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{6}
+}
+func (m *EventConvertCoinToEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertCoinToEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertCoinToEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertCoinToEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertCoinToEvm.Merge(m, src)
+}
+func (m *EventConvertCoinToEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertCoinToEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertCoinToEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertCoinToEvm proto.InternalMessageInfo
+
+func (m *EventConvertCoinToEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertCoinToEvm) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertCoinToEvm) GetToEthAddr() string {
+	if m != nil {
+		return m.ToEthAddr
+	}
+	return ""
+}
+
+func (m *EventConvertCoinToEvm) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
+// EventSendFunTokenToEvm: Deprecated. Replaced by "EventConvertCoinToEvm",
+// which has the same fields. Kept to decode events emitted before the rename.
+//
+// Deprecated: Do not use.
 type EventSendFunTokenToEvm struct {
 	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
@@ -361,7 +480,7 @@ func (m *EventSendFunTokenToEvm) Reset()         { *m = EventSendFunTokenToEvm{}
 func (m *EventSendFunTokenToEvm) String() string { return proto.CompactTextString(m) }
 func (*EventSendFunTokenToEvm) ProtoMessage()    {}
 func (*EventSendFunTokenToEvm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{5}
+	return fileDescriptor_f8bc26b53c788f17, []int{7}
 }
 func (m *EventSendFunTokenToEvm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,6 +537,157 @@ func (m *EventSendFunTokenToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventFunTokenBankSend defines the event for sending the ERC20 tokens of a
+// "FunToken" mapping as bank coins with the "bankSend" method of the FunToken
+// precompile.
+type EventFunTokenBankSend struct {
+	// sender: EVM address of the caller that sent the ERC20 tokens.
+	Sender               string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	// recipient: Nibiru bech32 address that received the bank coins.
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	BankCoin  types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventFunTokenBankSend) Reset()         { *m = EventFunTokenBankSend{} }
+func (m *EventFunTokenBankSend) String() string { return proto.CompactTextString(m) }
+func (*EventFunTokenBankSend) ProtoMessage()    {}
+func (*EventFunTokenBankSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{8}
+}
+func (m *EventFunTokenBankSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunTokenBankSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunTokenBankSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunTokenBankSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunTokenBankSend.Merge(m, src)
+}
+func (m *EventFunTokenBankSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunTokenBankSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunTokenBankSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunTokenBankSend proto.InternalMessageInfo
+
+func (m *EventFunTokenBankSend) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventFunTokenBankSend) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventFunTokenBankSend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventFunTokenBankSend) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
+// EventFunTokenMetadataUpdated defines the event for an update to the
+// verification flag or bank metadata of a "FunToken" mapping.
+type EventFunTokenMetadataUpdated struct {
+	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	Verified             bool   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	MetadataUpdated      bool   `protobuf:"varint,4,opt,name=metadata_updated,json=metadataUpdated,proto3" json:"metadata_updated,omitempty"`
+	Sender               string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *EventFunTokenMetadataUpdated) Reset()         { *m = EventFunTokenMetadataUpdated{} }
+func (m *EventFunTokenMetadataUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFunTokenMetadataUpdated) ProtoMessage()    {}
+func (*EventFunTokenMetadataUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{9}
+}
+func (m *EventFunTokenMetadataUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunTokenMetadataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunTokenMetadataUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunTokenMetadataUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunTokenMetadataUpdated.Merge(m, src)
+}
+func (m *EventFunTokenMetadataUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunTokenMetadataUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunTokenMetadataUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunTokenMetadataUpdated proto.InternalMessageInfo
+
+func (m *EventFunTokenMetadataUpdated) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventFunTokenMetadataUpdated) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventFunTokenMetadataUpdated) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *EventFunTokenMetadataUpdated) GetMetadataUpdated() bool {
+	if m != nil {
+		return m.MetadataUpdated
+	}
+	return false
+}
+
+func (m *EventFunTokenMetadataUpdated) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -429,7 +699,7 @@ func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
 func (m *EventTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventTransfer) ProtoMessage()    {}
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{6}
+	return fileDescriptor_f8bc26b53c788f17, []int{10}
 }
 func (m *EventTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +759,7 @@ func (m *EventContractDeployed) Reset()         { *m = EventContractDeployed{} }
 func (m *EventContractDeployed) String() string { return proto.CompactTextString(m) }
 func (*EventContractDeployed) ProtoMessage()    {}
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{7}
+	return fileDescriptor_f8bc26b53c788f17, []int{11}
 }
 func (m *EventContractDeployed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +812,7 @@ func (m *EventContractExecuted) Reset()         { *m = EventContractExecuted{} }
 func (m *EventContractExecuted) String() string { return proto.CompactTextString(m) }
 func (*EventContractExecuted) ProtoMessage()    {}
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{8}
+	return fileDescriptor_f8bc26b53c788f17, []int{12}
 }
 func (m *EventContractExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,64 +855,133 @@ func (m *EventContractExecuted) GetContractAddr() string {
 	return ""
 }
 
+// EventPostTxProcessingFailed defines event for EVM hooks that failed after a
+// successful transaction. The transaction keeps its result, and the state
+// changes of the hooks are discarded.
+type EventPostTxProcessingFailed struct {
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventPostTxProcessingFailed) Reset()         { *m = EventPostTxProcessingFailed{} }
+func (m *EventPostTxProcessingFailed) String() string { return proto.CompactTextString(m) }
+func (*EventPostTxProcessingFailed) ProtoMessage()    {}
+func (*EventPostTxProcessingFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{13}
+}
+func (m *EventPostTxProcessingFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPostTxProcessingFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPostTxProcessingFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPostTxProcessingFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPostTxProcessingFailed.Merge(m, src)
+}
+func (m *EventPostTxProcessingFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPostTxProcessingFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPostTxProcessingFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPostTxProcessingFailed proto.InternalMessageInfo
+
+func (m *EventPostTxProcessingFailed) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventPostTxProcessingFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventEthereumTx)(nil), "eth.evm.v1.EventEthereumTx")
 	proto.RegisterType((*EventTxLog)(nil), "eth.evm.v1.EventTxLog")
 	proto.RegisterType((*EventMessage)(nil), "eth.evm.v1.EventMessage")
 	proto.RegisterType((*EventBlockBloom)(nil), "eth.evm.v1.EventBlockBloom")
+	proto.RegisterType((*EventBlockBaseFee)(nil), "eth.evm.v1.EventBlockBaseFee")
 	proto.RegisterType((*EventFunTokenCreated)(nil), "eth.evm.v1.EventFunTokenCreated")
+	proto.RegisterType((*EventConvertCoinToEvm)(nil), "eth.evm.v1.EventConvertCoinToEvm")
 	proto.RegisterType((*EventSendFunTokenToEvm)(nil), "eth.evm.v1.EventSendFunTokenToEvm")
+	proto.RegisterType((*EventFunTokenBankSend)(nil), "eth.evm.v1.EventFunTokenBankSend")
+	proto.RegisterType((*EventFunTokenMetadataUpdated)(nil), "eth.evm.v1.EventFunTokenMetadataUpdated")
 	proto.RegisterType((*EventTransfer)(nil), "eth.evm.v1.EventTransfer")
 	proto.RegisterType((*EventContractDeployed)(nil), "eth.evm.v1.EventContractDeployed")
 	proto.RegisterType((*EventContractExecuted)(nil), "eth.evm.v1.EventContractExecuted")
+	proto.RegisterType((*EventPostTxProcessingFailed)(nil), "eth.evm.v1.EventPostTxProcessingFailed")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd4, 0x3c,
-	0x14, 0x9d, 0xf4, 0x67, 0xa6, 0xe3, 0xb6, 0xdf, 0x8f, 0x35, 0xb4, 0x69, 0x05, 0x69, 0x95, 0x0a,
-	0x01, 0x9b, 0x84, 0x29, 0xac, 0x90, 0x58, 0x30, 0xd3, 0xa9, 0x58, 0x50, 0x84, 0xca, 0x20, 0x24,
-	0x24, 0x14, 0x39, 0xf1, 0x6d, 0x12, 0x35, 0xb1, 0x47, 0xb6, 0x13, 0x65, 0xde, 0x82, 0x47, 0xe1,
-	0x31, 0x2a, 0xb1, 0xe9, 0xb2, 0xab, 0x0a, 0xb5, 0x6f, 0xc0, 0x13, 0x20, 0x3b, 0x99, 0xfe, 0x80,
-	0xba, 0x81, 0xdd, 0xbd, 0xe7, 0x5e, 0x1f, 0x9f, 0x73, 0x73, 0x63, 0xb4, 0x0e, 0x2a, 0xf1, 0xa1,
-	0xcc, 0xfd, 0xb2, 0xef, 0x43, 0x09, 0x4c, 0x49, 0x6f, 0x22, 0xb8, 0xe2, 0x18, 0x81, 0x4a, 0x3c,
-	0x28, 0x73, 0xaf, 0xec, 0x6f, 0x3a, 0x11, 0x97, 0x39, 0x97, 0x7e, 0x48, 0x24, 0xf8, 0x65, 0x3f,
-	0x04, 0x45, 0xfa, 0x7e, 0xc4, 0x53, 0x56, 0xf7, 0x6e, 0xf6, 0x62, 0x1e, 0x73, 0x13, 0xfa, 0x3a,
-	0xaa, 0x51, 0xf7, 0x9b, 0x85, 0xfe, 0x1d, 0x69, 0xca, 0x91, 0x4a, 0x40, 0x40, 0x91, 0x8f, 0x2b,
-	0xbc, 0x86, 0xda, 0x24, 0xe7, 0x05, 0x53, 0xb6, 0xb5, 0x6d, 0x3d, 0xee, 0x1e, 0x36, 0x19, 0xde,
-	0x40, 0x4b, 0xa0, 0x92, 0x20, 0x21, 0x32, 0xb1, 0xe7, 0x4c, 0xa5, 0x03, 0x2a, 0x79, 0x4d, 0x64,
-	0x82, 0x7b, 0x68, 0x31, 0x65, 0x14, 0x2a, 0x7b, 0xde, 0xe0, 0x75, 0xa2, 0x0f, 0xc4, 0x44, 0x06,
-	0x85, 0x04, 0x6a, 0x2f, 0xd4, 0x07, 0x62, 0x22, 0x3f, 0x48, 0xa0, 0x18, 0xa3, 0x05, 0xc3, 0xb3,
-	0x68, 0x60, 0x13, 0xe3, 0xfb, 0xa8, 0x2b, 0x20, 0x4a, 0x27, 0x29, 0x30, 0x65, 0xb7, 0x4d, 0xe1,
-	0x1a, 0xc0, 0x2e, 0x5a, 0xd5, 0xb7, 0xab, 0x2a, 0x38, 0x22, 0x69, 0x06, 0xd4, 0xee, 0x98, 0x8e,
-	0x65, 0x50, 0xc9, 0xb8, 0xda, 0x37, 0x90, 0xfb, 0x10, 0x21, 0x63, 0x66, 0x5c, 0xbd, 0xe1, 0x31,
-	0x5e, 0x47, 0x1d, 0x55, 0x05, 0x19, 0x8f, 0xa5, 0x6d, 0x6d, 0xcf, 0x6b, 0x23, 0x4a, 0xe3, 0xd2,
-	0xfd, 0x88, 0x56, 0x4c, 0xdb, 0x01, 0x48, 0x49, 0x62, 0xd0, 0x86, 0x73, 0x4e, 0x8b, 0x0c, 0x66,
-	0x86, 0xeb, 0x4c, 0xe3, 0x12, 0x18, 0x05, 0xd1, 0xd8, 0x6d, 0xb2, 0x86, 0x58, 0x4d, 0x27, 0xd0,
-	0xf8, 0x6d, 0xab, 0x6a, 0x3c, 0x9d, 0x80, 0xfb, 0xa8, 0x19, 0xe6, 0x20, 0xe3, 0xd1, 0xf1, 0x20,
-	0xe3, 0x3c, 0xd7, 0x93, 0x09, 0x75, 0xd0, 0x50, 0xd7, 0x89, 0xfb, 0xd5, 0x42, 0x3d, 0xd3, 0xb9,
-	0x5f, 0xb0, 0x31, 0x3f, 0x06, 0x36, 0x14, 0x40, 0x14, 0x50, 0xfc, 0x00, 0xa1, 0x90, 0xb0, 0xe3,
-	0x80, 0x02, 0xbb, 0x3a, 0xd3, 0xd5, 0xc8, 0x9e, 0x06, 0xf0, 0x73, 0xb4, 0x06, 0x22, 0xda, 0x7d,
-	0x1a, 0x44, 0x9c, 0x29, 0x41, 0x22, 0x15, 0x10, 0x4a, 0x05, 0x48, 0xd9, 0x28, 0xec, 0x99, 0xea,
-	0xb0, 0x29, 0xbe, 0xaa, 0x6b, 0xd8, 0x46, 0x9d, 0x48, 0xf3, 0x73, 0xd1, 0xe8, 0x9d, 0xa5, 0xf8,
-	0x09, 0xfa, 0x3f, 0x95, 0x41, 0x4e, 0x28, 0x04, 0x47, 0x82, 0xe7, 0x81, 0xde, 0x17, 0xf3, 0xa9,
-	0x96, 0x0e, 0xff, 0x49, 0xe5, 0x01, 0xa1, 0xb0, 0x2f, 0x78, 0x3e, 0xe4, 0x29, 0x73, 0xcf, 0x2c,
-	0xb4, 0x66, 0x24, 0xbf, 0x07, 0x46, 0x67, 0xb2, 0xc7, 0x7c, 0x54, 0xe6, 0x37, 0xe6, 0x64, 0xdd,
-	0x9a, 0xd3, 0x9f, 0xa9, 0x75, 0xd0, 0xb2, 0xe2, 0x81, 0xfe, 0xd6, 0xba, 0xbb, 0x51, 0xdc, 0x55,
-	0x7c, 0xa4, 0x12, 0xdd, 0x82, 0xdf, 0x21, 0x33, 0x90, 0x6b, 0xad, 0xcb, 0xbb, 0x1b, 0x5e, 0xbd,
-	0xfc, 0x9e, 0x5e, 0x7e, 0xaf, 0x59, 0x7e, 0x4f, 0xcb, 0x1e, 0xd8, 0x27, 0xe7, 0x5b, 0xad, 0x1f,
-	0xe7, 0x5b, 0xff, 0x4d, 0x49, 0x9e, 0xbd, 0x70, 0xaf, 0x4e, 0xba, 0x87, 0x4b, 0x3a, 0x36, 0xd6,
-	0x3e, 0xa3, 0xd5, 0x7a, 0x6d, 0x04, 0x61, 0xf2, 0x08, 0xc4, 0x9d, 0x86, 0x6e, 0x6d, 0xe8, 0xdc,
-	0xaf, 0x1b, 0x7a, 0xfd, 0xdf, 0xcc, 0xdf, 0xfc, 0x6f, 0xdc, 0x31, 0xba, 0x67, 0xe8, 0x67, 0x46,
-	0xf7, 0x60, 0x92, 0xf1, 0x29, 0xd0, 0x3b, 0xaf, 0xd9, 0x41, 0xab, 0xb7, 0x26, 0xd6, 0x5c, 0xb5,
-	0x12, 0xdd, 0x98, 0xd4, 0x6f, 0xac, 0xa3, 0x0a, 0xa2, 0x42, 0xfd, 0x25, 0xeb, 0xe0, 0xe5, 0xc9,
-	0x85, 0x63, 0x9d, 0x5e, 0x38, 0xd6, 0xf7, 0x0b, 0xc7, 0xfa, 0x72, 0xe9, 0xb4, 0x4e, 0x2f, 0x9d,
-	0xd6, 0xd9, 0xa5, 0xd3, 0xfa, 0xb4, 0x13, 0xa7, 0x2a, 0x29, 0x42, 0x2f, 0xe2, 0xb9, 0xff, 0x36,
-	0x0d, 0x53, 0x51, 0x0c, 0x13, 0x92, 0x32, 0x9f, 0x99, 0xd8, 0xaf, 0xf4, 0x03, 0x15, 0xb6, 0xcd,
-	0xab, 0xf2, 0xec, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0xfa, 0x56, 0x49, 0xb2, 0x04, 0x00,
-	0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0xef, 0x76, 0xf3, 0x67, 0xb6, 0x4b, 0x5b, 0x2b, 0x6c, 0xdd, 0xa5, 0xb8, 0x95, 0x2b,
+	0x04, 0xbd, 0xd8, 0xa4, 0x70, 0xaa, 0xc4, 0x81, 0xa4, 0x89, 0x38, 0x74, 0xd1, 0x2a, 0xb8, 0x42,
+	0x42, 0x42, 0xd6, 0xd8, 0xf3, 0x62, 0x5b, 0x89, 0x67, 0xa2, 0x99, 0xb1, 0xe5, 0x7c, 0x0b, 0x3e,
+	0x0a, 0x1f, 0xa3, 0x12, 0x97, 0x0a, 0x09, 0xc1, 0x01, 0x55, 0x68, 0xf7, 0x1b, 0xf0, 0x09, 0xd0,
+	0x8c, 0xc7, 0x9b, 0x64, 0x51, 0x2f, 0xb0, 0x5c, 0xb8, 0xbd, 0x7f, 0xf3, 0xe6, 0xfd, 0x7e, 0x6f,
+	0xde, 0x3c, 0x74, 0x1f, 0x64, 0x16, 0x40, 0x55, 0x04, 0xd5, 0x30, 0x80, 0x0a, 0xa8, 0x14, 0xfe,
+	0x8a, 0x33, 0xc9, 0x6c, 0x04, 0x32, 0xf3, 0xa1, 0x2a, 0xfc, 0x6a, 0x78, 0xea, 0x26, 0x4c, 0x14,
+	0x4c, 0x04, 0x31, 0x16, 0x10, 0x54, 0xc3, 0x18, 0x24, 0x1e, 0x06, 0x09, 0xcb, 0x69, 0x13, 0x7b,
+	0x3a, 0x48, 0x59, 0xca, 0xb4, 0x18, 0x28, 0xa9, 0xb1, 0x7a, 0x3f, 0x59, 0xe8, 0xce, 0x44, 0xa5,
+	0x9c, 0xc8, 0x0c, 0x38, 0x94, 0x45, 0x58, 0xdb, 0x27, 0xa8, 0x83, 0x0b, 0x56, 0x52, 0xe9, 0x58,
+	0x8f, 0xad, 0x4f, 0xfa, 0x33, 0xa3, 0xd9, 0x0f, 0x50, 0x0f, 0x64, 0x16, 0x65, 0x58, 0x64, 0xce,
+	0xbe, 0xf6, 0x74, 0x41, 0x66, 0x5f, 0x61, 0x91, 0xd9, 0x03, 0x74, 0x98, 0x53, 0x02, 0xb5, 0x73,
+	0xa0, 0xed, 0x8d, 0xa2, 0x0e, 0xa4, 0x58, 0x44, 0xa5, 0x00, 0xe2, 0xdc, 0x6a, 0x0e, 0xa4, 0x58,
+	0xbc, 0x12, 0x40, 0x6c, 0x1b, 0xdd, 0xd2, 0x79, 0x0e, 0xb5, 0x59, 0xcb, 0xf6, 0x43, 0xd4, 0xe7,
+	0x90, 0xe4, 0xab, 0x1c, 0xa8, 0x74, 0x3a, 0xda, 0xb1, 0x31, 0xd8, 0x1e, 0x3a, 0x56, 0xb7, 0xcb,
+	0x3a, 0x9a, 0xe3, 0x7c, 0x09, 0xc4, 0xe9, 0xea, 0x88, 0x23, 0x90, 0x59, 0x58, 0x4f, 0xb5, 0xc9,
+	0xfb, 0x08, 0x21, 0x0d, 0x26, 0xac, 0x5f, 0xb2, 0xd4, 0xbe, 0x8f, 0xba, 0xb2, 0x8e, 0x96, 0x2c,
+	0x15, 0x8e, 0xf5, 0xf8, 0x40, 0x01, 0x91, 0xca, 0x2e, 0xbc, 0x6f, 0xd1, 0x6d, 0x1d, 0x76, 0x06,
+	0x42, 0xe0, 0x14, 0x14, 0xe0, 0x82, 0x91, 0x72, 0x09, 0x2d, 0xe0, 0x46, 0x53, 0x76, 0x01, 0x94,
+	0x00, 0x37, 0x70, 0x8d, 0x66, 0x12, 0xcb, 0xf5, 0x0a, 0x0c, 0xde, 0x8e, 0xac, 0xc3, 0xf5, 0x0a,
+	0xbc, 0x8f, 0x0d, 0x99, 0xa3, 0x25, 0x4b, 0x16, 0xa3, 0x25, 0x63, 0x85, 0x62, 0x26, 0x56, 0x82,
+	0x49, 0xdd, 0x28, 0x9e, 0x8f, 0xee, 0x6d, 0x05, 0x62, 0x01, 0x53, 0x00, 0x45, 0x97, 0x6a, 0x5e,
+	0x34, 0x87, 0xb6, 0x90, 0x6e, 0xdc, 0xb8, 0xbc, 0x1f, 0x2d, 0x34, 0xd0, 0x07, 0xa6, 0x25, 0x0d,
+	0xd9, 0x02, 0xe8, 0x98, 0x03, 0x96, 0x40, 0xec, 0x0f, 0x11, 0x8a, 0x31, 0x5d, 0x44, 0x04, 0xe8,
+	0xd5, 0x1d, 0x7d, 0x65, 0x79, 0xa1, 0x0c, 0xf6, 0xe7, 0xe8, 0x04, 0x78, 0xf2, 0xec, 0xd3, 0x28,
+	0x61, 0x54, 0x72, 0x9c, 0xc8, 0x08, 0x13, 0xc2, 0x41, 0x08, 0x83, 0x68, 0xa0, 0xbd, 0x63, 0xe3,
+	0xfc, 0xb2, 0xf1, 0xd9, 0x0e, 0xea, 0x26, 0x2a, 0x3f, 0xe3, 0x06, 0x5f, 0xab, 0xda, 0x4f, 0xd1,
+	0xbd, 0x5c, 0x44, 0x05, 0x26, 0x10, 0xcd, 0x39, 0x2b, 0x22, 0xf5, 0xbe, 0x74, 0x6b, 0x7b, 0xb3,
+	0xf7, 0x72, 0x71, 0x86, 0x09, 0x4c, 0x39, 0x2b, 0xc6, 0x2c, 0xa7, 0xde, 0xaf, 0x16, 0x7a, 0x5f,
+	0x97, 0x3c, 0x66, 0xb4, 0x02, 0x2e, 0x95, 0x31, 0x64, 0x93, 0xaa, 0xd8, 0xa2, 0xd5, 0xda, 0xa1,
+	0xf5, 0x9f, 0x15, 0xeb, 0xa2, 0x23, 0xc9, 0x22, 0xf5, 0x34, 0x54, 0xb4, 0x29, 0xb8, 0x2f, 0xd9,
+	0x44, 0x66, 0x2a, 0xc4, 0x3e, 0x47, 0x9a, 0x8f, 0x4d, 0xa9, 0x47, 0xcf, 0x1e, 0xf8, 0xcd, 0xac,
+	0xf8, 0x8a, 0x5e, 0xdf, 0xcc, 0x8a, 0xaf, 0x0a, 0x1c, 0x39, 0xaf, 0xdf, 0x3e, 0xda, 0xfb, 0xf3,
+	0xed, 0xa3, 0xbb, 0x6b, 0x5c, 0x2c, 0x9f, 0x7b, 0x57, 0x27, 0xbd, 0x59, 0x4f, 0xc9, 0x1a, 0xd9,
+	0xef, 0x16, 0x3a, 0xd1, 0xc8, 0xbe, 0x01, 0x4a, 0xda, 0x86, 0xfc, 0x2f, 0xa0, 0x3d, 0xdf, 0x77,
+	0x2c, 0xef, 0x97, 0xb6, 0x71, 0x2d, 0xb4, 0x11, 0xa6, 0x0b, 0x05, 0xf5, 0x86, 0xd1, 0xed, 0x8c,
+	0xfb, 0xc1, 0xf5, 0x71, 0xbf, 0xf9, 0xb6, 0xfd, 0x6c, 0xa1, 0x87, 0x3b, 0xb8, 0xce, 0x40, 0x62,
+	0x82, 0x25, 0x7e, 0xb5, 0x22, 0xff, 0xdd, 0x2c, 0x9d, 0xa2, 0x5e, 0x05, 0x3c, 0x9f, 0xe7, 0x40,
+	0x34, 0xc8, 0xde, 0xec, 0x4a, 0xb7, 0x9f, 0xa2, 0xbb, 0x85, 0xa9, 0x21, 0x2a, 0x9b, 0x22, 0xcc,
+	0x30, 0xdd, 0x29, 0xae, 0xd5, 0xb6, 0xa1, 0xfe, 0x70, 0x9b, 0x7a, 0xef, 0x7b, 0x74, 0xdc, 0xfc,
+	0x78, 0x1c, 0x53, 0x31, 0x07, 0xfe, 0xce, 0x1e, 0xed, 0xb0, 0xbd, 0x7f, 0x9d, 0xed, 0xcd, 0x97,
+	0x7f, 0xb0, 0xfd, 0xe5, 0x7b, 0xe1, 0x66, 0x86, 0x35, 0xaa, 0x17, 0xb0, 0x5a, 0xb2, 0x35, 0xbc,
+	0xfb, 0x29, 0x3c, 0x41, 0xc7, 0x3b, 0xf4, 0x98, 0xab, 0x6e, 0x27, 0x5b, 0xb4, 0xfc, 0x2d, 0xeb,
+	0xa4, 0x86, 0xa4, 0x94, 0xff, 0x36, 0xeb, 0x4b, 0xf4, 0x81, 0xce, 0x7a, 0xce, 0x84, 0x0c, 0xeb,
+	0x73, 0xce, 0x12, 0x10, 0x22, 0xa7, 0x69, 0xb3, 0x1b, 0xcc, 0xa7, 0xad, 0x97, 0x8e, 0xd5, 0x7e,
+	0xda, 0xed, 0xee, 0x02, 0xce, 0x59, 0x9b, 0xb4, 0x51, 0x46, 0x5f, 0xbc, 0xbe, 0x70, 0xad, 0x37,
+	0x17, 0xae, 0xf5, 0xc7, 0x85, 0x6b, 0xfd, 0x70, 0xe9, 0xee, 0xbd, 0xb9, 0x74, 0xf7, 0x7e, 0xbb,
+	0x74, 0xf7, 0xbe, 0x7b, 0x92, 0xe6, 0x32, 0x2b, 0x63, 0x3f, 0x61, 0x45, 0xf0, 0x75, 0x1e, 0xe7,
+	0xbc, 0x1c, 0x67, 0x38, 0xa7, 0x01, 0xd5, 0x72, 0x50, 0xab, 0x4d, 0x1d, 0x77, 0xf4, 0x7a, 0xfd,
+	0xec, 0xaf, 0x01, 0x00, 0x13, 0x07, 0xad, 0x81, 0xbb, 0x07, 0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -823,6 +1162,36 @@ func (m *EventBlockBloom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockBaseFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockBaseFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockBaseFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		i -= len(m.BaseFee)
+		copy(dAtA[i:], m.BaseFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BaseFee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventFunTokenCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -877,7 +1246,7 @@ func (m *EventFunTokenCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
+func (m *EventConvertCoinToEvm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -887,12 +1256,12 @@ func (m *EventSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventConvertCoinToEvm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -931,7 +1300,7 @@ func (m *EventSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
+func (m *EventSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -941,27 +1310,199 @@ func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToEthAddr) > 0 {
+		i -= len(m.ToEthAddr)
+		copy(dAtA[i:], m.ToEthAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToEthAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFunTokenBankSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFunTokenBankSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFunTokenBankSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFunTokenMetadataUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFunTokenMetadataUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFunTokenMetadataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MetadataUpdated {
+		i--
+		if m.MetadataUpdated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1049,6 +1590,43 @@ func (m *EventContractExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPostTxProcessingFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPostTxProcessingFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPostTxProcessingFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1146,6 +1724,19 @@ func (m *EventBlockBloom) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockBaseFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventFunTokenCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1170,7 +1761,7 @@ func (m *EventFunTokenCreated) Size() (n int) {
 	return n
 }
 
-func (m *EventSendFunTokenToEvm) Size() (n int) {
+func (m *EventConvertCoinToEvm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1193,7 +1784,7 @@ func (m *EventSendFunTokenToEvm) Size() (n int) {
 	return n
 }
 
-func (m *EventTransfer) Size() (n int) {
+func (m *EventSendFunTokenToEvm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1203,18 +1794,20 @@ func (m *EventTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Recipient)
+	l = len(m.Erc20ContractAddress)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Amount)
+	l = len(m.ToEthAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
-func (m *EventContractDeployed) Size() (n int) {
+func (m *EventFunTokenBankSend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1224,37 +1817,791 @@ func (m *EventContractDeployed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContractAddr)
+	l = len(m.Erc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFunTokenMetadataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.MetadataUpdated {
+		n += 2
+	}
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractDeployed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPostTxProcessingFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxFailed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxFailed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTxLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxLogs = append(m.TxLogs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockBloom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockBloom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockBaseFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockBaseFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockBaseFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventContractExecuted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1277,15 +2624,15 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFunTokenCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFunTokenCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1313,11 +2660,11 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1345,11 +2692,11 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthHash = string(dAtA[iNdEx:postIndex])
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1377,11 +2724,81 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMadeFromCoin = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertCoinToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1409,11 +2826,11 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasUsed = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,11 +2858,11 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,13 +2890,13 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.ToEthAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthTxFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1489,23 +2906,24 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthTxFailed = string(dAtA[iNdEx:postIndex])
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1528,7 +2946,7 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTxLog) Unmarshal(dAtA []byte) error {
+func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1551,17 +2969,113 @@ func (m *EventTxLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendFunTokenToEvm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToEthAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1571,23 +3085,24 @@ func (m *EventTxLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxLogs = append(m.TxLogs, string(dAtA[iNdEx:postIndex]))
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1610,7 +3125,7 @@ func (m *EventTxLog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMessage) Unmarshal(dAtA []byte) error {
+func (m *EventFunTokenBankSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1633,15 +3148,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFunTokenBankSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFunTokenBankSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1669,11 +3184,11 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Module = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1701,11 +3216,11 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1733,63 +3248,13 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxType = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBlockBloom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBlockBloom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1799,23 +3264,24 @@ func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bloom = string(dAtA[iNdEx:postIndex])
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1838,7 +3304,7 @@ func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
+func (m *EventFunTokenMetadataUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1861,10 +3327,10 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFunTokenCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFunTokenMetadataUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFunTokenCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFunTokenMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1932,10 +3398,10 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1945,27 +3411,15 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Verified = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUpdated", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1982,7 +3436,39 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsMadeFromCoin = bool(v != 0)
+			m.MetadataUpdated = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2004,7 +3490,7 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
+func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2027,10 +3513,10 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendFunTokenToEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2067,7 +3553,7 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2095,11 +3581,11 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2127,40 +3613,7 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToEthAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2183,7 +3636,7 @@ func (m *EventSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTransfer) Unmarshal(dAtA []byte) error {
+func (m *EventContractDeployed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2206,10 +3659,10 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractDeployed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractDeployed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2246,39 +3699,7 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2306,7 +3727,7 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2329,7 +3750,7 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractDeployed) Unmarshal(dAtA []byte) error {
+func (m *EventContractExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2352,10 +3773,10 @@ func (m *EventContractDeployed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractDeployed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractDeployed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2443,7 +3864,7 @@ func (m *EventContractDeployed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContractExecuted) Unmarshal(dAtA []byte) error {
+func (m *EventPostTxProcessingFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2466,15 +3887,15 @@ func (m *EventContractExecuted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContractExecuted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPostTxProcessingFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContractExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPostTxProcessingFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2502,11 +3923,11 @@ func (m *EventContractExecuted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2534,7 +3955,7 @@ func (m *EventContractExecuted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50,3 +50,10 @@ func NewFunToken(
 		IsMadeFromCoin: isMadeFromCoin,
 	}
 }
+
+// IsBroken returns true if the circulating supply of the FunToken is not fully
+// backed by the escrow of the EVM module. A report whose ERC20 state could not
+// be read is not broken, since that says nothing about the supply.
+func (r FunTokenSupplyReport) IsBroken() bool {
+	return r.Discrepancy.IsNegative()
+}
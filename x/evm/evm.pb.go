@@ -38,6 +38,11 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// verified: True if governance or the x/sudo root approved the bank metadata
+	// of the `FunToken`. Wallets and frontends should only display the name and
+	// symbol of verified mappings as trusted. Set with
+	// "MsgUpdateFunTokenMetadata".
+	Verified bool `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
 func (m *FunToken) Reset()         { *m = FunToken{} }
@@ -87,6 +92,13 @@ func (m *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *FunToken) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -111,6 +123,17 @@ type Params struct {
 	// Fee deducted and burned when calling "CreateFunToken" in units of
 	// "evm_denom".
 	CreateFuntokenFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=create_funtoken_fee,json=createFuntokenFee,proto3,customtype=cosmossdk.io/math.Int" json:"create_funtoken_fee"`
+	// base_fee_change_denominator bounds the amount the EIP-1559 base fee can
+	// change between blocks. A value of 0 disables the dynamic base fee, keeping
+	// it fixed at "min_base_fee".
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,10,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
+	// have relative to its gas target. The gas target is the block gas limit
+	// divided by the elasticity multiplier.
+	ElasticityMultiplier uint32 `protobuf:"varint,11,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// min_base_fee: Lower bound for the EIP-1559 base fee in units of
+	// "evm_denom" per unit gas.
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -195,6 +218,20 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -667,81 +704,87 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 1181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0xb6, 0x2c, 0xca, 0xa2, 0x56, 0x72, 0x24, 0xaf, 0x95, 0xfc, 0xf8, 0x4b, 0x10, 0xd3, 0x60,
-	0x2e, 0x0e, 0x90, 0x4a, 0xb5, 0x8b, 0xa2, 0x40, 0x8a, 0x14, 0x30, 0x1d, 0x1b, 0x89, 0x6b, 0x07,
-	0xc6, 0xc6, 0xe9, 0xa1, 0x17, 0x62, 0x45, 0x8e, 0x29, 0x56, 0x24, 0x57, 0xe0, 0x2e, 0x55, 0xf9,
-	0x01, 0x0a, 0xf4, 0xd8, 0x47, 0xc8, 0xa9, 0xcf, 0x12, 0xf4, 0x94, 0x63, 0xd1, 0x03, 0x51, 0x38,
-	0x97, 0x54, 0x47, 0x3f, 0x41, 0xb1, 0xbb, 0x94, 0x25, 0xa7, 0x40, 0x4e, 0x9e, 0x6f, 0xbe, 0x9d,
-	0x3f, 0x9c, 0xf9, 0x76, 0x65, 0xd4, 0x05, 0x31, 0xec, 0xc3, 0x24, 0xe9, 0x4f, 0x76, 0xe5, 0x9f,
-	0xde, 0x38, 0x63, 0x82, 0x61, 0x04, 0x62, 0xd8, 0x93, 0x70, 0xb2, 0x7b, 0xbf, 0x1b, 0xb2, 0x90,
-	0x29, 0x77, 0x5f, 0x5a, 0xfa, 0x84, 0xf3, 0x7b, 0x05, 0x99, 0x47, 0x79, 0x7a, 0xce, 0x46, 0x90,
-	0xe2, 0x33, 0x84, 0x20, 0xf3, 0xf7, 0xbe, 0xf4, 0x68, 0x10, 0x64, 0x56, 0x65, 0xbb, 0xb2, 0xd3,
-	0x70, 0x77, 0xdf, 0x15, 0xf6, 0xca, 0x5f, 0x85, 0xfd, 0x38, 0x8c, 0xc4, 0x30, 0x1f, 0xf4, 0x7c,
-	0x96, 0xf4, 0x5f, 0x45, 0x83, 0x28, 0xcb, 0x0f, 0x86, 0x34, 0x4a, 0xfb, 0xa9, 0xb2, 0xfb, 0xb2,
-	0xd0, 0x0b, 0x98, 0xee, 0x07, 0x41, 0x46, 0x1a, 0x2a, 0x89, 0x34, 0xf1, 0x43, 0x84, 0x06, 0x34,
-	0x1d, 0x79, 0x01, 0xa4, 0x2c, 0xb1, 0x56, 0x65, 0x46, 0xd2, 0x90, 0x9e, 0xe7, 0xd2, 0x81, 0x1f,
-	0xa3, 0x8d, 0x88, 0x7b, 0x09, 0x0d, 0xc0, 0xbb, 0xc8, 0x58, 0xe2, 0xf9, 0x2c, 0x4a, 0xad, 0xea,
-	0x76, 0x65, 0xc7, 0x24, 0x77, 0x22, 0x7e, 0x4a, 0x03, 0x38, 0xca, 0x58, 0x72, 0xc0, 0xa2, 0xd4,
-	0xf9, 0x58, 0x45, 0x6b, 0x67, 0x34, 0xa3, 0x09, 0xc7, 0xbb, 0xa8, 0x01, 0x93, 0xa4, 0xcc, 0xa9,
-	0xbb, 0xec, 0x5e, 0x17, 0x76, 0xe7, 0x92, 0x26, 0xf1, 0x53, 0xe7, 0x86, 0x72, 0x88, 0x09, 0x93,
-	0x44, 0x17, 0x7a, 0x86, 0xd6, 0x21, 0xa5, 0x83, 0x18, 0x3c, 0x3f, 0x03, 0x2a, 0x40, 0xb5, 0x62,
-	0xba, 0xd6, 0x75, 0x61, 0x77, 0xcb, 0xb0, 0x65, 0xda, 0x21, 0x2d, 0x8d, 0x0f, 0x14, 0xc4, 0xdf,
-	0xa0, 0xe6, 0x9c, 0xa7, 0x71, 0xac, 0x3b, 0x74, 0xef, 0x5d, 0x17, 0x36, 0xbe, 0x1d, 0x4c, 0xe3,
-	0xd8, 0x21, 0xa8, 0x0c, 0xa5, 0x71, 0x8c, 0xf7, 0x11, 0x82, 0xa9, 0xc8, 0xa8, 0x07, 0xd1, 0x98,
-	0x5b, 0xc6, 0x76, 0x75, 0xa7, 0xea, 0x3a, 0x57, 0x85, 0xdd, 0x38, 0x94, 0xde, 0xc3, 0x97, 0x67,
-	0xfc, 0xba, 0xb0, 0x37, 0xca, 0x24, 0x37, 0x07, 0x1d, 0xd2, 0x50, 0xe0, 0x30, 0x1a, 0x73, 0xbc,
-	0x87, 0xee, 0xd2, 0x38, 0x66, 0x3f, 0x7b, 0x79, 0x2a, 0x57, 0x06, 0xbe, 0x80, 0xc0, 0x13, 0x53,
-	0x6e, 0xad, 0xa9, 0x39, 0x6d, 0x2a, 0xf2, 0xcd, 0x82, 0x3b, 0x9f, 0x72, 0xfc, 0x05, 0xc2, 0xd4,
-	0x17, 0xd1, 0x04, 0xbc, 0x71, 0x06, 0x3e, 0x4b, 0xc6, 0x51, 0x0c, 0xdc, 0xaa, 0x6f, 0x57, 0x77,
-	0x1a, 0x64, 0x43, 0x33, 0x67, 0x0b, 0x02, 0xef, 0xa1, 0x96, 0x9c, 0x9a, 0x3f, 0xa4, 0x69, 0x0a,
-	0x31, 0xb7, 0x4c, 0x79, 0xd0, 0x6d, 0x5f, 0x15, 0x76, 0xf3, 0xf0, 0x87, 0xd3, 0x83, 0xd2, 0x4d,
-	0x9a, 0x30, 0x49, 0xe6, 0x00, 0x9f, 0xa2, 0x4d, 0x3d, 0x2b, 0xef, 0x22, 0x4f, 0x85, 0x94, 0x8f,
-	0x77, 0x01, 0x60, 0x35, 0xd4, 0x3a, 0x1e, 0x96, 0xa2, 0xb9, 0xeb, 0x33, 0x9e, 0x30, 0xce, 0x83,
-	0x51, 0x2f, 0x62, 0xfd, 0x84, 0x8a, 0x61, 0xef, 0x65, 0x2a, 0xc8, 0x86, 0x8e, 0x3c, 0x2a, 0x03,
-	0x8f, 0x00, 0x9e, 0x1a, 0x1f, 0xdf, 0xda, 0x95, 0x63, 0xc3, 0xac, 0x75, 0xd6, 0x9c, 0x3e, 0xaa,
-	0xbd, 0x16, 0x72, 0xec, 0x1d, 0x54, 0x1d, 0xc1, 0xa5, 0x5e, 0x31, 0x91, 0x26, 0xee, 0xa2, 0xda,
-	0x84, 0xc6, 0x39, 0x94, 0x52, 0xd2, 0xc0, 0x39, 0x46, 0xed, 0xf3, 0x8c, 0xa6, 0x5c, 0x7e, 0x19,
-	0x4b, 0x4f, 0x58, 0xc8, 0x31, 0x46, 0xc6, 0x90, 0xf2, 0x61, 0x19, 0xab, 0x6c, 0xfc, 0x08, 0x19,
-	0x31, 0x0b, 0xb9, 0xb5, 0xba, 0x5d, 0xdd, 0x69, 0xee, 0xb5, 0x7b, 0x8b, 0xcb, 0xd1, 0x3b, 0x61,
-	0x21, 0x51, 0xa4, 0xf3, 0xc7, 0x2a, 0xaa, 0x9e, 0xb0, 0x10, 0x5b, 0xa8, 0x2e, 0x6f, 0x01, 0x70,
-	0x5e, 0xe6, 0x98, 0x43, 0x7c, 0x0f, 0xad, 0x09, 0x36, 0x8e, 0x7c, 0x9d, 0xa8, 0x41, 0x4a, 0x24,
-	0x4b, 0x06, 0x54, 0x50, 0xa5, 0x8e, 0x16, 0x51, 0xb6, 0x9c, 0xec, 0x20, 0x66, 0xfe, 0xc8, 0x4b,
-	0xf3, 0x64, 0x00, 0x99, 0x65, 0x6c, 0x57, 0x76, 0x0c, 0xb7, 0x3d, 0x2b, 0xec, 0xa6, 0xf2, 0xbf,
-	0x52, 0x6e, 0xb2, 0x0c, 0xf0, 0x13, 0x54, 0x17, 0x53, 0x4f, 0x75, 0x5f, 0x53, 0xd3, 0xdc, 0x9c,
-	0x15, 0x76, 0x5b, 0x2c, 0x3e, 0xf0, 0x05, 0xe5, 0x43, 0xb2, 0x26, 0xa6, 0xf2, 0x2f, 0xee, 0x23,
-	0x53, 0x4c, 0xbd, 0x28, 0x0d, 0x60, 0xaa, 0x14, 0x61, 0xb8, 0xdd, 0x59, 0x61, 0x77, 0x96, 0x8e,
-	0xbf, 0x94, 0x1c, 0xa9, 0x8b, 0xa9, 0x32, 0xf0, 0x13, 0x84, 0x74, 0x4b, 0xaa, 0x42, 0x5d, 0x55,
-	0x58, 0x9f, 0x15, 0x76, 0x43, 0x79, 0x55, 0xee, 0x85, 0x89, 0x1d, 0x54, 0xd3, 0xb9, 0x4d, 0x95,
-	0xbb, 0x35, 0x2b, 0x6c, 0x33, 0x66, 0xa1, 0xce, 0xa9, 0x29, 0x39, 0xaa, 0x0c, 0x12, 0x36, 0x81,
-	0x40, 0xad, 0xdf, 0x24, 0x73, 0xe8, 0xfc, 0xb2, 0x8a, 0xcc, 0xf3, 0x29, 0x01, 0x9e, 0xc7, 0x02,
-	0x1f, 0xa1, 0x8e, 0xcf, 0x52, 0x91, 0x51, 0x5f, 0x78, 0xb7, 0x46, 0xeb, 0x3e, 0xb8, 0x2e, 0xec,
-	0xff, 0xe9, 0x4b, 0xf0, 0xe9, 0x09, 0x87, 0xb4, 0xe7, 0xae, 0xfd, 0x72, 0xfe, 0x5d, 0x54, 0x1b,
-	0xc4, 0xac, 0x7c, 0x4e, 0x5a, 0x44, 0x03, 0x7c, 0xa2, 0xa6, 0xa6, 0xf6, 0x2b, 0x17, 0xd0, 0xdc,
-	0x7b, 0xb0, 0xbc, 0xdf, 0x4f, 0xe4, 0xe1, 0xde, 0x93, 0x02, 0xbd, 0x2e, 0xec, 0x3b, 0xba, 0x6a,
-	0x19, 0xe9, 0xc8, 0xa9, 0x2a, 0xf9, 0x74, 0x50, 0x35, 0x03, 0xa1, 0xd6, 0xd5, 0x22, 0xd2, 0xc4,
-	0xf7, 0x91, 0x99, 0xc1, 0x04, 0x32, 0x01, 0x81, 0x5a, 0x8b, 0x49, 0x6e, 0x30, 0xfe, 0x3f, 0x32,
-	0x43, 0xca, 0xbd, 0x9c, 0x43, 0xa0, 0x77, 0x40, 0xea, 0x21, 0xe5, 0x6f, 0x38, 0x04, 0x4f, 0x8d,
-	0x5f, 0xdf, 0xda, 0x2b, 0x0e, 0x45, 0xcd, 0x7d, 0xdf, 0x07, 0xce, 0xcf, 0xf3, 0x71, 0x0c, 0x9f,
-	0xd1, 0xd6, 0x1e, 0x6a, 0x71, 0xc1, 0x32, 0x1a, 0x82, 0x37, 0x82, 0xcb, 0x52, 0x61, 0x5a, 0x2f,
-	0xa5, 0xff, 0x7b, 0xb8, 0xe4, 0x64, 0x19, 0x94, 0x25, 0xfe, 0xa9, 0xa2, 0xe6, 0x79, 0x46, 0x7d,
-	0x38, 0x60, 0xe9, 0x45, 0x14, 0x2a, 0x95, 0x4a, 0x58, 0xbe, 0xe3, 0xa4, 0x44, 0xb2, 0xb6, 0x88,
-	0x12, 0x60, 0xb9, 0x28, 0xef, 0xd0, 0x1c, 0xca, 0x88, 0x0c, 0x60, 0x0a, 0xbe, 0x1a, 0xa0, 0x41,
-	0x4a, 0x84, 0xbf, 0x46, 0xeb, 0x41, 0xc4, 0xd5, 0x03, 0xc7, 0x05, 0xf5, 0x47, 0xfa, 0xf3, 0xdd,
-	0xce, 0xac, 0xb0, 0x5b, 0x25, 0xf1, 0x5a, 0xfa, 0xc9, 0x2d, 0x84, 0xbf, 0x45, 0xed, 0x45, 0x98,
-	0xea, 0x56, 0xbf, 0x58, 0x2e, 0x9e, 0x15, 0xf6, 0x9d, 0x9b, 0xa3, 0x8a, 0x21, 0x9f, 0x60, 0xb9,
-	0xe3, 0x00, 0x06, 0x79, 0xa8, 0x64, 0x67, 0x12, 0x0d, 0xa4, 0x37, 0x8e, 0x92, 0x48, 0x28, 0x99,
-	0xd5, 0x88, 0x06, 0xb2, 0xbf, 0xf2, 0xfd, 0x4d, 0x20, 0x61, 0xd9, 0xa5, 0xd5, 0x5c, 0xf4, 0xa7,
-	0x89, 0x53, 0xe5, 0x27, 0xb7, 0x10, 0x76, 0x11, 0x2e, 0xc3, 0x32, 0x10, 0x79, 0x96, 0x7a, 0xea,
-	0xf2, 0xb6, 0x54, 0xac, 0xba, 0x42, 0x9a, 0x25, 0x8a, 0x7c, 0x4e, 0x05, 0x25, 0xff, 0xf1, 0xe0,
-	0xef, 0x10, 0xd6, 0x63, 0xf5, 0x7e, 0xe2, 0x2c, 0xf5, 0x7c, 0x35, 0x7a, 0x6b, 0x5d, 0x89, 0x5a,
-	0xd5, 0xd7, 0xac, 0x5e, 0x09, 0xe9, 0x68, 0x74, 0xcc, 0x59, 0xaa, 0x3d, 0xc7, 0x86, 0x69, 0x74,
-	0x6a, 0xc7, 0x86, 0x59, 0xef, 0x98, 0xc7, 0x86, 0x89, 0x3a, 0xcd, 0x9b, 0x41, 0x94, 0xdf, 0x42,
-	0x36, 0xe7, 0x78, 0xa9, 0x49, 0xf7, 0xd9, 0xbb, 0xab, 0xad, 0xca, 0xfb, 0xab, 0xad, 0xca, 0xdf,
-	0x57, 0x5b, 0x95, 0xdf, 0x3e, 0x6c, 0xad, 0xbc, 0xff, 0xb0, 0xb5, 0xf2, 0xe7, 0x87, 0xad, 0x95,
-	0x1f, 0x1f, 0x7d, 0xfe, 0x57, 0x7a, 0x2a, 0xff, 0x37, 0x18, 0xac, 0xa9, 0x9f, 0xfe, 0xaf, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x12, 0x25, 0x13, 0xb4, 0x34, 0x08, 0x00, 0x00,
+	// 1269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0x63, 0x39, 0x91, 0x69, 0xa7, 0x71, 0x18, 0xb7, 0xd3, 0x5a, 0x34, 0x0a, 0xd4, 0x4b,
+	0x0a, 0x74, 0xf1, 0x92, 0x62, 0x18, 0xd0, 0xa1, 0x1b, 0xa2, 0x34, 0x41, 0x9b, 0x25, 0x45, 0xc0,
+	0xa6, 0x3b, 0xec, 0x22, 0xd0, 0xd2, 0x8b, 0xcc, 0x45, 0x12, 0x0d, 0x91, 0xf2, 0x9c, 0x0f, 0x30,
+	0x60, 0xc7, 0x7d, 0x81, 0x01, 0xfd, 0x2c, 0x3b, 0x15, 0x3b, 0xf5, 0x38, 0xec, 0x20, 0x0c, 0xe9,
+	0x65, 0xcb, 0x31, 0x9f, 0x60, 0x20, 0x29, 0xdb, 0x49, 0x07, 0x14, 0x3b, 0x99, 0xbf, 0xf7, 0x7b,
+	0xff, 0xf4, 0xde, 0x8f, 0xb2, 0x50, 0x17, 0xe4, 0xa0, 0x07, 0xa3, 0xb4, 0x37, 0xda, 0x52, 0x3f,
+	0x9b, 0xc3, 0x9c, 0x4b, 0x8e, 0x11, 0xc8, 0xc1, 0xa6, 0x82, 0xa3, 0xad, 0xbb, 0xdd, 0x98, 0xc7,
+	0x5c, 0x9b, 0x7b, 0xea, 0x64, 0x3c, 0xbc, 0xdf, 0x6a, 0xc8, 0xde, 0x2f, 0xb2, 0x13, 0x7e, 0x06,
+	0x19, 0x3e, 0x46, 0x08, 0xf2, 0x70, 0xfb, 0xf3, 0x80, 0x46, 0x51, 0xee, 0xd4, 0xd6, 0x6b, 0x1b,
+	0x4d, 0x7f, 0xeb, 0x6d, 0xe9, 0xce, 0xfd, 0x59, 0xba, 0x0f, 0x63, 0x26, 0x07, 0x45, 0x7f, 0x33,
+	0xe4, 0x69, 0xef, 0x25, 0xeb, 0xb3, 0xbc, 0xd8, 0x1d, 0x50, 0x96, 0xf5, 0x32, 0x7d, 0xee, 0xa9,
+	0x42, 0xcf, 0x61, 0xbc, 0x13, 0x45, 0x39, 0x69, 0xea, 0x24, 0xea, 0x88, 0xef, 0x23, 0xd4, 0xa7,
+	0xd9, 0x59, 0x10, 0x41, 0xc6, 0x53, 0x67, 0x5e, 0x65, 0x24, 0x4d, 0x65, 0x79, 0xa6, 0x0c, 0xf8,
+	0x21, 0x5a, 0x61, 0x22, 0x48, 0x69, 0x04, 0xc1, 0x69, 0xce, 0xd3, 0x20, 0xe4, 0x2c, 0x73, 0xea,
+	0xeb, 0xb5, 0x0d, 0x9b, 0xdc, 0x62, 0xe2, 0x88, 0x46, 0xb0, 0x9f, 0xf3, 0x74, 0x97, 0xb3, 0x0c,
+	0xdf, 0x45, 0xf6, 0x08, 0x72, 0x76, 0xca, 0x20, 0x72, 0x2c, 0xed, 0x31, 0xc5, 0xde, 0xaf, 0x0d,
+	0xb4, 0x70, 0x4c, 0x73, 0x9a, 0x0a, 0xbc, 0x85, 0x9a, 0x30, 0x4a, 0xab, 0x7a, 0xe6, 0x09, 0xba,
+	0x57, 0xa5, 0xdb, 0x39, 0xa7, 0x69, 0xf2, 0xc4, 0x9b, 0x52, 0x1e, 0xb1, 0x61, 0x94, 0x9a, 0x26,
+	0x9e, 0xa2, 0x25, 0xc8, 0x68, 0x3f, 0x81, 0x20, 0xcc, 0x81, 0x4a, 0xd0, 0x6d, 0xda, 0xbe, 0x73,
+	0x55, 0xba, 0xdd, 0x2a, 0xec, 0x3a, 0xed, 0x91, 0xb6, 0xc1, 0xbb, 0x1a, 0xe2, 0x2f, 0x51, 0x6b,
+	0xc2, 0xd3, 0x24, 0x31, 0xdd, 0xfb, 0x77, 0xae, 0x4a, 0x17, 0xdf, 0x0c, 0xa6, 0x49, 0xe2, 0x11,
+	0x54, 0x85, 0xd2, 0x24, 0xc1, 0x3b, 0x08, 0xc1, 0x58, 0xe6, 0x34, 0x00, 0x36, 0x14, 0x8e, 0xb5,
+	0x5e, 0xdf, 0xa8, 0xfb, 0xde, 0x45, 0xe9, 0x36, 0xf7, 0x94, 0x75, 0xef, 0xc5, 0xb1, 0xb8, 0x2a,
+	0xdd, 0x95, 0x2a, 0xc9, 0xd4, 0xd1, 0x23, 0x4d, 0x0d, 0xf6, 0xd8, 0x50, 0xe0, 0x6d, 0x74, 0x9b,
+	0x26, 0x09, 0xff, 0x31, 0x28, 0x32, 0xb5, 0x4e, 0x08, 0x25, 0x44, 0x81, 0x1c, 0x0b, 0x67, 0x41,
+	0x4f, 0x68, 0x55, 0x93, 0xaf, 0x67, 0xdc, 0xc9, 0x58, 0xe0, 0xcf, 0x10, 0xa6, 0xa1, 0x64, 0x23,
+	0x08, 0x86, 0x39, 0x84, 0x3c, 0x1d, 0xb2, 0x04, 0x84, 0xb3, 0xb8, 0x5e, 0xdf, 0x68, 0x92, 0x15,
+	0xc3, 0x1c, 0xcf, 0x08, 0xbc, 0x8d, 0xda, 0x6a, 0x6a, 0xe1, 0x80, 0x66, 0x19, 0x24, 0xc2, 0xb1,
+	0x95, 0xa3, 0xbf, 0x7c, 0x51, 0xba, 0xad, 0xbd, 0xef, 0x8e, 0x76, 0x2b, 0x33, 0x69, 0xc1, 0x28,
+	0x9d, 0x00, 0x7c, 0x84, 0x56, 0xcd, 0xac, 0x82, 0xd3, 0x22, 0x93, 0x4a, 0x5a, 0xc1, 0x29, 0x80,
+	0xd3, 0xd4, 0xeb, 0xb8, 0x5f, 0x09, 0xea, 0x76, 0xc8, 0x45, 0xca, 0x85, 0x88, 0xce, 0x36, 0x19,
+	0xef, 0xa5, 0x54, 0x0e, 0x36, 0x5f, 0x64, 0x92, 0xac, 0x98, 0xc8, 0xfd, 0x2a, 0x70, 0x1f, 0x00,
+	0x3f, 0x45, 0xf7, 0xfa, 0x54, 0x80, 0xca, 0xa1, 0xfb, 0x88, 0xc1, 0x2c, 0x91, 0x65, 0x54, 0xf2,
+	0xdc, 0x41, 0xeb, 0xb5, 0x8d, 0x25, 0xe2, 0x28, 0x97, 0x7d, 0x80, 0x5d, 0xed, 0xf0, 0x6c, 0xc6,
+	0xe3, 0xc7, 0xe8, 0x36, 0x24, 0x54, 0x48, 0x16, 0x32, 0x79, 0x1e, 0xa4, 0x45, 0x22, 0xd9, 0x30,
+	0x61, 0x90, 0x3b, 0x2d, 0x1d, 0xd8, 0x9d, 0x91, 0x47, 0x53, 0x0e, 0x7f, 0x83, 0xda, 0x29, 0xcb,
+	0x82, 0x49, 0x5d, 0xa7, 0xfd, 0x7f, 0x7a, 0x47, 0x29, 0xcb, 0x7c, 0xd3, 0xc6, 0x13, 0xeb, 0xef,
+	0x37, 0x6e, 0xed, 0xc0, 0xb2, 0x1b, 0x9d, 0x05, 0xaf, 0x87, 0x1a, 0xaf, 0xa4, 0xd2, 0x4a, 0x07,
+	0xd5, 0xcf, 0xe0, 0xdc, 0xe8, 0x92, 0xa8, 0x23, 0xee, 0xa2, 0xc6, 0x88, 0x26, 0x05, 0x54, 0x77,
+	0xc3, 0x00, 0xef, 0x00, 0x2d, 0x9f, 0xe4, 0x34, 0x13, 0x6a, 0x1d, 0x3c, 0x3b, 0xe4, 0xb1, 0xc0,
+	0x18, 0x59, 0x03, 0x2a, 0x06, 0x55, 0xac, 0x3e, 0xe3, 0x07, 0xc8, 0x4a, 0x78, 0x2c, 0x9c, 0xf9,
+	0xf5, 0xfa, 0x46, 0x6b, 0x7b, 0x79, 0x73, 0x76, 0xdb, 0x37, 0x0f, 0x79, 0x4c, 0x34, 0xe9, 0xfd,
+	0x3e, 0x8f, 0xea, 0x87, 0x3c, 0xc6, 0x0e, 0x5a, 0x54, 0xd7, 0x1a, 0x84, 0xa8, 0x72, 0x4c, 0x20,
+	0xbe, 0x83, 0x16, 0x24, 0x1f, 0xb2, 0xd0, 0x24, 0x6a, 0x92, 0x0a, 0xa9, 0x92, 0x11, 0x95, 0x54,
+	0x4b, 0xba, 0x4d, 0xf4, 0x59, 0xc9, 0xa1, 0x9f, 0xf0, 0xf0, 0x2c, 0xc8, 0x8a, 0xb4, 0x0f, 0xb9,
+	0xbe, 0x8a, 0x96, 0xbf, 0x7c, 0x59, 0xba, 0x2d, 0x6d, 0x7f, 0xa9, 0xcd, 0xe4, 0x3a, 0xc0, 0x8f,
+	0xd0, 0xa2, 0x1c, 0x07, 0xba, 0xfb, 0x86, 0x1e, 0xe3, 0xea, 0x65, 0xe9, 0x2e, 0xcb, 0xd9, 0x03,
+	0x3e, 0xa7, 0x62, 0x40, 0x16, 0xe4, 0x58, 0xfd, 0xe2, 0x1e, 0xb2, 0xe5, 0x38, 0x60, 0x59, 0x04,
+	0x63, 0x2d, 0x63, 0xcb, 0xef, 0x5e, 0x96, 0x6e, 0xe7, 0x9a, 0xfb, 0x0b, 0xc5, 0x91, 0x45, 0x39,
+	0xd6, 0x07, 0xfc, 0x08, 0x21, 0xd3, 0x92, 0xae, 0xb0, 0xa8, 0x2b, 0x2c, 0x5d, 0x96, 0x6e, 0x53,
+	0x5b, 0x75, 0xee, 0xd9, 0x11, 0x7b, 0xa8, 0x61, 0x72, 0xdb, 0x3a, 0x77, 0xfb, 0xb2, 0x74, 0xed,
+	0x84, 0xc7, 0x26, 0xa7, 0xa1, 0xd4, 0xa8, 0x72, 0x48, 0xf9, 0x08, 0x22, 0xad, 0x59, 0x9b, 0x4c,
+	0xa0, 0xf7, 0xd3, 0x3c, 0xb2, 0x4f, 0xc6, 0x04, 0x44, 0x91, 0x48, 0xbc, 0x8f, 0x3a, 0x21, 0xcf,
+	0x64, 0x4e, 0x43, 0x19, 0xdc, 0x18, 0xad, 0x7f, 0xef, 0xaa, 0x74, 0x3f, 0x31, 0x37, 0xf7, 0x43,
+	0x0f, 0x8f, 0x2c, 0x4f, 0x4c, 0x3b, 0xd5, 0xfc, 0xbb, 0xa8, 0xd1, 0x4f, 0x78, 0xf5, 0x7e, 0x6c,
+	0x13, 0x03, 0xf0, 0xa1, 0x9e, 0x9a, 0xde, 0xaf, 0x5a, 0x40, 0x6b, 0xfb, 0xde, 0xf5, 0xfd, 0x7e,
+	0x20, 0x0f, 0xff, 0x8e, 0x52, 0xe6, 0x55, 0xe9, 0xde, 0x32, 0x55, 0xab, 0x48, 0x4f, 0x4d, 0x55,
+	0xcb, 0xa7, 0x83, 0xea, 0x39, 0x48, 0xbd, 0xae, 0x36, 0x51, 0x47, 0xf5, 0x42, 0xcd, 0x61, 0x04,
+	0xb9, 0x84, 0x48, 0xaf, 0xc5, 0x26, 0x53, 0x8c, 0x3f, 0x45, 0x76, 0x4c, 0x45, 0x50, 0x08, 0x88,
+	0xcc, 0x0e, 0xc8, 0x62, 0x4c, 0xc5, 0x6b, 0x01, 0xd1, 0x13, 0xeb, 0xe7, 0x37, 0xee, 0x9c, 0x47,
+	0x51, 0x6b, 0x27, 0x0c, 0x41, 0x88, 0x93, 0x62, 0x98, 0xc0, 0x47, 0xb4, 0xb5, 0x8d, 0xda, 0x42,
+	0xf2, 0x9c, 0xc6, 0x10, 0x9c, 0xc1, 0x79, 0xa5, 0x30, 0xa3, 0x97, 0xca, 0xfe, 0x2d, 0x9c, 0x0b,
+	0x72, 0x1d, 0x54, 0x25, 0xfe, 0xa9, 0xa3, 0xd6, 0x49, 0x4e, 0x43, 0xd8, 0xe5, 0xd9, 0x29, 0x8b,
+	0xb5, 0x4a, 0x15, 0xac, 0xfe, 0x98, 0x48, 0x85, 0x54, 0x6d, 0xc9, 0x52, 0xe0, 0x85, 0xac, 0xee,
+	0xd0, 0x04, 0xaa, 0x88, 0x1c, 0x60, 0x0c, 0xa1, 0x1e, 0xa0, 0x45, 0x2a, 0x84, 0xbf, 0x40, 0x4b,
+	0x11, 0x13, 0xfa, 0xad, 0x2c, 0x24, 0x0d, 0xcf, 0xcc, 0xe3, 0xfb, 0x9d, 0xcb, 0xd2, 0x6d, 0x57,
+	0xc4, 0x2b, 0x65, 0x27, 0x37, 0x10, 0xfe, 0x0a, 0x2d, 0xcf, 0xc2, 0x74, 0xb7, 0xe6, 0x35, 0xeb,
+	0xe3, 0xcb, 0xd2, 0xbd, 0x35, 0x75, 0xd5, 0x0c, 0xf9, 0x00, 0xab, 0x1d, 0x47, 0xd0, 0x2f, 0x62,
+	0x2d, 0x3b, 0x9b, 0x18, 0xa0, 0xac, 0x09, 0x4b, 0x99, 0xd4, 0x32, 0x6b, 0x10, 0x03, 0x54, 0x7f,
+	0xd5, 0x9f, 0x46, 0x0a, 0x29, 0xcf, 0xcf, 0x9d, 0xd6, 0xac, 0x3f, 0x43, 0x1c, 0x69, 0x3b, 0xb9,
+	0x81, 0xb0, 0x8f, 0x70, 0x15, 0x96, 0x83, 0x2c, 0xf2, 0x2c, 0xd0, 0x97, 0xb7, 0xad, 0x63, 0xf5,
+	0x15, 0x32, 0x2c, 0xd1, 0xe4, 0x33, 0x2a, 0x29, 0xf9, 0x8f, 0x05, 0x7f, 0x8d, 0xb0, 0x19, 0x6b,
+	0xf0, 0x83, 0xe0, 0x59, 0x10, 0xea, 0xd1, 0x3b, 0x4b, 0x5a, 0xd4, 0xba, 0xbe, 0x61, 0xcd, 0x4a,
+	0x48, 0xc7, 0xa0, 0x03, 0xc1, 0x33, 0x63, 0x39, 0xb0, 0x6c, 0xab, 0xd3, 0x38, 0xb0, 0xec, 0xc5,
+	0x8e, 0x7d, 0x60, 0xd9, 0xa8, 0xd3, 0x9a, 0x0e, 0xa2, 0x7a, 0x16, 0xb2, 0x3a, 0xc1, 0xd7, 0x9a,
+	0xf4, 0x9f, 0xbe, 0xbd, 0x58, 0xab, 0xbd, 0xbb, 0x58, 0xab, 0xfd, 0x75, 0xb1, 0x56, 0xfb, 0xe5,
+	0xfd, 0xda, 0xdc, 0xbb, 0xf7, 0x6b, 0x73, 0x7f, 0xbc, 0x5f, 0x9b, 0xfb, 0xfe, 0xc1, 0xc7, 0x3f,
+	0x3b, 0xc6, 0xea, 0x63, 0xa7, 0xbf, 0xa0, 0xbf, 0x65, 0x1e, 0xff, 0x3b, 0x00, 0x92, 0x19, 0x54,
+	0xbc, 0x05, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -802,6 +845,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CreateFuntokenFee.Equal(that1.CreateFuntokenFee) {
 		return false
 	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.ElasticityMultiplier != that1.ElasticityMultiplier {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -824,6 +876,16 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsMadeFromCoin {
 		i--
 		if m.IsMadeFromCoin {
@@ -874,6 +936,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.CreateFuntokenFee.Size()
 		i -= size
@@ -1365,6 +1447,9 @@ func (m *FunToken) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	if m.Verified {
+		n += 2
+	}
 	return n
 }
 
@@ -1408,6 +1493,14 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.CreateFuntokenFee.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovEvm(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovEvm(uint64(m.ElasticityMultiplier))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1701,6 +1794,26 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2017,6 +2130,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -68,12 +68,18 @@ func InitGenesis(
 
 	// Create fungible token mappings
 	for _, funToken := range genState.FuntokenMappings {
+		erc20 := gethcommon.HexToAddress(funToken.Erc20Addr.String())
 		err := k.FunTokens.SafeInsert(
-			ctx, gethcommon.HexToAddress(funToken.Erc20Addr.String()), funToken.BankDenom, funToken.IsMadeFromCoin,
+			ctx, erc20, funToken.BankDenom, funToken.IsMadeFromCoin,
 		)
 		if err != nil {
 			panic(fmt.Errorf("failed creating funtoken: %w", err))
 		}
+		if funToken.Verified {
+			verified := evm.NewFunToken(erc20, funToken.BankDenom, funToken.IsMadeFromCoin)
+			verified.Verified = true
+			k.FunTokens.Insert(ctx, verified.ID(), verified)
+		}
 	}
 
 	return []abci.ValidatorUpdate{}
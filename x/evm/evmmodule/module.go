@@ -26,7 +26,7 @@ import (
 )
 
 // consensusVersion: EVM module consensus version for upgrades.
-const consensusVersion = 1
+const consensusVersion = 2
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -119,9 +119,9 @@ func (AppModule) Name() string {
 	return evm.ModuleName
 }
 
-// RegisterInvariants interface for registering invariants. Performs a no-op
-// as the evm module doesn't expose invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the evm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -129,6 +129,11 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	evm.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	evm.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(evm.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", evm.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
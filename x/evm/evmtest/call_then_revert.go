@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmtest
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	srvconfig "github.com/NibiruChain/nibiru/app/server/config"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
+)
+
+// CallThenRevertCode returns the runtime bytecode of a contract that calls
+// "target" with its own calldata and then reverts. The revert data is the
+// 32-byte success flag of the inner call. Tests use it to check that a call
+// leaves no state behind when the calling frame reverts.
+func CallThenRevertCode(target gethcommon.Address) []byte {
+	code := []byte{
+		0x36,       // CALLDATASIZE
+		0x60, 0x00, // PUSH1 0
+		0x60, 0x00, // PUSH1 0
+		0x37,       // CALLDATACOPY
+		0x60, 0x00, // PUSH1 0 (retSize)
+		0x60, 0x00, // PUSH1 0 (retOffset)
+		0x36,       // CALLDATASIZE (argsSize)
+		0x60, 0x00, // PUSH1 0 (argsOffset)
+		0x60, 0x00, // PUSH1 0 (value)
+		0x73, // PUSH20 target
+	}
+	code = append(code, target.Bytes()...)
+	return append(code,
+		0x5a,       // GAS
+		0xf1,       // CALL
+		0x60, 0x00, // PUSH1 0
+		0x52,       // MSTORE
+		0x60, 0x20, // PUSH1 32
+		0x60, 0x00, // PUSH1 0
+		0xfd, // REVERT
+	)
+}
+
+// CallThenRevert sets the code of "caller" to [CallThenRevertCode] for
+// "target" and calls it from "from" with "input". The state changes are
+// committed, so only the ones that survive the revert remain. The inner call
+// succeeded if the last byte of "Ret" in the response is 1.
+func CallThenRevert(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	from, caller, target gethcommon.Address,
+	input []byte,
+) (*evm.MsgEthereumTxResponse, error) {
+	db := statedb.New(ctx, k, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	db.SetCode(caller, CallThenRevertCode(target))
+	if err := db.Commit(); err != nil {
+		return nil, err
+	}
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := gethcore.NewMessage(
+		from,
+		&caller,
+		k.GetAccNonce(ctx, from),
+		unusedBigInt, // amount
+		srvconfig.DefaultEthCallGasLimit,
+		unusedBigInt, // gasFeeCap
+		unusedBigInt, // gasTipCap
+		unusedBigInt, // gasPrice
+		input,
+		gethcore.AccessList{},
+		false, // isFake
+	)
+	cfg, err := k.GetEVMConfig(
+		ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.EthChainID(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return k.ApplyEvmMsg(
+		ctx, evmMsg, evm.NewNoOpTracer(), true, cfg,
+		statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())),
+	)
+}
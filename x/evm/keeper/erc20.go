@@ -91,6 +91,15 @@ func (e erc20Calls) BalanceOf(
 	return e.LoadERC20BigInt(ctx, e.ABI, contract, "balanceOf", account)
 }
 
+// TotalSupply retrieves the total supply of an ERC20 token.
+// Implements "ERC20.totalSupply".
+func (e erc20Calls) TotalSupply(
+	contract gethcommon.Address,
+	ctx sdk.Context,
+) (out *big.Int, err error) {
+	return e.LoadERC20BigInt(ctx, e.ABI, contract, "totalSupply")
+}
+
 /*
 Burn implements "ERC20Burnable.burn"
 
@@ -19,6 +19,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
 )
 
 func (s *Suite) TestCreateFunTokenFromERC20() {
@@ -276,11 +277,11 @@ func (s *Suite) TestCreateFunTokenFromCoin() {
 	s.Require().ErrorContains(err, "funtoken mapping already created")
 }
 
-// TestSendFunTokenToEvm executes sending fun tokens from bank coin to erc20 and checks the results:
+// TestConvertCoinToEvm executes sending fun tokens from bank coin to erc20 and checks the results:
 // - sender balance should be reduced by sendAmount
 // - erc-20 balance should be increased by sendAmount
 // - evm module account should hold sender's coins
-func (s *Suite) TestSendFunTokenToEvm() {
+func (s *Suite) TestConvertCoinToEvm() {
 	for _, tc := range []struct {
 		name                string
 		bankDenom           string
@@ -351,9 +352,9 @@ func (s *Suite) TestSendFunTokenToEvm() {
 
 			// Send fun token to ERC-20 contract
 			bankCoin := sdk.Coin{Denom: tc.bankDenom, Amount: tc.amountToSend}
-			_, err = deps.EvmKeeper.SendFunTokenToEvm(
+			_, err = deps.EvmKeeper.ConvertCoinToEvm(
 				ctx,
-				&evm.MsgSendFunTokenToEvm{
+				&evm.MsgConvertCoinToEvm{
 					Sender:    deps.Sender.NibiruAddr.String(),
 					BankCoin:  bankCoin,
 					ToEthAddr: recipientEVMAddr,
@@ -365,11 +366,11 @@ func (s *Suite) TestSendFunTokenToEvm() {
 			}
 			s.Require().NoError(err)
 
-			// Event "EventSendFunTokenToEvm" must present
+			// Event "EventConvertCoinToEvm" must present
 			testutil.RequireContainsTypedEvent(
 				s.T(),
 				deps.Ctx,
-				&evm.EventSendFunTokenToEvm{
+				&evm.EventConvertCoinToEvm{
 					Sender:               deps.Sender.NibiruAddr.String(),
 					Erc20ContractAddress: funTokenErc20Addr.String(),
 					ToEthAddr:            recipientEVMAddr.String(),
@@ -410,6 +411,21 @@ func (s *Suite) TestSendFunTokenToEvm() {
 			s.Require().NoError(err)
 			s.Equal(1, len(res))
 			s.Equal(tc.amountToSend.BigInt(), res[0])
+
+			// Check 4: the deprecated "MsgSendFunTokenToEvm" converts the same way
+			_, err = deps.EvmKeeper.SendFunTokenToEvm(
+				ctx,
+				&evm.MsgSendFunTokenToEvm{
+					Sender:    deps.Sender.NibiruAddr.String(),
+					BankCoin:  bankCoin,
+					ToEthAddr: recipientEVMAddr,
+				},
+			)
+			s.Require().NoError(err)
+			evmtest.AssertERC20BalanceEqual(
+				s.T(), deps, funTokenErc20Addr, recipientEVMAddr.ToAddr(),
+				tc.amountToSend.MulRaw(2).BigInt(),
+			)
 		})
 	}
 }
@@ -495,3 +511,126 @@ func (s *Suite) TestERC20Calls() {
 		evmtest.AssertERC20BalanceEqual(s.T(), deps, contract, theEvm, big.NewInt(54_000))
 	}
 }
+
+// TestFunTokenRoundTrip moves the tokens of a FunToken between their bank coin
+// and ERC20 representations for both FunToken origins. The escrowed
+// representation must always back the circulating one.
+func (s *Suite) TestFunTokenRoundTrip() {
+	s.Run("made from coin", func() {
+		deps := evmtest.NewTestDeps()
+		bankDenom := "ibc/usdc"
+		funtoken := evmtest.CreateFunTokenForBankCoin(&deps, bankDenom, &s.Suite)
+		erc20Addr := funtoken.Erc20Addr.ToAddr()
+		s.Require().NoError(testapp.FundAccount(
+			deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 1_000)),
+		))
+
+		s.T().Log("coin -> ERC20: escrow coins and mint ERC20")
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(), &evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(bankDenom, 400),
+			ToEthAddr: eth.NewHexAddr(deps.Sender.EthAddr),
+		})
+		s.Require().NoError(err)
+		s.assertFunTokenSupply(&deps, funtoken, 1_000, 400, 400, 400, 600)
+
+		s.T().Log("ERC20 -> coin: burn ERC20 and release escrowed coins")
+		input, err := embeds.Contract_Funtoken.ABI.Pack(
+			string(precompile.FunTokenMethod_BankSend),
+			precompile.ArgsFunTokenBankSend(erc20Addr, big.NewInt(150), deps.Sender.NibiruAddr)...,
+		)
+		s.Require().NoError(err)
+		_, err = evmtest.DoEthTx(&deps, precompile.PrecompileAddr_FuntokenGateway.ToAddr(), deps.Sender.EthAddr, input)
+		s.Require().NoError(err)
+		s.assertFunTokenSupply(&deps, funtoken, 1_000, 250, 250, 250, 750)
+	})
+
+	s.Run("made from ERC20", func() {
+		deps := evmtest.NewTestDeps()
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20Addr := deployResp.ContractAddr
+		input, err := embeds.Contract_ERC20Minter.ABI.Pack("mint", deps.Sender.EthAddr, big.NewInt(1_000))
+		s.Require().NoError(err)
+		_, err = evmtest.DoEthTx(&deps, erc20Addr, deps.Sender.EthAddr, input)
+		s.Require().NoError(err)
+
+		s.Require().NoError(testapp.FundAccount(
+			deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+		))
+		erc20HexAddr := eth.NewHexAddr(erc20Addr)
+		createResp, err := deps.EvmKeeper.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+			FromErc20: &erc20HexAddr,
+			Sender:    deps.Sender.NibiruAddr.String(),
+		})
+		s.Require().NoError(err)
+		funtoken := createResp.FuntokenMapping
+
+		s.T().Log("ERC20 -> coin: escrow ERC20 and mint coins")
+		input, err = embeds.Contract_Funtoken.ABI.Pack(
+			string(precompile.FunTokenMethod_BankSend),
+			precompile.ArgsFunTokenBankSend(erc20Addr, big.NewInt(400), deps.Sender.NibiruAddr)...,
+		)
+		s.Require().NoError(err)
+		_, err = evmtest.DoEthTx(&deps, precompile.PrecompileAddr_FuntokenGateway.ToAddr(), deps.Sender.EthAddr, input)
+		s.Require().NoError(err)
+		s.assertFunTokenSupply(&deps, funtoken, 400, 1_000, 400, 600, 400)
+
+		s.T().Log("coin -> ERC20: burn coins and release escrowed ERC20")
+		_, err = deps.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(), &evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 150),
+			ToEthAddr: eth.NewHexAddr(deps.Sender.EthAddr),
+		})
+		s.Require().NoError(err)
+		s.assertFunTokenSupply(&deps, funtoken, 250, 1_000, 250, 750, 250)
+
+		s.T().Log("sad: cannot convert more coins than were minted")
+		_, err = deps.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(), &evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 251),
+			ToEthAddr: eth.NewHexAddr(deps.Sender.EthAddr),
+		})
+		s.Require().ErrorContains(err, "insufficient funds")
+		s.assertFunTokenSupply(&deps, funtoken, 250, 1_000, 250, 750, 250)
+	})
+}
+
+// assertFunTokenSupply checks the bank supply, the ERC20 total supply, the
+// amount escrowed by the EVM module in the representation that did not
+// originate the FunToken, and the balances of "TestDeps.Sender".
+func (s *Suite) assertFunTokenSupply(
+	deps *evmtest.TestDeps,
+	funtoken evm.FunToken,
+	bankSupply, erc20Supply, escrowed, senderERC20, senderCoins int64,
+) {
+	erc20Addr := funtoken.Erc20Addr.ToAddr()
+	evmModuleAddr := eth.EthAddrToNibiruAddr(evm.ModuleAddressEVM())
+
+	s.Equal(bankSupply,
+		deps.Chain.BankKeeper.GetSupply(deps.Ctx, funtoken.BankDenom).Amount.Int64(), "bank supply")
+	gotErc20Supply, err := deps.EvmKeeper.LoadERC20BigInt(
+		deps.Ctx, embeds.Contract_ERC20Minter.ABI, erc20Addr, "totalSupply",
+	)
+	s.Require().NoError(err)
+	s.Equal(erc20Supply, gotErc20Supply.Int64(), "ERC20 total supply")
+
+	if funtoken.IsMadeFromCoin {
+		s.Equal(escrowed,
+			deps.Chain.BankKeeper.GetBalance(deps.Ctx, evmModuleAddr, funtoken.BankDenom).Amount.Int64(),
+			"escrowed coins")
+		s.Equal(gotErc20Supply.Int64(), escrowed, "ERC20 supply must equal the escrowed coins")
+	} else {
+		evmtest.AssertERC20BalanceEqual(s.T(), *deps, erc20Addr, evm.ModuleAddressEVM(), big.NewInt(escrowed))
+		s.Equal(bankSupply, escrowed, "bank supply must equal the escrowed ERC20")
+	}
+
+	evmtest.AssertERC20BalanceEqual(s.T(), *deps, erc20Addr, deps.Sender.EthAddr, big.NewInt(senderERC20))
+	s.Equal(senderCoins,
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, funtoken.BankDenom).Amount.Int64(),
+		"sender coins")
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"slices"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkstore "github.com/cosmos/cosmos-sdk/store/types"
@@ -39,8 +40,19 @@ type EvmState struct {
 		[]byte,
 	]
 
+	// BaseFee: EIP-1559 base fee of the current block in units of "evm_denom"
+	// per unit gas. Set at the start of each block.
+	BaseFee collections.Item[sdkmath.Int]
+	// ParentBlockGasUsed: Gas used by Ethereum txs in the previous block. Used
+	// to compute the base fee at the start of each block.
+	ParentBlockGasUsed collections.Item[uint64]
+
 	// BlockGasUsed: Gas used by Ethereum txs in the block (transient).
 	BlockGasUsed collections.ItemTransient[uint64]
+	// BlockGasUsedTotal: Gas used by all Ethereum txs in the block (transient).
+	// Unlike "BlockGasUsed", which resets for each Cosmos tx, this value
+	// accumulates over the entire block and drives the base fee.
+	BlockGasUsedTotal collections.ItemTransient[uint64]
 	// BlockLogSize: EVM tx log size for the block (transient).
 	BlockLogSize collections.ItemTransient[uint64]
 	// BlockTxIndex: EVM tx index for the block (transient).
@@ -71,11 +83,24 @@ func NewEvmState(
 			collections.PairKeyEncoder(eth.KeyEncoderEthAddr, eth.KeyEncoderEthHash),
 			eth.ValueEncoderBytes,
 		),
+		BaseFee: collections.NewItem(
+			storeKey, evm.KeyPrefixBaseFee,
+			collections.IntValueEncoder,
+		),
+		ParentBlockGasUsed: collections.NewItem(
+			storeKey, evm.KeyPrefixParentBlockGasUsed,
+			collections.Uint64ValueEncoder,
+		),
 		BlockGasUsed: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockGasUsed,
 			collections.Uint64ValueEncoder,
 		),
+		BlockGasUsedTotal: collections.NewItemTransient(
+			storeKeyTransient,
+			evm.NamespaceBlockGasUsedTotal,
+			collections.Uint64ValueEncoder,
+		),
 		BlockLogSize: collections.NewItemTransient(
 			storeKeyTransient,
 			evm.NamespaceBlockLogSize,
@@ -14,10 +14,13 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
 
@@ -415,10 +418,11 @@ func (k Keeper) EstimateGasForEvmCallType(
 			msg.IsFake(),
 		)
 
-		tmpCtx := ctx
+		// Each attempt runs on a fresh cache, since precompiles write to the
+		// sdk.Context directly. Otherwise, the state changes of one attempt
+		// would lower the gas used by the next.
+		tmpCtx, _ := ctx.CacheContext()
 		if fromType == evm.CallTypeRPC {
-			tmpCtx, _ = ctx.CacheContext()
-
 			acct := k.GetAccount(tmpCtx, msg.From())
 
 			from := msg.From()
@@ -768,3 +772,58 @@ func (k Keeper) FunTokenMapping(
 
 	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
 }
+
+// VerifiedFunTokens: Implements the gRPC query for
+// "/eth.evm.v1.Query/VerifiedFunTokens". Lists the FunToken mappings with the
+// "verified" flag, one page at a time.
+func (k Keeper) VerifiedFunTokens(
+	goCtx context.Context, req *evm.QueryVerifiedFunTokensRequest,
+) (*evm.QueryVerifiedFunTokensResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, "empty request")
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funTokens := []evm.FunToken{}
+	funTokenStore := prefix.NewStore(ctx.KVStore(k.storeKey), evm.KeyPrefixFunTokens.Prefix())
+	pageRes, err := sdkquery.FilteredPaginate(
+		funTokenStore, pageReq,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var funToken evm.FunToken
+			if err := k.cdc.Unmarshal(value, &funToken); err != nil {
+				return false, err
+			}
+			if !funToken.Verified {
+				return false, nil
+			}
+			if accumulate {
+				funTokens = append(funTokens, funToken)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.QueryVerifiedFunTokensResponse{
+		FunTokens:  funTokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FunTokenSupplyDiscrepancies implements the Query/FunTokenSupplyDiscrepancies
+// gRPC method
+func (k Keeper) FunTokenSupplyDiscrepancies(
+	goCtx context.Context, _ *evm.QueryFunTokenSupplyDiscrepanciesRequest,
+) (*evm.QueryFunTokenSupplyDiscrepanciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reports, broken := k.FunTokenSupplyReports(ctx)
+	return &evm.QueryFunTokenSupplyDiscrepanciesResponse{
+		Reports: reports,
+		Broken:  broken,
+	}, nil
+}
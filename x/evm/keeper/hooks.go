@@ -5,18 +5,26 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the EIP-1559 base fee for the block based on the gas used by
+// the parent block.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	// TODO: feat(evm): impl BeginBlock
-	// Is it necessary to set a local variable, or can we use ctx everywhere?
+	baseFee := k.UpdateBaseFee(ctx)
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventBlockBaseFee{
+		BaseFee: baseFee.String(),
+	})
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock commits the gas used by Ethereum txs in the block from the transient
+// store to the KVStore so that the next block can derive its base fee. The EVM
+// end block logic doesn't update the validator set, thus it returns an empty
+// slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	// TODO: Do we care about bloom here?
+	k.EvmState.ParentBlockGasUsed.Set(
+		ctx, k.EvmState.BlockGasUsedTotal.GetOr(ctx, 0),
+	)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+)
+
+func (s *Suite) TestBaseFeeBlockHooks() {
+	deps := evmtest.NewTestDeps()
+	k := deps.EvmKeeper
+	ctx := deps.Ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 20_000_000},
+	})
+
+	params := k.GetParams(ctx)
+	params.MinBaseFee = math.NewInt(1_000)
+	k.SetParams(ctx, params)
+
+	s.T().Log("base fee defaults to the min base fee before the first block")
+	s.Equal(big.NewInt(1_000), k.GetBaseFee(ctx))
+
+	s.T().Log("full block raises the base fee by 1/8")
+	_, err := k.AddToBlockGasUsed(ctx, 20_000_000)
+	s.Require().NoError(err)
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	k.BeginBlock(ctx, abci.RequestBeginBlock{})
+	s.Equal(big.NewInt(1_125), k.GetBaseFee(ctx))
+
+	s.T().Log("base fee is queryable")
+	resp, err := k.BaseFee(ctx, &evm.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Equal("1125", resp.BaseFee.String())
+
+	s.T().Log("empty block lowers the base fee, floored at the min base fee")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.EvmState.BlockGasUsedTotal.Set(ctx, 0)
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	k.BeginBlock(ctx, abci.RequestBeginBlock{})
+	s.Equal(big.NewInt(1_000), k.GetBaseFee(ctx))
+
+	s.T().Log("disabling the dynamic base fee pins it to the min base fee")
+	_, err = k.AddToBlockGasUsed(ctx, 20_000_000)
+	s.Require().NoError(err)
+	params.BaseFeeChangeDenominator = 0
+	params.MinBaseFee = math.NewInt(7)
+	k.SetParams(ctx, params)
+	k.EndBlock(ctx, abci.RequestEndBlock{})
+	k.BeginBlock(ctx, abci.RequestBeginBlock{})
+	s.Equal(big.NewInt(7), k.GetBaseFee(ctx))
+}
+
+func (s *Suite) TestMigrate1to2() {
+	deps := evmtest.NewTestDeps()
+	k := deps.EvmKeeper
+
+	s.T().Log("params from consensus version 1 have no EIP-1559 fields")
+	params := k.GetParams(deps.Ctx)
+	params.BaseFeeChangeDenominator = 0
+	params.ElasticityMultiplier = 0
+	params.MinBaseFee = math.Int{}
+	k.EvmState.ModuleParams.Set(deps.Ctx, params)
+	s.Equal(big.NewInt(0), k.GetBaseFee(deps.Ctx))
+
+	s.Require().NoError(keeper.NewMigrator(&deps.Chain.EvmKeeper).Migrate1to2(deps.Ctx))
+
+	params = k.GetParams(deps.Ctx)
+	defaults := evm.DefaultParams()
+	s.Equal(defaults.BaseFeeChangeDenominator, params.BaseFeeChangeDenominator)
+	s.Equal(defaults.ElasticityMultiplier, params.ElasticityMultiplier)
+	s.Equal(defaults.MinBaseFee, params.MinBaseFee)
+	s.Equal(defaults.MinBaseFee.BigInt(), k.GetBaseFee(deps.Ctx))
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// RegisterInvariants registers the x/evm invariants with the crisis module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(evm.ModuleName, "funtoken-supply", FunTokenSupplyInvariant(k))
+}
+
+// FunTokenSupplyInvariant checks that the escrow held by the EVM module for
+// every FunToken made from a bank coin covers the ERC20 supply circulating in
+// the EVM. Messages like "MsgConvertCoinToEvm" and the "bankSend" method of the
+// FunToken precompile move value between the bank and ERC20 representations,
+// so a bug in either path shows up as an under-collateralized FunToken.
+//
+// FunTokens made from an ERC20 are left out: anyone can register any ERC20,
+// and a malicious, rebasing, or fee-on-transfer contract can report a
+// shortfall at will. Their discrepancies only show up in the
+// "FunTokenSupplyDiscrepancies" query.
+func FunTokenSupplyInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reports, _ := k.FunTokenSupplyReports(ctx)
+		var msg strings.Builder
+		broken := false
+		for _, report := range reports {
+			if !report.FunToken.IsMadeFromCoin || !report.IsBroken() {
+				continue
+			}
+			broken = true
+			msg.WriteString(fmt.Sprintf(
+				"\tfuntoken %s (%s): escrowed %s, circulating %s, discrepancy %s\n",
+				report.FunToken.BankDenom, report.FunToken.Erc20Addr,
+				report.Escrowed, report.Circulating, report.Discrepancy,
+			))
+		}
+		return sdk.FormatInvariant(
+			evm.ModuleName, "funtoken-supply",
+			fmt.Sprintf("FunTokens with circulating supply not backed by escrow:\n%s", msg.String()),
+		), broken
+	}
+}
+
+// FunTokenSupplyReports compares the escrow held by the EVM module against the
+// circulating supply for every FunToken mapping. The returned boolean is true
+// if any of the reports is broken.
+//
+//   - FunToken made from a bank coin: the coins are escrowed in the EVM module
+//     account and back the ERC20 total supply.
+//   - FunToken made from an ERC20: the ERC20 tokens are escrowed in the EVM
+//     module account and back the bank supply.
+//
+// The escrow may exceed the circulating supply, for instance when tokens are
+// burned or sent directly to the EVM module, so only a shortfall is broken.
+// Failing to read the ERC20 state, which happens when there is no block
+// proposer to run the EVM like during InitChain, is recorded in the report
+// instead of halting the chain.
+func (k Keeper) FunTokenSupplyReports(
+	ctx sdk.Context,
+) (reports []evm.FunTokenSupplyReport, broken bool) {
+	reports = []evm.FunTokenSupplyReport{}
+	for _, funToken := range k.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+		report := k.funTokenSupplyReport(ctx, funToken)
+		broken = broken || report.IsBroken()
+		reports = append(reports, report)
+	}
+	return reports, broken
+}
+
+func (k Keeper) funTokenSupplyReport(
+	ctx sdk.Context, funToken evm.FunToken,
+) (report evm.FunTokenSupplyReport) {
+	defer func() {
+		if r := recover(); r != nil {
+			report.Error = fmt.Sprintf("panic while reading ERC20 state: %v", r)
+			report.Discrepancy = math.ZeroInt()
+		}
+	}()
+
+	report = evm.FunTokenSupplyReport{
+		FunToken:         funToken,
+		BankSupply:       k.bankKeeper.GetSupply(ctx, funToken.BankDenom).Amount,
+		Erc20TotalSupply: math.ZeroInt(),
+		Escrowed:         math.ZeroInt(),
+		Circulating:      math.ZeroInt(),
+		Discrepancy:      math.ZeroInt(),
+	}
+
+	// The reads are made on a branch of the state so that the EVM calls can't
+	// leave anything behind in an invariant check or a query.
+	tmpCtx, _ := ctx.CacheContext()
+	erc20Addr := funToken.Erc20Addr.ToAddr()
+	totalSupply, err := k.ERC20().TotalSupply(erc20Addr, tmpCtx)
+	if err != nil {
+		report.Error = fmt.Sprintf("failed to query ERC20 total supply: %s", err)
+		return report
+	}
+	report.Erc20TotalSupply = math.NewIntFromBigInt(totalSupply)
+
+	if funToken.IsMadeFromCoin {
+		report.Escrowed = k.bankKeeper.GetBalance(
+			ctx, k.accountKeeper.GetModuleAddress(evm.ModuleName), funToken.BankDenom,
+		).Amount
+		report.Circulating = report.Erc20TotalSupply
+	} else {
+		escrowed, err := k.ERC20().BalanceOf(erc20Addr, evm.ModuleAddressEVM(), tmpCtx)
+		if err != nil {
+			report.Error = fmt.Sprintf("failed to query ERC20 escrow balance: %s", err)
+			return report
+		}
+		report.Escrowed = math.NewIntFromBigInt(escrowed)
+		report.Circulating = report.BankSupply
+	}
+	report.Discrepancy = report.Escrowed.Sub(report.Circulating)
+	return report
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/keeper"
+)
+
+func (s *Suite) TestFunTokenSupplyInvariant() {
+	deps := evmtest.NewTestDeps()
+	invariant := keeper.FunTokenSupplyInvariant(&deps.EvmKeeper)
+
+	s.T().Log("happy: no FunTokens")
+	_, broken := invariant(deps.Ctx)
+	s.False(broken)
+
+	s.T().Log("Setup: FunToken made from a bank coin, converted to the EVM")
+	coinFunToken := evmtest.CreateFunTokenForBankCoin(&deps, "unibi", &s.Suite)
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 1000)),
+	))
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(), &evm.MsgConvertCoinToEvm{
+		Sender:    deps.Sender.NibiruAddr.String(),
+		BankCoin:  sdk.NewInt64Coin("unibi", 400),
+		ToEthAddr: eth.NewHexAddr(deps.Sender.EthAddr),
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("Setup: FunToken made from an ERC20, converted to bank coins")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	erc20Addr := eth.NewHexAddr(deployResp.ContractAddr)
+	createResp, err := deps.EvmKeeper.CreateFunToken(deps.GoCtx(), &evm.MsgCreateFunToken{
+		FromErc20: &erc20Addr,
+		Sender:    deps.Sender.NibiruAddr.String(),
+	})
+	s.Require().NoError(err)
+	erc20FunToken := createResp.FuntokenMapping
+	_, err = deps.EvmKeeper.ERC20().Mint(
+		erc20Addr.ToAddr(), deps.Sender.EthAddr, evm.ModuleAddressEVM(), big.NewInt(300), deps.Ctx,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(deps.Chain.BankKeeper.MintCoins(
+		deps.Ctx, evm.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(erc20FunToken.BankDenom, 300)),
+	))
+
+	s.T().Log("happy: every FunToken is fully backed")
+	_, broken = invariant(deps.Ctx)
+	s.False(broken)
+	resp, err := deps.EvmKeeper.FunTokenSupplyDiscrepancies(
+		deps.GoCtx(), &evm.QueryFunTokenSupplyDiscrepanciesRequest{},
+	)
+	s.Require().NoError(err)
+	s.False(resp.Broken)
+	s.Require().Len(resp.Reports, 2)
+	for _, report := range resp.Reports {
+		s.Empty(report.Error)
+		s.True(report.Discrepancy.IsZero(), report.String())
+		switch report.FunToken.BankDenom {
+		case coinFunToken.BankDenom:
+			s.Equal(math.NewInt(400), report.Escrowed)
+			s.Equal(math.NewInt(400), report.Circulating)
+			s.Equal(math.NewInt(400), report.Erc20TotalSupply)
+		case erc20FunToken.BankDenom:
+			s.Equal(math.NewInt(300), report.Escrowed)
+			s.Equal(math.NewInt(300), report.Circulating)
+			s.Equal(math.NewInt(300), report.BankSupply)
+		default:
+			s.Failf("unexpected FunToken", "%s", report.FunToken.BankDenom)
+		}
+	}
+
+	s.T().Log("happy: escrow in excess of the circulating supply")
+	s.Require().NoError(testapp.FundModuleAccount(
+		deps.Chain.BankKeeper, deps.Ctx, evm.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 50)),
+	))
+	_, broken = invariant(deps.Ctx)
+	s.False(broken)
+
+	s.T().Log("happy: ERC20 state can't be read without a block proposer")
+	noProposerCtx := deps.Ctx.WithBlockHeader(tmproto.Header{})
+	reports, broken := deps.EvmKeeper.FunTokenSupplyReports(noProposerCtx)
+	s.False(broken)
+	for _, report := range reports {
+		s.Contains(report.Error, "failed to query ERC20 total supply")
+	}
+
+	s.T().Log("happy: ERC20-origin shortfalls are only reported by the query")
+	s.Require().NoError(deps.Chain.BankKeeper.MintCoins(
+		deps.Ctx, evm.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(erc20FunToken.BankDenom, 10)),
+	))
+	_, broken = invariant(deps.Ctx)
+	s.False(broken)
+
+	s.T().Log("sad: ERC20 minted without escrowing coins")
+	_, err = deps.EvmKeeper.ERC20().Mint(
+		coinFunToken.Erc20Addr.ToAddr(), evm.ModuleAddressEVM(), deps.Sender.EthAddr,
+		big.NewInt(100), deps.Ctx,
+	)
+	s.Require().NoError(err)
+	msg, broken := invariant(deps.Ctx)
+	s.True(broken)
+	s.Contains(msg, coinFunToken.BankDenom)
+	s.NotContains(msg, erc20FunToken.BankDenom)
+
+	resp, err = deps.EvmKeeper.FunTokenSupplyDiscrepancies(
+		deps.GoCtx(), &evm.QueryFunTokenSupplyDiscrepanciesRequest{},
+	)
+	s.Require().NoError(err)
+	s.True(resp.Broken)
+	for _, report := range resp.Reports {
+		s.True(report.IsBroken())
+		switch report.FunToken.BankDenom {
+		case coinFunToken.BankDenom:
+			s.Equal(math.NewInt(-50), report.Discrepancy)
+		case erc20FunToken.BankDenom:
+			s.Equal(math.NewInt(-10), report.Discrepancy)
+		}
+	}
+}
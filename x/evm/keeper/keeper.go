@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
@@ -18,6 +19,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/NibiruChain/nibiru/app/appconst"
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/evm"
 )
 
@@ -42,6 +44,11 @@ type Keeper struct {
 	bankKeeper    evm.BankKeeper
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
+	sudoKeeper    evm.SudoKeeper
+
+	// hooks: Run after each successful Ethereum transaction. Set with
+	// [Keeper.SetHooks].
+	hooks evm.EvmHooks
 
 	// Integer for the Ethereum EIP155 Chain ID
 	// eip155ChainIDInt *big.Int
@@ -61,6 +68,7 @@ func NewKeeper(
 	accKeeper evm.AccountKeeper,
 	bankKeeper evm.BankKeeper,
 	stakingKeeper evm.StakingKeeper,
+	sudoKeeper evm.SudoKeeper,
 	tracer string,
 ) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -76,10 +84,40 @@ func NewKeeper(
 		accountKeeper: accKeeper,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		sudoKeeper:    sudoKeeper,
 		tracer:        tracer,
 	}
 }
 
+// SetHooks sets the hooks that run after each successful Ethereum
+// transaction. It panics if the hooks are already set.
+func (k *Keeper) SetHooks(hooks evm.EvmHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set evm hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// postTxProcessing runs the hooks on a branch of the state. The hooks are
+// best-effort: if they fail, their state changes are discarded and the failure
+// is logged and emitted as an event, but the transaction still succeeds.
+func (k *Keeper) postTxProcessing(
+	ctx sdk.Context, msg core.Message, receipt *gethcore.Receipt,
+) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.hooks.PostTxProcessing(cacheCtx, msg, receipt); err != nil {
+		k.Logger(ctx).Error("failed to execute post transaction processing hooks",
+			"tx_hash", receipt.TxHash.Hex(), "error", err)
+		_ = ctx.EventManager().EmitTypedEvent(&evm.EventPostTxProcessingFailed{
+			TxHash: receipt.TxHash.Hex(),
+			Error:  err.Error(),
+		})
+		return
+	}
+	writeCache()
+}
+
 // GetEvmGasBalance: Implements `evm.EVMKeeper` from
 // "github.com/NibiruChain/nibiru/app/ante/evm": Load account's balance of gas
 // tokens for EVM execution in EVM denom units.
@@ -108,6 +146,12 @@ func (k *Keeper) AddToBlockGasUsed(
 		return 0, sdkerrors.Wrap(evm.ErrGasOverflow, "transient gas used")
 	}
 	k.EvmState.BlockGasUsed.Set(ctx, result)
+
+	blockTotal := k.EvmState.BlockGasUsedTotal.GetOr(ctx, 0) + gasUsed
+	if blockTotal < gasUsed {
+		return 0, sdkerrors.Wrap(evm.ErrGasOverflow, "transient block gas used")
+	}
+	k.EvmState.BlockGasUsedTotal.Set(ctx, blockTotal)
 	return result, nil
 }
 
@@ -116,9 +160,34 @@ func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) math.LegacyDec {
 	return math.LegacyNewDecWithPrec(50, 2) // 50%
 }
 
+// GetBaseFee returns the EIP-1559 base fee of the current block in units of
+// "evm_denom" per unit gas. If the dynamic base fee is disabled or the base fee
+// has not been set yet, the minimum base fee from the module params is used.
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
-	// TODO: plug in fee market keeper
-	return big.NewInt(1)
+	params := k.GetParams(ctx)
+	if !params.IsDynamicBaseFee() {
+		return params.MinBaseFeeBigInt()
+	}
+	baseFee, err := k.EvmState.BaseFee.Get(ctx)
+	if err != nil {
+		return params.MinBaseFeeBigInt()
+	}
+	return baseFee.BigInt()
+}
+
+// UpdateBaseFee computes the EIP-1559 base fee for the current block from the
+// base fee and gas used of the parent block, then stores it. Called at the
+// start of every block.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context) *big.Int {
+	params := k.GetParams(ctx)
+	baseFee := evm.CalcBaseFee(
+		params,
+		k.GetBaseFee(ctx),
+		k.EvmState.ParentBlockGasUsed.GetOr(ctx, 0),
+		eth.BlockGasLimit(ctx),
+	)
+	k.EvmState.BaseFee.Set(ctx, math.NewIntFromBigInt(baseFee))
+	return baseFee
 }
 
 // Logger returns a module-specific logger.
@@ -0,0 +1,38 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the EIP-1559 params "base_fee_change_denominator",
+// "elasticity_multiplier", and "min_base_fee", which did not exist in
+// consensus version 1. Without them, the base fee would decode as zero.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.EvmState.ModuleParams.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaults := evm.DefaultParams()
+	params.BaseFeeChangeDenominator = defaults.BaseFeeChangeDenominator
+	params.ElasticityMultiplier = defaults.ElasticityMultiplier
+	params.MinBaseFee = defaults.MinBaseFee
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	m.keeper.EvmState.BaseFee.Set(ctx, params.MinBaseFee)
+	return nil
+}
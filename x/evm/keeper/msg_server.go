@@ -185,6 +185,10 @@ func (k *Keeper) ApplyEvmTx(
 		return nil, errors.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	if !res.Failed() && k.hooks != nil {
+		k.postTxProcessing(ctx, msg, receipt)
+	}
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.EvmState.BlockBloom.Set(ctx, receipt.Bloom.Bytes())
@@ -375,8 +379,7 @@ func (k *Keeper) ApplyEvmMsg(ctx sdk.Context,
 	stateDB := statedb.New(ctx, k, txConfig)
 	evmObj := k.NewEVM(ctx, msg, evmConfig, tracer, stateDB)
 
-	numPrecompiles := len(k.precompiles)
-	precompileAddrs := make([]gethcommon.Address, numPrecompiles)
+	precompileAddrs := evmConfig.Params.GetActivePrecompilesAddrs()
 
 	// Check if the transaction is sent to an inactive precompile
 	//
@@ -583,55 +586,118 @@ func (k Keeper) FeeForCreateFunToken(ctx sdk.Context) sdk.Coins {
 	return sdk.NewCoins(sdk.NewCoin(evmParams.EvmDenom, evmParams.CreateFuntokenFee))
 }
 
-// SendFunTokenToEvm Sends a coin with a valid "FunToken" mapping to the
+// ConvertCoinToEvm Sends a coin with a valid "FunToken" mapping to the
 // given recipient address ("to_eth_addr") in the corresponding ERC20
 // representation.
-func (k *Keeper) SendFunTokenToEvm(
-	goCtx context.Context, msg *evm.MsgSendFunTokenToEvm,
-) (resp *evm.MsgSendFunTokenToEvmResponse, err error) {
+func (k *Keeper) ConvertCoinToEvm(
+	goCtx context.Context, msg *evm.MsgConvertCoinToEvm,
+) (resp *evm.MsgConvertCoinToEvmResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
-	toEthAddr := msg.ToEthAddr.ToAddr()
 	bankDenom := msg.BankCoin.Denom
-	amount := msg.BankCoin.Amount
 
 	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom))
 	if len(funTokens) == 0 {
 		return nil, fmt.Errorf("funtoken for bank denom \"%s\" does not exist", bankDenom)
 	}
-	erc20ContractAddr := funTokens[0].Erc20Addr.ToAddr()
+	funToken := funTokens[0]
+
+	if funToken.IsMadeFromCoin {
+		err = k.convertCoinToEvmBornCoin(ctx, sender, msg.ToEthAddr.ToAddr(), funToken, msg.BankCoin)
+	} else {
+		err = k.convertCoinToEvmBornERC20(ctx, sender, msg.ToEthAddr.ToAddr(), funToken, msg.BankCoin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertCoinToEvm{
+		Sender:               msg.Sender,
+		Erc20ContractAddress: funToken.Erc20Addr.String(),
+		ToEthAddr:            msg.ToEthAddr.String(),
+		BankCoin:             msg.BankCoin,
+	})
+
+	return &evm.MsgConvertCoinToEvmResponse{}, nil
+}
+
+// SendFunTokenToEvm: Deprecated alias of [Keeper.ConvertCoinToEvm] for the
+// "MsgSendFunTokenToEvm" type URL.
+func (k *Keeper) SendFunTokenToEvm(
+	goCtx context.Context, msg *evm.MsgSendFunTokenToEvm,
+) (resp *evm.MsgSendFunTokenToEvmResponse, err error) {
+	if _, err = k.ConvertCoinToEvm(goCtx, msg.ToMsgConvertCoinToEvm()); err != nil {
+		return nil, err
+	}
+	return &evm.MsgSendFunTokenToEvmResponse{}, nil
+}
+
+// convertCoinToEvmBornCoin: Converts a coin of a FunToken made from a bank
+// coin. The coin is escrowed in the EVM module account, and the EVM module,
+// which owns the ERC20 contract, mints the ERC20 tokens to the recipient.
+func (k Keeper) convertCoinToEvmBornCoin(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	recipient gethcommon.Address,
+	funToken evm.FunToken,
+	coin sdk.Coin,
+) error {
+	erc20Addr := funToken.Erc20Addr.ToAddr()
 
 	// Step 1: Send coins to the evm module account
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, evm.ModuleName, sdk.Coins{msg.BankCoin})
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, evm.ModuleName, sdk.Coins{coin})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to send coins to module account")
+		return errors.Wrap(err, "failed to send coins to module account")
 	}
 
-	// Step 2: evm call to erc20 minter: mint tokens for a toEthAddr
+	// Step 2: evm call to erc20 minter: mint tokens for the recipient
 	evmResp, err := k.CallContract(
 		ctx,
 		embeds.Contract_ERC20Minter.ABI,
 		evm.ModuleAddressEVM(),
-		&erc20ContractAddr,
+		&erc20Addr,
 		true,
 		"mint",
-		toEthAddr,
-		amount.BigInt(),
+		recipient,
+		coin.Amount.BigInt(),
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if evmResp.Failed() {
-		return nil,
-			fmt.Errorf("failed to mint erc-20 tokens of contract %s", erc20ContractAddr.String())
+		return fmt.Errorf("failed to mint erc-20 tokens of contract %s", erc20Addr.String())
 	}
-	_ = ctx.EventManager().EmitTypedEvent(&evm.EventSendFunTokenToEvm{
-		Sender:               msg.Sender,
-		Erc20ContractAddress: erc20ContractAddr.String(),
-		ToEthAddr:            toEthAddr.String(),
-		BankCoin:             msg.BankCoin,
-	})
+	return nil
+}
 
-	return &evm.MsgSendFunTokenToEvmResponse{}, nil
+// convertCoinToEvmBornERC20: Converts a coin of a FunToken made from an
+// ERC20. These coins were minted when ERC20 tokens were escrowed in the EVM
+// module account, so the coin is burned and the escrowed ERC20 tokens are
+// released to the recipient.
+func (k Keeper) convertCoinToEvmBornERC20(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	recipient gethcommon.Address,
+	funToken evm.FunToken,
+	coin sdk.Coin,
+) error {
+	erc20Addr := funToken.Erc20Addr.ToAddr()
+
+	// Step 1: Burn the coins
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, evm.ModuleName, sdk.Coins{coin})
+	if err != nil {
+		return errors.Wrap(err, "failed to send coins to module account")
+	}
+	if err = k.bankKeeper.BurnCoins(ctx, evm.ModuleName, sdk.Coins{coin}); err != nil {
+		return errors.Wrap(err, "failed to burn coins")
+	}
+
+	// Step 2: Release the escrowed ERC20 tokens to the recipient
+	_, err = k.ERC20().Transfer(erc20Addr, evm.ModuleAddressEVM(), recipient, coin.Amount.BigInt(), ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release escrowed erc-20 tokens of contract %s: %w",
+			erc20Addr.String(), err)
+	}
+	return nil
 }
@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/evm"
+)
+
+// UpdateFunTokenMetadata sets the "verified" flag of a FunToken mapping and
+// optionally overrides the bank metadata of a FunToken created from an ERC20.
+// The metadata derived from an ERC20 contract comes from arbitrary contract
+// code, so only governance or the x/sudo module can vouch for it.
+func (k *Keeper) UpdateFunTokenMetadata(
+	goCtx context.Context, msg *evm.MsgUpdateFunTokenMetadata,
+) (resp *evm.MsgUpdateFunTokenMetadataResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if !sender.Equals(k.authority) {
+		if err := k.sudoKeeper.CheckMsgPermissions(sender, ctx, sdk.MsgTypeURL(msg)); err != nil {
+			return nil, errors.Wrapf(govtypes.ErrInvalidSigner,
+				"sender must be the module authority %s or a sudoer: %s", k.authority, err)
+		}
+	}
+
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, msg.BankDenom))
+	if len(funTokens) == 0 {
+		return nil, fmt.Errorf("funtoken for bank denom \"%s\" does not exist", msg.BankDenom)
+	}
+	funToken := funTokens[0]
+
+	if msg.Metadata != nil {
+		if funToken.IsMadeFromCoin {
+			return nil, fmt.Errorf(
+				"cannot override the bank metadata of \"%s\": the FunToken was made from a bank coin", msg.BankDenom)
+		}
+		if msg.Metadata.Base != funToken.BankDenom {
+			return nil, fmt.Errorf("metadata base denom \"%s\" must equal the FunToken bank denom \"%s\"",
+				msg.Metadata.Base, funToken.BankDenom)
+		}
+		if err := msg.Metadata.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid bank metadata")
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, *msg.Metadata)
+	}
+
+	funToken.Verified = msg.Verified
+	k.FunTokens.Insert(ctx, funToken.ID(), funToken)
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenMetadataUpdated{
+		BankDenom:            funToken.BankDenom,
+		Erc20ContractAddress: funToken.Erc20Addr.String(),
+		Verified:             funToken.Verified,
+		MetadataUpdated:      msg.Metadata != nil,
+		Sender:               msg.Sender,
+	})
+
+	return &evm.MsgUpdateFunTokenMetadataResponse{FuntokenMapping: funToken}, nil
+}
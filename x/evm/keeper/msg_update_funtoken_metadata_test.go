@@ -0,0 +1,180 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmmodule"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+)
+
+func (s *Suite) TestUpdateFunTokenMetadata() {
+	deps := evmtest.NewTestDeps()
+
+	s.T().Log("Setup: FunToken made from a bank coin")
+	coinFunToken := evmtest.CreateFunTokenForBankCoin(&deps, "unibi", &s.Suite)
+
+	s.T().Log("Setup: FunToken made from an ERC20")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20Minter, s.T(), "erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	erc20Addr := eth.NewHexAddr(deployResp.ContractAddr)
+	createResp, err := deps.EvmKeeper.CreateFunToken(
+		deps.GoCtx(),
+		&evm.MsgCreateFunToken{
+			FromErc20: &erc20Addr,
+			Sender:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	erc20FunToken := createResp.FuntokenMapping
+
+	sudoRoot := testapp.DefaultSudoRoot().String()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.T().Log("sad: sender is neither the authority nor a sudoer")
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    testutil.AccAddress().String(),
+		BankDenom: coinFunToken.BankDenom,
+		Verified:  true,
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	s.T().Log("sad: FunToken does not exist")
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    sudoRoot,
+		BankDenom: "nonexistent",
+		Verified:  true,
+	})
+	s.Require().ErrorContains(err, "does not exist")
+
+	newMetadata := bank.Metadata{
+		Description: "verified token",
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: erc20FunToken.BankDenom, Exponent: 0},
+			{Denom: "TKN", Exponent: 18},
+		},
+		Base:    erc20FunToken.BankDenom,
+		Display: "TKN",
+		Name:    "Token",
+		Symbol:  "TKN",
+	}
+
+	s.T().Log("sad: metadata override for a FunToken made from a bank coin")
+	coinMetadata := newMetadata
+	coinMetadata.Base = coinFunToken.BankDenom
+	coinMetadata.DenomUnits = []*bank.DenomUnit{{Denom: coinFunToken.BankDenom, Exponent: 0}}
+	coinMetadata.Display = coinFunToken.BankDenom
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    sudoRoot,
+		BankDenom: coinFunToken.BankDenom,
+		Verified:  true,
+		Metadata:  &coinMetadata,
+	})
+	s.Require().ErrorContains(err, "made from a bank coin")
+
+	s.T().Log("sad: metadata base does not match the FunToken")
+	wrongBase := newMetadata
+	wrongBase.Base = "unibi"
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    sudoRoot,
+		BankDenom: erc20FunToken.BankDenom,
+		Verified:  true,
+		Metadata:  &wrongBase,
+	})
+	s.Require().ErrorContains(err, "must equal the FunToken bank denom")
+
+	s.T().Log("happy: sudoer verifies the ERC20 FunToken and overrides its metadata")
+	resp, err := deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    sudoRoot,
+		BankDenom: erc20FunToken.BankDenom,
+		Verified:  true,
+		Metadata:  &newMetadata,
+	})
+	s.Require().NoError(err)
+	s.True(resp.FuntokenMapping.Verified)
+	gotMetadata, found := deps.Chain.BankKeeper.GetDenomMetaData(deps.Ctx, erc20FunToken.BankDenom)
+	s.Require().True(found)
+	s.Equal(newMetadata, gotMetadata)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventFunTokenMetadataUpdated{
+		BankDenom:            erc20FunToken.BankDenom,
+		Erc20ContractAddress: erc20FunToken.Erc20Addr.String(),
+		Verified:             true,
+		MetadataUpdated:      true,
+		Sender:               sudoRoot,
+	})
+
+	s.T().Log("happy: module authority verifies the bank coin FunToken")
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    authority,
+		BankDenom: coinFunToken.BankDenom,
+		Verified:  true,
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("happy: query verified FunTokens")
+	queryResp, err := deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{})
+	s.Require().NoError(err)
+	s.Len(queryResp.FunTokens, 2)
+
+	s.T().Log("happy: query verified FunTokens one page at a time")
+	queryResp, err = deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryResp.FunTokens, 1)
+	s.EqualValues(2, queryResp.Pagination.Total)
+	s.Require().NotNil(queryResp.Pagination.NextKey)
+	firstPage := queryResp.FunTokens[0]
+	queryResp, err = deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 1, Key: queryResp.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(queryResp.FunTokens, 1)
+	s.NotEqual(firstPage.BankDenom, queryResp.FunTokens[0].BankDenom)
+	s.Nil(queryResp.Pagination.NextKey)
+
+	s.T().Log("sad: page request with both an offset and a key")
+	_, err = deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{
+		Pagination: &sdkquery.PageRequest{Offset: 1, Key: []byte{1}},
+	})
+	s.ErrorContains(err, "either offset or key is expected")
+
+	s.T().Log("happy: unverify the bank coin FunToken")
+	_, err = deps.EvmKeeper.UpdateFunTokenMetadata(deps.GoCtx(), &evm.MsgUpdateFunTokenMetadata{
+		Sender:    sudoRoot,
+		BankDenom: coinFunToken.BankDenom,
+		Verified:  false,
+	})
+	s.Require().NoError(err)
+	queryResp, err = deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queryResp.FunTokens, 1)
+	s.Equal(erc20FunToken.BankDenom, queryResp.FunTokens[0].BankDenom)
+
+	s.T().Log("happy: the verified flag survives a genesis export and import")
+	genState := evmmodule.ExportGenesis(deps.Ctx, &deps.EvmKeeper, deps.Chain.AccountKeeper)
+	authGenState := deps.Chain.AccountKeeper.ExportGenesis(deps.Ctx)
+	deps = evmtest.NewTestDeps()
+	deps.Chain.AccountKeeper.InitGenesis(deps.Ctx, *authGenState)
+	evmmodule.InitGenesis(deps.Ctx, &deps.EvmKeeper, deps.Chain.AccountKeeper, *genState)
+	queryResp, err = deps.EvmKeeper.VerifiedFunTokens(deps.GoCtx(), &evm.QueryVerifiedFunTokensRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queryResp.FunTokens, 1)
+	s.Equal(erc20FunToken.BankDenom, queryResp.FunTokens[0].BankDenom)
+}
@@ -28,21 +28,6 @@ func (k *Keeper) AddPrecompiles(precompileMap map[gethcommon.Address]vm.Precompi
 	for addr, precompile := range precompileMap {
 		k.precompiles[addr] = precompile
 	}
-	// The following TODOs can go in an epic together.
-
-	// TODO: feat(evm): implement precompiled contracts for fungible tokens
-	// https://github.com/NibiruChain/nibiru/issues/1898
-
-	// TODO: feat(evm): implement precompiled contracts for ibc transfer
-	// Check if there is sufficient demand for this.
-
-	// TODO: feat(evm): implement precompiled contracts for staking
-	// Note that liquid staked assets can be a useful alternative to adding a
-	// staking precompile.
-	// Check if there is sufficient demand for this.
-
-	// TODO: feat(evm): implement precompiled contracts for wasm calls
-	// Check if there is sufficient demand for this.
 }
 
 // IsAvailablePrecompile returns true if the given precompile address is contained in the
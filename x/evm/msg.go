@@ -292,6 +292,16 @@ func (msg MsgEthereumTx) GetEffectiveGasPrice(baseFee *big.Int) *big.Int {
 	return txData.EffectiveGasPrice(baseFee)
 }
 
+// GetGasFeeCap returns the maximum gas price the sender is willing to pay. For
+// access list and legacy txs, this is the gas price.
+func (msg MsgEthereumTx) GetGasFeeCap() *big.Int {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil
+	}
+	return txData.GetGasFeeCap()
+}
+
 // GetFrom loads the ethereum sender address from the sigcache and returns an
 // sdk.AccAddress from its bytes
 func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
@@ -518,28 +528,96 @@ func (m MsgCreateFunToken) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
 
-// GetSigners returns the expected signers for a MsgSendFunTokenToEvm message.
-func (m MsgSendFunTokenToEvm) GetSigners() []sdk.AccAddress {
+// GetSigners returns the expected signers for a MsgConvertCoinToEvm message.
+func (m MsgConvertCoinToEvm) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{addr}
 }
 
-func errMsgSendFunTokenToEvmValidate(errMsg string) error {
-	return fmt.Errorf("MsgSendFunTokenToEvm ValidateBasic error: %s", errMsg)
+func errMsgConvertCoinToEvmValidate(errMsg string) error {
+	return fmt.Errorf("MsgConvertCoinToEvm ValidateBasic error: %s", errMsg)
 }
 
 // ValidateBasic does a sanity check of the provided data
-func (m *MsgSendFunTokenToEvm) ValidateBasic() error {
+func (m *MsgConvertCoinToEvm) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
 		return errMsgCreateFunTokenValidate("invalid sender addr")
 	}
 	if m.ToEthAddr == "" {
-		return errMsgSendFunTokenToEvmValidate("\"to_eth_addr\" must be set")
+		return errMsgConvertCoinToEvmValidate("\"to_eth_addr\" must be set")
+	}
+	if m.BankCoin.Amount.IsNil() {
+		return errMsgConvertCoinToEvmValidate("\"bank_coin\" amount must be set")
+	}
+	if err := m.BankCoin.Validate(); err != nil {
+		return errMsgConvertCoinToEvmValidate(fmt.Sprintf("invalid \"bank_coin\": %s", err))
+	}
+	if m.BankCoin.IsZero() {
+		return errMsgConvertCoinToEvmValidate("\"bank_coin\" amount must be positive")
 	}
 	return nil
 }
 
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertCoinToEvm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ToMsgConvertCoinToEvm returns the "MsgConvertCoinToEvm" that replaced the
+// deprecated "MsgSendFunTokenToEvm".
+func (m MsgSendFunTokenToEvm) ToMsgConvertCoinToEvm() *MsgConvertCoinToEvm {
+	return &MsgConvertCoinToEvm{
+		ToEthAddr: m.ToEthAddr,
+		Sender:    m.Sender,
+		BankCoin:  m.BankCoin,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgSendFunTokenToEvm message.
+func (m MsgSendFunTokenToEvm) GetSigners() []sdk.AccAddress {
+	return m.ToMsgConvertCoinToEvm().GetSigners()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSendFunTokenToEvm) ValidateBasic() error {
+	return m.ToMsgConvertCoinToEvm().ValidateBasic()
+}
+
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgSendFunTokenToEvm) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgUpdateFunTokenMetadata message.
+func (m MsgUpdateFunTokenMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func errMsgUpdateFunTokenMetadataValidate(errMsg string) error {
+	return fmt.Errorf("MsgUpdateFunTokenMetadata ValidateBasic error: %s", errMsg)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateFunTokenMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errMsgUpdateFunTokenMetadataValidate("invalid sender addr")
+	}
+	if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+		return errMsgUpdateFunTokenMetadataValidate(err.Error())
+	}
+	if m.Metadata != nil {
+		if m.Metadata.Base != m.BankDenom {
+			return errMsgUpdateFunTokenMetadataValidate("metadata base denom must equal \"bank_denom\"")
+		}
+		if err := m.Metadata.Validate(); err != nil {
+			return errMsgUpdateFunTokenMetadataValidate(err.Error())
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateFunTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -18,6 +18,7 @@ import (
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/eth/crypto/ethsecp256k1"
 
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -984,3 +985,96 @@ func (s *MsgsSuite) TestTransactionLogsEncodeDecode() {
 	s.Nil(decodeErr)
 	s.Equal(txLogs, txLogsEncodedDecoded)
 }
+
+func (s *MsgsSuite) TestMsgConvertCoinToEvm_ValidateBasic() {
+	sender := sdk.AccAddress(s.from.Bytes()).String()
+	toEthAddr := evmtest.NewEthAccInfo().EthAddr.Hex()
+	for _, tc := range []struct {
+		name     string
+		msg      evm.MsgConvertCoinToEvm
+		errorStr string
+	}{
+		{
+			name: "happy",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:    sender,
+				ToEthAddr: eth.HexAddr(toEthAddr),
+				BankCoin:  sdk.NewInt64Coin("unibi", 1),
+			},
+		},
+		{
+			name: "sad: invalid sender",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:    "nibi1invalid",
+				ToEthAddr: eth.HexAddr(toEthAddr),
+				BankCoin:  sdk.NewInt64Coin("unibi", 1),
+			},
+			errorStr: "invalid sender addr",
+		},
+		{
+			name: "sad: missing to_eth_addr",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:   sender,
+				BankCoin: sdk.NewInt64Coin("unibi", 1),
+			},
+			errorStr: "\"to_eth_addr\" must be set",
+		},
+		{
+			name: "sad: invalid bank_coin denom",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:    sender,
+				ToEthAddr: eth.HexAddr(toEthAddr),
+				BankCoin:  sdk.Coin{Denom: "!", Amount: sdkmath.OneInt()},
+			},
+			errorStr: "invalid \"bank_coin\"",
+		},
+		{
+			name: "sad: nil bank_coin amount",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:    sender,
+				ToEthAddr: eth.HexAddr(toEthAddr),
+				BankCoin:  sdk.Coin{Denom: "unibi"},
+			},
+			errorStr: "\"bank_coin\" amount must be set",
+		},
+		{
+			name: "sad: zero bank_coin amount",
+			msg: evm.MsgConvertCoinToEvm{
+				Sender:    sender,
+				ToEthAddr: eth.HexAddr(toEthAddr),
+				BankCoin:  sdk.NewInt64Coin("unibi", 0),
+			},
+			errorStr: "\"bank_coin\" amount must be positive",
+		},
+	} {
+		s.Run(tc.name, func() {
+			deprecatedMsg := evm.MsgSendFunTokenToEvm{
+				Sender:    tc.msg.Sender,
+				ToEthAddr: tc.msg.ToEthAddr,
+				BankCoin:  tc.msg.BankCoin,
+			}
+			for _, err := range []error{tc.msg.ValidateBasic(), deprecatedMsg.ValidateBasic()} {
+				if tc.errorStr != "" {
+					s.ErrorContains(err, tc.errorStr)
+				} else {
+					s.NoError(err)
+				}
+			}
+		})
+	}
+}
+
+// TestMsgSendFunTokenToEvm_TypeURL: The deprecated "MsgSendFunTokenToEvm" must
+// still decode so that clients signing the old type URL keep working.
+func (s *MsgsSuite) TestMsgSendFunTokenToEvm_TypeURL() {
+	registry := encoding.MakeConfig(app.ModuleBasics).InterfaceRegistry
+	for _, typeURL := range []string{
+		"/eth.evm.v1.MsgSendFunTokenToEvm",
+		"/eth.evm.v1.MsgConvertCoinToEvm",
+	} {
+		msg, err := registry.Resolve(typeURL)
+		s.Require().NoError(err, typeURL)
+		_, isSdkMsg := msg.(sdk.Msg)
+		s.True(isSdkMsg, typeURL)
+	}
+}
@@ -25,8 +25,16 @@ const (
 	DefaultEVMDenom = appconst.BondDenom
 )
 
-// AvailableEVMExtensions defines the default active precompiles
-var AvailableEVMExtensions = []string{}
+// AvailableEVMExtensions defines the default active precompiles. These are
+// the Nibiru precompiles that can be turned off by removing them from
+// "Params.ActivePrecompiles".
+var AvailableEVMExtensions = []string{
+	"0x0000000000000000000000000000000000000801", // bank precompile: "IBank.sol"
+	"0x0000000000000000000000000000000000000802", // staking precompile: "IStaking.sol"
+	"0x0000000000000000000000000000000000000803", // wasm precompile: "IWasm.sol"
+	"0x0000000000000000000000000000000000000804", // IBC transfer precompile: "IIbcTransfer.sol"
+	"0x0000000000000000000000000000000000000805", // oracle precompile: "IOracle.sol"
+}
 
 // DefaultParams returns default evm parameters
 // ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
@@ -42,6 +50,10 @@ func DefaultParams() Params {
 		ActivePrecompiles:   AvailableEVMExtensions,
 		EVMChannels:         []string{},
 		CreateFuntokenFee:   math.NewIntWithDecimal(10_000, 6), // 10_000 NIBI
+
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+		MinBaseFee:               math.NewInt(1),
 	}
 }
 
@@ -89,6 +101,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.validateBaseFeeParams(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -127,6 +143,19 @@ func (p Params) IsActivePrecompile(address string) bool {
 	return found
 }
 
+// validateBaseFeeParams checks the EIP-1559 base fee parameters.
+func (p Params) validateBaseFeeParams() error {
+	if !p.MinBaseFee.IsNil() && p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+	if p.IsDynamicBaseFee() && p.ElasticityMultiplier == 0 {
+		return fmt.Errorf(
+			"elasticity multiplier must be positive when the dynamic base fee is enabled",
+		)
+	}
+	return nil
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -0,0 +1,299 @@
+package precompile
+
+import (
+	"fmt"
+	basicmath "math"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileBank)(nil)
+	_ NibiruPrecompile       = (*precompileBank)(nil)
+)
+
+// Precompile address for "IBank.sol", the contract that gives EVM contracts
+// access to balances, supplies, metadata, and transfers of any bank coin.
+// Unlike the FunToken precompile, this works for coins without an ERC20
+// mapping, such as IBC and tokenfactory coins.
+var PrecompileAddr_Bank = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000801",
+)
+
+func (p precompileBank) Address() gethcommon.Address {
+	return PrecompileAddr_Bank.ToAddr()
+}
+
+const (
+	// gasCostBankRead: Gas cost of the read-only methods of the bank precompile.
+	gasCostBankRead uint64 = 2_000
+	// gasCostBankSend: Gas cost of a coin transfer with the bank precompile.
+	gasCostBankSend uint64 = 20_000
+)
+
+func (p precompileBank) RequiredGas(input []byte) (gasCost uint64) {
+	method, err := ABIMethodByID(p.ABI(), input)
+	if err != nil {
+		// Invalid input fails in "Run". Charge the cheaper cost here.
+		return gasCostBankRead
+	}
+	if BankMethod(method.Name) == BankMethod_Send {
+		return gasCostBankSend
+	}
+	return gasCostBankRead
+}
+
+const (
+	BankMethod_Balance  BankMethod = "balance"
+	BankMethod_Supply   BankMethod = "supply"
+	BankMethod_Send     BankMethod = "send"
+	BankMethod_Metadata BankMethod = "metadata"
+)
+
+type BankMethod string
+
+// Run runs the precompiled contract
+func (p precompileBank) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	contractInput := contract.Input
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = assertPrecompileActive(ctx, p.PublicKeepers, p.Address()); err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+	switch BankMethod(method.Name) {
+	case BankMethod_Balance:
+		bz, err = p.balance(ctx, method, args)
+	case BankMethod_Supply:
+		bz, err = p.supply(ctx, method, args)
+	case BankMethod_Send:
+		bz, err = p.send(ctx, caller, method, args, readonly)
+	case BankMethod_Metadata:
+		bz, err = p.metadata(ctx, method, args)
+	default:
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	return
+}
+
+func PrecompileBank(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileBank{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileBank) ABI() gethabi.ABI {
+	return embeds.Contract_Bank.ABI
+}
+
+type precompileBank struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+/*
+balance: Implements "IBank.balance"
+
+```solidity
+function balance(address account, string memory denom) external view returns (uint256);
+```
+*/
+func (p precompileBank) balance(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	if err = AssertArgCount(args, 2); err != nil {
+		return
+	}
+	account, ok1 := args[0].(gethcommon.Address)
+	denom, ok2 := args[1].(string)
+	if !(ok1 && ok2) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function balance(address account, string memory denom)")
+		return
+	}
+
+	coin := p.BankKeeper.GetBalance(ctx, eth.EthAddrToNibiruAddr(account), denom)
+	return method.Outputs.Pack(coin.Amount.BigInt())
+}
+
+/*
+supply: Implements "IBank.supply"
+
+```solidity
+function supply(string memory denom) external view returns (uint256);
+```
+*/
+func (p precompileBank) supply(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	denom, err := assertArgDenom(args, "function supply(string memory denom)")
+	if err != nil {
+		return
+	}
+
+	coin := p.BankKeeper.GetSupply(ctx, denom)
+	return method.Outputs.Pack(coin.Amount.BigInt())
+}
+
+/*
+metadata: Implements "IBank.metadata". The decimals are given by the exponent
+of the denom unit that matches the display denom.
+
+```solidity
+function metadata(string memory denom) external view returns (string memory name, string memory symbol, uint8 decimals);
+```
+*/
+func (p precompileBank) metadata(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	denom, err := assertArgDenom(args, "function metadata(string memory denom)")
+	if err != nil {
+		return
+	}
+
+	md, found := p.BankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		err = fmt.Errorf("bank metadata not found for denom \"%s\"", denom)
+		return
+	}
+
+	var decimals uint8
+	for _, unit := range md.DenomUnits {
+		if unit.Denom == md.Display {
+			if unit.Exponent > basicmath.MaxUint8 {
+				err = fmt.Errorf("display exponent %d of denom \"%s\" overflows uint8",
+					unit.Exponent, denom)
+				return
+			}
+			decimals = uint8(unit.Exponent)
+			break
+		}
+	}
+	return method.Outputs.Pack(md.Name, md.Symbol, decimals)
+}
+
+/*
+send: Implements "IBank.send"
+
+The caller sends its own bank coins to the "to" account. Coins in the EVM
+denom are rejected because their balances are managed by the EVM StateDB and
+should be moved with a native value transfer instead.
+
+```solidity
+function send(address to, string memory denom, uint256 amount) external;
+```
+*/
+func (p precompileBank) send(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+	readOnly bool,
+) (bz []byte, err error) {
+	if readOnly {
+		// Check required for transactions but not needed for queries
+		err = fmt.Errorf("cannot write state from staticcall (a read-only call)")
+		return
+	}
+
+	to, denom, amount, err := p.AssertArgTypesSend(args)
+	if err != nil {
+		return
+	}
+
+	if err = sdk.ValidateDenom(denom); err != nil {
+		return
+	}
+	if evmDenom := p.EvmKeeper.GetParams(ctx).EvmDenom; denom == evmDenom {
+		err = fmt.Errorf(
+			"cannot send the EVM denom \"%s\" with the bank precompile: use a value transfer", evmDenom)
+		return
+	}
+
+	// Amount should be positive
+	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
+		err = fmt.Errorf("send amount must be positive")
+		return
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(amount)))
+	err = p.BankKeeper.SendCoins(
+		ctx, eth.EthAddrToNibiruAddr(caller), eth.EthAddrToNibiruAddr(to), coins,
+	)
+	if err != nil {
+		err = fmt.Errorf("send failed from %s to %s: %w", caller.Hex(), to.Hex(), err)
+		return
+	}
+
+	return method.Outputs.Pack()
+}
+
+// ArgsBankSend: Constructor for an "args" array of arguments for the
+// "IBank.send" function.
+func ArgsBankSend(
+	to gethcommon.Address,
+	denom string,
+	amount *big.Int,
+) []any {
+	return []any{to, denom, amount}
+}
+
+func (p precompileBank) AssertArgTypesSend(args []any) (
+	to gethcommon.Address,
+	denom string,
+	amount *big.Int,
+	err error,
+) {
+	err = AssertArgCount(args, 3)
+	if err != nil {
+		return
+	}
+
+	to, ok1 := args[0].(gethcommon.Address)
+	denom, ok2 := args[1].(string)
+	amount, ok3 := args[2].(*big.Int)
+	if !(ok1 && ok2 && ok3) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function send(address to, string memory denom, uint256 amount) external")
+	}
+	return
+}
+
+// assertArgDenom: Type validation for methods that take a single denom as
+// their argument.
+func assertArgDenom(args []any, signature string) (denom string, err error) {
+	if err = AssertArgCount(args, 1); err != nil {
+		return
+	}
+	denom, ok := args[0].(string)
+	if !ok {
+		err = fmt.Errorf("type validation failed for \"%s\"", signature)
+	}
+	return
+}
@@ -0,0 +1,178 @@
+package precompile_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Bank() {
+	s.Run("PrecompileExists", s.Bank_PrecompileExists)
+	s.Run("Queries", s.Bank_Queries)
+	s.Run("Send", s.Bank_Send)
+	s.Run("RevertedSend", s.Bank_RevertedSend)
+	s.Run("Inactive", s.Bank_Inactive)
+}
+
+// bankCall: Calls the bank precompile and returns the unpacked outputs.
+func bankCall(
+	deps *evmtest.TestDeps,
+	from gethcommon.Address,
+	commit bool,
+	method precompile.BankMethod,
+	args ...any,
+) (out []any, err error) {
+	abi := embeds.Contract_Bank.ABI
+	input, err := abi.Pack(string(method), args...)
+	if err != nil {
+		return
+	}
+	contractAddr := precompile.PrecompileAddr_Bank.ToAddr()
+	evmResp, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, from, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return
+	}
+	return abi.Unpack(string(method), evmResp.Ret)
+}
+
+func (s *Suite) Bank_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	precompileAddr := precompile.PrecompileAddr_Bank
+
+	s.True(deps.EvmKeeper.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+	s.True(deps.EvmKeeper.GetParams(deps.Ctx).IsActivePrecompile(precompileAddr.String()),
+		"bank precompile should be active by default")
+
+	contractAddr := precompileAddr.ToAddr()
+	_, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, deps.Sender.EthAddr, &contractAddr, true, []byte("nonsense"),
+	)
+	s.ErrorContains(err, "precompile error")
+}
+
+func (s *Suite) Bank_Queries() {
+	deps := evmtest.NewTestDeps()
+	denom := "tf/nibi1creator/coin"
+	theUser := deps.Sender.EthAddr
+
+	s.T().Log("balance and supply start at zero")
+	out, err := bankCall(&deps, theUser, false, precompile.BankMethod_Balance, theUser, denom)
+	s.Require().NoError(err)
+	s.Equal("0", out[0].(*big.Int).String())
+	out, err = bankCall(&deps, theUser, false, precompile.BankMethod_Supply, denom)
+	s.Require().NoError(err)
+	s.Equal("0", out[0].(*big.Int).String())
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 420)),
+	))
+
+	s.T().Log("balance and supply reflect the bank state")
+	out, err = bankCall(&deps, theUser, false, precompile.BankMethod_Balance, theUser, denom)
+	s.Require().NoError(err)
+	s.Equal("420", out[0].(*big.Int).String())
+	out, err = bankCall(&deps, theUser, false, precompile.BankMethod_Supply, denom)
+	s.Require().NoError(err)
+	s.Equal("420", out[0].(*big.Int).String())
+
+	s.T().Log("metadata - sad: not found")
+	_, err = bankCall(&deps, theUser, false, precompile.BankMethod_Metadata, denom)
+	s.ErrorContains(err, "bank metadata not found")
+
+	s.T().Log("metadata - happy")
+	deps.Chain.BankKeeper.SetDenomMetaData(deps.Ctx, bank.Metadata{
+		DenomUnits: []*bank.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "COIN", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "COIN",
+		Name:    "Coin",
+		Symbol:  "COIN",
+	})
+	out, err = bankCall(&deps, theUser, false, precompile.BankMethod_Metadata, denom)
+	s.Require().NoError(err)
+	s.Equal([]any{"Coin", "COIN", uint8(6)}, out)
+}
+
+func (s *Suite) Bank_Send() {
+	deps := evmtest.NewTestDeps()
+	denom := "ibc/usdc"
+	theUser := deps.Sender.EthAddr
+	toAcc := testutil.AccAddress()
+	to := eth.NibiruAddrToEthAddr(toAcc)
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000)),
+	))
+
+	s.T().Log("sad: insufficient funds")
+	_, err := bankCall(&deps, theUser, true, precompile.BankMethod_Send,
+		precompile.ArgsBankSend(to, denom, big.NewInt(1_001))...)
+	s.ErrorContains(err, "insufficient funds")
+
+	s.T().Log("sad: non-positive amount")
+	_, err = bankCall(&deps, theUser, true, precompile.BankMethod_Send,
+		precompile.ArgsBankSend(to, denom, big.NewInt(0))...)
+	s.ErrorContains(err, "send amount must be positive")
+
+	s.T().Log("sad: the EVM denom moves with value transfers")
+	evmDenom := deps.EvmKeeper.GetParams(deps.Ctx).EvmDenom
+	_, err = bankCall(&deps, theUser, true, precompile.BankMethod_Send,
+		precompile.ArgsBankSend(to, evmDenom, big.NewInt(1))...)
+	s.ErrorContains(err, "cannot send the EVM denom")
+
+	s.T().Log("happy: send")
+	_, err = bankCall(&deps, theUser, true, precompile.BankMethod_Send,
+		precompile.ArgsBankSend(to, denom, big.NewInt(420))...)
+	s.Require().NoError(err)
+	s.Equal("580",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, denom).Amount.String())
+	s.Equal("420",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, toAcc, denom).Amount.String())
+}
+
+func (s *Suite) Bank_RevertedSend() {
+	deps := evmtest.NewTestDeps()
+	denom := "ibc/usdc"
+	caller := evmtest.NewEthAccInfo().EthAddr
+	callerAcc := eth.EthAddrToNibiruAddr(caller)
+	toAcc := testutil.AccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))
+	s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, callerAcc, coins))
+
+	input, err := embeds.Contract_Bank.ABI.Pack(string(precompile.BankMethod_Send),
+		precompile.ArgsBankSend(eth.NibiruAddrToEthAddr(toAcc), denom, big.NewInt(420))...)
+	s.Require().NoError(err)
+	s.callThenRevert(&deps, caller, precompile.PrecompileAddr_Bank.ToAddr(), input)
+
+	s.Equal(coins, deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, callerAcc))
+	s.True(deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, toAcc).IsZero())
+}
+
+func (s *Suite) Bank_Inactive() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+
+	params := deps.EvmKeeper.GetParams(deps.Ctx)
+	params.ActivePrecompiles = []string{}
+	deps.EvmKeeper.SetParams(deps.Ctx, params)
+
+	_, err := bankCall(&deps, theUser, false, precompile.BankMethod_Supply, "unibi")
+	s.ErrorContains(err, evm.ErrInactivePrecompile.Error())
+}
@@ -59,7 +59,7 @@ func (p precompileFunToken) Run(
 	}()
 
 	contractInput := contract.Input
-	ctx, method, args, err := OnRunStart(p, evm, contractInput)
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (p precompileFunToken) Run(
 	case FunTokenMethod_BankSend:
 		// TODO: UD-DEBUG: Test that calling non-method on the right address does
 		// nothing.
-		bz, err = p.bankSend(ctx, caller, method, args, readonly)
+		bz, err = p.bankSend(ctx, evm, caller, method, args, readonly)
 	default:
 		// TODO: UD-DEBUG: test invalid method called
 		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
@@ -109,6 +109,7 @@ function bankSend(address erc20, uint256 amount, string memory to) external;
 */
 func (p precompileFunToken) bankSend(
 	ctx sdk.Context,
+	evmObj *vm.EVM,
 	caller gethcommon.Address,
 	method *gethabi.Method,
 	args []interface{},
@@ -160,15 +161,31 @@ func (p precompileFunToken) bankSend(
 		return
 	}
 
-	// EVM account mints FunToken.BankDenom to module account
 	amt := math.NewIntFromBigInt(amount)
 	coins := sdk.NewCoins(sdk.NewCoin(funtoken.BankDenom, amt))
-	err = p.BankKeeper.MintCoins(ctx, evm.ModuleName, coins)
-	if err != nil {
-		err = fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
-			evm.ModuleName, evm.ModuleAddressEVM().Hex(), caller.Hex(), err,
-		)
-		return
+	if funtoken.IsMadeFromCoin {
+		// If the FunToken mapping was created from a bank coin, then the EVM
+		// account owns the ERC20 contract and minted the ERC20 tokens when the
+		// coins were escrowed. Since we're sending them away and want accurate
+		// total supply tracking, the tokens need to be burned and the escrowed
+		// coins released.
+		caller := evm.ModuleAddressEVM()
+		_, err = p.EvmKeeper.ERC20().Burn(erc20, caller, amount, ctx)
+		if err != nil {
+			err = fmt.Errorf("ERC20.Burn: %w", err)
+			return
+		}
+	} else {
+		// EVM account mints FunToken.BankDenom to module account. The ERC20
+		// tokens stay escrowed in the EVM account until the coins are converted
+		// back with "MsgConvertCoinToEvm".
+		err = p.BankKeeper.MintCoins(ctx, evm.ModuleName, coins)
+		if err != nil {
+			err = fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
+				evm.ModuleName, evm.ModuleAddressEVM().Hex(), caller.Hex(), err,
+			)
+			return
+		}
 	}
 
 	err = p.BankKeeper.SendCoinsFromModuleToAccount(ctx, evm.ModuleName, toAddr, coins)
@@ -179,21 +196,23 @@ func (p precompileFunToken) bankSend(
 		return
 	}
 
-	// If the FunToken mapping was created from a bank coin, then the EVM account
-	// owns the ERC20 contract and was the original minter of the ERC20 tokens.
-	// Since we're sending them away and want accurate total supply tracking, the
-	// tokens need to be burned.
-	if funtoken.IsMadeFromCoin {
-		caller := evm.ModuleAddressEVM()
-		_, err = p.EvmKeeper.ERC20().Burn(erc20, caller, amount, ctx)
-		if err != nil {
-			err = fmt.Errorf("ERC20.Burn: %w", err)
-			return
-		}
+	// Emit the EVM log and the typed ABCI event so that indexers on both sides
+	// can link the ERC20 transfer into the EVM account to the bank coins.
+	if err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["BankSend"],
+		[]gethcommon.Hash{
+			gethcommon.BytesToHash(erc20.Bytes()),
+			gethcommon.BytesToHash(caller.Bytes()),
+		},
+		to, amount,
+	); err != nil {
+		return
 	}
-
-	// TODO: UD-DEBUG: feat: Emit EVM events
-	// TODO: UD-DEBUG: feat: Emit ABCI events
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenBankSend{
+		Sender:               caller.Hex(),
+		Erc20ContractAddress: erc20.Hex(),
+		Recipient:            toAddr.String(),
+		BankCoin:             coins[0],
+	})
 
 	return method.Outputs.Pack() // TODO: change interface
 }
@@ -5,7 +5,13 @@ import (
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/embeds"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
@@ -24,6 +30,21 @@ func TestPrecompileSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+// callThenRevert: Calls "precompileAddr" with "input" from a contract at
+// "caller" that reverts after the call. It requires the precompile call itself
+// to succeed, so that only the revert of the caller can undo its changes.
+func (s *Suite) callThenRevert(
+	deps *evmtest.TestDeps, caller, precompileAddr gethcommon.Address, input []byte,
+) {
+	evmResp, err := evmtest.CallThenRevert(
+		deps.Ctx, &deps.Chain.EvmKeeper, deps.Sender.EthAddr, caller, precompileAddr, input,
+	)
+	s.Require().NoError(err)
+	s.Require().Contains(evmResp.VmError, vm.ErrExecutionReverted.Error())
+	s.Require().Len(evmResp.Ret, 32)
+	s.Require().Equal(byte(1), evmResp.Ret[31], "precompile call should succeed")
+}
+
 func (s *Suite) TestPrecompile_FunToken() {
 	s.Run("PrecompileExists", s.FunToken_PrecompileExists)
 	s.Run("HappyPath", s.FunToken_HappyPath)
@@ -101,6 +122,12 @@ func (s *Suite) FunToken_HappyPath() {
 
 	s.T().Log("Mint tokens - Success")
 	{
+		// The ERC20 of a FunToken made from a coin is backed by coins escrowed
+		// in the EVM module account.
+		s.Require().NoError(testapp.FundModuleAccount(
+			deps.Chain.BankKeeper, deps.Ctx, evm.ModuleName,
+			sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 69_420)),
+		))
 		from := theEvm
 		to := theUser
 		input, err := embeds.Contract_ERC20Minter.ABI.Pack("mint", to, big.NewInt(69_420))
@@ -134,8 +161,27 @@ func (s *Suite) FunToken_HappyPath() {
 	s.NoError(err)
 
 	from := theUser
-	_, err = evmtest.DoEthTx(&deps, precompileAddr.ToAddr(), from, input)
+	evmResp, err := evmtest.DoEthTx(&deps, precompileAddr.ToAddr(), from, input)
+	s.Require().NoError(err)
+
+	s.T().Log("Send using precompile: EVM log and typed event")
+	bankSendEvent := abi.Events["BankSend"]
+	s.Require().Len(evmResp.Logs, 1)
+	s.Equal(precompileAddr.String(), evmResp.Logs[0].Address)
+	s.Equal([]string{
+		bankSendEvent.ID.Hex(),
+		gethcommon.BytesToHash(contract.Bytes()).Hex(),
+		gethcommon.BytesToHash(theUser.Bytes()).Hex(),
+	}, evmResp.Logs[0].Topics)
+	logData, err := bankSendEvent.Inputs.NonIndexed().Unpack(evmResp.Logs[0].Data)
 	s.Require().NoError(err)
+	s.Equal([]any{randomAcc.String(), big.NewInt(amtToSend)}, logData)
+	testutil.RequireContainsTypedEvent(s.T(), deps.Ctx, &evm.EventFunTokenBankSend{
+		Sender:               theUser.Hex(),
+		Erc20ContractAddress: contract.Hex(),
+		Recipient:            randomAcc.String(),
+		BankCoin:             sdk.NewInt64Coin(funtoken.BankDenom, amtToSend),
+	})
 
 	evmtest.AssertERC20BalanceEqual(s.T(), deps, contract, theUser, big.NewInt(69_419-amtToSend))
 	evmtest.AssertERC20BalanceEqual(s.T(), deps, contract, theEvm, big.NewInt(1))
@@ -148,4 +194,8 @@ func (s *Suite) FunToken_HappyPath() {
 	s.Equal("419",
 		deps.Chain.BankKeeper.GetBalance(deps.Ctx, randomAcc, funtoken.BankDenom).Amount.String(),
 	)
+	s.Equal("69001",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, eth.EthAddrToNibiruAddr(theEvm), funtoken.BankDenom).Amount.String(),
+		"escrowed coins should be released instead of minted",
+	)
 }
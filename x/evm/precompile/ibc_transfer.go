@@ -0,0 +1,182 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileIbcTransfer)(nil)
+	_ NibiruPrecompile       = (*precompileIbcTransfer)(nil)
+)
+
+// Precompile address for "IIbcTransfer.sol", the contract that enables EVM
+// accounts and contracts to send ICS-20 transfers over the IBC channels in the
+// "EVMChannels" param.
+var PrecompileAddr_IbcTransfer = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000804",
+)
+
+func (p precompileIbcTransfer) Address() gethcommon.Address {
+	return PrecompileAddr_IbcTransfer.ToAddr()
+}
+
+// gasCostIbcTransferBase: Base gas cost of a call to the IBC transfer
+// precompile. The gas consumed by the Cosmos-SDK stores while sending the
+// packet is charged on top of this.
+const gasCostIbcTransferBase uint64 = 2_000
+
+func (p precompileIbcTransfer) RequiredGas(input []byte) (gasCost uint64) {
+	return gasCostIbcTransferBase
+}
+
+const (
+	IbcTransferMethod_Transfer IbcTransferMethod = "transfer"
+)
+
+type IbcTransferMethod string
+
+// Run runs the precompiled contract
+func (p precompileIbcTransfer) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	contractInput := contract.Input
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = assertPrecompileActive(ctx, p.PublicKeepers, p.Address()); err != nil {
+		return nil, err
+	}
+
+	switch IbcTransferMethod(method.Name) {
+	case IbcTransferMethod_Transfer:
+		if readonly {
+			return nil, fmt.Errorf("cannot write state from staticcall (a read-only call)")
+		}
+	default:
+		return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+	}
+
+	// The EVM denom can be sent over IBC, so the StateDB and bank balances of
+	// the caller have to be kept in sync.
+	caller := contract.CallerAddress
+	stateDB := evm.StateDB.(*statedb.StateDB)
+	if err = flushEvmDenomBalances(ctx, p.PublicKeepers, stateDB, caller); err != nil {
+		return nil, err
+	}
+	defer reloadEvmDenomBalances(ctx, p.PublicKeepers, stateDB, caller)
+
+	return runWithGasLimit(ctx, contract, true, func(ctx sdk.Context) ([]byte, error) {
+		return p.transfer(ctx, evm, caller, method, args)
+	})
+}
+
+func PrecompileIbcTransfer(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileIbcTransfer{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileIbcTransfer) ABI() gethabi.ABI {
+	return embeds.Contract_IbcTransfer.ABI
+}
+
+type precompileIbcTransfer struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+/*
+transfer: Implements "IIbcTransfer.transfer"
+
+```solidity
+function transfer(string memory channel, string memory denom, uint256 amount, string memory receiver, uint64 timeoutTimestamp) external returns (uint64 sequence);
+```
+*/
+func (p precompileIbcTransfer) transfer(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	var callArgs struct {
+		Channel          string   `abi:"channel"`
+		Denom            string   `abi:"denom"`
+		Amount           *big.Int `abi:"amount"`
+		Receiver         string   `abi:"receiver"`
+		TimeoutTimestamp uint64   `abi:"timeoutTimestamp"`
+	}
+	if err = method.Inputs.Copy(&callArgs, args); err != nil {
+		return nil, fmt.Errorf("type validation failed for \"%s\": %w", method.Sig, err)
+	}
+	if !p.EvmKeeper.GetParams(ctx).IsEVMChannel(callArgs.Channel) {
+		return nil, fmt.Errorf("channel \"%s\" is not an EVM channel", callArgs.Channel)
+	}
+	if callArgs.Amount == nil || callArgs.Amount.Sign() <= 0 {
+		return nil, errors.New("transfer amount must be positive")
+	}
+	if callArgs.TimeoutTimestamp == 0 {
+		return nil, errors.New("timeout timestamp must be set")
+	}
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		callArgs.Channel,
+		sdk.Coin{Denom: callArgs.Denom, Amount: math.NewIntFromBigInt(callArgs.Amount)},
+		eth.EthAddrToNibiruAddr(caller).String(),
+		callArgs.Receiver,
+		clienttypes.ZeroHeight(),
+		callArgs.TimeoutTimestamp,
+		"",
+	)
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	resp, err := p.IBCTransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["IbcTransfer"],
+		[]gethcommon.Hash{gethcommon.BytesToHash(caller.Bytes())},
+		callArgs.Channel, callArgs.Denom, callArgs.Amount, callArgs.Receiver, resp.Sequence,
+	); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.Sequence)
+}
+
+// ArgsIbcTransfer: Constructor for an "args" array of arguments for the
+// "IIbcTransfer.transfer" function.
+func ArgsIbcTransfer(
+	channel string, coin sdk.Coin, receiver string, timeoutTimestamp uint64,
+) []any {
+	return []any{channel, coin.Denom, coin.Amount.BigInt(), receiver, timeoutTimestamp}
+}
@@ -0,0 +1,104 @@
+package precompile_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+// The happy path of the IBC transfer precompile needs a counterparty chain.
+// See "TestPrecompileIbcTransfer" in the "app" package.
+func (s *Suite) TestPrecompile_IbcTransfer() {
+	s.Run("PrecompileExists", s.IbcTransfer_PrecompileExists)
+	s.Run("SadPaths", s.IbcTransfer_SadPaths)
+}
+
+// ibcTransferCall: Calls the IBC transfer precompile and returns the unpacked
+// outputs.
+func ibcTransferCall(
+	deps *evmtest.TestDeps,
+	from gethcommon.Address,
+	commit bool,
+	args ...any,
+) (out []any, err error) {
+	abi := embeds.Contract_IbcTransfer.ABI
+	method := string(precompile.IbcTransferMethod_Transfer)
+	input, err := abi.Pack(method, args...)
+	if err != nil {
+		return
+	}
+	contractAddr := precompile.PrecompileAddr_IbcTransfer.ToAddr()
+	evmResp, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, from, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return
+	}
+	return abi.Unpack(method, evmResp.Ret)
+}
+
+func (s *Suite) IbcTransfer_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	precompileAddr := precompile.PrecompileAddr_IbcTransfer
+
+	s.True(deps.EvmKeeper.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+	s.True(deps.EvmKeeper.GetParams(deps.Ctx).IsActivePrecompile(precompileAddr.String()),
+		"IBC transfer precompile should be active by default")
+}
+
+func (s *Suite) IbcTransfer_SadPaths() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	coin := sdk.NewInt64Coin("tf/nibi1creator/coin", 420)
+	receiver := "cosmos1receiver"
+	timeout := uint64(deps.Ctx.BlockTime().UnixNano()) + 1
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr, sdk.NewCoins(coin),
+	))
+
+	s.T().Log("sad: channel is not an EVM channel")
+	_, err := ibcTransferCall(&deps, theUser, true,
+		precompile.ArgsIbcTransfer("channel-0", coin, receiver, timeout)...)
+	s.ErrorContains(err, "is not an EVM channel")
+
+	params := deps.EvmKeeper.GetParams(deps.Ctx)
+	params.EVMChannels = []string{"channel-0"}
+	deps.EvmKeeper.SetParams(deps.Ctx, params)
+
+	s.T().Log("sad: non-positive amount")
+	_, err = ibcTransferCall(&deps, theUser, true,
+		precompile.ArgsIbcTransfer("channel-0", sdk.NewInt64Coin(coin.Denom, 0), receiver, timeout)...)
+	s.ErrorContains(err, "transfer amount must be positive")
+
+	s.T().Log("sad: missing timeout")
+	_, err = ibcTransferCall(&deps, theUser, true,
+		precompile.ArgsIbcTransfer("channel-0", coin, receiver, 0)...)
+	s.ErrorContains(err, "timeout timestamp must be set")
+
+	s.T().Log("sad: the channel does not exist")
+	_, err = ibcTransferCall(&deps, theUser, true,
+		precompile.ArgsIbcTransfer("channel-0", coin, receiver, timeout)...)
+	s.ErrorContains(err, "channel not found")
+	s.Equal(coin,
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, coin.Denom),
+		"failed transfer should not move funds")
+
+	s.T().Log("sad: read-only call")
+	_, err = ibcTransferCall(&deps, theUser, false,
+		precompile.ArgsIbcTransfer("channel-0", coin, receiver, timeout)...)
+	s.ErrorContains(err, "precompile error")
+
+	s.T().Log("sad: inactive precompile")
+	params.ActivePrecompiles = []string{}
+	deps.EvmKeeper.SetParams(deps.Ctx, params)
+	_, err = ibcTransferCall(&deps, theUser, true,
+		precompile.ArgsIbcTransfer("channel-0", coin, receiver, timeout)...)
+	s.ErrorContains(err, evm.ErrInactivePrecompile.Error())
+}
@@ -0,0 +1,184 @@
+package precompile
+
+import (
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileOracle)(nil)
+	_ NibiruPrecompile       = (*precompileOracle)(nil)
+)
+
+// Precompile address for "IOracle.sol", the contract that enables EVM
+// contracts to read the prices posted to the "x/oracle" module.
+var PrecompileAddr_Oracle = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000805",
+)
+
+func (p precompileOracle) Address() gethcommon.Address {
+	return PrecompileAddr_Oracle.ToAddr()
+}
+
+// gasCostOracleBase: Base gas cost of a call to the oracle precompile. The gas
+// consumed reading the Cosmos-SDK stores, such as the price snapshots of a
+// TWAP, is charged on top of this.
+const gasCostOracleBase uint64 = 2_000
+
+func (p precompileOracle) RequiredGas(input []byte) (gasCost uint64) {
+	return gasCostOracleBase
+}
+
+const (
+	OracleMethod_GetExchangeRate     OracleMethod = "getExchangeRate"
+	OracleMethod_GetExchangeRateTwap OracleMethod = "getExchangeRateTwap"
+	OracleMethod_GetDatedPrice       OracleMethod = "getDatedPrice"
+)
+
+type OracleMethod string
+
+// Run runs the precompiled contract
+//
+// Every method of the oracle precompile is read-only. Prices are returned as
+// the integer representation of the sdk.Dec, which has 18 decimals.
+func (p precompileOracle) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	contractInput := contract.Input
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = assertPrecompileActive(ctx, p.PublicKeepers, p.Address()); err != nil {
+		return nil, err
+	}
+
+	var query func(ctx sdk.Context, method *gethabi.Method, args []any) ([]byte, error)
+	switch OracleMethod(method.Name) {
+	case OracleMethod_GetExchangeRate:
+		query = p.getExchangeRate
+	case OracleMethod_GetExchangeRateTwap:
+		query = p.getExchangeRateTwap
+	case OracleMethod_GetDatedPrice:
+		query = p.getDatedPrice
+	default:
+		return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+	}
+
+	return runWithGasLimit(ctx, contract, false, func(ctx sdk.Context) ([]byte, error) {
+		return query(ctx, method, args)
+	})
+}
+
+func PrecompileOracle(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileOracle{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileOracle) ABI() gethabi.ABI {
+	return embeds.Contract_Oracle.ABI
+}
+
+type precompileOracle struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+/*
+getExchangeRate: Implements "IOracle.getExchangeRate"
+
+```solidity
+function getExchangeRate(string memory pair) external view returns (uint256 price);
+```
+*/
+func (p precompileOracle) getExchangeRate(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	pair, err := p.parseArgPair(method, args)
+	if err != nil {
+		return
+	}
+	datedPrice, err := p.OracleKeeper.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return nil, fmt.Errorf("no exchange rate for pair %s: %w", pair, err)
+	}
+	return method.Outputs.Pack(datedPrice.ExchangeRate.BigInt())
+}
+
+/*
+getExchangeRateTwap: Implements "IOracle.getExchangeRateTwap"
+
+```solidity
+function getExchangeRateTwap(string memory pair) external view returns (uint256 price);
+```
+*/
+func (p precompileOracle) getExchangeRateTwap(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	pair, err := p.parseArgPair(method, args)
+	if err != nil {
+		return
+	}
+	price, err := p.OracleKeeper.GetExchangeRateTwap(ctx, pair)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(price.BigInt())
+}
+
+/*
+getDatedPrice: Implements "IOracle.getDatedPrice"
+
+```solidity
+function getDatedPrice(string memory pair) external view returns (uint256 price, uint64 createdBlock);
+```
+*/
+func (p precompileOracle) getDatedPrice(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	pair, err := p.parseArgPair(method, args)
+	if err != nil {
+		return
+	}
+	datedPrice, err := p.OracleKeeper.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return nil, fmt.Errorf("no exchange rate for pair %s: %w", pair, err)
+	}
+	return method.Outputs.Pack(datedPrice.ExchangeRate.BigInt(), datedPrice.LastAcceptedBlock())
+}
+
+// parseArgPair: Parses the "pair" argument shared by the methods of the oracle
+// precompile.
+func (p precompileOracle) parseArgPair(
+	method *gethabi.Method, args []any,
+) (pair asset.Pair, err error) {
+	if err = AssertArgCount(args, 1); err != nil {
+		return
+	}
+	pairStr, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf("type validation failed for \"%s\": expected string for pair, got %T", method.Sig, args[0])
+	}
+	return asset.TryNewPair(pairStr)
+}
@@ -0,0 +1,101 @@
+package precompile_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Oracle() {
+	s.Run("PrecompileExists", s.Oracle_PrecompileExists)
+	s.Run("HappyPath", s.Oracle_HappyPath)
+	s.Run("SadPaths", s.Oracle_SadPaths)
+}
+
+// oracleCall: Calls the oracle precompile and returns the unpacked outputs.
+func oracleCall(
+	deps *evmtest.TestDeps,
+	from gethcommon.Address,
+	method precompile.OracleMethod,
+	args ...any,
+) (out []any, err error) {
+	abi := embeds.Contract_Oracle.ABI
+	input, err := abi.Pack(string(method), args...)
+	if err != nil {
+		return
+	}
+	contractAddr := precompile.PrecompileAddr_Oracle.ToAddr()
+	evmResp, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, from, &contractAddr, false, input,
+	)
+	if err != nil {
+		return
+	}
+	return abi.Unpack(string(method), evmResp.Ret)
+}
+
+func (s *Suite) Oracle_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	precompileAddr := precompile.PrecompileAddr_Oracle
+
+	s.True(deps.EvmKeeper.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+	s.True(deps.EvmKeeper.GetParams(deps.Ctx).IsActivePrecompile(precompileAddr.String()),
+		"oracle precompile should be active by default")
+}
+
+func (s *Suite) Oracle_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	s.T().Log("set a price of 1.5, then 2.5 ten seconds later")
+	startTime := deps.Ctx.BlockTime()
+	deps.Chain.OracleKeeper.SetPrice(deps.Ctx, pair, math.LegacyMustNewDecFromStr("1.5"))
+	deps.Ctx = deps.Ctx.
+		WithBlockTime(startTime.Add(10 * time.Second)).
+		WithBlockHeight(deps.Ctx.BlockHeight() + 1)
+	deps.Chain.OracleKeeper.SetPrice(deps.Ctx, pair, math.LegacyMustNewDecFromStr("2.5"))
+	createdBlock := uint64(deps.Ctx.BlockHeight())
+	deps.Ctx = deps.Ctx.WithBlockTime(startTime.Add(20 * time.Second))
+
+	s.T().Log("getExchangeRate: 18 decimals")
+	out, err := oracleCall(&deps, theUser, precompile.OracleMethod_GetExchangeRate, pair.String())
+	s.Require().NoError(err)
+	s.Equal("2500000000000000000", out[0].(*big.Int).String())
+
+	s.T().Log("getDatedPrice: price and created block")
+	out, err = oracleCall(&deps, theUser, precompile.OracleMethod_GetDatedPrice, pair.String())
+	s.Require().NoError(err)
+	s.Equal("2500000000000000000", out[0].(*big.Int).String())
+	s.Equal(createdBlock, out[1].(uint64))
+
+	s.T().Log("getExchangeRateTwap: equal time at 1.5 and 2.5")
+	out, err = oracleCall(&deps, theUser, precompile.OracleMethod_GetExchangeRateTwap, pair.String())
+	s.Require().NoError(err)
+	s.Equal("2000000000000000000", out[0].(*big.Int).String())
+}
+
+func (s *Suite) Oracle_SadPaths() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+
+	s.T().Log("sad: invalid pair")
+	_, err := oracleCall(&deps, theUser, precompile.OracleMethod_GetExchangeRate, "ubtc")
+	s.ErrorContains(err, "invalid token pair")
+
+	s.T().Log("sad: no price for pair")
+	pair := asset.Registry.Pair(denoms.ETH, denoms.NUSD).String()
+	_, err = oracleCall(&deps, theUser, precompile.OracleMethod_GetDatedPrice, pair)
+	s.ErrorContains(err, "no exchange rate for pair")
+	_, err = oracleCall(&deps, theUser, precompile.OracleMethod_GetExchangeRateTwap, pair)
+	s.ErrorContains(err, "no snapshots for pair")
+}
@@ -10,10 +10,13 @@ import (
 	"github.com/NibiruChain/collections"
 	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
 )
 
@@ -41,6 +44,11 @@ func InitPrecompiles(
 	// Custom precompiles
 	for _, precompileSetupFn := range []func(k keepers.PublicKeepers) vm.PrecompiledContract{
 		PrecompileFunToken,
+		PrecompileBank,
+		PrecompileStaking,
+		PrecompileWasm,
+		PrecompileIbcTransfer,
+		PrecompileOracle,
 	} {
 		pc := precompileSetupFn(k)
 		addPrecompileToVM(pc)
@@ -96,8 +104,12 @@ func ABIMethodByID(abi gethabi.ABI, sigdata []byte) (*gethabi.Method, error) {
 	return nil, fmt.Errorf("no method with id: %#x", sigdata[:4])
 }
 
+// OnRunStart parses the ABI method and arguments of a precompile call and
+// returns the context for the call. Calls that are not read-only run on a
+// journaled branch of the StateDB context, so their Cosmos-SDK writes are
+// discarded if the EVM call frame reverts.
 func OnRunStart(
-	p NibiruPrecompile, evm *vm.EVM, input []byte,
+	p NibiruPrecompile, evm *vm.EVM, input []byte, readonly bool,
 ) (ctx sdk.Context, method *gethabi.Method, args []interface{}, err error) {
 	// 1 | Get context from StateDB
 	stateDB, ok := evm.StateDB.(*statedb.StateDB)
@@ -105,7 +117,6 @@ func OnRunStart(
 		err = fmt.Errorf("failed to load the sdk.Context from the EVM StateDB")
 		return
 	}
-	ctx = stateDB.GetContext()
 
 	// 2 | Parse the ABI method
 	// ABI method IDs are at least 4 bytes according to "gethabi.ABI.MethodByID".
@@ -130,5 +141,81 @@ func OnRunStart(
 		return
 	}
 
+	// 3 | Branch the context if the call can write state
+	if readonly {
+		ctx = stateDB.GetContext()
+	} else {
+		ctx = stateDB.CacheCtxForPrecompile()
+	}
 	return ctx, method, args, nil
 }
+
+// assertPrecompileActive: Returns an error if the precompile at "addr" was
+// disabled by removing it from the "ActivePrecompiles" param. Only precompiles
+// listed in "evm.AvailableEVMExtensions" can be disabled this way.
+func assertPrecompileActive(
+	ctx sdk.Context, k keepers.PublicKeepers, addr gethcommon.Address,
+) error {
+	if !k.EvmKeeper.GetParams(ctx).IsActivePrecompile(addr.Hex()) {
+		return fmt.Errorf("%w: %s", evm.ErrInactivePrecompile, addr.Hex())
+	}
+	return nil
+}
+
+// flushEvmDenomBalances writes the EVM denom balances that the StateDB holds
+// for "addrs" to the bank module. Precompiles call this before moving coins of
+// the EVM denom with Cosmos-SDK keepers so that the keepers see the balance
+// changes from earlier in the EVM execution, such as value transfers.
+func flushEvmDenomBalances(
+	ctx sdk.Context, k keepers.PublicKeepers, db *statedb.StateDB, addrs ...gethcommon.Address,
+) error {
+	for _, addr := range addrs {
+		balance := evm.WeiToNative(db.GetBalance(addr))
+		if err := k.EvmKeeper.SetAccBalance(ctx, addr, balance); err != nil {
+			return fmt.Errorf("failed to flush balance of %s: %w", addr.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// reloadEvmDenomBalances sets the StateDB balances for "addrs" to their EVM
+// denom balances in the bank module. Precompiles call this after moving coins
+// of the EVM denom with Cosmos-SDK keepers. Otherwise, "StateDB.Commit" would
+// overwrite the bank balances with stale values. Balances that did not change
+// are left alone so that accounts that were only read are not marked dirty.
+func reloadEvmDenomBalances(
+	ctx sdk.Context, k keepers.PublicKeepers, db *statedb.StateDB, addrs ...gethcommon.Address,
+) {
+	evmDenom := k.EvmKeeper.GetParams(ctx).EvmDenom
+	for _, addr := range addrs {
+		balance := k.BankKeeper.GetBalance(ctx, eth.EthAddrToNibiruAddr(addr), evmDenom)
+		balanceWei := evm.NativeToWei(balance.Amount.BigInt())
+		if db.GetBalance(addr).Cmp(balanceWei) == 0 {
+			continue
+		}
+		db.SetBalance(addr, balanceWei)
+	}
+}
+
+// emitEvmLog adds an EVM log for the ABI "event" emitted by the precompile at
+// "addr". The indexed arguments become topics, and the remaining arguments are
+// ABI-encoded as the log data.
+func emitEvmLog(
+	evmObj *vm.EVM,
+	addr gethcommon.Address,
+	event gethabi.Event,
+	indexedArgs []gethcommon.Hash,
+	nonIndexedArgs ...any,
+) error {
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexedArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to pack EVM log data for event %s: %w", event.Name, err)
+	}
+	evmObj.StateDB.AddLog(&gethcore.Log{
+		Address:     addr,
+		Topics:      append([]gethcommon.Hash{event.ID}, indexedArgs...),
+		Data:        data,
+		BlockNumber: evmObj.Context.BlockNumber.Uint64(),
+	})
+	return nil
+}
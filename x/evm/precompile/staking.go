@@ -0,0 +1,442 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileStaking)(nil)
+	_ NibiruPrecompile       = (*precompileStaking)(nil)
+)
+
+// Precompile address for "IStaking.sol", the contract that enables EVM
+// accounts and contracts to manage their own stake with the "x/staking" and
+// "x/distribution" modules.
+var PrecompileAddr_Staking = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000802",
+)
+
+func (p precompileStaking) Address() gethcommon.Address {
+	return PrecompileAddr_Staking.ToAddr()
+}
+
+const (
+	// gasCostStakingRead: Gas cost of the read-only methods of the staking
+	// precompile.
+	gasCostStakingRead uint64 = 2_000
+	// gasCostStakingWrite: Gas cost of the methods of the staking precompile
+	// that change the delegations or withdraw rewards of the caller.
+	gasCostStakingWrite uint64 = 50_000
+)
+
+func (p precompileStaking) RequiredGas(input []byte) (gasCost uint64) {
+	method, err := ABIMethodByID(p.ABI(), input)
+	if err != nil {
+		// Invalid input fails in "Run". Charge the cheaper cost here.
+		return gasCostStakingRead
+	}
+	if method.IsConstant() {
+		return gasCostStakingRead
+	}
+	return gasCostStakingWrite
+}
+
+const (
+	StakingMethod_Delegate        StakingMethod = "delegate"
+	StakingMethod_Undelegate      StakingMethod = "undelegate"
+	StakingMethod_Redelegate      StakingMethod = "redelegate"
+	StakingMethod_WithdrawRewards StakingMethod = "withdrawRewards"
+	StakingMethod_Delegation      StakingMethod = "delegation"
+	StakingMethod_Validator       StakingMethod = "validator"
+)
+
+type StakingMethod string
+
+// Run runs the precompiled contract
+func (p precompileStaking) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	contractInput := contract.Input
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = assertPrecompileActive(ctx, p.PublicKeepers, p.Address()); err != nil {
+		return nil, err
+	}
+
+	switch StakingMethod(method.Name) {
+	case StakingMethod_Delegation:
+		return p.delegation(ctx, method, args)
+	case StakingMethod_Validator:
+		return p.validator(ctx, method, args)
+	case StakingMethod_Delegate,
+		StakingMethod_Undelegate,
+		StakingMethod_Redelegate,
+		StakingMethod_WithdrawRewards:
+		if readonly {
+			// Check required for transactions but not needed for queries
+			return nil, fmt.Errorf("cannot write state from staticcall (a read-only call)")
+		}
+	default:
+		return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+	}
+
+	// The methods below move coins of the bond denom, which is also the EVM
+	// denom, so the StateDB and bank balances of the delegator and its rewards
+	// recipient have to be kept in sync.
+	delegator := contract.CallerAddress
+	stateDB := evm.StateDB.(*statedb.StateDB)
+	withdrawAddr := eth.NibiruAddrToEthAddr(
+		p.DistrKeeper.GetDelegatorWithdrawAddr(ctx, eth.EthAddrToNibiruAddr(delegator)),
+	)
+	if err = flushEvmDenomBalances(ctx, p.PublicKeepers, stateDB, delegator, withdrawAddr); err != nil {
+		return nil, err
+	}
+	defer reloadEvmDenomBalances(ctx, p.PublicKeepers, stateDB, delegator, withdrawAddr)
+
+	switch StakingMethod(method.Name) {
+	case StakingMethod_Delegate:
+		bz, err = p.delegate(ctx, evm, delegator, method, args)
+	case StakingMethod_Undelegate:
+		bz, err = p.undelegate(ctx, evm, delegator, method, args)
+	case StakingMethod_Redelegate:
+		bz, err = p.redelegate(ctx, evm, delegator, method, args)
+	case StakingMethod_WithdrawRewards:
+		bz, err = p.withdrawRewards(ctx, evm, delegator, method, args)
+	}
+	return
+}
+
+func PrecompileStaking(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileStaking{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileStaking) ABI() gethabi.ABI {
+	return embeds.Contract_Staking.ABI
+}
+
+type precompileStaking struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+/*
+delegate: Implements "IStaking.delegate"
+
+```solidity
+function delegate(string memory validator, uint256 amount) external;
+```
+*/
+func (p precompileStaking) delegate(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	delegator gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	if err = AssertArgCount(args, 2); err != nil {
+		return
+	}
+	validator, ok1 := args[0].(string)
+	amount, ok2 := args[1].(*big.Int)
+	if !(ok1 && ok2) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function delegate(string memory validator, uint256 amount) external")
+		return
+	}
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return
+	}
+
+	_, err = stakingkeeper.NewMsgServerImpl(p.StakingKeeper).Delegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(delegator).String(),
+			ValidatorAddress: validator,
+			Amount:           coin,
+		},
+	)
+	if err != nil {
+		return
+	}
+
+	err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["Delegate"],
+		[]gethcommon.Hash{gethcommon.BytesToHash(delegator.Bytes())},
+		validator, amount,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack()
+}
+
+/*
+undelegate: Implements "IStaking.undelegate"
+
+```solidity
+function undelegate(string memory validator, uint256 amount) external returns (int64 completionTime);
+```
+*/
+func (p precompileStaking) undelegate(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	delegator gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	if err = AssertArgCount(args, 2); err != nil {
+		return
+	}
+	validator, ok1 := args[0].(string)
+	amount, ok2 := args[1].(*big.Int)
+	if !(ok1 && ok2) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function undelegate(string memory validator, uint256 amount) external")
+		return
+	}
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return
+	}
+
+	resp, err := stakingkeeper.NewMsgServerImpl(p.StakingKeeper).Undelegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgUndelegate{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(delegator).String(),
+			ValidatorAddress: validator,
+			Amount:           coin,
+		},
+	)
+	if err != nil {
+		return
+	}
+	completionTime := resp.CompletionTime.Unix()
+
+	err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["Undelegate"],
+		[]gethcommon.Hash{gethcommon.BytesToHash(delegator.Bytes())},
+		validator, amount, completionTime,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+/*
+redelegate: Implements "IStaking.redelegate"
+
+```solidity
+function redelegate(string memory srcValidator, string memory dstValidator, uint256 amount) external returns (int64 completionTime);
+```
+*/
+func (p precompileStaking) redelegate(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	delegator gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	if err = AssertArgCount(args, 3); err != nil {
+		return
+	}
+	srcValidator, ok1 := args[0].(string)
+	dstValidator, ok2 := args[1].(string)
+	amount, ok3 := args[2].(*big.Int)
+	if !(ok1 && ok2 && ok3) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function redelegate(string memory srcValidator, string memory dstValidator, uint256 amount) external")
+		return
+	}
+	coin, err := p.bondCoin(ctx, amount)
+	if err != nil {
+		return
+	}
+
+	resp, err := stakingkeeper.NewMsgServerImpl(p.StakingKeeper).BeginRedelegate(
+		sdk.WrapSDKContext(ctx),
+		&stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    eth.EthAddrToNibiruAddr(delegator).String(),
+			ValidatorSrcAddress: srcValidator,
+			ValidatorDstAddress: dstValidator,
+			Amount:              coin,
+		},
+	)
+	if err != nil {
+		return
+	}
+	completionTime := resp.CompletionTime.Unix()
+
+	err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["Redelegate"],
+		[]gethcommon.Hash{gethcommon.BytesToHash(delegator.Bytes())},
+		srcValidator, dstValidator, amount, completionTime,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+/*
+withdrawRewards: Implements "IStaking.withdrawRewards"
+
+```solidity
+function withdrawRewards(string memory validator) external returns (uint256 amount);
+```
+*/
+func (p precompileStaking) withdrawRewards(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	delegator gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	validator, err := assertArgValidator(args, "function withdrawRewards(string memory validator) external")
+	if err != nil {
+		return
+	}
+
+	resp, err := distrkeeper.NewMsgServerImpl(p.DistrKeeper).WithdrawDelegatorReward(
+		sdk.WrapSDKContext(ctx),
+		&distrtypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(delegator).String(),
+			ValidatorAddress: validator,
+		},
+	)
+	if err != nil {
+		return
+	}
+	amount := resp.Amount.AmountOf(p.StakingKeeper.BondDenom(ctx)).BigInt()
+
+	err = emitEvmLog(evmObj, p.Address(), p.ABI().Events["WithdrawRewards"],
+		[]gethcommon.Hash{gethcommon.BytesToHash(delegator.Bytes())},
+		validator, amount,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(amount)
+}
+
+/*
+delegation: Implements "IStaking.delegation". Zero values are returned if the
+delegation does not exist.
+
+```solidity
+function delegation(address delegator, string memory validator) external view returns (uint256 shares, uint256 balance);
+```
+*/
+func (p precompileStaking) delegation(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	if err = AssertArgCount(args, 2); err != nil {
+		return
+	}
+	delegator, ok1 := args[0].(gethcommon.Address)
+	validator, ok2 := args[1].(string)
+	if !(ok1 && ok2) {
+		err = fmt.Errorf("type validation failed for \"%s\"",
+			"function delegation(address delegator, string memory validator)")
+		return
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return
+	}
+
+	val, found := p.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		err = fmt.Errorf("validator \"%s\" not found", validator)
+		return
+	}
+	del, found := p.StakingKeeper.GetDelegation(ctx, eth.EthAddrToNibiruAddr(delegator), valAddr)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+	}
+	balance := val.TokensFromShares(del.Shares).TruncateInt()
+	return method.Outputs.Pack(del.Shares.BigInt(), balance.BigInt())
+}
+
+/*
+validator: Implements "IStaking.validator"
+
+```solidity
+function validator(string memory validator) external view returns (bool jailed, uint8 status, uint256 tokens, uint256 delegatorShares, uint256 commissionRate);
+```
+*/
+func (p precompileStaking) validator(
+	ctx sdk.Context, method *gethabi.Method, args []any,
+) (bz []byte, err error) {
+	validator, err := assertArgValidator(args, "function validator(string memory validator)")
+	if err != nil {
+		return
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return
+	}
+
+	val, found := p.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		err = fmt.Errorf("validator \"%s\" not found", validator)
+		return
+	}
+	return method.Outputs.Pack(
+		val.Jailed,
+		uint8(val.Status),
+		val.Tokens.BigInt(),
+		val.DelegatorShares.BigInt(),
+		val.Commission.Rate.BigInt(),
+	)
+}
+
+// bondCoin: Returns a coin of the bond denom, requiring a positive amount.
+func (p precompileStaking) bondCoin(ctx sdk.Context, amount *big.Int) (coin sdk.Coin, err error) {
+	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
+		return coin, fmt.Errorf("amount must be positive")
+	}
+	return sdk.NewCoin(p.StakingKeeper.BondDenom(ctx), math.NewIntFromBigInt(amount)), nil
+}
+
+// assertArgValidator: Type validation for methods that take a single
+// validator address as their argument.
+func assertArgValidator(args []any, signature string) (validator string, err error) {
+	if err = AssertArgCount(args, 1); err != nil {
+		return
+	}
+	validator, ok := args[0].(string)
+	if !ok {
+		err = fmt.Errorf("type validation failed for \"%s\"", signature)
+	}
+	return
+}
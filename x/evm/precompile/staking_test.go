@@ -0,0 +1,167 @@
+package precompile_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+func (s *Suite) TestPrecompile_Staking() {
+	s.Run("PrecompileExists", s.Staking_PrecompileExists)
+	s.Run("HappyPath", s.Staking_HappyPath)
+	s.Run("SadPaths", s.Staking_SadPaths)
+	s.Run("RevertedDelegate", s.Staking_RevertedDelegate)
+}
+
+// stakingCall: Calls the staking precompile and returns the EVM response
+// along with the unpacked outputs.
+func stakingCall(
+	deps *evmtest.TestDeps,
+	from gethcommon.Address,
+	commit bool,
+	method precompile.StakingMethod,
+	args ...any,
+) (evmResp *evm.MsgEthereumTxResponse, out []any, err error) {
+	abi := embeds.Contract_Staking.ABI
+	input, err := abi.Pack(string(method), args...)
+	if err != nil {
+		return
+	}
+	contractAddr := precompile.PrecompileAddr_Staking.ToAddr()
+	evmResp, err = deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, from, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return
+	}
+	out, err = abi.Unpack(string(method), evmResp.Ret)
+	return
+}
+
+func (s *Suite) Staking_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	precompileAddr := precompile.PrecompileAddr_Staking
+
+	s.True(deps.EvmKeeper.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+	s.True(deps.EvmKeeper.GetParams(deps.Ctx).IsActivePrecompile(precompileAddr.String()),
+		"staking precompile should be active by default")
+}
+
+func (s *Suite) Staking_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	bondDenom := deps.Chain.StakingKeeper.BondDenom(deps.Ctx)
+	valoper := deps.Chain.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	validator := valoper.OperatorAddress
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000)),
+	))
+
+	s.T().Log("validator view")
+	_, out, err := stakingCall(&deps, theUser, false, precompile.StakingMethod_Validator, validator)
+	s.Require().NoError(err)
+	s.Equal(valoper.Jailed, out[0].(bool))
+	s.Equal(uint8(valoper.Status), out[1].(uint8))
+	s.Equal(valoper.Tokens.String(), out[2].(*big.Int).String())
+
+	s.T().Log("delegation view - no delegation yet")
+	_, out, err = stakingCall(&deps, theUser, false, precompile.StakingMethod_Delegation, theUser, validator)
+	s.Require().NoError(err)
+	s.Equal("0", out[0].(*big.Int).String())
+	s.Equal("0", out[1].(*big.Int).String())
+
+	s.T().Log("delegate")
+	evmResp, _, err := stakingCall(&deps, theUser, true, precompile.StakingMethod_Delegate,
+		validator, big.NewInt(600))
+	s.Require().NoError(err)
+	s.Require().Len(evmResp.Logs, 1)
+	s.Equal(embeds.Contract_Staking.ABI.Events["Delegate"].ID.Hex(), evmResp.Logs[0].Topics[0])
+	s.Equal(gethcommon.BytesToHash(theUser.Bytes()).Hex(), evmResp.Logs[0].Topics[1])
+	s.Equal("400",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, bondDenom).Amount.String(),
+		"StateDB.Commit should not undo the delegation")
+
+	_, out, err = stakingCall(&deps, theUser, false, precompile.StakingMethod_Delegation, theUser, validator)
+	s.Require().NoError(err)
+	wantShares, err := valoper.SharesFromTokens(sdkmath.NewInt(600))
+	s.Require().NoError(err)
+	s.Equal(wantShares.BigInt().String(), out[0].(*big.Int).String())
+	s.Equal("600", out[1].(*big.Int).String())
+
+	s.T().Log("withdrawRewards")
+	evmResp, _, err = stakingCall(&deps, theUser, true, precompile.StakingMethod_WithdrawRewards, validator)
+	s.Require().NoError(err)
+	s.Require().Len(evmResp.Logs, 1)
+	s.Equal(embeds.Contract_Staking.ABI.Events["WithdrawRewards"].ID.Hex(), evmResp.Logs[0].Topics[0])
+
+	s.T().Log("undelegate")
+	evmResp, out, err = stakingCall(&deps, theUser, true, precompile.StakingMethod_Undelegate,
+		validator, big.NewInt(100))
+	s.Require().NoError(err)
+	s.Require().Len(evmResp.Logs, 1)
+	s.Equal(embeds.Contract_Staking.ABI.Events["Undelegate"].ID.Hex(), evmResp.Logs[0].Topics[0])
+	s.Greater(out[0].(int64), deps.Ctx.BlockTime().Unix())
+
+	_, out, err = stakingCall(&deps, theUser, false, precompile.StakingMethod_Delegation, theUser, validator)
+	s.Require().NoError(err)
+	s.Equal("500", out[1].(*big.Int).String())
+}
+
+func (s *Suite) Staking_SadPaths() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	validator := deps.Chain.StakingKeeper.GetValidators(deps.Ctx, 1)[0].OperatorAddress
+
+	s.T().Log("sad: non-positive amount")
+	_, _, err := stakingCall(&deps, theUser, true, precompile.StakingMethod_Delegate,
+		validator, big.NewInt(0))
+	s.ErrorContains(err, "amount must be positive")
+
+	s.T().Log("sad: insufficient funds")
+	_, _, err = stakingCall(&deps, theUser, true, precompile.StakingMethod_Delegate,
+		validator, big.NewInt(1_000_000))
+	s.ErrorContains(err, "insufficient funds")
+
+	s.T().Log("sad: redelegate without a delegation")
+	_, _, err = stakingCall(&deps, theUser, true, precompile.StakingMethod_Redelegate,
+		validator, validator, big.NewInt(1))
+	s.ErrorContains(err, "no delegation")
+
+	s.T().Log("sad: unknown validator")
+	_, _, err = stakingCall(&deps, theUser, false, precompile.StakingMethod_Validator,
+		"nibivaloper1invalid")
+	s.ErrorContains(err, "precompile error")
+}
+
+func (s *Suite) Staking_RevertedDelegate() {
+	deps := evmtest.NewTestDeps()
+	bondDenom := deps.Chain.StakingKeeper.BondDenom(deps.Ctx)
+	valoper := deps.Chain.StakingKeeper.GetValidators(deps.Ctx, 1)[0]
+	caller := evmtest.NewEthAccInfo().EthAddr
+	callerAcc := eth.EthAddrToNibiruAddr(caller)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000))
+	s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, callerAcc, coins))
+
+	input, err := embeds.Contract_Staking.ABI.Pack(string(precompile.StakingMethod_Delegate),
+		valoper.OperatorAddress, big.NewInt(600))
+	s.Require().NoError(err)
+	s.callThenRevert(&deps, caller, precompile.PrecompileAddr_Staking.ToAddr(), input)
+
+	s.Equal(coins, deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, callerAcc))
+	s.Empty(deps.Chain.StakingKeeper.GetAllDelegatorDelegations(deps.Ctx, callerAcc))
+	valAfter, found := deps.Chain.StakingKeeper.GetValidator(deps.Ctx, valoper.GetOperator())
+	s.Require().True(found)
+	s.Equal(valoper.Tokens, valAfter.Tokens)
+}
@@ -0,0 +1,336 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/app/keepers"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/statedb"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileWasm)(nil)
+	_ NibiruPrecompile       = (*precompileWasm)(nil)
+)
+
+// Precompile address for "IWasm.sol", the contract that enables EVM contracts
+// to execute, query, and instantiate CosmWasm contracts.
+var PrecompileAddr_Wasm = eth.MustNewHexAddrFromStr(
+	"0x0000000000000000000000000000000000000803",
+)
+
+func (p precompileWasm) Address() gethcommon.Address {
+	return PrecompileAddr_Wasm.ToAddr()
+}
+
+// gasCostWasmBase: Base gas cost of a call to the wasm precompile. The gas
+// consumed by the Wasm VM and the Cosmos-SDK stores is charged on top of this
+// while the call runs.
+const gasCostWasmBase uint64 = 2_000
+
+func (p precompileWasm) RequiredGas(input []byte) (gasCost uint64) {
+	return gasCostWasmBase
+}
+
+const (
+	WasmMethod_Execute     WasmMethod = "execute"
+	WasmMethod_Query       WasmMethod = "query"
+	WasmMethod_Instantiate WasmMethod = "instantiate"
+)
+
+type WasmMethod string
+
+// WasmBankCoin: Go representation of the "IWasm.BankCoin" struct.
+type WasmBankCoin struct {
+	Denom  string   `abi:"denom"`
+	Amount *big.Int `abi:"amount"`
+}
+
+// Run runs the precompiled contract
+//
+// Each call runs on a cached context with a gas meter limited to the gas left
+// for the precompile call. The Cosmos-SDK gas consumed by the call is charged
+// to the EVM contract, and the state changes are only written if the call
+// succeeds, so a failed call leaves no partial Wasm state behind.
+func (p precompileWasm) Run(
+	evm *vm.EVM, contract *vm.Contract, readonly bool,
+) (bz []byte, err error) {
+	// This is a `defer` pattern to add behavior that runs in the case that the error is
+	// non-nil, creating a concise way to add extra information.
+	defer func() {
+		if err != nil {
+			precompileType := reflect.TypeOf(p).Name()
+			err = fmt.Errorf("precompile error: failed to run %s: %w", precompileType, err)
+		}
+	}()
+
+	contractInput := contract.Input
+	ctx, method, args, err := OnRunStart(p, evm, contractInput, readonly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = assertPrecompileActive(ctx, p.PublicKeepers, p.Address()); err != nil {
+		return nil, err
+	}
+
+	caller := contract.CallerAddress
+	switch WasmMethod(method.Name) {
+	case WasmMethod_Query:
+		return runWithGasLimit(ctx, contract, false, func(ctx sdk.Context) ([]byte, error) {
+			return p.query(ctx, method, args)
+		})
+	case WasmMethod_Execute, WasmMethod_Instantiate:
+		if readonly {
+			// Check required for transactions but not needed for queries
+			return nil, fmt.Errorf("cannot write state from staticcall (a read-only call)")
+		}
+	default:
+		return nil, fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+	}
+
+	// The Wasm contract can move coins of the EVM denom between any accounts,
+	// not only the caller's, so the bank module has to see every balance
+	// changed in the StateDB, and every account in the StateDB has to see the
+	// bank balances after the call.
+	stateDB := evm.StateDB.(*statedb.StateDB)
+	if err = flushEvmDenomBalances(
+		ctx, p.PublicKeepers, stateDB, append(stateDB.DirtyAddresses(), caller)...,
+	); err != nil {
+		return nil, err
+	}
+	defer func() {
+		reloadEvmDenomBalances(
+			ctx, p.PublicKeepers, stateDB, append(stateDB.CachedAddresses(), caller)...,
+		)
+	}()
+
+	return runWithGasLimit(ctx, contract, true, func(ctx sdk.Context) ([]byte, error) {
+		if WasmMethod(method.Name) == WasmMethod_Execute {
+			return p.execute(ctx, caller, method, args)
+		}
+		return p.instantiate(ctx, caller, method, args)
+	})
+}
+
+// runWithGasLimit runs "fn" on a cached context with a gas meter limited to
+// the gas left in the precompile "contract" and charges the gas consumed. The
+// cached state is written only if "commit" is true and "fn" succeeds.
+func runWithGasLimit(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	commit bool,
+	fn func(ctx sdk.Context) ([]byte, error),
+) (bz []byte, err error) {
+	gasMeter := sdk.NewGasMeter(contract.Gas)
+	cacheCtx, writeCache := ctx.WithGasMeter(gasMeter).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, isOutOfGas := r.(sdk.ErrorOutOfGas)
+			if !isOutOfGas {
+				panic(r)
+			}
+			contract.UseGas(contract.Gas)
+			err = fmt.Errorf("%w: %s", vm.ErrOutOfGas, outOfGas.Descriptor)
+		}
+	}()
+
+	bz, err = fn(cacheCtx)
+	if !contract.UseGas(gasMeter.GasConsumedToLimit()) {
+		return nil, vm.ErrOutOfGas
+	}
+	if err != nil {
+		return nil, err
+	}
+	if commit {
+		writeCache()
+	}
+	return bz, nil
+}
+
+func PrecompileWasm(keepers keepers.PublicKeepers) vm.PrecompiledContract {
+	return precompileWasm{
+		PublicKeepers: keepers,
+	}
+}
+
+func (p precompileWasm) ABI() gethabi.ABI {
+	return embeds.Contract_Wasm.ABI
+}
+
+type precompileWasm struct {
+	keepers.PublicKeepers
+	NibiruPrecompile
+}
+
+// contractKeeper: Returns the wasm keeper with the permissions used for
+// messages sent by accounts.
+func (p precompileWasm) contractKeeper() *wasmkeeper.PermissionedKeeper {
+	return wasmkeeper.NewDefaultPermissionKeeper(&p.WasmKeeper)
+}
+
+/*
+execute: Implements "IWasm.execute"
+
+```solidity
+function execute(string memory contractAddr, bytes memory msgJson, BankCoin[] memory funds) external returns (bytes memory response);
+```
+*/
+func (p precompileWasm) execute(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	var callArgs struct {
+		ContractAddr string         `abi:"contractAddr"`
+		MsgJson      []byte         `abi:"msgJson"`
+		Funds        []WasmBankCoin `abi:"funds"`
+	}
+	if err = method.Inputs.Copy(&callArgs, args); err != nil {
+		return nil, fmt.Errorf("type validation failed for \"%s\": %w", method.Sig, err)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callArgs.ContractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address \"%s\": %w", callArgs.ContractAddr, err)
+	}
+	funds, err := parseWasmFunds(callArgs.Funds)
+	if err != nil {
+		return
+	}
+
+	data, err := p.contractKeeper().Execute(
+		ctx, contractAddr, eth.EthAddrToNibiruAddr(caller), callArgs.MsgJson, funds,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(data)
+}
+
+/*
+query: Implements "IWasm.query"
+
+```solidity
+function query(string memory contractAddr, bytes memory reqJson) external view returns (bytes memory response);
+```
+*/
+func (p precompileWasm) query(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	var callArgs struct {
+		ContractAddr string `abi:"contractAddr"`
+		ReqJson      []byte `abi:"reqJson"`
+	}
+	if err = method.Inputs.Copy(&callArgs, args); err != nil {
+		return nil, fmt.Errorf("type validation failed for \"%s\": %w", method.Sig, err)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callArgs.ContractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address \"%s\": %w", callArgs.ContractAddr, err)
+	}
+
+	resp, err := p.WasmKeeper.QuerySmart(ctx, contractAddr, callArgs.ReqJson)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(resp)
+}
+
+/*
+instantiate: Implements "IWasm.instantiate"
+
+```solidity
+function instantiate(string memory admin, uint64 codeID, bytes memory msgJson, string memory label, BankCoin[] memory funds) external returns (string memory contractAddr, bytes memory data);
+```
+*/
+func (p precompileWasm) instantiate(
+	ctx sdk.Context,
+	caller gethcommon.Address,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	var callArgs struct {
+		Admin   string         `abi:"admin"`
+		CodeID  uint64         `abi:"codeID"`
+		MsgJson []byte         `abi:"msgJson"`
+		Label   string         `abi:"label"`
+		Funds   []WasmBankCoin `abi:"funds"`
+	}
+	if err = method.Inputs.Copy(&callArgs, args); err != nil {
+		return nil, fmt.Errorf("type validation failed for \"%s\": %w", method.Sig, err)
+	}
+	var admin sdk.AccAddress
+	if callArgs.Admin != "" {
+		admin, err = sdk.AccAddressFromBech32(callArgs.Admin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin address \"%s\": %w", callArgs.Admin, err)
+		}
+	}
+	funds, err := parseWasmFunds(callArgs.Funds)
+	if err != nil {
+		return
+	}
+
+	contractAddr, data, err := p.contractKeeper().Instantiate(
+		ctx,
+		callArgs.CodeID,
+		eth.EthAddrToNibiruAddr(caller),
+		admin,
+		callArgs.MsgJson,
+		callArgs.Label,
+		funds,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(contractAddr.String(), data)
+}
+
+// parseWasmFunds: Converts the "funds" argument of the wasm precompile into
+// valid bank coins.
+func parseWasmFunds(funds []WasmBankCoin) (coins sdk.Coins, err error) {
+	for _, fund := range funds {
+		if fund.Amount == nil || fund.Amount.Sign() < 0 {
+			return nil, errors.New("funds cannot have a negative amount")
+		}
+		coin := sdk.Coin{Denom: fund.Denom, Amount: math.NewIntFromBigInt(fund.Amount)}
+		if err = coin.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid funds: %w", err)
+		}
+		coins = coins.Add(coin)
+	}
+	return coins, nil
+}
+
+// ArgsWasmExecute: Constructor for an "args" array of arguments for the
+// "IWasm.execute" function.
+func ArgsWasmExecute(
+	contractAddr sdk.AccAddress, msgJson []byte, funds sdk.Coins,
+) []any {
+	return []any{contractAddr.String(), msgJson, WasmBankCoinsFromCoins(funds)}
+}
+
+// WasmBankCoinsFromCoins: Converts bank coins into the "IWasm.BankCoin[]"
+// argument type.
+func WasmBankCoinsFromCoins(coins sdk.Coins) []WasmBankCoin {
+	out := make([]WasmBankCoin, len(coins))
+	for i, coin := range coins {
+		out[i] = WasmBankCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return out
+}
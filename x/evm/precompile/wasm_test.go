@@ -0,0 +1,262 @@
+package precompile_test
+
+import (
+	// The `_ "embed"` import adds access to files embedded in the running Go
+	// program (smart contracts).
+	_ "embed"
+	"encoding/json"
+	"math/big"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	srvconfig "github.com/NibiruChain/nibiru/app/server/config"
+	"github.com/NibiruChain/nibiru/eth"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
+	"github.com/NibiruChain/nibiru/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/x/evm/precompile"
+)
+
+// wasmNameservice: The "cw_nameservice" example contract from CosmWasm. It
+// charges a purchase price in funds to register a name.
+//
+//go:embed testdata/cw_nameservice.wasm
+var wasmNameservice []byte
+
+// wasmHackatom: The "hackatom" test contract from wasmd. Its "release" message
+// sends all of its funds to the beneficiary set at instantiation.
+//
+//go:embed testdata/hackatom.wasm
+var wasmHackatom []byte
+
+func (s *Suite) TestPrecompile_Wasm() {
+	s.Run("PrecompileExists", s.Wasm_PrecompileExists)
+	s.Run("HappyPath", s.Wasm_HappyPath)
+	s.Run("SadPaths", s.Wasm_SadPaths)
+	s.Run("RevertedExecute", s.Wasm_RevertedExecute)
+	s.Run("PaysDirtyAccount", s.Wasm_PaysDirtyAccount)
+}
+
+// wasmCall: Calls the wasm precompile and returns the unpacked outputs.
+func wasmCall(
+	deps *evmtest.TestDeps,
+	from gethcommon.Address,
+	commit bool,
+	method precompile.WasmMethod,
+	args ...any,
+) (out []any, err error) {
+	abi := embeds.Contract_Wasm.ABI
+	input, err := abi.Pack(string(method), args...)
+	if err != nil {
+		return
+	}
+	contractAddr := precompile.PrecompileAddr_Wasm.ToAddr()
+	evmResp, err := deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, from, &contractAddr, commit, input,
+	)
+	if err != nil {
+		return
+	}
+	return abi.Unpack(string(method), evmResp.Ret)
+}
+
+// setupNameservice: Stores the nameservice code and instantiates it with the
+// wasm precompile. Registering a name costs 100 of the EVM denom.
+func (s *Suite) setupNameservice(deps *evmtest.TestDeps) (contractAddr sdk.AccAddress) {
+	codeID, _, err := wasmkeeper.NewDefaultPermissionKeeper(&deps.Chain.WasmKeeper).Create(
+		deps.Ctx, deps.Sender.NibiruAddr, wasmNameservice, nil,
+	)
+	s.Require().NoError(err)
+
+	evmDenom := deps.EvmKeeper.GetParams(deps.Ctx).EvmDenom
+	instantiateMsg, err := json.Marshal(map[string]any{
+		"purchase_price": sdk.NewInt64Coin(evmDenom, 100),
+		"transfer_price": sdk.NewInt64Coin(evmDenom, 999),
+	})
+	s.Require().NoError(err)
+
+	out, err := wasmCall(deps, deps.Sender.EthAddr, true, precompile.WasmMethod_Instantiate,
+		"", codeID, instantiateMsg, "nameservice", []precompile.WasmBankCoin{},
+	)
+	s.Require().NoError(err)
+	contractAddr, err = sdk.AccAddressFromBech32(out[0].(string))
+	s.Require().NoError(err)
+
+	contractInfo := deps.Chain.WasmKeeper.GetContractInfo(deps.Ctx, contractAddr)
+	s.Require().NotNil(contractInfo)
+	s.Equal(deps.Sender.NibiruAddr.String(), contractInfo.Creator)
+	return contractAddr
+}
+
+func (s *Suite) Wasm_PrecompileExists() {
+	deps := evmtest.NewTestDeps()
+	precompileAddr := precompile.PrecompileAddr_Wasm
+
+	s.True(deps.EvmKeeper.PrecompileSet().Has(precompileAddr.ToAddr()),
+		"did not see precompile address during \"InitPrecompiles\"")
+	s.True(deps.EvmKeeper.GetParams(deps.Ctx).IsActivePrecompile(precompileAddr.String()),
+		"wasm precompile should be active by default")
+}
+
+func (s *Suite) Wasm_HappyPath() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	evmDenom := deps.EvmKeeper.GetParams(deps.Ctx).EvmDenom
+	contractAddr := s.setupNameservice(&deps)
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000)),
+	))
+
+	s.T().Log("execute: register a name with funds in the EVM denom")
+	_, err := wasmCall(&deps, theUser, true, precompile.WasmMethod_Execute,
+		precompile.ArgsWasmExecute(
+			contractAddr,
+			[]byte(`{"register": {"name": "nibiru"}}`),
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 100)),
+		)...,
+	)
+	s.Require().NoError(err)
+	s.Equal("900",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, deps.Sender.NibiruAddr, evmDenom).Amount.String(),
+		"StateDB.Commit should not undo the funds sent")
+	s.Equal("100",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, contractAddr, evmDenom).Amount.String())
+
+	s.T().Log("query: resolve the registered name")
+	out, err := wasmCall(&deps, theUser, false, precompile.WasmMethod_Query,
+		contractAddr.String(), []byte(`{"resolve_record": {"name": "nibiru"}}`),
+	)
+	s.Require().NoError(err)
+	s.JSONEq(
+		`{"address": "`+deps.Sender.NibiruAddr.String()+`"}`,
+		string(out[0].([]byte)),
+	)
+}
+
+func (s *Suite) Wasm_SadPaths() {
+	deps := evmtest.NewTestDeps()
+	theUser := deps.Sender.EthAddr
+	contractAddr := s.setupNameservice(&deps)
+
+	s.T().Log("sad: execute fails without the purchase price")
+	_, err := wasmCall(&deps, theUser, true, precompile.WasmMethod_Execute,
+		precompile.ArgsWasmExecute(
+			contractAddr, []byte(`{"register": {"name": "nibiru"}}`), sdk.NewCoins(),
+		)...,
+	)
+	s.ErrorContains(err, "precompile error")
+
+	out, err := wasmCall(&deps, theUser, false, precompile.WasmMethod_Query,
+		contractAddr.String(), []byte(`{"resolve_record": {"name": "nibiru"}}`),
+	)
+	s.Require().NoError(err)
+	s.JSONEq(`{"address": null}`, string(out[0].([]byte)))
+
+	s.T().Log("sad: invalid contract address")
+	_, err = wasmCall(&deps, theUser, false, precompile.WasmMethod_Query,
+		"not-an-address", []byte(`{}`),
+	)
+	s.ErrorContains(err, "invalid contract address")
+
+	s.T().Log("sad: invalid funds")
+	_, err = wasmCall(&deps, theUser, true, precompile.WasmMethod_Execute,
+		contractAddr.String(), []byte(`{"register": {"name": "nibiru"}}`),
+		[]precompile.WasmBankCoin{{Denom: "!nibi", Amount: big.NewInt(100)}},
+	)
+	s.ErrorContains(err, "invalid funds")
+}
+
+func (s *Suite) Wasm_RevertedExecute() {
+	deps := evmtest.NewTestDeps()
+	evmDenom := deps.EvmKeeper.GetParams(deps.Ctx).EvmDenom
+	contractAddr := s.setupNameservice(&deps)
+	caller := evmtest.NewEthAccInfo().EthAddr
+	callerAcc := eth.EthAddrToNibiruAddr(caller)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000))
+	s.Require().NoError(testapp.FundAccount(deps.Chain.BankKeeper, deps.Ctx, callerAcc, coins))
+
+	input, err := embeds.Contract_Wasm.ABI.Pack(string(precompile.WasmMethod_Execute),
+		precompile.ArgsWasmExecute(
+			contractAddr,
+			[]byte(`{"register": {"name": "nibiru"}}`),
+			sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 100)),
+		)...,
+	)
+	s.Require().NoError(err)
+	s.callThenRevert(&deps, caller, precompile.PrecompileAddr_Wasm.ToAddr(), input)
+
+	s.Equal(coins, deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, callerAcc))
+	s.True(deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, contractAddr).IsZero())
+	out, err := wasmCall(&deps, deps.Sender.EthAddr, false, precompile.WasmMethod_Query,
+		contractAddr.String(), []byte(`{"resolve_record": {"name": "nibiru"}}`),
+	)
+	s.Require().NoError(err)
+	s.JSONEq(`{"address": null}`, string(out[0].([]byte)), "the name should not be registered")
+}
+
+// Wasm_PaysDirtyAccount: A Wasm contract that sends the EVM denom to an
+// account changed earlier in the same EVM transaction must not have the
+// payment undone by "StateDB.Commit".
+func (s *Suite) Wasm_PaysDirtyAccount() {
+	deps := evmtest.NewTestDeps()
+	evmDenom := deps.EvmKeeper.GetParams(deps.Ctx).EvmDenom
+	beneficiary := evmtest.NewEthAccInfo()
+	s.Require().NoError(testapp.FundAccount(
+		deps.Chain.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 1_000)),
+	))
+
+	codeID, _, err := wasmkeeper.NewDefaultPermissionKeeper(&deps.Chain.WasmKeeper).Create(
+		deps.Ctx, deps.Sender.NibiruAddr, wasmHackatom, nil,
+	)
+	s.Require().NoError(err)
+	instantiateMsg, err := json.Marshal(map[string]any{
+		"verifier":    deps.Sender.NibiruAddr.String(),
+		"beneficiary": beneficiary.NibiruAddr.String(),
+	})
+	s.Require().NoError(err)
+	out, err := wasmCall(&deps, deps.Sender.EthAddr, true, precompile.WasmMethod_Instantiate,
+		"", codeID, instantiateMsg, "hackatom",
+		[]precompile.WasmBankCoin{{Denom: evmDenom, Amount: big.NewInt(100)}},
+	)
+	s.Require().NoError(err)
+	contractAddr, err := sdk.AccAddressFromBech32(out[0].(string))
+	s.Require().NoError(err)
+
+	s.T().Log("release the funds while the beneficiary is dirty in the StateDB")
+	db := deps.StateDB()
+	db.SetNonce(beneficiary.EthAddr, 1)
+	cfg, err := deps.EvmKeeper.GetEVMConfig(
+		deps.Ctx, sdk.ConsAddress(deps.Ctx.BlockHeader().ProposerAddress), deps.EvmKeeper.EthChainID(deps.Ctx),
+	)
+	s.Require().NoError(err)
+	precompileAddr := precompile.PrecompileAddr_Wasm.ToAddr()
+	evmMsg := gethcore.NewMessage(
+		deps.Sender.EthAddr, &precompileAddr, 0, big.NewInt(0), srvconfig.DefaultEthCallGasLimit,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, gethcore.AccessList{}, false,
+	)
+	evmObj := deps.EvmKeeper.NewEVM(deps.Ctx, evmMsg, cfg, evm.NewNoOpTracer(), db)
+	input, err := embeds.Contract_Wasm.ABI.Pack(string(precompile.WasmMethod_Execute),
+		precompile.ArgsWasmExecute(contractAddr, []byte(`{"release": {}}`), sdk.NewCoins())...,
+	)
+	s.Require().NoError(err)
+	_, _, err = evmObj.Call(
+		vm.AccountRef(deps.Sender.EthAddr), precompileAddr, input,
+		srvconfig.DefaultEthCallGasLimit, big.NewInt(0),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(db.Commit())
+
+	s.Equal("100",
+		deps.Chain.BankKeeper.GetBalance(deps.Ctx, beneficiary.NibiruAddr, evmDenom).Amount.String(),
+		"StateDB.Commit should not undo the payment")
+	s.True(deps.Chain.BankKeeper.GetAllBalances(deps.Ctx, contractAddr).IsZero())
+	s.Equal(uint64(1), deps.EvmKeeper.GetAccNonce(deps.Ctx, beneficiary.EthAddr))
+}
@@ -1388,6 +1388,272 @@ func (m *QueryFunTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFunTokenMappingResponse proto.InternalMessageInfo
 
+type QueryVerifiedFunTokensRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerifiedFunTokensRequest) Reset()         { *m = QueryVerifiedFunTokensRequest{} }
+func (m *QueryVerifiedFunTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedFunTokensRequest) ProtoMessage()    {}
+func (*QueryVerifiedFunTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
+}
+func (m *QueryVerifiedFunTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedFunTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedFunTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifiedFunTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedFunTokensRequest.Merge(m, src)
+}
+func (m *QueryVerifiedFunTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedFunTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedFunTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifiedFunTokensRequest proto.InternalMessageInfo
+
+func (m *QueryVerifiedFunTokensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVerifiedFunTokensResponse struct {
+	// fun_tokens: The verified "FunToken" mappings.
+	FunTokens []FunToken `protobuf:"bytes,1,rep,name=fun_tokens,json=funTokens,proto3" json:"fun_tokens"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVerifiedFunTokensResponse) Reset()         { *m = QueryVerifiedFunTokensResponse{} }
+func (m *QueryVerifiedFunTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifiedFunTokensResponse) ProtoMessage()    {}
+func (*QueryVerifiedFunTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
+}
+func (m *QueryVerifiedFunTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifiedFunTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifiedFunTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifiedFunTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifiedFunTokensResponse.Merge(m, src)
+}
+func (m *QueryVerifiedFunTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifiedFunTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifiedFunTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifiedFunTokensResponse proto.InternalMessageInfo
+
+func (m *QueryVerifiedFunTokensResponse) GetFunTokens() []FunToken {
+	if m != nil {
+		return m.FunTokens
+	}
+	return nil
+}
+
+func (m *QueryVerifiedFunTokensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFunTokenSupplyDiscrepanciesRequest struct {
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) Reset() {
+	*m = QueryFunTokenSupplyDiscrepanciesRequest{}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenSupplyDiscrepanciesRequest) ProtoMessage()    {}
+func (*QueryFunTokenSupplyDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesRequest.Merge(m, src)
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesRequest proto.InternalMessageInfo
+
+type QueryFunTokenSupplyDiscrepanciesResponse struct {
+	// reports: One supply report per "FunToken" mapping.
+	Reports []FunTokenSupplyReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
+	// broken: True if any FunToken has less escrowed than circulating.
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) Reset() {
+	*m = QueryFunTokenSupplyDiscrepanciesResponse{}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenSupplyDiscrepanciesResponse) ProtoMessage()    {}
+func (*QueryFunTokenSupplyDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesResponse.Merge(m, src)
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenSupplyDiscrepanciesResponse proto.InternalMessageInfo
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) GetReports() []FunTokenSupplyReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+// FunTokenSupplyReport compares the escrow held by the EVM module for a
+// "FunToken" against the supply it backs.
+//
+// For a FunToken made from a bank coin, the ERC20 total supply circulates and
+// is backed by the coins escrowed in the EVM module account. For a FunToken
+// made from an ERC20, the bank supply circulates and is backed by the ERC20
+// tokens escrowed in the EVM module account.
+type FunTokenSupplyReport struct {
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	// bank_supply: Total bank supply of the FunToken bank denom.
+	BankSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bank_supply,json=bankSupply,proto3,customtype=cosmossdk.io/math.Int" json:"bank_supply"`
+	// erc20_total_supply: "ERC20.totalSupply" of the FunToken contract.
+	Erc20TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=erc20_total_supply,json=erc20TotalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_total_supply"`
+	// escrowed: Amount of the origin representation held by the EVM module.
+	Escrowed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=escrowed,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed"`
+	// circulating: Amount of the non-origin representation that must be backed
+	// by the escrow.
+	Circulating cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=circulating,proto3,customtype=cosmossdk.io/math.Int" json:"circulating"`
+	// discrepancy: "escrowed - circulating". A negative value means the
+	// circulating supply is not fully backed.
+	Discrepancy cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=discrepancy,proto3,customtype=cosmossdk.io/math.Int" json:"discrepancy"`
+	// error: Set if the ERC20 state of the FunToken could not be read. Such a
+	// report does not break the invariant.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FunTokenSupplyReport) Reset()         { *m = FunTokenSupplyReport{} }
+func (m *FunTokenSupplyReport) String() string { return proto.CompactTextString(m) }
+func (*FunTokenSupplyReport) ProtoMessage()    {}
+func (*FunTokenSupplyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{30}
+}
+func (m *FunTokenSupplyReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunTokenSupplyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunTokenSupplyReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunTokenSupplyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunTokenSupplyReport.Merge(m, src)
+}
+func (m *FunTokenSupplyReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunTokenSupplyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunTokenSupplyReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunTokenSupplyReport proto.InternalMessageInfo
+
+func (m *FunTokenSupplyReport) GetFunToken() FunToken {
+	if m != nil {
+		return m.FunToken
+	}
+	return FunToken{}
+}
+
+func (m *FunTokenSupplyReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
 	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
@@ -1415,111 +1681,133 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryFunTokenMappingRequest)(nil), "eth.evm.v1.QueryFunTokenMappingRequest")
 	proto.RegisterType((*QueryFunTokenMappingResponse)(nil), "eth.evm.v1.QueryFunTokenMappingResponse")
+	proto.RegisterType((*QueryVerifiedFunTokensRequest)(nil), "eth.evm.v1.QueryVerifiedFunTokensRequest")
+	proto.RegisterType((*QueryVerifiedFunTokensResponse)(nil), "eth.evm.v1.QueryVerifiedFunTokensResponse")
+	proto.RegisterType((*QueryFunTokenSupplyDiscrepanciesRequest)(nil), "eth.evm.v1.QueryFunTokenSupplyDiscrepanciesRequest")
+	proto.RegisterType((*QueryFunTokenSupplyDiscrepanciesResponse)(nil), "eth.evm.v1.QueryFunTokenSupplyDiscrepanciesResponse")
+	proto.RegisterType((*FunTokenSupplyReport)(nil), "eth.evm.v1.FunTokenSupplyReport")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x1b, 0x8e, 0x63, 0x27, 0x76, 0x5e, 0xa7, 0x4d, 0xbe, 0x69, 0xda, 0x24, 0x6e, 0x62, 0x27, 0x9b,
-	0xaf, 0x49, 0xda, 0xaf, 0xdd, 0xfd, 0x12, 0x10, 0x88, 0x8a, 0x0a, 0xd5, 0x51, 0x1a, 0x4a, 0x7f,
-	0xa8, 0x35, 0x11, 0x48, 0x20, 0x64, 0x8d, 0xd7, 0x93, 0xf5, 0x2a, 0xde, 0x1d, 0x77, 0x67, 0x9c,
-	0x3a, 0x94, 0x5c, 0xe8, 0x05, 0x09, 0x21, 0x2a, 0xf1, 0x0f, 0xf4, 0xc4, 0xbf, 0xc0, 0xbf, 0xd0,
-	0x63, 0x25, 0x84, 0x84, 0x38, 0x14, 0xd4, 0x72, 0xe0, 0xcc, 0x91, 0x13, 0x9a, 0xd9, 0x99, 0x78,
-	0xbd, 0x5e, 0x27, 0x94, 0x1f, 0x37, 0x4e, 0xbb, 0x33, 0xf3, 0xce, 0xfb, 0x3c, 0xf3, 0xeb, 0x7d,
-	0x1e, 0x38, 0x43, 0x78, 0xc3, 0x22, 0x7b, 0x9e, 0xb5, 0xb7, 0x66, 0xdd, 0x6b, 0x93, 0x60, 0xdf,
-	0x6c, 0x05, 0x94, 0x53, 0x04, 0x84, 0x37, 0x4c, 0xb2, 0xe7, 0x99, 0x7b, 0x6b, 0x85, 0x0b, 0x36,
-	0x65, 0x1e, 0x65, 0x56, 0x0d, 0x33, 0x12, 0x06, 0x59, 0x7b, 0x6b, 0x35, 0xc2, 0xf1, 0x9a, 0xd5,
-	0xc2, 0x8e, 0xeb, 0x63, 0xee, 0x52, 0x3f, 0x9c, 0x57, 0x98, 0x8a, 0xe4, 0x13, 0xd3, 0xc3, 0xde,
-	0x53, 0x91, 0x5e, 0xde, 0xd1, 0xa1, 0x0e, 0x75, 0xa8, 0xfc, 0xb5, 0xc4, 0x9f, 0xea, 0x9d, 0x73,
-	0x28, 0x75, 0x9a, 0xc4, 0xc2, 0x2d, 0xd7, 0xc2, 0xbe, 0x4f, 0xb9, 0xcc, 0xce, 0xd4, 0x68, 0x49,
-	0x8d, 0xca, 0x56, 0xad, 0xbd, 0x63, 0x71, 0xd7, 0x23, 0x8c, 0x63, 0xaf, 0x15, 0x06, 0x18, 0x6f,
-	0xc2, 0x99, 0xbb, 0x82, 0xe1, 0x26, 0x6f, 0x5c, 0xb5, 0x6d, 0xda, 0xf6, 0x79, 0x85, 0xdc, 0x6b,
-	0x13, 0xc6, 0xd1, 0x0c, 0x64, 0x71, 0xbd, 0x1e, 0x10, 0xc6, 0x66, 0x52, 0x0b, 0xa9, 0xd5, 0xb1,
-	0x8a, 0x6e, 0x5e, 0xce, 0x7d, 0xf6, 0xb8, 0x34, 0xf4, 0xcb, 0xe3, 0xd2, 0x90, 0xe1, 0xc1, 0x74,
-	0xdf, 0x6c, 0xd6, 0xa2, 0x3e, 0x23, 0xa8, 0x04, 0xf9, 0x1a, 0x6e, 0x62, 0xdf, 0x26, 0xd5, 0xfb,
-	0xc4, 0x55, 0x29, 0x40, 0x75, 0xbd, 0x4f, 0x5c, 0x74, 0x16, 0xc6, 0x6c, 0x5a, 0x27, 0xd5, 0x06,
-	0x66, 0x8d, 0x99, 0x61, 0x39, 0x9c, 0x13, 0x1d, 0x6f, 0x63, 0xd6, 0x40, 0x53, 0x30, 0xe2, 0x53,
-	0xdf, 0x26, 0x33, 0xe9, 0x85, 0xd4, 0x6a, 0xa6, 0x12, 0x36, 0x8c, 0xb7, 0x60, 0x56, 0xc2, 0xdd,
-	0x76, 0x6b, 0x6e, 0xd0, 0xfe, 0x13, 0x7c, 0xf7, 0xa1, 0x90, 0x94, 0x40, 0x51, 0x1e, 0x98, 0x01,
-	0x15, 0x20, 0xc7, 0x04, 0x8c, 0x60, 0x34, 0x2c, 0x19, 0x1d, 0xb6, 0xd1, 0x39, 0x38, 0x89, 0xc3,
-	0x44, 0x55, 0xbf, 0xed, 0xd5, 0x48, 0xa0, 0x38, 0x9f, 0x50, 0xbd, 0xb7, 0x65, 0xa7, 0x71, 0x03,
-	0xe6, 0x24, 0xf4, 0x7b, 0xb8, 0xe9, 0xd6, 0x31, 0xa7, 0x41, 0x8c, 0xfe, 0x22, 0x8c, 0xdb, 0xd4,
-	0x67, 0xd5, 0x5e, 0x06, 0x79, 0xd1, 0x77, 0xb5, 0x6f, 0x1d, 0x9f, 0xa7, 0x60, 0x7e, 0x40, 0x36,
-	0xb5, 0x96, 0x15, 0x98, 0xd0, 0xac, 0x7a, 0x33, 0x6a, 0xb2, 0x57, 0xff, 0xbe, 0xa5, 0xbd, 0x01,
-	0xa7, 0x24, 0x99, 0x72, 0x78, 0xb8, 0x2f, 0x73, 0x20, 0x77, 0x61, 0xaa, 0x77, 0x6a, 0xf7, 0x28,
-	0xd4, 0x55, 0xd1, 0x73, 0x55, 0x33, 0x7e, 0xaf, 0x86, 0xe3, 0xf7, 0xca, 0xb8, 0xa1, 0xd8, 0xbc,
-	0xcb, 0x69, 0x80, 0x9d, 0xe3, 0xd9, 0xa0, 0x49, 0x48, 0xef, 0x92, 0x7d, 0x95, 0x49, 0xfc, 0x46,
-	0xf8, 0x5d, 0x54, 0xfc, 0x0e, 0x93, 0x29, 0x7e, 0x53, 0x30, 0xb2, 0x87, 0x9b, 0x6d, 0xcd, 0x2e,
-	0x6c, 0x18, 0xaf, 0xc1, 0xa4, 0x8c, 0xde, 0xa0, 0xf5, 0x97, 0xda, 0x85, 0x15, 0xf8, 0x4f, 0x64,
-	0x9e, 0x82, 0x40, 0x90, 0x11, 0xcf, 0x41, 0xce, 0x1a, 0xaf, 0xc8, 0x7f, 0xe3, 0x63, 0x40, 0x32,
-	0x70, 0xbb, 0x73, 0x93, 0x3a, 0x4c, 0x43, 0x20, 0xc8, 0xc8, 0x47, 0x14, 0xe6, 0x97, 0xff, 0xe8,
-	0x1a, 0x40, 0xb7, 0xd6, 0xc8, 0xb5, 0xe5, 0xd7, 0x97, 0xcd, 0xb0, 0x30, 0x99, 0xa2, 0x30, 0x99,
-	0x61, 0xf5, 0x52, 0x85, 0xc9, 0xbc, 0xd3, 0xdd, 0xaa, 0x4a, 0x64, 0x66, 0x84, 0xe4, 0xc3, 0x94,
-	0xda, 0x58, 0x0d, 0xae, 0x78, 0x2e, 0x41, 0xa6, 0x49, 0x1d, 0xb1, 0xba, 0xf4, 0x6a, 0x7e, 0x7d,
-	0xc2, 0xec, 0x16, 0x42, 0xf3, 0x26, 0x75, 0x2a, 0x72, 0x10, 0x6d, 0x25, 0xd0, 0x59, 0x39, 0x96,
-	0x4e, 0x88, 0x10, 0xe5, 0x63, 0x4c, 0xa9, 0x1d, 0xb8, 0x83, 0x03, 0xec, 0xe9, 0x1d, 0x30, 0xb6,
-	0x14, 0x35, 0xdd, 0xab, 0xa8, 0xfd, 0x1f, 0x46, 0x5b, 0xb2, 0x47, 0x6e, 0x4d, 0x7e, 0x1d, 0x45,
-	0xc9, 0x85, 0xb1, 0xe5, 0xcc, 0x93, 0x67, 0xa5, 0xa1, 0x8a, 0x8a, 0x33, 0xbe, 0x49, 0xc1, 0xc9,
-	0x4d, 0xde, 0xd8, 0xc0, 0xcd, 0x66, 0x64, 0x77, 0x71, 0xe0, 0x30, 0x7d, 0x0e, 0xe2, 0x1f, 0x4d,
-	0x43, 0xd6, 0xc1, 0xac, 0x6a, 0xe3, 0x96, 0x7a, 0x33, 0xa3, 0x0e, 0x66, 0x1b, 0xb8, 0x85, 0x3e,
-	0x82, 0xc9, 0x56, 0x40, 0x5b, 0x94, 0x91, 0xe0, 0xf0, 0xdd, 0x89, 0x37, 0x33, 0x5e, 0x5e, 0xff,
-	0xed, 0x59, 0xc9, 0x74, 0x5c, 0xde, 0x68, 0xd7, 0x4c, 0x9b, 0x7a, 0x96, 0xd2, 0x88, 0xf0, 0x73,
-	0x89, 0xd5, 0x77, 0x2d, 0xbe, 0xdf, 0x22, 0xcc, 0xdc, 0xe8, 0x3e, 0xf8, 0xca, 0x84, 0xce, 0xa5,
-	0x1f, 0xeb, 0x2c, 0xe4, 0xec, 0x06, 0x76, 0xfd, 0xaa, 0x5b, 0x9f, 0xc9, 0x2c, 0xa4, 0x56, 0xd3,
-	0x95, 0xac, 0x6c, 0x5f, 0xaf, 0x1b, 0x2b, 0x70, 0x6a, 0x93, 0x71, 0xd7, 0xc3, 0x9c, 0x6c, 0xe1,
-	0xee, 0x16, 0x4c, 0x42, 0xda, 0xc1, 0x21, 0xf9, 0x4c, 0x45, 0xfc, 0x1a, 0xbf, 0xa6, 0xf5, 0x39,
-	0x06, 0xd8, 0x26, 0xdb, 0x1d, 0xbd, 0xce, 0xff, 0x41, 0xda, 0x63, 0x8e, 0xda, 0xa9, 0xd9, 0xe8,
-	0x4e, 0xdd, 0x62, 0xce, 0x26, 0x6f, 0x90, 0x80, 0xb4, 0xbd, 0xed, 0x4e, 0x45, 0x44, 0xa1, 0xcb,
-	0x30, 0xce, 0xc5, 0xf4, 0xaa, 0x4d, 0xfd, 0x1d, 0xd7, 0x91, 0x6b, 0xcc, 0xaf, 0x4f, 0x47, 0x67,
-	0xc9, 0xf4, 0x1b, 0x72, 0xb8, 0x92, 0xe7, 0xdd, 0x06, 0xba, 0x02, 0xe3, 0xad, 0x80, 0xd4, 0x89,
-	0x4d, 0x18, 0xa3, 0x01, 0x9b, 0xc9, 0xc8, 0x8b, 0x73, 0x04, 0x62, 0x4f, 0xb8, 0x28, 0x94, 0xb5,
-	0x26, 0xb5, 0x77, 0x75, 0x49, 0x1a, 0x91, 0xfb, 0x90, 0x97, 0x7d, 0x61, 0x41, 0x42, 0xf3, 0x00,
-	0x61, 0x88, 0x7c, 0x16, 0xa3, 0xf2, 0x59, 0x8c, 0xc9, 0x1e, 0x29, 0x2e, 0x1b, 0x7a, 0x58, 0x88,
-	0xe1, 0x4c, 0x56, 0x52, 0x2f, 0x98, 0xa1, 0x52, 0x9a, 0x5a, 0x29, 0xcd, 0x6d, 0xad, 0x94, 0xe5,
-	0x9c, 0xb8, 0x22, 0x8f, 0x7e, 0x2c, 0xa5, 0x54, 0x12, 0x31, 0x92, 0x78, 0xd2, 0xb9, 0x7f, 0xe6,
-	0xa4, 0xc7, 0x7a, 0x4e, 0x1a, 0x19, 0x70, 0x22, 0xa4, 0xef, 0xe1, 0x4e, 0x55, 0x1c, 0x2e, 0x44,
-	0x76, 0xe0, 0x16, 0xee, 0x6c, 0x61, 0xf6, 0x4e, 0x26, 0x37, 0x3c, 0x99, 0xae, 0xe4, 0x78, 0xa7,
-	0xea, 0xfa, 0x75, 0xd2, 0x31, 0x2e, 0xa8, 0x3a, 0x76, 0x78, 0xe6, 0xdd, 0x22, 0x53, 0xc7, 0x1c,
-	0xeb, 0xcb, 0x2d, 0xfe, 0x8d, 0xaf, 0xd3, 0xca, 0x13, 0xc8, 0xe0, 0xb2, 0xc8, 0x1a, 0xb9, 0x23,
-	0xbc, 0xa3, 0x9f, 0xfa, 0x51, 0x77, 0x84, 0x77, 0xd8, 0x5f, 0xba, 0x23, 0xff, 0x1e, 0xf2, 0xf1,
-	0x87, 0x6c, 0x5c, 0x52, 0xee, 0x2b, 0x7a, 0x4e, 0x47, 0x9c, 0xeb, 0xe9, 0x43, 0x99, 0x66, 0xe4,
-	0x1a, 0xd1, 0xd5, 0xde, 0xb8, 0x79, 0x28, 0xc1, 0xaa, 0x5b, 0xa5, 0x78, 0x15, 0x72, 0xa2, 0x30,
-	0x57, 0x77, 0x88, 0x52, 0xb9, 0xf2, 0xec, 0x0f, 0xcf, 0x4a, 0xa7, 0xc3, 0x15, 0xb2, 0xfa, 0xae,
-	0xe9, 0x52, 0xcb, 0xc3, 0xbc, 0x61, 0x5e, 0xf7, 0xb9, 0x90, 0x67, 0x39, 0xdb, 0xb8, 0x02, 0x67,
-	0x65, 0xb6, 0x6b, 0x6d, 0x7f, 0x9b, 0xee, 0x12, 0xff, 0x16, 0x6e, 0xb5, 0x5c, 0xdf, 0xd1, 0x17,
-	0x68, 0x0a, 0x46, 0xb8, 0xe8, 0xd6, 0xba, 0x29, 0x1b, 0x11, 0x91, 0xf9, 0x50, 0xb9, 0xa4, 0xbe,
-	0xe9, 0x8a, 0xd4, 0x1a, 0x8c, 0xed, 0xb4, 0xfd, 0x6a, 0x37, 0x47, 0x7e, 0x7d, 0x2a, 0x7a, 0xa1,
-	0xf4, 0xbc, 0x4a, 0x6e, 0x47, 0xfd, 0x75, 0x93, 0xaf, 0x7f, 0x37, 0x0e, 0x23, 0x32, 0x3b, 0x7a,
-	0x98, 0x02, 0xe8, 0x7a, 0x56, 0x64, 0x44, 0x53, 0x24, 0xdb, 0xe1, 0xc2, 0xd2, 0x91, 0x31, 0x21,
-	0x3d, 0xe3, 0xe2, 0xa7, 0xdf, 0xfe, 0xfc, 0xd5, 0xf0, 0x32, 0xfa, 0xaf, 0xe5, 0x4b, 0x87, 0x79,
-	0xe8, 0xec, 0x79, 0xa3, 0xaa, 0x3c, 0x93, 0xf5, 0x40, 0x5d, 0xa4, 0x03, 0xf4, 0x65, 0x0a, 0x4e,
-	0xf4, 0x38, 0x51, 0x74, 0xae, 0x0f, 0x24, 0xc9, 0xea, 0x16, 0x96, 0x8f, 0x0b, 0x53, 0x74, 0x2c,
-	0x49, 0xe7, 0x3c, 0x5a, 0x89, 0xd1, 0x09, 0x5b, 0x09, 0x8c, 0x1e, 0xa7, 0x60, 0x32, 0x6e, 0x29,
-	0xd1, 0x6a, 0x1f, 0xda, 0x00, 0x0f, 0x5b, 0x38, 0xff, 0x07, 0x22, 0x15, 0xb5, 0xd7, 0x25, 0xb5,
-	0x35, 0x64, 0xc5, 0xa8, 0xed, 0xe9, 0x09, 0x5d, 0x76, 0x51, 0x5b, 0x7c, 0x80, 0xee, 0x43, 0xb6,
-	0xac, 0xad, 0x60, 0x1f, 0x5c, 0xaf, 0x03, 0x2d, 0x2c, 0x0c, 0x0e, 0x50, 0x34, 0xce, 0x4b, 0x1a,
-	0x4b, 0x68, 0x31, 0x46, 0x43, 0xf9, 0x49, 0x16, 0xd9, 0x9b, 0x4f, 0x20, 0xab, 0x5c, 0x60, 0x02,
-	0x70, 0xaf, 0xd9, 0x4c, 0x00, 0x8e, 0x19, 0x48, 0xc3, 0x94, 0xc0, 0xab, 0x68, 0x39, 0x06, 0xcc,
-	0xc2, 0xb8, 0x2e, 0xae, 0xf5, 0x60, 0x97, 0xec, 0x1f, 0xa0, 0x5d, 0xc8, 0x08, 0x77, 0x88, 0xe6,
-	0xfa, 0x32, 0x47, 0xcc, 0x66, 0x61, 0x7e, 0xc0, 0xa8, 0x02, 0x5d, 0x96, 0xa0, 0x0b, 0xa8, 0x18,
-	0x03, 0x15, 0xde, 0x32, 0xba, 0xd4, 0x06, 0x8c, 0x86, 0xee, 0x08, 0x15, 0xfb, 0x12, 0xf6, 0x18,
-	0xaf, 0x42, 0x69, 0xe0, 0xb8, 0x82, 0x9c, 0x97, 0x90, 0xd3, 0xe8, 0x74, 0x0c, 0x32, 0xf4, 0x5b,
-	0xc8, 0x85, 0xac, 0xb2, 0x5b, 0xa8, 0x10, 0x4d, 0xd5, 0xeb, 0xc1, 0x0a, 0x8b, 0x83, 0xa5, 0x46,
-	0x03, 0x95, 0x24, 0xd0, 0x2c, 0x9a, 0x4e, 0x78, 0x7a, 0xb6, 0xc8, 0x4f, 0x21, 0x1f, 0x31, 0x48,
-	0x47, 0xc2, 0xf5, 0xac, 0x2a, 0xc1, 0x55, 0x19, 0x4b, 0x12, 0x6c, 0x1e, 0x9d, 0x8d, 0x83, 0xa9,
-	0x58, 0x51, 0xb1, 0x91, 0x07, 0x59, 0x25, 0xb7, 0x09, 0x17, 0xa6, 0xd7, 0x7c, 0x25, 0x5c, 0x98,
-	0x98, 0x52, 0x0f, 0x5c, 0x5f, 0x28, 0xb1, 0xbc, 0x83, 0xf6, 0x01, 0xba, 0x42, 0x90, 0x50, 0xd2,
-	0xfa, 0xd4, 0x3c, 0xa1, 0xa4, 0xf5, 0x2b, 0x89, 0x61, 0x48, 0xdc, 0x39, 0x54, 0x48, 0xc4, 0x95,
-	0x72, 0x24, 0x56, 0xaa, 0xd4, 0x23, 0xf1, 0x4d, 0x46, 0xe5, 0x26, 0xf1, 0x4d, 0xf6, 0x08, 0xcf,
-	0xc0, 0x95, 0x6a, 0x35, 0x42, 0x5f, 0xa4, 0x60, 0x22, 0x26, 0x10, 0x68, 0xa5, 0x2f, 0x6d, 0xb2,
-	0x02, 0x15, 0x56, 0x8f, 0x0f, 0x54, 0x3c, 0x56, 0x24, 0x8f, 0x45, 0x54, 0x8a, 0xf1, 0xd8, 0x69,
-	0xfb, 0x52, 0x7f, 0xac, 0x07, 0xf2, 0x73, 0x50, 0xbe, 0xf2, 0xe4, 0x79, 0x31, 0xf5, 0xf4, 0x79,
-	0x31, 0xf5, 0xd3, 0xf3, 0x62, 0xea, 0xd1, 0x8b, 0xe2, 0xd0, 0xd3, 0x17, 0xc5, 0xa1, 0xef, 0x5f,
-	0x14, 0x87, 0x3e, 0x58, 0x8a, 0x38, 0x84, 0xb0, 0x44, 0x6f, 0x08, 0x7d, 0xd7, 0x09, 0x3b, 0x22,
-	0x65, 0x6d, 0x54, 0xba, 0x91, 0x57, 0x7e, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xdd, 0x5e, 0x28,
-	0x5b, 0x12, 0x00, 0x00,
+	// 1855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x8a, 0x94, 0x48, 0x3d, 0xca, 0x91, 0x32, 0xa2, 0x2d, 0x6a, 0x2d, 0x91, 0xf4, 0xea,
+	0x1b, 0x49, 0xf6, 0x37, 0xd9, 0x8d, 0x94, 0xa0, 0x81, 0x83, 0xba, 0xa9, 0xa9, 0xda, 0x6e, 0x6a,
+	0x3b, 0x48, 0x36, 0x42, 0x0b, 0xb4, 0x28, 0x88, 0xe1, 0x72, 0xb4, 0x5c, 0x88, 0xdc, 0xdd, 0xec,
+	0x0c, 0x65, 0xaa, 0xae, 0x2f, 0x4d, 0x0f, 0x05, 0x8a, 0xa2, 0x01, 0x8a, 0xde, 0x7d, 0x28, 0x8a,
+	0xde, 0x7a, 0x6c, 0xff, 0x84, 0x1c, 0x03, 0xf4, 0x52, 0xf4, 0xe0, 0x16, 0x76, 0x0f, 0x3d, 0xf7,
+	0xd8, 0x53, 0x31, 0xb3, 0x33, 0xe4, 0x2e, 0xb9, 0x14, 0xe5, 0x34, 0xbd, 0xf5, 0xb4, 0x3b, 0x33,
+	0xef, 0x7d, 0xde, 0xe7, 0xcd, 0x8f, 0xf7, 0x03, 0xae, 0x10, 0xd6, 0xb1, 0xc8, 0x69, 0xcf, 0x3a,
+	0xdd, 0xb7, 0x3e, 0xe9, 0x93, 0xe8, 0xcc, 0x0c, 0xa3, 0x80, 0x05, 0x08, 0x08, 0xeb, 0x98, 0xe4,
+	0xb4, 0x67, 0x9e, 0xee, 0xeb, 0x37, 0x9c, 0x80, 0xf6, 0x02, 0x6a, 0xb5, 0x30, 0x25, 0xb1, 0x90,
+	0x75, 0xba, 0xdf, 0x22, 0x0c, 0xef, 0x5b, 0x21, 0x76, 0x3d, 0x1f, 0x33, 0x2f, 0xf0, 0x63, 0x3d,
+	0xbd, 0x9c, 0xc0, 0xe3, 0xea, 0xf1, 0xec, 0x5a, 0x62, 0x96, 0x0d, 0x94, 0xa8, 0x1b, 0xb8, 0x81,
+	0xf8, 0xb5, 0xf8, 0x9f, 0x9c, 0xdd, 0x74, 0x83, 0xc0, 0xed, 0x12, 0x0b, 0x87, 0x9e, 0x85, 0x7d,
+	0x3f, 0x60, 0x02, 0x9d, 0xca, 0xd5, 0x9a, 0x5c, 0x15, 0xa3, 0x56, 0xff, 0xd8, 0x62, 0x5e, 0x8f,
+	0x50, 0x86, 0x7b, 0x61, 0x2c, 0x60, 0x7c, 0x1d, 0xae, 0x7c, 0xc4, 0x19, 0xde, 0x61, 0x9d, 0xdb,
+	0x8e, 0x13, 0xf4, 0x7d, 0x66, 0x93, 0x4f, 0xfa, 0x84, 0x32, 0x54, 0x81, 0x02, 0x6e, 0xb7, 0x23,
+	0x42, 0x69, 0x45, 0xab, 0x6b, 0x7b, 0x4b, 0xb6, 0x1a, 0xbe, 0x5b, 0xfc, 0xd9, 0xd3, 0xda, 0xdc,
+	0x3f, 0x9e, 0xd6, 0xe6, 0x8c, 0x1e, 0xac, 0x4f, 0x68, 0xd3, 0x30, 0xf0, 0x29, 0x41, 0x35, 0x28,
+	0xb5, 0x70, 0x17, 0xfb, 0x0e, 0x69, 0x3e, 0x22, 0x9e, 0x84, 0x00, 0x39, 0xf5, 0x3d, 0xe2, 0xa1,
+	0xab, 0xb0, 0xe4, 0x04, 0x6d, 0xd2, 0xec, 0x60, 0xda, 0xa9, 0xcc, 0x8b, 0xe5, 0x22, 0x9f, 0xf8,
+	0x36, 0xa6, 0x1d, 0x54, 0x86, 0x05, 0x3f, 0xf0, 0x1d, 0x52, 0xc9, 0xd5, 0xb5, 0xbd, 0xbc, 0x1d,
+	0x0f, 0x8c, 0xf7, 0x60, 0x43, 0x98, 0xfb, 0xc0, 0x6b, 0x79, 0x51, 0xff, 0x4b, 0xf0, 0x3d, 0x03,
+	0x3d, 0x0b, 0x40, 0x52, 0x9e, 0x8a, 0x80, 0x74, 0x28, 0x52, 0x6e, 0x86, 0x33, 0x9a, 0x17, 0x8c,
+	0x86, 0x63, 0xf4, 0x1a, 0xbc, 0x82, 0x63, 0xa0, 0xa6, 0xdf, 0xef, 0xb5, 0x48, 0x24, 0x39, 0x5f,
+	0x92, 0xb3, 0x1f, 0x88, 0x49, 0xe3, 0x3e, 0x6c, 0x0a, 0xd3, 0xdf, 0xc5, 0x5d, 0xaf, 0x8d, 0x59,
+	0x10, 0x8d, 0xd1, 0xbf, 0x06, 0xcb, 0x4e, 0xe0, 0xd3, 0x66, 0x9a, 0x41, 0x89, 0xcf, 0xdd, 0x9e,
+	0xf0, 0xe3, 0xe7, 0x1a, 0x6c, 0x4d, 0x41, 0x93, 0xbe, 0xec, 0xc2, 0x8a, 0x62, 0x95, 0x46, 0x54,
+	0x64, 0x6f, 0x7f, 0x75, 0xae, 0xdd, 0x84, 0x35, 0x41, 0xa6, 0x11, 0x1f, 0xee, 0xcb, 0x1c, 0xc8,
+	0x47, 0x50, 0x4e, 0xab, 0x8e, 0x8e, 0x42, 0x5e, 0x15, 0xa5, 0x2b, 0x87, 0xe3, 0xf7, 0x6a, 0x7e,
+	0xfc, 0x5e, 0x19, 0xf7, 0x25, 0x9b, 0x8f, 0x59, 0x10, 0x61, 0x77, 0x36, 0x1b, 0xb4, 0x0a, 0xb9,
+	0x13, 0x72, 0x26, 0x91, 0xf8, 0x6f, 0x82, 0xdf, 0xeb, 0x50, 0x4e, 0x83, 0x49, 0x7e, 0x65, 0x58,
+	0x38, 0xc5, 0xdd, 0xbe, 0x62, 0x17, 0x0f, 0x8c, 0xaf, 0xc1, 0xaa, 0x90, 0x3e, 0x0c, 0xda, 0x2f,
+	0xb5, 0x0b, 0xbb, 0xf0, 0x6a, 0x42, 0x4f, 0x9a, 0x40, 0x90, 0xe7, 0xcf, 0x41, 0x68, 0x2d, 0xdb,
+	0xe2, 0xdf, 0xf8, 0x11, 0x20, 0x21, 0x78, 0x34, 0x78, 0x10, 0xb8, 0x54, 0x99, 0x40, 0x90, 0x17,
+	0x8f, 0x28, 0xc6, 0x17, 0xff, 0xe8, 0x2e, 0xc0, 0x28, 0xd6, 0x08, 0xdf, 0x4a, 0x07, 0x3b, 0x66,
+	0x1c, 0x98, 0x4c, 0x1e, 0x98, 0xcc, 0x38, 0x7a, 0xc9, 0xc0, 0x64, 0x7e, 0x38, 0xda, 0x2a, 0x3b,
+	0xa1, 0x99, 0x20, 0xf9, 0xa9, 0x06, 0x6b, 0x29, 0xe3, 0x92, 0xe7, 0x36, 0xe4, 0xbb, 0x81, 0xcb,
+	0xbd, 0xcb, 0xed, 0x95, 0x0e, 0x56, 0xcc, 0x51, 0x20, 0x34, 0x1f, 0x04, 0xae, 0x2d, 0x16, 0xd1,
+	0xbd, 0x0c, 0x3a, 0xbb, 0x33, 0xe9, 0xc4, 0x16, 0x92, 0x7c, 0x8c, 0xb2, 0xdc, 0x81, 0x0f, 0x71,
+	0x84, 0x7b, 0x6a, 0x07, 0x8c, 0x7b, 0xb0, 0x96, 0x9a, 0x95, 0xd4, 0xde, 0x84, 0xc5, 0x50, 0xcc,
+	0x88, 0xad, 0x29, 0x1d, 0xa0, 0x24, 0xb9, 0x58, 0xb6, 0x91, 0xff, 0xfc, 0x59, 0x6d, 0xce, 0x96,
+	0x72, 0xc6, 0x1f, 0x34, 0x78, 0xe5, 0x0e, 0xeb, 0x1c, 0xe2, 0x6e, 0x37, 0xb1, 0xbb, 0x38, 0x72,
+	0xa9, 0x3a, 0x07, 0xfe, 0x8f, 0xd6, 0xa1, 0xe0, 0x62, 0xda, 0x74, 0x70, 0x28, 0xdf, 0xcc, 0xa2,
+	0x8b, 0xe9, 0x21, 0x0e, 0xd1, 0x0f, 0x61, 0x35, 0x8c, 0x82, 0x30, 0xa0, 0x24, 0x1a, 0xbe, 0x3b,
+	0xfe, 0x66, 0x96, 0x1b, 0x07, 0xff, 0x7a, 0x56, 0x33, 0x5d, 0x8f, 0x75, 0xfa, 0x2d, 0xd3, 0x09,
+	0x7a, 0x96, 0xcc, 0x11, 0xf1, 0xe7, 0x0d, 0xda, 0x3e, 0xb1, 0xd8, 0x59, 0x48, 0xa8, 0x79, 0x38,
+	0x7a, 0xf0, 0xf6, 0x8a, 0xc2, 0x52, 0x8f, 0x75, 0x03, 0x8a, 0x4e, 0x07, 0x7b, 0x7e, 0xd3, 0x6b,
+	0x57, 0xf2, 0x75, 0x6d, 0x2f, 0x67, 0x17, 0xc4, 0xf8, 0xfd, 0xb6, 0xb1, 0x0b, 0x6b, 0x77, 0x28,
+	0xf3, 0x7a, 0x98, 0x91, 0x7b, 0x78, 0xb4, 0x05, 0xab, 0x90, 0x73, 0x71, 0x4c, 0x3e, 0x6f, 0xf3,
+	0x5f, 0xe3, 0x9f, 0x39, 0x75, 0x8e, 0x11, 0x76, 0xc8, 0xd1, 0x40, 0xf9, 0xf9, 0xff, 0x90, 0xeb,
+	0x51, 0x57, 0xee, 0xd4, 0x46, 0x72, 0xa7, 0x1e, 0x52, 0xf7, 0x0e, 0xeb, 0x90, 0x88, 0xf4, 0x7b,
+	0x47, 0x03, 0x9b, 0x4b, 0xa1, 0x77, 0x61, 0x99, 0x71, 0xf5, 0xa6, 0x13, 0xf8, 0xc7, 0x9e, 0x2b,
+	0x7c, 0x2c, 0x1d, 0xac, 0x27, 0xb5, 0x04, 0xfc, 0xa1, 0x58, 0xb6, 0x4b, 0x6c, 0x34, 0x40, 0xb7,
+	0x60, 0x39, 0x8c, 0x48, 0x9b, 0x38, 0x84, 0xd2, 0x20, 0xa2, 0x95, 0x7c, 0x3d, 0x77, 0xbe, 0xc5,
+	0x94, 0x38, 0x0f, 0x94, 0xad, 0x6e, 0xe0, 0x9c, 0xa8, 0x90, 0xb4, 0x20, 0xf6, 0xa1, 0x24, 0xe6,
+	0xe2, 0x80, 0x84, 0xb6, 0x00, 0x62, 0x11, 0xf1, 0x2c, 0x16, 0xc5, 0xb3, 0x58, 0x12, 0x33, 0x22,
+	0xb9, 0x1c, 0xaa, 0x65, 0x9e, 0x0c, 0x2b, 0x05, 0x41, 0x5d, 0x37, 0xe3, 0x4c, 0x69, 0xaa, 0x4c,
+	0x69, 0x1e, 0xa9, 0x4c, 0xd9, 0x28, 0xf2, 0x2b, 0xf2, 0xd9, 0x5f, 0x6b, 0x9a, 0x04, 0xe1, 0x2b,
+	0x99, 0x27, 0x5d, 0xfc, 0xef, 0x9c, 0xf4, 0x52, 0xea, 0xa4, 0x91, 0x01, 0x97, 0x62, 0xfa, 0x3d,
+	0x3c, 0x68, 0xf2, 0xc3, 0x85, 0xc4, 0x0e, 0x3c, 0xc4, 0x83, 0x7b, 0x98, 0x7e, 0x27, 0x5f, 0x9c,
+	0x5f, 0xcd, 0xd9, 0x45, 0x36, 0x68, 0x7a, 0x7e, 0x9b, 0x0c, 0x8c, 0x1b, 0x32, 0x8e, 0x0d, 0xcf,
+	0x7c, 0x14, 0x64, 0xda, 0x98, 0x61, 0x75, 0xb9, 0xf9, 0xbf, 0xf1, 0xdb, 0x1c, 0x5c, 0x19, 0x09,
+	0x37, 0x38, 0x6a, 0xe2, 0x8e, 0xb0, 0x81, 0x7a, 0xea, 0xe7, 0xdd, 0x11, 0x36, 0xa0, 0xff, 0xd1,
+	0x1d, 0xf9, 0xdf, 0x21, 0xcf, 0x3e, 0x64, 0xe3, 0x0d, 0x59, 0x7d, 0x25, 0xcf, 0xe9, 0x9c, 0x73,
+	0xbd, 0x3c, 0x4c, 0xd3, 0x94, 0xdc, 0x25, 0x2a, 0xda, 0x1b, 0x0f, 0xa0, 0x9c, 0x9e, 0x96, 0x10,
+	0x6f, 0x43, 0x91, 0x07, 0xe6, 0xe6, 0x31, 0x91, 0x59, 0xae, 0xb1, 0xf1, 0x97, 0x67, 0xb5, 0xcb,
+	0xb1, 0x87, 0xb4, 0x7d, 0x62, 0x7a, 0x81, 0xd5, 0xc3, 0xac, 0x63, 0xbe, 0xef, 0x33, 0x9e, 0x9e,
+	0x85, 0xb6, 0x71, 0x0b, 0xae, 0x0a, 0xb4, 0xbb, 0x7d, 0xff, 0x28, 0x38, 0x21, 0xfe, 0x43, 0x1c,
+	0x86, 0x9e, 0xef, 0xaa, 0x0b, 0x54, 0x86, 0x05, 0xc6, 0xa7, 0x55, 0xde, 0x14, 0x83, 0x44, 0x92,
+	0xf9, 0x01, 0x6c, 0x66, 0xab, 0x4b, 0x52, 0xfb, 0xb0, 0x74, 0xdc, 0xf7, 0x9b, 0x23, 0x8c, 0xd2,
+	0x41, 0x39, 0x79, 0xa1, 0x94, 0x9e, 0x5d, 0x3c, 0x96, 0x7f, 0x09, 0x70, 0x57, 0x15, 0x4d, 0x24,
+	0xf2, 0x8e, 0x3d, 0xd2, 0x56, 0xc2, 0xc3, 0x44, 0x9a, 0x4e, 0x9a, 0xda, 0x97, 0x4d, 0x9a, 0xc6,
+	0x6f, 0x34, 0xa8, 0x4e, 0xb3, 0x24, 0x1d, 0xb9, 0x09, 0x30, 0x74, 0x44, 0x3d, 0xa8, 0x4c, 0x4f,
+	0x64, 0x82, 0x5a, 0x52, 0xfe, 0x7c, 0x85, 0xb9, 0xf4, 0x3a, 0xec, 0xa6, 0x36, 0xfb, 0xe3, 0x7e,
+	0x18, 0x76, 0xcf, 0xbe, 0xe5, 0x51, 0x27, 0x22, 0x21, 0xf6, 0x1d, 0x8f, 0x0c, 0x13, 0xec, 0x4f,
+	0x35, 0xd8, 0x9b, 0x2d, 0x2b, 0x7d, 0xfb, 0x26, 0x14, 0x22, 0x12, 0x06, 0x11, 0x53, 0x8e, 0xd5,
+	0xb3, 0x1c, 0x8b, 0x11, 0x6c, 0x21, 0x28, 0x9d, 0x54, 0x6a, 0xe8, 0x0a, 0x2c, 0xb6, 0x22, 0x71,
+	0xc6, 0xdc, 0xbd, 0xa2, 0x2d, 0x47, 0xc6, 0xef, 0x73, 0x50, 0xce, 0xd2, 0x47, 0xef, 0x5c, 0xf0,
+	0x5e, 0x48, 0x43, 0xc3, 0xdb, 0x81, 0xbe, 0xc1, 0xcb, 0x49, 0xff, 0xa4, 0x49, 0x05, 0x5a, 0x5c,
+	0x04, 0x36, 0xb6, 0xb8, 0xd0, 0xf4, 0xcb, 0x0e, 0x5c, 0x23, 0x36, 0x8f, 0xee, 0x03, 0x22, 0x91,
+	0x73, 0xf0, 0x66, 0x93, 0x05, 0x0c, 0x77, 0x15, 0x4c, 0xee, 0x22, 0x30, 0xab, 0x42, 0xf1, 0x88,
+	0xeb, 0x49, 0xb0, 0x9b, 0x50, 0x24, 0xd4, 0x89, 0x82, 0x47, 0x24, 0x4e, 0xef, 0x33, 0x21, 0x86,
+	0xe2, 0xe8, 0x3d, 0x28, 0x39, 0x5e, 0xe4, 0xf4, 0xbb, 0x98, 0x79, 0xbe, 0x5b, 0x59, 0xb8, 0x88,
+	0x76, 0x52, 0x83, 0x03, 0xb4, 0x87, 0xa7, 0x79, 0x56, 0x59, 0xbc, 0x10, 0x40, 0x42, 0x83, 0x3f,
+	0x6d, 0x12, 0x45, 0x41, 0x24, 0x62, 0xed, 0x92, 0x1d, 0x0f, 0x0e, 0x7e, 0xb7, 0x02, 0x0b, 0xe2,
+	0xe2, 0xa0, 0x4f, 0x35, 0x80, 0x51, 0x9f, 0x88, 0x8c, 0xe4, 0xf1, 0x64, 0xb7, 0xa0, 0xfa, 0xf6,
+	0xb9, 0x32, 0xf1, 0x6d, 0x33, 0x5e, 0xff, 0xc9, 0x9f, 0xfe, 0xfe, 0xab, 0xf9, 0x1d, 0xf4, 0x7f,
+	0x96, 0x2f, 0xba, 0xba, 0x61, 0x37, 0xcd, 0x3a, 0x4d, 0xd9, 0xa7, 0x58, 0x8f, 0x65, 0xf0, 0x7e,
+	0x82, 0x7e, 0xa9, 0xc1, 0xa5, 0x54, 0xf7, 0x87, 0x5e, 0x9b, 0x30, 0x92, 0xd5, 0x5e, 0xea, 0x3b,
+	0xb3, 0xc4, 0x24, 0x1d, 0x4b, 0xd0, 0xb9, 0x8e, 0x76, 0xc7, 0xe8, 0xc4, 0xa3, 0x0c, 0x46, 0x4f,
+	0x35, 0x58, 0x1d, 0x6f, 0xe3, 0xd0, 0xde, 0x84, 0xb5, 0x29, 0x7d, 0xa3, 0x7e, 0xfd, 0x02, 0x92,
+	0x92, 0xda, 0x3b, 0x82, 0xda, 0x3e, 0xb2, 0xc6, 0xa8, 0x9d, 0x2a, 0x85, 0x11, 0xbb, 0x64, 0x2b,
+	0xfa, 0x04, 0x3d, 0x82, 0x42, 0x43, 0xb5, 0x5f, 0x13, 0xe6, 0xd2, 0x5d, 0x9f, 0x5e, 0x9f, 0x2e,
+	0x20, 0x69, 0x5c, 0x17, 0x34, 0xb6, 0xd1, 0xb5, 0x31, 0x1a, 0xb2, 0x87, 0xa3, 0x89, 0xbd, 0xf9,
+	0x31, 0x14, 0x64, 0xe7, 0x95, 0x61, 0x38, 0xdd, 0xe0, 0xe9, 0xf5, 0xe9, 0x02, 0xd2, 0xb0, 0x29,
+	0x0c, 0xef, 0xa1, 0x9d, 0x31, 0xc3, 0x34, 0x96, 0x1b, 0xd9, 0xb5, 0x1e, 0x9f, 0x90, 0xb3, 0x27,
+	0xe8, 0x04, 0xf2, 0xbc, 0x23, 0x43, 0x9b, 0x13, 0xc8, 0x89, 0x06, 0x4f, 0xdf, 0x9a, 0xb2, 0x2a,
+	0x8d, 0xee, 0x08, 0xa3, 0x75, 0x54, 0x1d, 0x33, 0xca, 0xfb, 0xb9, 0xa4, 0xab, 0x1d, 0x58, 0x8c,
+	0x3b, 0x12, 0x54, 0x9d, 0x00, 0x4c, 0x35, 0x3b, 0x7a, 0x6d, 0xea, 0xba, 0x34, 0xb9, 0x25, 0x4c,
+	0xae, 0xa3, 0xcb, 0x63, 0x26, 0xe3, 0x1e, 0x07, 0x79, 0x50, 0x90, 0x2d, 0x0e, 0xd2, 0x93, 0x50,
+	0xe9, 0xbe, 0x47, 0xbf, 0x36, 0xbd, 0xbc, 0x53, 0x86, 0x6a, 0xc2, 0xd0, 0x06, 0x5a, 0xcf, 0x78,
+	0x7a, 0x0e, 0xc7, 0x0f, 0xa0, 0x94, 0x68, 0x4a, 0xce, 0x35, 0x97, 0xf2, 0x2a, 0xa3, 0x93, 0x31,
+	0xb6, 0x85, 0xb1, 0x2d, 0x74, 0x75, 0xdc, 0x98, 0x94, 0xe5, 0x55, 0x12, 0xea, 0x41, 0x41, 0x96,
+	0xb8, 0x19, 0x17, 0x26, 0xdd, 0xf0, 0xe8, 0xf5, 0xe9, 0x02, 0x33, 0xfc, 0x8b, 0xcb, 0x5a, 0x36,
+	0x40, 0x67, 0x00, 0xa3, 0xe2, 0x2b, 0x23, 0xa4, 0x4d, 0x54, 0xd0, 0xfa, 0xf6, 0xb9, 0x32, 0xd2,
+	0xae, 0x21, 0xec, 0x6e, 0x22, 0x3d, 0xd3, 0xae, 0x28, 0x01, 0xb9, 0xa7, 0xb2, 0x62, 0xcb, 0x7c,
+	0x93, 0xc9, 0x12, 0x4f, 0xaf, 0x4f, 0x17, 0x98, 0xe1, 0xa9, 0xaa, 0x00, 0xd1, 0x2f, 0x34, 0x58,
+	0x19, 0x2b, 0xca, 0xd0, 0xee, 0x04, 0x6c, 0x76, 0xd5, 0xa7, 0xef, 0xcd, 0x16, 0x94, 0x3c, 0x76,
+	0x05, 0x8f, 0x6b, 0xa8, 0x36, 0xc6, 0xe3, 0xb8, 0xef, 0x8b, 0xdc, 0x6e, 0x3d, 0x16, 0x9f, 0x27,
+	0xe8, 0xd7, 0x1a, 0xbc, 0x3a, 0x51, 0x5d, 0xa1, 0x8c, 0x60, 0x38, 0xa5, 0xd6, 0xd3, 0x6f, 0x5c,
+	0x44, 0x74, 0x46, 0xc4, 0x52, 0xac, 0xa8, 0x75, 0x2a, 0x75, 0xd1, 0x1f, 0x35, 0xb8, 0x7a, 0x4e,
+	0x8d, 0x84, 0xde, 0x9a, 0xba, 0x15, 0xd3, 0xab, 0x2f, 0xfd, 0xed, 0x97, 0x53, 0x9a, 0x11, 0xee,
+	0x46, 0xac, 0xdb, 0x49, 0xbd, 0xc6, 0xad, 0xcf, 0x9f, 0x57, 0xb5, 0x2f, 0x9e, 0x57, 0xb5, 0xbf,
+	0x3d, 0xaf, 0x6a, 0x9f, 0xbd, 0xa8, 0xce, 0x7d, 0xf1, 0xa2, 0x3a, 0xf7, 0xe7, 0x17, 0xd5, 0xb9,
+	0xef, 0x6f, 0x27, 0x1a, 0x9d, 0x38, 0xeb, 0x1d, 0xf2, 0x36, 0x45, 0xe1, 0x0e, 0x38, 0x72, 0x6b,
+	0x51, 0x34, 0x55, 0x6f, 0xfd, 0x7b, 0x00, 0xfe, 0xf6, 0xa6, 0xf8, 0x22, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1558,10 +1846,19 @@ type QueryClient interface {
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block,
-	// Similar to feemarket module's method
+	// BaseFee queries the EIP-1559 base fee of the current block. The base fee
+	// rises and falls with the gas used by the parent block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// VerifiedFunTokens lists the "FunToken" mappings that governance or the
+	// x/sudo root verified with "MsgUpdateFunTokenMetadata".
+	VerifiedFunTokens(ctx context.Context, in *QueryVerifiedFunTokensRequest, opts ...grpc.CallOption) (*QueryVerifiedFunTokensResponse, error)
+	// FunTokenSupplyDiscrepancies reports, for every "FunToken" mapping, the
+	// amount escrowed by the EVM module against the amount of the token
+	// circulating in the other representation. The "funtoken-supply" crisis
+	// invariant runs the same check, but only for the FunTokens made from bank
+	// coins, since the ERC20 contracts of the others can report any supply.
+	FunTokenSupplyDiscrepancies(ctx context.Context, in *QueryFunTokenSupplyDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryFunTokenSupplyDiscrepanciesResponse, error)
 }
 
 type queryClient struct {
@@ -1689,6 +1986,24 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) VerifiedFunTokens(ctx context.Context, in *QueryVerifiedFunTokensRequest, opts ...grpc.CallOption) (*QueryVerifiedFunTokensResponse, error) {
+	out := new(QueryVerifiedFunTokensResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/VerifiedFunTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunTokenSupplyDiscrepancies(ctx context.Context, in *QueryFunTokenSupplyDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryFunTokenSupplyDiscrepanciesResponse, error) {
+	out := new(QueryFunTokenSupplyDiscrepanciesResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenSupplyDiscrepancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries an Ethereum account.
@@ -1715,10 +2030,19 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block,
-	// Similar to feemarket module's method
+	// BaseFee queries the EIP-1559 base fee of the current block. The base fee
+	// rises and falls with the gas used by the parent block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// VerifiedFunTokens lists the "FunToken" mappings that governance or the
+	// x/sudo root verified with "MsgUpdateFunTokenMetadata".
+	VerifiedFunTokens(context.Context, *QueryVerifiedFunTokensRequest) (*QueryVerifiedFunTokensResponse, error)
+	// FunTokenSupplyDiscrepancies reports, for every "FunToken" mapping, the
+	// amount escrowed by the EVM module against the amount of the token
+	// circulating in the other representation. The "funtoken-supply" crisis
+	// invariant runs the same check, but only for the FunTokens made from bank
+	// coins, since the ERC20 contracts of the others can report any supply.
+	FunTokenSupplyDiscrepancies(context.Context, *QueryFunTokenSupplyDiscrepanciesRequest) (*QueryFunTokenSupplyDiscrepanciesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1764,6 +2088,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) FunTokenMapping(ctx context.Context, req *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) VerifiedFunTokens(ctx context.Context, req *QueryVerifiedFunTokensRequest) (*QueryVerifiedFunTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiedFunTokens not implemented")
+}
+func (*UnimplementedQueryServer) FunTokenSupplyDiscrepancies(ctx context.Context, req *QueryFunTokenSupplyDiscrepanciesRequest) (*QueryFunTokenSupplyDiscrepanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenSupplyDiscrepancies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2003,6 +2333,42 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifiedFunTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiedFunTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiedFunTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/VerifiedFunTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiedFunTokens(ctx, req.(*QueryVerifiedFunTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunTokenSupplyDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenSupplyDiscrepanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenSupplyDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenSupplyDiscrepancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenSupplyDiscrepancies(ctx, req.(*QueryFunTokenSupplyDiscrepanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2059,6 +2425,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "VerifiedFunTokens",
+			Handler:    _Query_VerifiedFunTokens_Handler,
+		},
+		{
+			MethodName: "FunTokenSupplyDiscrepancies",
+			Handler:    _Query_FunTokenSupplyDiscrepancies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -3059,61 +3433,305 @@ func (m *QueryFunTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryEthAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVerifiedFunTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEthAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BalanceWei)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+func (m *QueryVerifiedFunTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNibiruAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVerifiedFunTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifiedFunTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifiedFunTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifiedFunTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunTokens) > 0 {
+		for iNdEx := len(m.FunTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunTokenSupplyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunTokenSupplyReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunTokenSupplyReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Discrepancy.Size()
+		i -= size
+		if _, err := m.Discrepancy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Circulating.Size()
+		i -= size
+		if _, err := m.Circulating.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Escrowed.Size()
+		i -= size
+		if _, err := m.Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Erc20TotalSupply.Size()
+		i -= size
+		if _, err := m.Erc20TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BalanceWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryNibiruAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryNibiruAccountResponse) Size() (n int) {
@@ -3498,17 +4116,101 @@ func (m *QueryFunTokenMappingResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryVerifiedFunTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryVerifiedFunTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FunTokens) > 0 {
+		for _, e := range m.FunTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Broken {
+		n += 2
+	}
+	return n
+}
+
+func (m *FunTokenSupplyReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Erc20TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Escrowed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Circulating.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discrepancy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEthAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -6337,6 +7039,651 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVerifiedFunTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedFunTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedFunTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifiedFunTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifiedFunTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifiedFunTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunTokens = append(m.FunTokens, FunToken{})
+			if err := m.FunTokens[len(m.FunTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenSupplyDiscrepanciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenSupplyDiscrepanciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenSupplyDiscrepanciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenSupplyDiscrepanciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenSupplyDiscrepanciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenSupplyDiscrepanciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, FunTokenSupplyReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunTokenSupplyReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunTokenSupplyReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunTokenSupplyReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Circulating.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discrepancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
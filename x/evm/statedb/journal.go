@@ -21,6 +21,7 @@ import (
 	"math/big"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -137,6 +138,11 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the Cosmos-SDK state by a precompile
+	precompileCalledChange struct {
+		prevCtx sdk.Context
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -239,3 +245,21 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch precompileCalledChange) Revert(s *StateDB) {
+	s.ctx = ch.prevCtx
+	s.writeCacheCtxFns = s.writeCacheCtxFns[:len(s.writeCacheCtxFns)-1]
+
+	// Accounts that were loaded from the discarded branch can hold balances
+	// written by the precompile. Drop the ones without changes in the journal
+	// so that they are loaded again from the restored context.
+	for addr := range s.stateObjects {
+		if _, dirty := s.journal.dirties[addr]; !dirty {
+			delete(s.stateObjects, addr)
+		}
+	}
+}
+
+func (ch precompileCalledChange) Dirtied() *common.Address {
+	return nil
+}
@@ -2,6 +2,7 @@
 package statedb
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sort"
@@ -30,7 +31,16 @@ var _ vm.StateDB = &StateDB{}
 // * Accounts
 type StateDB struct {
 	keeper Keeper
-	ctx    sdk.Context
+	// evmTxCtx: Context of the EVM transaction. "Commit" writes all state
+	// changes to this context.
+	evmTxCtx sdk.Context
+	// ctx: Context for reads and writes during the EVM execution. Each
+	// precompile call that can change Cosmos-SDK state runs on a new branch of
+	// this context. See [StateDB.CacheCtxForPrecompile].
+	ctx sdk.Context
+	// writeCacheCtxFns: Functions that write each precompile branch of "ctx"
+	// to its parent, in the order the branches were created.
+	writeCacheCtxFns []func()
 
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
@@ -56,6 +66,7 @@ type StateDB struct {
 func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	return &StateDB{
 		keeper:       keeper,
+		evmTxCtx:     ctx,
 		ctx:          ctx,
 		stateObjects: make(map[common.Address]*stateObject),
 		journal:      newJournal(),
@@ -70,11 +81,25 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
-// GetContext returns the transaction Context.
+// GetContext returns the Context for reads and writes during the EVM
+// execution. It includes the uncommitted writes of earlier precompile calls.
 func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// CacheCtxForPrecompile returns a branch of the StateDB context for a
+// precompile call that can change Cosmos-SDK state. The branch is journaled,
+// so reverting to a snapshot taken before the call discards its writes and
+// events, like the rest of the EVM state. "Commit" writes the branches that
+// remain to the context of the EVM transaction.
+func (s *StateDB) CacheCtxForPrecompile() sdk.Context {
+	cacheCtx, writeCacheCtx := s.ctx.CacheContext()
+	s.journal.append(precompileCalledChange{prevCtx: s.ctx})
+	s.ctx = cacheCtx
+	s.writeCacheCtxFns = append(s.writeCacheCtxFns, writeCacheCtx)
+	return cacheCtx
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *gethcore.Log) {
 	s.journal.append(addLogChange{})
@@ -309,6 +334,15 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance (in wei) of the account associated with addr.
+// The change is journaled like "AddBalance" and "SubBalance".
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -475,9 +509,36 @@ func (s *StateDB) Commit() error {
 			}
 		}
 	}
+
+	// Write the precompile branches from the innermost one down to the
+	// context of the EVM transaction.
+	for i := len(s.writeCacheCtxFns) - 1; i >= 0; i-- {
+		s.writeCacheCtxFns[i]()
+	}
+	s.writeCacheCtxFns = nil
+	s.ctx = s.evmTxCtx
 	return nil
 }
 
+// DirtyAddresses: Returns the addresses of the accounts changed in the
+// StateDB, sorted for deterministic iteration.
+func (s *StateDB) DirtyAddresses() []common.Address {
+	return s.journal.sortedDirties()
+}
+
+// CachedAddresses: Returns the addresses of all accounts loaded into the
+// StateDB, sorted for deterministic iteration.
+func (s *StateDB) CachedAddresses() []common.Address {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
+
 // StateObjects: Returns a copy of the [StateDB.stateObjects] map.
 func (s *StateDB) StateObjects() map[common.Address]*stateObject {
 	copyOfMap := make(map[common.Address]*stateObject)
@@ -4,13 +4,17 @@ import (
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	s "github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/eth"
 	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/evm"
 	"github.com/NibiruChain/nibiru/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/x/evm/statedb"
 )
@@ -334,6 +338,9 @@ func (s *Suite) TestRevertSnapshot() {
 			db.AddBalance(address, big.NewInt(10))
 			db.SubBalance(address, big.NewInt(5))
 		}},
+		{"set balance", func(db vm.StateDB) {
+			db.(*statedb.StateDB).SetBalance(address, big.NewInt(42))
+		}},
 		{"override account", func(db vm.StateDB) {
 			db.CreateAccount(address)
 		}},
@@ -422,6 +429,51 @@ func (s *Suite) TestNestedSnapshot() {
 	s.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (s *Suite) TestCacheCtxForPrecompile() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 10))
+	wantBalanceWei := evm.NativeToWei(big.NewInt(10))
+
+	s.Run("revert discards the precompile writes", func() {
+		deps := evmtest.NewTestDeps()
+		db := deps.StateDB()
+
+		rev := db.Snapshot()
+		ctx := db.CacheCtxForPrecompile()
+		s.Require().NoError(testapp.FundAccount(
+			deps.Chain.BankKeeper, ctx, eth.EthAddrToNibiruAddr(address), coins,
+		))
+		s.Require().Equal(wantBalanceWei, db.GetBalance(address))
+
+		db.RevertToSnapshot(rev)
+		s.Require().Equal(big.NewInt(0), db.GetBalance(address))
+		s.Require().NoError(db.Commit())
+		s.Require().True(deps.Chain.BankKeeper.GetAllBalances(
+			deps.Ctx, eth.EthAddrToNibiruAddr(address)).IsZero())
+	})
+
+	s.Run("commit writes the nested precompile writes", func() {
+		deps := evmtest.NewTestDeps()
+		db := deps.StateDB()
+
+		for _, addr := range []common.Address{address, address2} {
+			db.Snapshot()
+			ctx := db.CacheCtxForPrecompile()
+			s.Require().NoError(testapp.FundAccount(
+				deps.Chain.BankKeeper, ctx, eth.EthAddrToNibiruAddr(addr), coins,
+			))
+		}
+		s.Require().True(deps.Chain.BankKeeper.GetAllBalances(
+			deps.Ctx, eth.EthAddrToNibiruAddr(address)).IsZero(),
+			"precompile writes should be pending until commit")
+
+		s.Require().NoError(db.Commit())
+		for _, addr := range []common.Address{address, address2} {
+			s.Require().Equal(coins, deps.Chain.BankKeeper.GetAllBalances(
+				deps.Ctx, eth.EthAddrToNibiruAddr(addr)))
+		}
+	})
+}
+
 func (s *Suite) TestInvalidSnapshotId() {
 	deps := evmtest.NewTestDeps()
 	db := deps.StateDB()
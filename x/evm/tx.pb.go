@@ -13,6 +13,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -546,7 +547,103 @@ func (m *MsgCreateFunTokenResponse) GetFuntokenMapping() FunToken {
 	return FunToken{}
 }
 
-// MsgSendFunTokenToEvm: Arguments to send a bank coin to ERC-20 representation
+// MsgConvertCoinToEvm: Arguments to send a bank coin to ERC-20 representation
+type MsgConvertCoinToEvm struct {
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	ToEthAddr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"to_eth_addr"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Bank coin to get converted to ERC20
+	BankCoin types1.Coin `protobuf:"bytes,3,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *MsgConvertCoinToEvm) Reset()         { *m = MsgConvertCoinToEvm{} }
+func (m *MsgConvertCoinToEvm) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinToEvm) ProtoMessage()    {}
+func (*MsgConvertCoinToEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{10}
+}
+func (m *MsgConvertCoinToEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinToEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinToEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoinToEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinToEvm.Merge(m, src)
+}
+func (m *MsgConvertCoinToEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinToEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinToEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoinToEvm proto.InternalMessageInfo
+
+func (m *MsgConvertCoinToEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgConvertCoinToEvm) GetBankCoin() types1.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types1.Coin{}
+}
+
+type MsgConvertCoinToEvmResponse struct {
+}
+
+func (m *MsgConvertCoinToEvmResponse) Reset()         { *m = MsgConvertCoinToEvmResponse{} }
+func (m *MsgConvertCoinToEvmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinToEvmResponse) ProtoMessage()    {}
+func (*MsgConvertCoinToEvmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{11}
+}
+func (m *MsgConvertCoinToEvmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinToEvmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinToEvmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertCoinToEvmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinToEvmResponse.Merge(m, src)
+}
+func (m *MsgConvertCoinToEvmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinToEvmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinToEvmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertCoinToEvmResponse proto.InternalMessageInfo
+
+// MsgSendFunTokenToEvm: Deprecated. Use "MsgConvertCoinToEvm", which has the
+// same fields and behavior.
+//
+// Deprecated: Do not use.
 type MsgSendFunTokenToEvm struct {
 	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
 	ToEthAddr github_com_NibiruChain_nibiru_eth.HexAddr `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3,customtype=github.com/NibiruChain/nibiru/eth.HexAddr" json:"to_eth_addr"`
@@ -560,7 +657,7 @@ func (m *MsgSendFunTokenToEvm) Reset()         { *m = MsgSendFunTokenToEvm{} }
 func (m *MsgSendFunTokenToEvm) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFunTokenToEvm) ProtoMessage()    {}
 func (*MsgSendFunTokenToEvm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82a0bfe4f0bab953, []int{10}
+	return fileDescriptor_82a0bfe4f0bab953, []int{12}
 }
 func (m *MsgSendFunTokenToEvm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,6 +700,7 @@ func (m *MsgSendFunTokenToEvm) GetBankCoin() types1.Coin {
 	return types1.Coin{}
 }
 
+// Deprecated: Do not use.
 type MsgSendFunTokenToEvmResponse struct {
 }
 
@@ -610,7 +708,7 @@ func (m *MsgSendFunTokenToEvmResponse) Reset()         { *m = MsgSendFunTokenToE
 func (m *MsgSendFunTokenToEvmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendFunTokenToEvmResponse) ProtoMessage()    {}
 func (*MsgSendFunTokenToEvmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82a0bfe4f0bab953, []int{11}
+	return fileDescriptor_82a0bfe4f0bab953, []int{13}
 }
 func (m *MsgSendFunTokenToEvmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,6 +737,127 @@ func (m *MsgSendFunTokenToEvmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendFunTokenToEvmResponse proto.InternalMessageInfo
 
+// MsgUpdateFunTokenMetadata: Arguments to verify a "FunToken" mapping and
+// override its bank metadata.
+type MsgUpdateFunTokenMetadata struct {
+	// Sender: Address for the signer of the transaction. Must be the module
+	// authority (x/gov) or a sudoer from the x/sudo module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// bank_denom: Coin denomination of the "FunToken" mapping to update.
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// verified: Value to set for the "verified" flag of the mapping.
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// metadata: Optional bank metadata that overrides the metadata derived from
+	// the ERC20 contract. The base denom must equal "bank_denom". Only allowed
+	// for mappings created from an ERC20.
+	Metadata *types2.Metadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgUpdateFunTokenMetadata) Reset()         { *m = MsgUpdateFunTokenMetadata{} }
+func (m *MsgUpdateFunTokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFunTokenMetadata) ProtoMessage()    {}
+func (*MsgUpdateFunTokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{14}
+}
+func (m *MsgUpdateFunTokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFunTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFunTokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFunTokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFunTokenMetadata.Merge(m, src)
+}
+func (m *MsgUpdateFunTokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFunTokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFunTokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFunTokenMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateFunTokenMetadata) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateFunTokenMetadata) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *MsgUpdateFunTokenMetadata) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *MsgUpdateFunTokenMetadata) GetMetadata() *types2.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type MsgUpdateFunTokenMetadataResponse struct {
+	FuntokenMapping FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping"`
+}
+
+func (m *MsgUpdateFunTokenMetadataResponse) Reset()         { *m = MsgUpdateFunTokenMetadataResponse{} }
+func (m *MsgUpdateFunTokenMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFunTokenMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateFunTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{15}
+}
+func (m *MsgUpdateFunTokenMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFunTokenMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFunTokenMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFunTokenMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFunTokenMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateFunTokenMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFunTokenMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFunTokenMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFunTokenMetadataResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateFunTokenMetadataResponse) GetFuntokenMapping() FunToken {
+	if m != nil {
+		return m.FuntokenMapping
+	}
+	return FunToken{}
+}
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
@@ -650,92 +869,105 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "eth.evm.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCreateFunToken)(nil), "eth.evm.v1.MsgCreateFunToken")
 	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
+	proto.RegisterType((*MsgConvertCoinToEvm)(nil), "eth.evm.v1.MsgConvertCoinToEvm")
+	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
 	proto.RegisterType((*MsgSendFunTokenToEvm)(nil), "eth.evm.v1.MsgSendFunTokenToEvm")
 	proto.RegisterType((*MsgSendFunTokenToEvmResponse)(nil), "eth.evm.v1.MsgSendFunTokenToEvmResponse")
+	proto.RegisterType((*MsgUpdateFunTokenMetadata)(nil), "eth.evm.v1.MsgUpdateFunTokenMetadata")
+	proto.RegisterType((*MsgUpdateFunTokenMetadataResponse)(nil), "eth.evm.v1.MsgUpdateFunTokenMetadataResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
 
 var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x1b, 0xff, 0x78, 0x76, 0x93, 0x76, 0xbf, 0xe9, 0xb7, 0x6b, 0x53, 0xbc, 0x66,
-	0x23, 0x90, 0x41, 0xca, 0x6e, 0x13, 0x24, 0xa4, 0x46, 0xe2, 0x10, 0x27, 0x2e, 0x14, 0x25, 0x10,
-	0xb6, 0x2e, 0x07, 0x2e, 0xd6, 0x78, 0x77, 0xb2, 0x5e, 0x25, 0x3b, 0xb3, 0xda, 0x19, 0x5b, 0x0e,
-	0x27, 0xd4, 0x13, 0x12, 0x07, 0x40, 0xdc, 0x11, 0x17, 0x2e, 0x9c, 0x38, 0xf4, 0xc0, 0x9f, 0x50,
-	0x71, 0xaa, 0xe8, 0x05, 0xf5, 0x60, 0x50, 0x8a, 0x84, 0x94, 0x63, 0x0f, 0x9c, 0xd1, 0xcc, 0xae,
-	0x1d, 0x3b, 0x21, 0x29, 0xad, 0x10, 0xb7, 0x79, 0xf3, 0x7e, 0xcc, 0x7b, 0x9f, 0xcf, 0x9b, 0x37,
-	0x03, 0xff, 0xc3, 0xbc, 0x67, 0xe3, 0x41, 0x68, 0x0f, 0x56, 0x6d, 0x3e, 0xb4, 0xa2, 0x98, 0x72,
-	0xaa, 0x01, 0xe6, 0x3d, 0x0b, 0x0f, 0x42, 0x6b, 0xb0, 0x5a, 0xbd, 0xe6, 0x52, 0x16, 0x52, 0x66,
-	0x87, 0xcc, 0x17, 0x36, 0x21, 0xf3, 0x13, 0xa3, 0x6a, 0x2d, 0x55, 0x74, 0x11, 0xc3, 0xf6, 0x60,
-	0xb5, 0x8b, 0x39, 0x5a, 0xb5, 0x5d, 0x1a, 0x90, 0x54, 0x5f, 0x49, 0xf4, 0x1d, 0x29, 0xd9, 0x89,
-	0x90, 0xaa, 0x96, 0xa6, 0x0e, 0x15, 0xc7, 0xa4, 0xbb, 0x3e, 0xf5, 0x69, 0x62, 0x2d, 0x56, 0xe9,
-	0xee, 0x75, 0x9f, 0x52, 0xff, 0x00, 0xdb, 0x28, 0x0a, 0x6c, 0x44, 0x08, 0xe5, 0x88, 0x07, 0x94,
-	0x8c, 0x23, 0x55, 0x52, 0xad, 0x94, 0xba, 0xfd, 0x3d, 0x1b, 0x91, 0xc3, 0x44, 0x65, 0x7e, 0xa1,
-	0xc0, 0xa5, 0x1d, 0xe6, 0xb7, 0x78, 0x0f, 0xc7, 0xb8, 0x1f, 0xb6, 0x87, 0x5a, 0x03, 0x54, 0x0f,
-	0x71, 0xa4, 0x2b, 0x75, 0xa5, 0x51, 0x5a, 0x5b, 0xb2, 0x12, 0x5f, 0x6b, 0xec, 0x6b, 0x6d, 0x90,
-	0x43, 0x47, 0x5a, 0x68, 0x15, 0x50, 0x59, 0xf0, 0x09, 0xd6, 0x33, 0x75, 0xa5, 0xa1, 0x34, 0xe7,
-	0x8f, 0x47, 0x86, 0xb2, 0xe2, 0xc8, 0x2d, 0xcd, 0x00, 0xb5, 0x87, 0x58, 0x4f, 0xcf, 0xd6, 0x95,
-	0x46, 0xb1, 0x59, 0x7a, 0x3a, 0x32, 0xf2, 0xf1, 0x41, 0xb4, 0x6e, 0xae, 0x98, 0x8e, 0x54, 0x68,
-	0x1a, 0xa8, 0x7b, 0x31, 0x0d, 0x75, 0x55, 0x18, 0x38, 0x72, 0xbd, 0xae, 0x7e, 0xf6, 0xad, 0x31,
-	0x67, 0x7e, 0x95, 0x81, 0xc2, 0x36, 0xf6, 0x91, 0x7b, 0xd8, 0x1e, 0x6a, 0x4b, 0x30, 0x4f, 0x28,
-	0x71, 0xb1, 0xcc, 0x46, 0x75, 0x12, 0x41, 0x7b, 0x0b, 0x8a, 0x3e, 0x12, 0x98, 0x05, 0x6e, 0x72,
-	0x7a, 0xb1, 0x59, 0x79, 0x3c, 0x32, 0xae, 0x26, 0xf0, 0x31, 0x6f, 0xdf, 0x0a, 0xa8, 0x1d, 0x22,
-	0xde, 0xb3, 0x6e, 0x13, 0xee, 0x14, 0x7c, 0xc4, 0x76, 0x85, 0xa9, 0x56, 0x83, 0xac, 0x8f, 0x98,
-	0x4c, 0x4a, 0x6d, 0x96, 0x8f, 0x46, 0x46, 0xe1, 0x1d, 0xc4, 0xb6, 0x83, 0x30, 0xe0, 0x8e, 0x50,
-	0x68, 0x0b, 0x90, 0xe1, 0x34, 0x4d, 0x29, 0xc3, 0xa9, 0x76, 0x13, 0xe6, 0x07, 0xe8, 0xa0, 0x8f,
-	0xf5, 0x79, 0x79, 0xc6, 0xf2, 0xb9, 0x67, 0x1c, 0x8d, 0x8c, 0xdc, 0x46, 0x48, 0xfb, 0x84, 0x3b,
-	0x89, 0x87, 0xa8, 0x4f, 0xa2, 0x98, 0xab, 0x2b, 0x8d, 0x72, 0x8a, 0x57, 0x19, 0x94, 0x81, 0x9e,
-	0x97, 0x1b, 0xca, 0x40, 0x48, 0xb1, 0x5e, 0x48, 0xa4, 0x58, 0x48, 0x4c, 0x2f, 0x26, 0x12, 0x5b,
-	0x5f, 0x10, 0x48, 0xfc, 0x74, 0x7f, 0x25, 0xd7, 0x1e, 0x6e, 0x21, 0x8e, 0xcc, 0x1f, 0xb3, 0x50,
-	0xde, 0x70, 0x5d, 0xcc, 0xd8, 0x76, 0xc0, 0x78, 0x7b, 0xa8, 0xbd, 0x07, 0x05, 0xb7, 0x87, 0x02,
-	0xd2, 0x09, 0x3c, 0x09, 0x4d, 0xb1, 0x69, 0x5f, 0x94, 0x5c, 0x7e, 0x53, 0x18, 0xdf, 0xde, 0x3a,
-	0x1e, 0x19, 0x79, 0x37, 0x59, 0x3a, 0xe9, 0xc2, 0x3b, 0xc1, 0x38, 0x73, 0x2e, 0xc6, 0xd9, 0xe7,
-	0xc6, 0x58, 0xbd, 0x18, 0xe3, 0xf9, 0xb3, 0x18, 0xe7, 0x5e, 0x18, 0xe3, 0xfc, 0x14, 0xc6, 0x77,
-	0xa1, 0x80, 0x24, 0x50, 0x98, 0xe9, 0x85, 0x7a, 0xb6, 0x51, 0x5a, 0xbb, 0x66, 0x9d, 0xdc, 0x53,
-	0x2b, 0x01, 0xb1, 0xdd, 0x8f, 0x0e, 0x70, 0xb3, 0xfe, 0x60, 0x64, 0xcc, 0x1d, 0x8f, 0x0c, 0x40,
-	0x13, 0x64, 0xbf, 0xff, 0xd5, 0x80, 0x13, 0x9c, 0x9d, 0x49, 0xa8, 0x84, 0xba, 0xe2, 0x0c, 0x75,
-	0x30, 0x43, 0x5d, 0xe9, 0x3c, 0xea, 0xfe, 0xcc, 0x42, 0x79, 0xeb, 0x90, 0xa0, 0x30, 0x70, 0x6f,
-	0x61, 0xfc, 0x9f, 0x50, 0x77, 0x13, 0x4a, 0x82, 0x3a, 0x1e, 0x44, 0x1d, 0x17, 0x45, 0xcf, 0x26,
-	0x4f, 0x10, 0xdd, 0x0e, 0xa2, 0x4d, 0x14, 0x8d, 0x5d, 0xf7, 0x30, 0x96, 0xae, 0xea, 0x3f, 0x71,
-	0xbd, 0x85, 0xb1, 0x70, 0x4d, 0x89, 0x9f, 0xbf, 0x98, 0xf8, 0xdc, 0x59, 0xe2, 0xf3, 0x2f, 0x4c,
-	0x7c, 0xe1, 0x1c, 0xe2, 0x8b, 0xff, 0x32, 0xf1, 0x30, 0x43, 0x7c, 0x69, 0x86, 0xf8, 0xf2, 0x79,
-	0xc4, 0x9b, 0x50, 0x6d, 0x0d, 0x39, 0x26, 0x2c, 0xa0, 0xe4, 0x83, 0x48, 0x8e, 0xe3, 0x93, 0x29,
-	0x9b, 0xce, 0xba, 0x6f, 0x14, 0xb8, 0x3a, 0x33, 0x7d, 0x1d, 0xcc, 0x22, 0x4a, 0x98, 0x2c, 0x51,
-	0x0e, 0x50, 0x25, 0x99, 0x8f, 0x72, 0x66, 0x2e, 0x83, 0x7a, 0x40, 0x7d, 0xa6, 0x67, 0x64, 0x79,
-	0x8b, 0xd3, 0xe5, 0x6d, 0x53, 0xdf, 0x91, 0x4a, 0xed, 0x32, 0x64, 0x63, 0xcc, 0x25, 0xe9, 0x65,
-	0x47, 0x2c, 0xb5, 0x0a, 0x14, 0x06, 0x61, 0x07, 0xc7, 0x31, 0x8d, 0xd3, 0xd9, 0x96, 0x1f, 0x84,
-	0x2d, 0x21, 0x0a, 0x95, 0xa0, 0xbb, 0xcf, 0xb0, 0x97, 0x10, 0xe7, 0xe4, 0x7d, 0xc4, 0xee, 0x32,
-	0xec, 0xa5, 0x09, 0x7e, 0xae, 0xc0, 0xe2, 0x0e, 0xf3, 0xef, 0x46, 0x1e, 0xe2, 0x78, 0x17, 0xc5,
-	0x28, 0x64, 0x62, 0x32, 0xa0, 0x3e, 0xef, 0xd1, 0x38, 0xe0, 0x87, 0x69, 0x07, 0xeb, 0x3f, 0xdf,
-	0x5f, 0x59, 0x4a, 0x1f, 0xaf, 0x0d, 0xcf, 0x8b, 0x31, 0x63, 0x77, 0x78, 0x1c, 0x10, 0xdf, 0x39,
-	0x31, 0xd5, 0x6e, 0x40, 0x2e, 0x92, 0x11, 0x64, 0xb7, 0x96, 0xd6, 0xb4, 0xe9, 0x02, 0x92, 0xd8,
-	0x4d, 0x55, 0x50, 0xe3, 0xa4, 0x76, 0xeb, 0x0b, 0xf7, 0xfe, 0xf8, 0xe1, 0x8d, 0x93, 0x08, 0x66,
-	0x05, 0xae, 0x9d, 0x4a, 0x66, 0x8c, 0x97, 0xf9, 0x9d, 0x02, 0x57, 0x76, 0x98, 0xbf, 0x19, 0x63,
-	0xc4, 0xf1, 0xad, 0x3e, 0x69, 0xd3, 0x7d, 0x4c, 0xb4, 0x5d, 0x00, 0xf1, 0xb2, 0x74, 0x70, 0xec,
-	0xae, 0xdd, 0x48, 0x73, 0x5d, 0x7d, 0x30, 0x32, 0x94, 0xc7, 0x23, 0xe3, 0x75, 0x3f, 0xe0, 0xbd,
-	0x7e, 0xd7, 0x72, 0x69, 0x68, 0xbf, 0x1f, 0x74, 0x83, 0xb8, 0x2f, 0x6f, 0x9a, 0x4d, 0xe4, 0xda,
-	0x16, 0xb9, 0xbd, 0x8b, 0x87, 0xa2, 0x1a, 0xa7, 0x28, 0x82, 0xb4, 0x44, 0x0c, 0xed, 0x35, 0x58,
-	0x94, 0x11, 0xbb, 0x88, 0xec, 0x77, 0x3c, 0x4c, 0x68, 0x98, 0x3c, 0x40, 0xce, 0x25, 0xb1, 0xdd,
-	0x44, 0x64, 0x7f, 0x4b, 0x6c, 0x6a, 0xff, 0x87, 0x1c, 0xc3, 0xc4, 0xc3, 0x71, 0x72, 0xfd, 0x9c,
-	0x54, 0x32, 0xbb, 0x50, 0x39, 0x93, 0xe6, 0x84, 0xf4, 0x16, 0x5c, 0xde, 0xeb, 0x13, 0x2e, 0xf6,
-	0x3a, 0x21, 0x8a, 0xa2, 0x80, 0xf8, 0x93, 0x67, 0x78, 0x0a, 0xab, 0xb1, 0x5f, 0x8a, 0xd6, 0xe2,
-	0xd8, 0x67, 0x27, 0x71, 0x31, 0x1f, 0x29, 0xb0, 0xb4, 0xc3, 0xfc, 0x3b, 0x98, 0x78, 0x63, 0xd3,
-	0x36, 0x6d, 0x0d, 0x42, 0xed, 0x43, 0x28, 0x71, 0xda, 0xc1, 0xbc, 0xd7, 0x41, 0x9e, 0x17, 0x4f,
-	0xe1, 0x31, 0xf7, 0x9c, 0x78, 0x70, 0xda, 0xe2, 0x3d, 0xb1, 0x9c, 0xaa, 0x33, 0x33, 0x5d, 0xa7,
-	0xb6, 0x0b, 0x45, 0x09, 0x91, 0xf8, 0xea, 0x48, 0x08, 0x4a, 0x6b, 0x15, 0x2b, 0xed, 0x10, 0xf1,
-	0x17, 0xb2, 0xd2, 0xbf, 0x90, 0xb5, 0x49, 0x03, 0xd2, 0xd4, 0x45, 0x0e, 0x4f, 0x47, 0xc6, 0xe5,
-	0x43, 0x14, 0x1e, 0xac, 0x9b, 0x13, 0x4f, 0xd3, 0x29, 0x88, 0xb5, 0xb0, 0x31, 0x6b, 0x70, 0xfd,
-	0xef, 0x8a, 0x1a, 0x83, 0xb7, 0xf6, 0x69, 0x16, 0xb2, 0x3b, 0xcc, 0xd7, 0x08, 0xc0, 0xd4, 0x6f,
-	0xa6, 0x32, 0x0d, 0xdc, 0xcc, 0x55, 0xab, 0xbe, 0x72, 0xae, 0x6a, 0xd2, 0x55, 0xe6, 0xbd, 0x47,
-	0xbf, 0x7f, 0x9d, 0xb9, 0x6e, 0x56, 0x27, 0x28, 0xa4, 0xdf, 0xb1, 0xd4, 0xb4, 0xc3, 0x87, 0xda,
-	0x2e, 0x94, 0x67, 0xae, 0xc7, 0x4b, 0xa7, 0xc2, 0x4e, 0x2b, 0xab, 0xcb, 0x17, 0x28, 0x27, 0x6d,
-	0xf0, 0x11, 0x2c, 0x9c, 0xea, 0xe3, 0x97, 0x4f, 0xb9, 0xcd, 0xaa, 0xab, 0xaf, 0x5e, 0xa8, 0x9e,
-	0xc4, 0xed, 0xc0, 0x95, 0xb3, 0x3d, 0x51, 0x3f, 0xe5, 0x7b, 0xc6, 0xa2, 0xda, 0x78, 0x96, 0xc5,
-	0xf8, 0x80, 0xe6, 0xdb, 0x0f, 0x8e, 0x6a, 0xca, 0xc3, 0xa3, 0x9a, 0xf2, 0xdb, 0x51, 0x4d, 0xf9,
-	0xf2, 0x49, 0x6d, 0xee, 0xe1, 0x93, 0xda, 0xdc, 0x2f, 0x4f, 0x6a, 0x73, 0x1f, 0x2f, 0x5f, 0xdc,
-	0x5c, 0x43, 0x01, 0x6c, 0x37, 0x27, 0xff, 0x98, 0x6f, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5d,
-	0xb8, 0xdf, 0xf4, 0x6e, 0x0b, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x25, 0x59, 0x1f, 0x23, 0xc5, 0x76, 0x18, 0x27, 0x91, 0x94, 0x58, 0x74, 0x68, 0xe4,
+	0x3d, 0xbf, 0x07, 0x58, 0x8c, 0xfd, 0x80, 0x07, 0xc4, 0x40, 0x0f, 0x96, 0xed, 0xb4, 0x29, 0xac,
+	0xd6, 0x65, 0x94, 0x1e, 0x8a, 0x02, 0xc2, 0x4a, 0x5c, 0x53, 0xac, 0xcd, 0x5d, 0x82, 0xbb, 0x12,
+	0xe4, 0x1e, 0x73, 0x0a, 0xd0, 0x43, 0x5b, 0xf4, 0x5e, 0xf4, 0xd2, 0x4b, 0x4f, 0x3d, 0x04, 0x45,
+	0xff, 0x84, 0xa0, 0xa7, 0x20, 0x39, 0xb4, 0xc8, 0x41, 0x2d, 0x9c, 0x02, 0x05, 0x72, 0xcc, 0xa1,
+	0xe7, 0x62, 0x97, 0x14, 0xf5, 0x15, 0x39, 0x1f, 0x08, 0x0a, 0xf4, 0xb6, 0xb3, 0xf3, 0x9b, 0xe1,
+	0xcc, 0xef, 0xb7, 0x3b, 0x24, 0xe1, 0x1c, 0xe6, 0x2d, 0x03, 0x77, 0x5c, 0xa3, 0xb3, 0x6e, 0xf0,
+	0x6e, 0xd9, 0xf3, 0x29, 0xa7, 0x2a, 0x60, 0xde, 0x2a, 0xe3, 0x8e, 0x5b, 0xee, 0xac, 0x17, 0x2f,
+	0x36, 0x29, 0x73, 0x29, 0x33, 0x5c, 0x66, 0x0b, 0x8c, 0xcb, 0xec, 0x00, 0x54, 0x2c, 0x85, 0x8e,
+	0x06, 0x22, 0x87, 0x46, 0x67, 0xbd, 0x81, 0x39, 0x5a, 0x97, 0xc6, 0x84, 0x9f, 0xe1, 0xc8, 0xdf,
+	0xa4, 0x0e, 0x09, 0xfd, 0x85, 0xc0, 0x5f, 0x97, 0x96, 0x11, 0x18, 0xa1, 0x6b, 0x71, 0xa8, 0x28,
+	0x51, 0x46, 0xb8, 0x6b, 0x53, 0x9b, 0x06, 0x68, 0xb1, 0x0a, 0x77, 0x2f, 0xdb, 0x94, 0xda, 0x47,
+	0xd8, 0x40, 0x9e, 0x63, 0x20, 0x42, 0x28, 0x47, 0xdc, 0xa1, 0xa4, 0x9f, 0xa9, 0x10, 0x7a, 0xa5,
+	0xd5, 0x68, 0x1f, 0x18, 0x88, 0x1c, 0x07, 0x2e, 0xfd, 0x73, 0x05, 0xce, 0x54, 0x99, 0xbd, 0xcb,
+	0x5b, 0xd8, 0xc7, 0x6d, 0xb7, 0xd6, 0x55, 0x57, 0x21, 0x61, 0x21, 0x8e, 0xf2, 0xca, 0xb2, 0xb2,
+	0x9a, 0xdd, 0x58, 0x2c, 0x07, 0xb1, 0xe5, 0x7e, 0x6c, 0x79, 0x8b, 0x1c, 0x9b, 0x12, 0xa1, 0x16,
+	0x20, 0xc1, 0x9c, 0x4f, 0x71, 0x3e, 0xb6, 0xac, 0xac, 0x2a, 0x95, 0xd9, 0xa7, 0x3d, 0x4d, 0x59,
+	0x33, 0xe5, 0x96, 0xaa, 0x41, 0xa2, 0x85, 0x58, 0x2b, 0x1f, 0x5f, 0x56, 0x56, 0x33, 0x95, 0xec,
+	0xb3, 0x9e, 0x96, 0xf2, 0x8f, 0xbc, 0x4d, 0x7d, 0x4d, 0x37, 0xa5, 0x43, 0x55, 0x21, 0x71, 0xe0,
+	0x53, 0x37, 0x9f, 0x10, 0x00, 0x53, 0xae, 0x37, 0x13, 0x77, 0xbf, 0xd1, 0x66, 0xf4, 0x2f, 0x63,
+	0x90, 0xde, 0xc3, 0x36, 0x6a, 0x1e, 0xd7, 0xba, 0xea, 0x22, 0xcc, 0x12, 0x4a, 0x9a, 0x58, 0x56,
+	0x93, 0x30, 0x03, 0x43, 0xfd, 0x3f, 0x64, 0x6c, 0x24, 0x38, 0x73, 0x9a, 0xc1, 0xd3, 0x33, 0x95,
+	0xc2, 0xe3, 0x9e, 0x76, 0x3e, 0xa0, 0x8f, 0x59, 0x87, 0x65, 0x87, 0x1a, 0x2e, 0xe2, 0xad, 0xf2,
+	0x4d, 0xc2, 0xcd, 0xb4, 0x8d, 0xd8, 0xbe, 0x80, 0xaa, 0x25, 0x88, 0xdb, 0x88, 0xc9, 0xa2, 0x12,
+	0x95, 0xdc, 0x49, 0x4f, 0x4b, 0xbf, 0x8d, 0xd8, 0x9e, 0xe3, 0x3a, 0xdc, 0x14, 0x0e, 0x75, 0x0e,
+	0x62, 0x9c, 0x86, 0x25, 0xc5, 0x38, 0x55, 0xaf, 0xc3, 0x6c, 0x07, 0x1d, 0xb5, 0x71, 0x7e, 0x56,
+	0x3e, 0x63, 0x65, 0xea, 0x33, 0x4e, 0x7a, 0x5a, 0x72, 0xcb, 0xa5, 0x6d, 0xc2, 0xcd, 0x20, 0x42,
+	0xf4, 0x27, 0x59, 0x4c, 0x2e, 0x2b, 0xab, 0xb9, 0x90, 0xaf, 0x1c, 0x28, 0x9d, 0x7c, 0x4a, 0x6e,
+	0x28, 0x1d, 0x61, 0xf9, 0xf9, 0x74, 0x60, 0xf9, 0xc2, 0x62, 0xf9, 0x4c, 0x60, 0xb1, 0xcd, 0x39,
+	0xc1, 0xc4, 0x4f, 0xf7, 0xd6, 0x92, 0xb5, 0xee, 0x0e, 0xe2, 0x48, 0xff, 0x31, 0x0e, 0xb9, 0xad,
+	0x66, 0x13, 0x33, 0xb6, 0xe7, 0x30, 0x5e, 0xeb, 0xaa, 0xef, 0x42, 0xba, 0xd9, 0x42, 0x0e, 0xa9,
+	0x3b, 0x96, 0xa4, 0x26, 0x53, 0x31, 0x4e, 0x2b, 0x2e, 0xb5, 0x2d, 0xc0, 0x37, 0x77, 0x9e, 0xf6,
+	0xb4, 0x54, 0x33, 0x58, 0x9a, 0xe1, 0xc2, 0x1a, 0x70, 0x1c, 0x9b, 0xca, 0x71, 0xfc, 0x95, 0x39,
+	0x4e, 0x9c, 0xce, 0xf1, 0xec, 0x24, 0xc7, 0xc9, 0xd7, 0xe6, 0x38, 0x35, 0xc4, 0xf1, 0x6d, 0x48,
+	0x23, 0x49, 0x14, 0x66, 0xf9, 0xf4, 0x72, 0x7c, 0x35, 0xbb, 0x71, 0xb1, 0x3c, 0xb8, 0xc7, 0xe5,
+	0x80, 0xc4, 0x5a, 0xdb, 0x3b, 0xc2, 0x95, 0xe5, 0xfb, 0x3d, 0x6d, 0xe6, 0x69, 0x4f, 0x03, 0x14,
+	0x31, 0xfb, 0xdd, 0xaf, 0x1a, 0x0c, 0x78, 0x36, 0xa3, 0x54, 0x81, 0x74, 0x99, 0x11, 0xe9, 0x60,
+	0x44, 0xba, 0xec, 0x34, 0xe9, 0xfe, 0x8c, 0x43, 0x6e, 0xe7, 0x98, 0x20, 0xd7, 0x69, 0xde, 0xc0,
+	0xf8, 0x6f, 0x91, 0xee, 0x3a, 0x64, 0x85, 0x74, 0xdc, 0xf1, 0xea, 0x4d, 0xe4, 0xbd, 0x58, 0x3c,
+	0x21, 0x74, 0xcd, 0xf1, 0xb6, 0x91, 0xd7, 0x0f, 0x3d, 0xc0, 0x58, 0x86, 0x26, 0x5e, 0x26, 0xf4,
+	0x06, 0xc6, 0x22, 0x34, 0x14, 0x7e, 0xf6, 0x74, 0xe1, 0x93, 0x93, 0xc2, 0xa7, 0x5e, 0x5b, 0xf8,
+	0xf4, 0x14, 0xe1, 0x33, 0x6f, 0x58, 0x78, 0x18, 0x11, 0x3e, 0x3b, 0x22, 0x7c, 0x6e, 0x9a, 0xf0,
+	0x3a, 0x14, 0x77, 0xbb, 0x1c, 0x13, 0xe6, 0x50, 0xf2, 0xbe, 0x27, 0xc7, 0xf1, 0x60, 0xca, 0x86,
+	0xb3, 0xee, 0x6b, 0x05, 0xce, 0x8f, 0x4c, 0x5f, 0x13, 0x33, 0x8f, 0x12, 0x26, 0x5b, 0x94, 0x03,
+	0x54, 0x09, 0xe6, 0xa3, 0x58, 0xab, 0x2b, 0x90, 0x38, 0xa2, 0x36, 0xcb, 0xc7, 0x64, 0x7b, 0xf3,
+	0xc3, 0xed, 0xed, 0x51, 0xdb, 0x94, 0x4e, 0x75, 0x01, 0xe2, 0x3e, 0xe6, 0x52, 0xf4, 0x9c, 0x29,
+	0x96, 0x6a, 0x01, 0xd2, 0x1d, 0xb7, 0x8e, 0x7d, 0x9f, 0xfa, 0xe1, 0x6c, 0x4b, 0x75, 0xdc, 0x5d,
+	0x61, 0x0a, 0x97, 0x90, 0xbb, 0xcd, 0xb0, 0x15, 0x08, 0x67, 0xa6, 0x6c, 0xc4, 0x6e, 0x33, 0x6c,
+	0x85, 0x05, 0x7e, 0xa6, 0xc0, 0x7c, 0x95, 0xd9, 0xb7, 0x3d, 0x0b, 0x71, 0xbc, 0x8f, 0x7c, 0xe4,
+	0x32, 0x31, 0x19, 0x50, 0x9b, 0xb7, 0xa8, 0xef, 0xf0, 0xe3, 0xf0, 0x04, 0xe7, 0x1f, 0xde, 0x5b,
+	0x5b, 0x0c, 0x5f, 0x5e, 0x5b, 0x96, 0xe5, 0x63, 0xc6, 0x6e, 0x71, 0xdf, 0x21, 0xb6, 0x39, 0x80,
+	0xaa, 0xd7, 0x20, 0xe9, 0xc9, 0x0c, 0xf2, 0xb4, 0x66, 0x37, 0xd4, 0xe1, 0x06, 0x82, 0xdc, 0x95,
+	0x84, 0x90, 0xc6, 0x0c, 0x71, 0x9b, 0x73, 0x77, 0xfe, 0xf8, 0xfe, 0xbf, 0x83, 0x0c, 0x7a, 0x01,
+	0x2e, 0x8e, 0x15, 0xd3, 0xe7, 0x4b, 0xff, 0x56, 0x81, 0xb3, 0x55, 0x66, 0x6f, 0xfb, 0x18, 0x71,
+	0x7c, 0xa3, 0x4d, 0x6a, 0xf4, 0x10, 0x13, 0x75, 0x1f, 0x40, 0xbc, 0x59, 0xea, 0xd8, 0x6f, 0x6e,
+	0x5c, 0x0b, 0x6b, 0x5d, 0xbf, 0xdf, 0xd3, 0x94, 0xc7, 0x3d, 0xed, 0x3f, 0xb6, 0xc3, 0x5b, 0xed,
+	0x46, 0xb9, 0x49, 0x5d, 0xe3, 0x3d, 0xa7, 0xe1, 0xf8, 0x6d, 0x79, 0xd3, 0x0c, 0x22, 0xd7, 0x86,
+	0xa8, 0xed, 0x1d, 0xdc, 0x15, 0xdd, 0x98, 0x19, 0x91, 0x64, 0x57, 0xe4, 0x50, 0xff, 0x05, 0xf3,
+	0x32, 0xa3, 0x78, 0xc5, 0xd7, 0x2d, 0x4c, 0xa8, 0x1b, 0xbc, 0x80, 0xcc, 0x33, 0x62, 0xbb, 0x82,
+	0xc8, 0xe1, 0x8e, 0xd8, 0x54, 0x2f, 0x40, 0x92, 0x61, 0x62, 0x61, 0x3f, 0xb8, 0x7e, 0x66, 0x68,
+	0xe9, 0x0d, 0x28, 0x4c, 0x94, 0x19, 0x89, 0xbe, 0x0b, 0x0b, 0x07, 0x6d, 0xc2, 0xc5, 0x5e, 0xdd,
+	0x45, 0x9e, 0xe7, 0x10, 0x3b, 0x7a, 0x0d, 0x0f, 0x71, 0xd5, 0x8f, 0x0b, 0xd9, 0x9a, 0xef, 0xc7,
+	0x54, 0x83, 0x10, 0xfd, 0xa1, 0x02, 0xe7, 0xc4, 0x43, 0x28, 0xe9, 0x60, 0x9f, 0x6f, 0x53, 0x87,
+	0xd4, 0xe8, 0x6e, 0xc7, 0x55, 0x3f, 0x80, 0x2c, 0xa7, 0x75, 0xcc, 0x5b, 0x75, 0x64, 0x59, 0xfe,
+	0x10, 0x1d, 0x33, 0xaf, 0x48, 0x07, 0xa7, 0xbb, 0xbc, 0x25, 0x96, 0x43, 0x6d, 0xc6, 0x86, 0xdb,
+	0x54, 0xf7, 0x21, 0x23, 0x19, 0x12, 0x5f, 0x3a, 0x92, 0x81, 0xec, 0x46, 0xa1, 0x1c, 0x1e, 0x10,
+	0xf1, 0x29, 0x54, 0x0e, 0x3f, 0x85, 0xca, 0xa2, 0xba, 0x4a, 0x5e, 0xd4, 0xf0, 0xac, 0xa7, 0x2d,
+	0x1c, 0x23, 0xf7, 0x68, 0x53, 0x8f, 0x22, 0x75, 0x33, 0x2d, 0xd6, 0x02, 0xa3, 0x2f, 0xc1, 0xa5,
+	0xe7, 0xf4, 0x14, 0xe9, 0xff, 0xb3, 0x02, 0x8b, 0x55, 0x66, 0xdf, 0xc2, 0xc4, 0xea, 0xd3, 0xf3,
+	0xcf, 0x6f, 0x7a, 0x33, 0x96, 0x57, 0x74, 0x1d, 0x2e, 0x3f, 0xaf, 0xb1, 0x7e, 0xe7, 0x12, 0xf3,
+	0x83, 0x02, 0x85, 0xe8, 0x66, 0xf4, 0x61, 0x55, 0xcc, 0x91, 0x1c, 0x8d, 0x83, 0x7a, 0x95, 0x91,
+	0x7a, 0x97, 0x00, 0x26, 0x8e, 0x71, 0xa6, 0x11, 0x1d, 0xe1, 0x22, 0xa4, 0x3b, 0xd8, 0x77, 0x0e,
+	0x1c, 0x6c, 0xc9, 0x6e, 0xd2, 0x66, 0x64, 0xab, 0xd7, 0x21, 0xed, 0x86, 0xe9, 0xe5, 0x4c, 0xc9,
+	0x6e, 0x2c, 0x0d, 0x3a, 0x25, 0x87, 0x51, 0xa7, 0xfd, 0x1a, 0xcc, 0x08, 0xbe, 0x99, 0x15, 0x97,
+	0xba, 0x7f, 0x1d, 0x3e, 0x81, 0x2b, 0x53, 0xeb, 0x7e, 0xc3, 0xd7, 0x62, 0xe3, 0x51, 0x02, 0xe2,
+	0x55, 0x66, 0xab, 0x04, 0x60, 0xe8, 0x73, 0xb7, 0x30, 0x9c, 0x62, 0x64, 0x16, 0x17, 0xaf, 0x4c,
+	0x75, 0x45, 0xc7, 0x4e, 0xbf, 0xf3, 0xe8, 0xf7, 0xaf, 0x62, 0x97, 0xf5, 0x62, 0x74, 0x64, 0xc2,
+	0xef, 0xf5, 0x10, 0x5a, 0xe7, 0x5d, 0x75, 0x1f, 0x72, 0x23, 0xf3, 0xf3, 0xd2, 0x58, 0xda, 0x61,
+	0x67, 0x71, 0xe5, 0x14, 0x67, 0x44, 0xc8, 0x87, 0x30, 0x37, 0x36, 0xe8, 0x96, 0xc6, 0xc2, 0x46,
+	0xdd, 0xc5, 0xab, 0xa7, 0xba, 0xa3, 0xbc, 0x1f, 0xc3, 0xc2, 0xc4, 0xd0, 0xd0, 0xc6, 0x43, 0xc7,
+	0x00, 0xc5, 0x7f, 0xbf, 0x00, 0x10, 0x65, 0xb7, 0xe0, 0xec, 0xe4, 0xf5, 0x5c, 0x1e, 0x8b, 0x9e,
+	0x40, 0x14, 0x57, 0x5f, 0x84, 0x88, 0xc4, 0x88, 0xdf, 0x8d, 0x29, 0x2a, 0x81, 0x0b, 0x53, 0xae,
+	0xc1, 0xd5, 0xe7, 0x52, 0x3b, 0x0e, 0x2b, 0xae, 0xbd, 0x14, 0xac, 0xff, 0xd0, 0xca, 0x5b, 0xf7,
+	0x4f, 0x4a, 0xca, 0x83, 0x93, 0x92, 0xf2, 0xdb, 0x49, 0x49, 0xf9, 0xe2, 0x49, 0x69, 0xe6, 0xc1,
+	0x93, 0xd2, 0xcc, 0x2f, 0x4f, 0x4a, 0x33, 0x1f, 0xad, 0x9c, 0x3e, 0x5c, 0xba, 0xe2, 0xac, 0x34,
+	0x92, 0xf2, 0xbf, 0xea, 0x7f, 0x7f, 0x0d, 0x00, 0x34, 0xc5, 0xda, 0x16, 0x82, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -759,10 +991,20 @@ type MsgClient interface {
 	// address can be given to create the mapping to a bank coin, or the
 	// denomination for a bank coin can be given to create the mapping to an ERC20.
 	CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error)
-	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
+	// representation. Coins of a FunToken made from a bank coin are escrowed
+	// and the ERC20 is minted. Coins of a FunToken made from an ERC20 are
+	// burned and the escrowed ERC20 tokens are released.
+	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// SendFunTokenToEvm: Deprecated alias of "ConvertCoinToEvm", kept so that
+	// clients signing the old message type keep working.
 	SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error)
+	// UpdateFunTokenMetadata: Sets the verification flag of a "FunToken"
+	// mapping and, for mappings created from an ERC20, optionally overrides the
+	// bank metadata derived from the contract. Only the module authority (x/gov)
+	// or the x/sudo root and sudo contracts can call this.
+	UpdateFunTokenMetadata(ctx context.Context, in *MsgUpdateFunTokenMetadata, opts ...grpc.CallOption) (*MsgUpdateFunTokenMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -800,6 +1042,16 @@ func (c *msgClient) CreateFunToken(ctx context.Context, in *MsgCreateFunToken, o
 	return out, nil
 }
 
+func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error) {
+	out := new(MsgConvertCoinToEvmResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertCoinToEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
 func (c *msgClient) SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenToEvm, opts ...grpc.CallOption) (*MsgSendFunTokenToEvmResponse, error) {
 	out := new(MsgSendFunTokenToEvmResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/SendFunTokenToEvm", in, out, opts...)
@@ -809,6 +1061,15 @@ func (c *msgClient) SendFunTokenToEvm(ctx context.Context, in *MsgSendFunTokenTo
 	return out, nil
 }
 
+func (c *msgClient) UpdateFunTokenMetadata(ctx context.Context, in *MsgUpdateFunTokenMetadata, opts ...grpc.CallOption) (*MsgUpdateFunTokenMetadataResponse, error) {
+	out := new(MsgUpdateFunTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UpdateFunTokenMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -820,10 +1081,20 @@ type MsgServer interface {
 	// address can be given to create the mapping to a bank coin, or the
 	// denomination for a bank coin can be given to create the mapping to an ERC20.
 	CreateFunToken(context.Context, *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error)
-	// SendFunTokenToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
+	// representation. Coins of a FunToken made from a bank coin are escrowed
+	// and the ERC20 is minted. Coins of a FunToken made from an ERC20 are
+	// burned and the escrowed ERC20 tokens are released.
+	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// SendFunTokenToEvm: Deprecated alias of "ConvertCoinToEvm", kept so that
+	// clients signing the old message type keep working.
 	SendFunTokenToEvm(context.Context, *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error)
+	// UpdateFunTokenMetadata: Sets the verification flag of a "FunToken"
+	// mapping and, for mappings created from an ERC20, optionally overrides the
+	// bank metadata derived from the contract. Only the module authority (x/gov)
+	// or the x/sudo root and sudo contracts can call this.
+	UpdateFunTokenMetadata(context.Context, *MsgUpdateFunTokenMetadata) (*MsgUpdateFunTokenMetadataResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -839,9 +1110,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFunToken not implemented")
 }
+func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
+}
 func (*UnimplementedMsgServer) SendFunTokenToEvm(ctx context.Context, req *MsgSendFunTokenToEvm) (*MsgSendFunTokenToEvmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendFunTokenToEvm not implemented")
 }
+func (*UnimplementedMsgServer) UpdateFunTokenMetadata(ctx context.Context, req *MsgUpdateFunTokenMetadata) (*MsgUpdateFunTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFunTokenMetadata not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -901,6 +1178,24 @@ func _Msg_CreateFunToken_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinToEvm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinToEvm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertCoinToEvm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinToEvm(ctx, req.(*MsgConvertCoinToEvm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SendFunTokenToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSendFunTokenToEvm)
 	if err := dec(in); err != nil {
@@ -919,6 +1214,24 @@ func _Msg_SendFunTokenToEvm_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFunTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFunTokenMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFunTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/UpdateFunTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFunTokenMetadata(ctx, req.(*MsgUpdateFunTokenMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eth.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -935,10 +1248,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateFunToken",
 			Handler:    _Msg_CreateFunToken_Handler,
 		},
+		{
+			MethodName: "ConvertCoinToEvm",
+			Handler:    _Msg_ConvertCoinToEvm_Handler,
+		},
 		{
 			MethodName: "SendFunTokenToEvm",
 			Handler:    _Msg_SendFunTokenToEvm_Handler,
 		},
+		{
+			MethodName: "UpdateFunTokenMetadata",
+			Handler:    _Msg_UpdateFunTokenMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
@@ -1570,7 +1891,7 @@ func (m *MsgCreateFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
+func (m *MsgConvertCoinToEvm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1580,12 +1901,12 @@ func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinToEvm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1620,7 +1941,7 @@ func (m *MsgSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConvertCoinToEvmResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1630,12 +1951,12 @@ func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendFunTokenToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendFunTokenToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1643,39 +1964,204 @@ func (m *MsgSendFunTokenToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSendFunTokenToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSendFunTokenToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFunTokenToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Size_ != 0 {
-		n += 9
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ToEthAddr.Size()
+		i -= size
+		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendFunTokenToEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendFunTokenToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendFunTokenToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFunTokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFunTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFunTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFunTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFunTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFunTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *LegacyTx) Size() (n int) {
@@ -1926,6 +2412,32 @@ func (m *MsgCreateFunTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConvertCoinToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ToEthAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertCoinToEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSendFunTokenToEvm) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1952,6 +2464,41 @@ func (m *MsgSendFunTokenToEvmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateFunTokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFunTokenMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FuntokenMapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3928,7 +4475,7 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3951,10 +4498,10 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinToEvm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4077,7 +4624,7 @@ func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4100,12 +4647,464 @@ func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFunTokenToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendFunTokenToEvmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendFunTokenToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFunTokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFunTokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFunTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types2.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFunTokenMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFunTokenMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFunTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -45,5 +45,5 @@ type StakingKeeper interface {
 
 type SudoKeeper interface {
 	GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error)
-	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
+	CheckMsgPermissions(sender sdk.AccAddress, ctx sdk.Context, msgTypeUrl string) error
 }
@@ -10,6 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BeginBlocker is called at the start of every block
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.RecordBlockStartFees(ctx)
+}
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -18,8 +25,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err != nil {
 		return
 	}
+	k.CollectValidatorFees(ctx, params)
+
 	if types.IsPeriodLastBlock(ctx, params.VotePeriod) {
 		k.UpdateExchangeRates(ctx)
+		k.PrunePriceSnapshots(ctx, params.SnapshotRetention)
 	}
 
 	// Do slash who did miss voting over threshold and
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -33,6 +35,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryRewards(),
+		GetCmdQueryPriceHistory(),
+		GetCmdQueryTwapOverWindow(),
+		GetCmdQueryOhlc(),
+		GetCmdQueryPairParams(),
+		GetCmdQueryValidatorPerformance(),
+		GetCmdQuerySlashPreview(),
 	)
 
 	return oracleQueryCmd
@@ -368,3 +377,328 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRewards implements the query rewards command.
+func GetCmdQueryRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Args:  cobra.NoArgs,
+		Short: "Query the active oracle rewards and the oracle fee pool",
+		Long: strings.TrimSpace(`
+Query the active oracle rewards entries, each with the coins it pays out per
+vote period and the number of vote periods it has left, along with the fees
+held by the oracle fee pool.
+
+$ nibid query oracle rewards
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Rewards(context.Background(), &types.QueryRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPairParams implements the query pair-params command.
+func GetCmdQueryPairParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-params [pair]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the ballot params in effect for a pair",
+		Long: strings.TrimSpace(`
+Query the vote threshold, reward band, minimum voters, and expiration blocks in
+effect for a pair, with its overrides applied over the global params. Without
+a pair, every whitelisted pair is returned.
+
+$ nibid query oracle pair-params ubtc:uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPairParamsRequest{}
+			if len(args) == 1 {
+				req.Pair = args[0]
+			}
+			res, err := queryClient.PairParams(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorPerformance implements the query validator performance
+// command.
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [validator] [windows]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the oracle performance of a validator per slash window",
+		Long: strings.TrimSpace(`
+Query the win, abstain, and miss counts and the reward weight of a validator in
+each of the latest slash windows, newest first. Without a number of windows,
+every window that is kept is returned.
+
+$ nibid query oracle validator-performance nibivaloper... 3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()}
+			if len(args) == 2 {
+				if req.Windows, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.ValidatorPerformance(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySlashPreview implements the query slash preview command.
+func GetCmdQuerySlashPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-preview",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators that would be slashed if the slash window ended now",
+		Long: strings.TrimSpace(`
+Query the validators whose valid vote rate in the current slash window is
+below the min valid per window, and who would be slashed and jailed if the
+window ended now.
+
+$ nibid query oracle slash-preview
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashPreview(context.Background(), &types.QuerySlashPreviewRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	FlagStartTime = "start"
+	FlagEndTime   = "end"
+)
+
+// GetCmdQueryPriceHistory implements the query price-history command.
+func GetCmdQueryPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the price snapshots of a pair within a time range",
+		Long: strings.TrimSpace(`
+Query the price snapshots of a pair, oldest first. The time range is given in
+RFC3339 format and is open-ended on the sides that are left out.
+
+$ nibid query oracle price-history ubtc:uusd --start 2024-01-01T00:00:00Z --end 2024-01-02T00:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+			start, end, err := parseTimeRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceHistory(context.Background(), &types.QueryPriceHistoryRequest{
+				Pair:       pair,
+				StartTime:  start,
+				EndTime:    end,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addTimeRangeFlags(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "price-history")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTwapOverWindow implements the query twap command.
+func GetCmdQueryTwapOverWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average price of a pair over a lookback window",
+		Long: strings.TrimSpace(`
+Query the time-weighted average price of a pair over a lookback window counted
+back from the latest block time. The window may not exceed the snapshot
+retention param.
+
+$ nibid query oracle twap ubtc:uusd 1h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TwapOverWindow(context.Background(), &types.QueryTwapOverWindowRequest{
+				Pair:   pair,
+				Window: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryOhlc implements the query ohlc command.
+func GetCmdQueryOhlc() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ohlc [pair] [interval]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the open, high, low, and close prices of a pair per interval",
+		Long: strings.TrimSpace(`
+Query candles with the open, high, low, and close prices of a pair in
+consecutive intervals of a time range given in RFC3339 format. The range
+defaults to the snapshot retention window up to the latest block time.
+
+$ nibid query oracle ohlc ubtc:uusd 1h --start 2024-01-01T00:00:00Z --end 2024-01-02T00:00:00Z
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+			interval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			start, end, err := parseTimeRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Ohlc(context.Background(), &types.QueryOhlcRequest{
+				Pair:      pair,
+				Interval:  interval,
+				StartTime: start,
+				EndTime:   end,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addTimeRangeFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func addTimeRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStartTime, "", "start of the time range in RFC3339 format")
+	cmd.Flags().String(FlagEndTime, "", "end of the time range in RFC3339 format")
+}
+
+// parseTimeRangeFlags returns the times given by the time range flags. Flags
+// that are not set give the zero time.
+func parseTimeRangeFlags(cmd *cobra.Command) (start, end time.Time, err error) {
+	for flag, t := range map[string]*time.Time{FlagStartTime: &start, FlagEndTime: &end} {
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return start, end, err
+		}
+		if value == "" {
+			continue
+		}
+		if *t, err = time.Parse(time.RFC3339, value); err != nil {
+			return start, end, err
+		}
+	}
+	return start, end, nil
+}
@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +32,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDelegateFeederPermission(),
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdAggregateExchangeRateDirectVote(),
+		GetCmdFundOracleRewards(),
+		GetCmdResetCircuitBreaker(),
 	)
 
 	return oracleTxCmd
@@ -205,3 +210,143 @@ $ nibid tx oracle aggregate-vote 1234 (40000.0,BTC:USD)|(1.243,NIBI:USD) nibival
 
 	return cmd
 }
+
+// GetCmdAggregateExchangeRateDirectVote will create an aggregate direct vote
+// tx and sign it with the given key.
+func GetCmdAggregateExchangeRateDirectVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-direct-vote [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit an oracle aggregate vote without a prevote",
+		Long: strings.TrimSpace(`
+Submit an aggregate vote for the exchange_rates of the proposed pairs in the current vote period, without a prevote. Only available when the "direct_vote_enabled" oracle param is set.
+
+$ nibid tx oracle aggregate-direct-vote (40000.0,BTC:USD)|(1.243,NIBI:USD)
+
+where "BTC:USD, NIBI:USD" is the pairs, and "40000.0,1.243" is the exchange rates as decimal string.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ nibid tx oracle aggregate-direct-vote (40000.0,BTC:USD)|(1.243,NIBI:USD) nibivaloper1....
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			exchangeRatesStr := args[0]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rate {%s} is not a valid format; exchange rate should be formatted as DecCoin; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			feeder := clientCtx.GetFromAddress()
+
+			// By default, the feeder is voting on behalf of itself
+			validator := sdk.ValAddress(feeder)
+
+			// Override validator if validator is given
+			if len(args) == 2 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			msg := types.NewMsgAggregateExchangeRateDirectVote(exchangeRatesStr, feeder, validator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFundOracleRewards will create a MsgFundOracleRewards tx and broadcast
+// it.
+func GetCmdFundOracleRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-rewards [coins] [vote-periods]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the rewards paid to oracle voters",
+		Long: strings.TrimSpace(`
+Fund the rewards paid to oracle voters. The coins are paid out in equal parts
+over the given number of vote periods.
+
+$ nibid tx oracle fund-rewards 1000000unibi 100
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewards, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			votePeriods, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "vote-periods must be a positive integer")
+			}
+
+			msg := types.NewMsgFundOracleRewards(clientCtx.GetFromAddress(), rewards, votePeriods)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdResetCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Resume price updates for a pair halted by the circuit breaker",
+		Long: strings.TrimSpace(`
+Resume price updates for a pair halted by the circuit breaker. Only sudoers
+may reset the circuit breaker.
+
+$ nibid tx oracle reset-circuit-breaker ubtc:uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResetCircuitBreaker{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
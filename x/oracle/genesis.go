@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -33,6 +34,16 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetPrice(ctx, ex.Pair, ex.ExchangeRate)
 	}
 
+	// skip the snapshots that already fell out of the retention window
+	snapshotCutoff := types.SnapshotRetentionCutoff(ctx.BlockTime(), data.Params.SnapshotRetention)
+	for _, snapshot := range data.PriceSnapshots {
+		timestamp := time.UnixMilli(snapshot.TimestampMs).UTC()
+		if timestamp.Before(snapshotCutoff) {
+			continue
+		}
+		keeper.PriceSnapshots.Insert(ctx, collections.Join(snapshot.Pair, timestamp), snapshot)
+	}
+
 	for _, missCounter := range data.MissCounters {
 		operator, err := sdk.ValAddressFromBech32(missCounter.ValidatorAddress)
 		if err != nil {
@@ -70,6 +81,19 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 	}
 
+	for _, pair := range data.HaltedPairs {
+		keeper.HaltedPairs.Insert(ctx, pair)
+	}
+
+	for _, performance := range data.PerformanceHistory {
+		valAddr, err := sdk.ValAddressFromBech32(performance.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.PerformanceHistory.Insert(ctx, collections.Join(valAddr, performance.Window), performance)
+	}
+
 	for _, pr := range data.Rewards {
 		keeper.Rewards.Insert(ctx, pr.Id, pr)
 	}
@@ -129,5 +153,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		keeper.Votes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		keeper.RetainedPriceSnapshots(ctx, params.SnapshotRetention),
+		keeper.HaltedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys(),
+		keeper.PerformanceHistory.Iterate(ctx, collections.PairRange[sdk.ValAddress, uint64]{}).Values(),
 	)
 }
@@ -2,22 +2,30 @@ package oracle_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle"
 	"github.com/NibiruChain/nibiru/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 func TestExportInitGenesis(t *testing.T) {
+	// snapshots are exported with millisecond timestamps
+	blockTime := time.Now().UTC().Truncate(time.Millisecond)
 	input := keeper.CreateTestFixture(t)
+	input.Ctx = input.Ctx.WithBlockTime(blockTime)
 
 	input.OracleKeeper.Params.Set(input.Ctx, types.DefaultParams())
 	input.OracleKeeper.FeederDelegations.Insert(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, "pair1:pair2", types.DatedPrice{ExchangeRate: math.LegacyNewDec(123), CreatedBlock: 0})
+	input.OracleKeeper.SetPrice(input.Ctx, "pair1:pair2", math.LegacyNewDec(123))
 	input.OracleKeeper.Prevotes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.Votes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Pair: "foo", ExchangeRate: math.LegacyNewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair1:pair1")
@@ -28,15 +36,70 @@ func TestExportInitGenesis(t *testing.T) {
 		VotePeriods: 100,
 		Coins:       sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
 	})
+	for window := uint64(0); window < 2; window++ {
+		input.OracleKeeper.PerformanceHistory.Insert(input.Ctx,
+			collections.Join(keeper.ValAddrs[0], window),
+			types.WindowPerformance{
+				Validator:    keeper.ValAddrs[0].String(),
+				Window:       window,
+				VotePeriods:  10,
+				WinCount:     8,
+				AbstainCount: 1,
+				MissCount:    1,
+				RewardWeight: 80,
+			})
+	}
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
 
+	require.Len(t, genesis.PriceSnapshots, 1)
+	require.Len(t, genesis.PerformanceHistory, 2)
+	require.NoError(t, types.ValidateGenesis(genesis))
+
 	newInput := keeper.CreateTestFixture(t)
+	newInput.Ctx = newInput.Ctx.WithBlockTime(blockTime)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
 }
 
+func TestGenesisSnapshotRetention(t *testing.T) {
+	blockTime := time.Now().UTC().Truncate(time.Millisecond)
+	input := keeper.CreateTestFixture(t)
+	input.Ctx = input.Ctx.WithBlockTime(blockTime)
+
+	params := types.DefaultParams()
+	params.TwapLookbackWindow = time.Hour
+	params.SnapshotRetention = 2 * time.Hour
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	pair := asset.NewPair(denoms.BTC, denoms.USD)
+	for _, age := range []time.Duration{3 * time.Hour, 90 * time.Minute, 0} {
+		ctx := input.Ctx.WithBlockTime(blockTime.Add(-age))
+		input.OracleKeeper.SetPrice(ctx, pair, math.LegacyNewDec(int64(age.Minutes())+1))
+	}
+
+	t.Log("export skips the snapshots older than the retention window")
+	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.PriceSnapshots, 2)
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	t.Log("import skips the snapshots that fell out of the retention window since")
+	newInput := keeper.CreateTestFixture(t)
+	newInput.Ctx = newInput.Ctx.WithBlockTime(blockTime.Add(time.Hour))
+	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
+	snapshots := newInput.OracleKeeper.PriceSnapshots.Iterate(
+		newInput.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	// the second snapshot is taken when the exchange rate is imported
+	require.Len(t, snapshots, 2)
+	require.Equal(t, blockTime.UnixMilli(), snapshots[0].TimestampMs)
+
+	t.Log("genesis with an invalid price snapshot is rejected")
+	genesis.PriceSnapshots[0].Price = math.LegacyZeroDec()
+	require.Error(t, types.ValidateGenesis(genesis))
+}
+
 func TestInitGenesis(t *testing.T) {
 	input := keeper.CreateTestFixture(t)
 	genesis := types.DefaultGenesisState()
@@ -116,6 +116,7 @@ func (k Keeper) removeInvalidVotes(
 	totalBondedPower := sdk.TokensToConsensusPower(
 		k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx),
 	)
+	params, _ := k.Params.Get(ctx)
 
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.OrderedMap_Pair[types.ExchangeRateVotes](pairVotes)
@@ -128,10 +129,11 @@ func (k Keeper) removeInvalidVotes(
 
 		// If the votes is not passed, remove it from the whitelistedPairs set
 		// to prevent slashing validators who did valid vote.
+		pairParams := params.ForPair(pair)
 		if !isPassingVoteThreshold(
 			pairVotes[pair],
-			k.VoteThreshold(ctx).MulInt64(totalBondedPower).RoundInt(),
-			k.MinVoters(ctx),
+			pairParams.VoteThreshold.MulInt64(totalBondedPower).RoundInt(),
+			pairParams.MinVoters,
 		) {
 			delete(whitelistedPairs, pair)
 			delete(pairVotes, pair)
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// previousPrices returns the unexpired prices of the voted pairs from before
+// the vote period. The circuit breaker compares the new prices against them.
+// A price kept by the circuit breaker counts from the block it was last
+// restored, so the breaker stays in place while it keeps rejecting votes.
+func (k Keeper) previousPrices(
+	ctx sdk.Context,
+	params types.Params,
+	pairVotes map[asset.Pair]types.ExchangeRateVotes,
+) map[asset.Pair]types.DatedPrice {
+	previousPrices := make(map[asset.Pair]types.DatedPrice)
+	for pair := range pairVotes {
+		price, err := k.ExchangeRates.Get(ctx, pair)
+		if err != nil || !price.ExchangeRate.IsPositive() {
+			continue
+		}
+		if price.CreatedBlock+params.ForPair(pair).ExpirationBlocks > uint64(ctx.BlockHeight()) {
+			previousPrices[pair] = price
+		}
+	}
+	return previousPrices
+}
+
+// applyCircuitBreaker checks the voted price of a pair against its previous
+// price. Returns the price to set and whether to set it at all. A price that
+// moved by more than the max change ratio is clamped to the edge of the
+// allowed band, or it is rejected, in which case the halt action also halts
+// the pair.
+func (k Keeper) applyCircuitBreaker(
+	ctx sdk.Context,
+	pairParams types.PairParams,
+	previous types.DatedPrice,
+	voted sdk.Dec,
+) (price sdk.Dec, accepted bool) {
+	maxChangeRatio := *pairParams.MaxChangeRatio
+	if maxChangeRatio.IsZero() {
+		return voted, true
+	}
+
+	maxChange := previous.ExchangeRate.Mul(maxChangeRatio)
+	lower := previous.ExchangeRate.Sub(maxChange)
+	upper := previous.ExchangeRate.Add(maxChange)
+	if voted.GTE(lower) && voted.LTE(upper) {
+		return voted, true
+	}
+
+	action := pairParams.CircuitBreakerAction
+	switch action {
+	case types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT:
+		price, accepted = previous.ExchangeRate, false
+	case types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT:
+		price, accepted = previous.ExchangeRate, false
+		k.HaltedPairs.Insert(ctx, pairParams.Pair)
+	default:
+		price, accepted = math.LegacyMaxDec(lower, math.LegacyMinDec(voted, upper)), true
+	}
+
+	k.Logger(ctx).Info(
+		"price tripped the circuit breaker",
+		"pair", pairParams.Pair.String(),
+		"previous", previous.ExchangeRate.String(),
+		"voted", voted.String(),
+		"action", action.String(),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPriceCircuitBreak{
+		Pair:          pairParams.Pair.String(),
+		PreviousPrice: previous.ExchangeRate,
+		VotedPrice:    voted,
+		Price:         price,
+		Action:        action,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit EventPriceCircuitBreak", "pair", pairParams.Pair, "error", err)
+	}
+	return price, accepted
+}
+
+// IsPriceStale returns true if no vote set the price in the latest vote
+// period, including when the circuit breaker rejected the votes.
+func IsPriceStale(price types.DatedPrice, votePeriod uint64, blockHeight int64) bool {
+	return price.LastAcceptedBlock()+votePeriod < uint64(blockHeight)
+}
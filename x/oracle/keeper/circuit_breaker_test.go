@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{btcUsd}
+	params.MaxChangeRatio = sdkmath.LegacyNewDecWithPrec(1, 1) // 10%
+	require.NoError(t, params.Validate())
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+	for _, p := range fixture.OracleKeeper.WhitelistedPairs.Iterate(fixture.Ctx, collections.Range[asset.Pair]{}).Keys() {
+		fixture.OracleKeeper.WhitelistedPairs.Delete(fixture.Ctx, p)
+	}
+	fixture.OracleKeeper.WhitelistedPairs.Insert(fixture.Ctx, btcUsd)
+
+	setAction := func(action types.CircuitBreakerAction) {
+		params.PairParams = []types.PairParams{{Pair: btcUsd, CircuitBreakerAction: action}}
+		fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+	}
+	voteAndTally := func(rate sdk.Dec) {
+		for i := 0; i < len(ValAddrs); i++ {
+			MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+				{Pair: btcUsd, ExchangeRate: rate},
+			}, i)
+		}
+		fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+	}
+	requirePrice := func(expected sdk.Dec) {
+		price, err := fixture.OracleKeeper.GetExchangeRate(fixture.Ctx, btcUsd)
+		require.NoError(t, err)
+		require.Equal(t, expected, price)
+	}
+
+	fixture.OracleKeeper.SetPrice(fixture.Ctx, btcUsd, testExchangeRate)
+
+	t.Log("prices within the max change ratio are set as voted")
+	inBand := testExchangeRate.Mul(sdkmath.LegacyMustNewDecFromStr("1.05"))
+	voteAndTally(inBand)
+	requirePrice(inBand)
+
+	t.Log("prices outside of the max change ratio are clamped by default")
+	voteAndTally(inBand.MulInt64(2))
+	clamped := inBand.Mul(sdkmath.LegacyMustNewDecFromStr("1.1"))
+	requirePrice(clamped)
+	acceptedBlock := uint64(fixture.Ctx.BlockHeight())
+
+	t.Log("rejected prices keep the previous price")
+	setAction(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT)
+	voteAndTally(clamped.QuoInt64(2))
+	requirePrice(clamped)
+	require.False(t, fixture.OracleKeeper.HaltedPairs.Has(fixture.Ctx, btcUsd))
+
+	t.Log("rejected prices do not expire, so later votes go through the circuit breaker")
+	height, expirationBlocks := fixture.Ctx.BlockHeight(), int64(params.ExpirationBlocks)
+	fixture.Ctx = fixture.Ctx.WithBlockHeight(height + expirationBlocks - 1)
+	voteAndTally(clamped.QuoInt64(2))
+	requirePrice(clamped)
+	fixture.Ctx = fixture.Ctx.WithBlockHeight(height + expirationBlocks)
+	voteAndTally(clamped.QuoInt64(2))
+	requirePrice(clamped)
+
+	t.Log("prices kept by the circuit breaker go stale and stop backing derived prices")
+	querier := NewQuerier(fixture.OracleKeeper)
+	res, err := querier.ExchangeRate(sdk.WrapSDKContext(fixture.Ctx), &types.QueryExchangeRateRequest{Pair: btcUsd})
+	require.NoError(t, err)
+	require.True(t, res.IsStale)
+	kept, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, btcUsd)
+	require.NoError(t, err)
+	require.Equal(t, uint64(fixture.Ctx.BlockHeight()), kept.CreatedBlock)
+	require.Equal(t, acceptedBlock, kept.LastAcceptedBlock())
+	_, fresh := fixture.OracleKeeper.freshPrice(fixture.Ctx, btcUsd, params)
+	require.False(t, fresh)
+
+	t.Log("halted pairs keep their price until they are reset")
+	setAction(types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT)
+	voteAndTally(clamped.QuoInt64(2))
+	requirePrice(clamped)
+	require.True(t, fixture.OracleKeeper.HaltedPairs.Has(fixture.Ctx, btcUsd))
+	voteAndTally(clamped)
+	requirePrice(clamped)
+
+	t.Log("the query flags halted and stale prices")
+	res, err = querier.ExchangeRate(sdk.WrapSDKContext(fixture.Ctx), &types.QueryExchangeRateRequest{Pair: btcUsd})
+	require.NoError(t, err)
+	require.True(t, res.IsHalted)
+	require.True(t, res.IsStale)
+
+	t.Log("reset pairs are updated again")
+	fixture.OracleKeeper.HaltedPairs.Delete(fixture.Ctx, btcUsd)
+	voteAndTally(inBand)
+	requirePrice(inBand)
+	res, err = querier.ExchangeRate(sdk.WrapSDKContext(fixture.Ctx), &types.QueryExchangeRateRequest{Pair: btcUsd})
+	require.NoError(t, err)
+	require.False(t, res.IsHalted)
+	require.False(t, res.IsStale)
+	res, err = querier.ExchangeRate(
+		sdk.WrapSDKContext(fixture.Ctx.WithBlockHeight(fixture.Ctx.BlockHeight()+2)),
+		&types.QueryExchangeRateRequest{Pair: btcUsd},
+	)
+	require.NoError(t, err)
+	require.True(t, res.IsStale)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// updateDerivedPrices recomputes the prices of the derived pairs from the
+// current exchange rates. The derived pairs are processed in the order of the
+// params, so a derived pair may be computed from one listed before it.
+//
+// A derived price takes the last accepted block of the oldest price it is
+// computed from, so it expires along with that price. Derived pairs that got votes in
+// this vote period keep their voted price.
+func (k Keeper) updateDerivedPrices(
+	ctx sdk.Context,
+	params types.Params,
+	pairVotes map[asset.Pair]types.ExchangeRateVotes,
+) {
+	for _, derivedPair := range params.DerivedPairs {
+		if _, isVoted := pairVotes[derivedPair.Pair]; isVoted {
+			continue
+		}
+		derivedPrice, ok := k.derivedPrice(ctx, derivedPair, params)
+		if !ok {
+			continue
+		}
+		prevPrice, err := k.ExchangeRates.Get(ctx, derivedPair.Pair)
+		if err == nil &&
+			prevPrice.CreatedBlock == derivedPrice.CreatedBlock &&
+			prevPrice.ExchangeRate.Equal(derivedPrice.ExchangeRate) {
+			continue
+		}
+		k.setDatedPrice(ctx, derivedPair.Pair, derivedPrice)
+	}
+}
+
+// derivedPrice computes the price of a derived pair. Returns false if the
+// price of any of its legs is missing or expired.
+func (k Keeper) derivedPrice(
+	ctx sdk.Context, derivedPair types.DerivedPair, params types.Params,
+) (derivedPrice types.DatedPrice, ok bool) {
+	if derivedPair.Via == "" {
+		// The inverse pair must be read directly. Its own inverse is the
+		// derived pair itself.
+		price, ok := k.freshPrice(ctx, derivedPair.Pair.Inverse(), params)
+		if !ok {
+			return derivedPrice, false
+		}
+		derivedPrice = invertPrice(price)
+		return derivedPrice, derivedPrice.ExchangeRate.IsPositive()
+	}
+
+	derivedPrice = types.DatedPrice{
+		ExchangeRate: math.LegacyOneDec(),
+		CreatedBlock: uint64(ctx.BlockHeight()),
+	}
+	for _, leg := range derivedPair.Legs() {
+		price, ok := k.legPrice(ctx, leg, params)
+		if !ok {
+			return derivedPrice, false
+		}
+		derivedPrice.ExchangeRate = derivedPrice.ExchangeRate.Mul(price.ExchangeRate)
+		if price.LastAcceptedBlock() < derivedPrice.CreatedBlock {
+			derivedPrice.CreatedBlock = price.LastAcceptedBlock()
+		}
+	}
+	return derivedPrice, derivedPrice.ExchangeRate.IsPositive()
+}
+
+// legPrice returns the price of "pair", or else the inverse of the price of
+// its inverse pair.
+func (k Keeper) legPrice(
+	ctx sdk.Context, pair asset.Pair, params types.Params,
+) (types.DatedPrice, bool) {
+	if price, ok := k.freshPrice(ctx, pair, params); ok {
+		return price, true
+	}
+	price, ok := k.freshPrice(ctx, pair.Inverse(), params)
+	if !ok {
+		return price, false
+	}
+	return invertPrice(price), true
+}
+
+// freshPrice returns the price of "pair" if it exists, is positive, and its
+// last accepted vote has not expired under the expiration blocks in effect
+// for the pair.
+func (k Keeper) freshPrice(
+	ctx sdk.Context, pair asset.Pair, params types.Params,
+) (types.DatedPrice, bool) {
+	price, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil || !price.ExchangeRate.IsPositive() {
+		return price, false
+	}
+	return price, price.LastAcceptedBlock()+params.ForPair(pair).ExpirationBlocks > uint64(ctx.BlockHeight())
+}
+
+func invertPrice(price types.DatedPrice) types.DatedPrice {
+	return types.DatedPrice{
+		ExchangeRate:  math.LegacyOneDec().Quo(price.ExchangeRate),
+		CreatedBlock:  price.CreatedBlock,
+		AcceptedBlock: price.AcceptedBlock,
+	}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestDerivedPrices(t *testing.T) {
+	fixture, _ := Setup(t)
+	querier := NewQuerier(fixture.OracleKeeper)
+
+	btcUsd := asset.NewPair(denoms.BTC, denoms.USD)
+	nibiUsd := asset.NewPair(denoms.NIBI, denoms.USD)
+	usdBtc := btcUsd.Inverse()
+	btcNibi := asset.NewPair(denoms.BTC, denoms.NIBI)
+	nibiBtc := btcNibi.Inverse()
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.ExpirationBlocks = 10
+	params.DerivedPairs = []types.DerivedPair{
+		types.NewDerivedPair(usdBtc, ""),
+		types.NewDerivedPair(btcNibi, denoms.USD),
+		// derived from the derived pair above
+		types.NewDerivedPair(nibiBtc, ""),
+	}
+	require.NoError(t, params.Validate())
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	requirePrice := func(ctx sdk.Context, pair asset.Pair, want math.LegacyDec, wantCreatedBlock uint64) {
+		t.Helper()
+		price, err := fixture.OracleKeeper.ExchangeRates.Get(ctx, pair)
+		require.NoError(t, err, pair)
+		require.Equal(t, want.String(), price.ExchangeRate.String(), pair)
+		require.Equal(t, wantCreatedBlock, price.CreatedBlock, pair)
+	}
+
+	t.Log("no derived prices without the prices they are computed from")
+	ctx := fixture.Ctx.WithBlockHeight(1)
+	fixture.OracleKeeper.UpdateExchangeRates(ctx)
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(ctx, btcNibi)
+	require.Error(t, err)
+
+	t.Log("inverse and cross rates are derived from voted prices")
+	fixture.OracleKeeper.SetPrice(ctx, btcUsd, math.LegacyNewDec(50_000))
+	fixture.OracleKeeper.SetPrice(ctx, nibiUsd, math.LegacyNewDec(2))
+	ctx = ctx.WithBlockHeight(2)
+	fixture.OracleKeeper.UpdateExchangeRates(ctx)
+	requirePrice(ctx, usdBtc, math.LegacyMustNewDecFromStr("0.00002"), 1)
+	requirePrice(ctx, btcNibi, math.LegacyNewDec(25_000), 1)
+	requirePrice(ctx, nibiBtc, math.LegacyMustNewDecFromStr("0.00004"), 1)
+
+	t.Log("derived prices are served by the exchange rate queries")
+	goCtx := sdk.WrapSDKContext(ctx)
+	resp, err := querier.ExchangeRate(goCtx, &types.QueryExchangeRateRequest{Pair: btcNibi})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(25_000), resp.ExchangeRate)
+	resp, err = querier.ExchangeRateTwap(goCtx, &types.QueryExchangeRateRequest{Pair: btcNibi})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(25_000), resp.ExchangeRate)
+
+	t.Log("derived prices keep the created block of their oldest leg")
+	ctx = ctx.WithBlockHeight(5)
+	fixture.OracleKeeper.SetPrice(ctx, nibiUsd, math.LegacyNewDec(4))
+	fixture.OracleKeeper.UpdateExchangeRates(ctx)
+	requirePrice(ctx, usdBtc, math.LegacyMustNewDecFromStr("0.00002"), 1)
+	requirePrice(ctx, btcNibi, math.LegacyNewDec(12_500), 1)
+	requirePrice(ctx, nibiBtc, math.LegacyMustNewDecFromStr("0.00008"), 1)
+
+	t.Log("derived prices expire along with their oldest leg")
+	ctx = ctx.WithBlockHeight(11)
+	fixture.OracleKeeper.UpdateExchangeRates(ctx)
+	for _, pair := range []asset.Pair{btcUsd, usdBtc, btcNibi, nibiBtc} {
+		_, err = fixture.OracleKeeper.ExchangeRates.Get(ctx, pair)
+		require.Error(t, err, pair)
+	}
+	requirePrice(ctx, nibiUsd, math.LegacyNewDec(4), 5)
+}
@@ -30,7 +30,35 @@ func NewHooks(k Keeper, accountKeeper keeper.AccountKeeper, bankKeeper bankkeepe
 	return &Hooks{k: k, accountKeeper: accountKeeper, bankKeeper: bankKeeper}
 }
 
+// AfterEpochEnd turns the fees collected in the oracle fee pool during the day
+// into a rewards entry that pays them out to the oracle voters over the
+// "FeeRewardsVotePeriods" param.
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64) {
+	if epochIdentifier != types.DayEpochID {
+		return
+	}
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		h.k.Logger(ctx).Error("Failed to get oracle params", "err", err)
+		return
+	}
+	feePool := h.accountKeeper.GetModuleAddress(oracletypes.FeePoolModuleAccount)
+	if feePool == nil {
+		return
+	}
+	fees := h.bankKeeper.GetAllBalances(ctx, feePool)
+	if fees.IsZero() {
+		return
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	if _, err := h.k.AllocateRewards(
+		cacheCtx, oracletypes.FeePoolModuleAccount, fees, params.FeeRewardsVotePeriods,
+	); err != nil {
+		h.k.Logger(ctx).Error("Failed to allocate oracle rewards from fees", "err", err)
+		return
+	}
+	commit()
 }
 
 func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ uint64) {}
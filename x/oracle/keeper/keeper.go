@@ -46,14 +46,27 @@ type Keeper struct {
 		collections.Pair[asset.Pair, time.Time],
 		types.PriceSnapshot]
 	WhitelistedPairs collections.KeySet[asset.Pair]
-	Rewards          collections.Map[uint64, types.Rewards]
-	RewardsID        collections.Sequence
+	// HaltedPairs are the pairs whose prices the circuit breaker froze until
+	// they are reset with MsgResetCircuitBreaker.
+	HaltedPairs collections.KeySet[asset.Pair]
+	// PerformanceHistory maps the performance of a validator in a slash window
+	// to the validator and the index of the window.
+	PerformanceHistory collections.Map[
+		collections.Pair[sdk.ValAddress, uint64],
+		types.WindowPerformance]
+	Rewards   collections.Map[uint64, types.Rewards]
+	RewardsID collections.Sequence
+
+	// BlockStartFees: Balances of the fee collector at the start of the block,
+	// by denom. Kept in the transient store.
+	BlockStartFees collections.Map[string, math.Int]
 }
 
 // NewKeeper constructs a new keeper for oracle
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -87,10 +100,17 @@ func NewKeeper(
 		Prevotes:          collections.NewMap(storeKey, 4, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
 		Votes:             collections.NewMap(storeKey, 5, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRateVote](cdc)),
 		WhitelistedPairs:  collections.NewKeySet(storeKey, 6, asset.PairKeyEncoder),
+		HaltedPairs:       collections.NewKeySet(storeKey, 12, asset.PairKeyEncoder),
+		PerformanceHistory: collections.NewMap(
+			storeKey, 13,
+			collections.PairKeyEncoder(collections.ValAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.WindowPerformance](cdc)),
 		Rewards: collections.NewMap(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
 		RewardsID: collections.NewSequence(storeKey, 9),
+		BlockStartFees: collections.NewMap(
+			tStoreKey, 0, collections.StringKeyEncoder, collections.IntValueEncoder),
 	}
 	return k
 }
@@ -132,13 +152,20 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 	if err != nil {
 		return math.LegacyOneDec().Neg(), err
 	}
+	return k.GetExchangeRateTwapOverWindow(ctx, pair, params.TwapLookbackWindow)
+}
 
+// GetExchangeRateTwapOverWindow returns the time-weighted average price of a
+// pair over the given lookback window, counted back from the block time.
+func (k Keeper) GetExchangeRateTwapOverWindow(
+	ctx sdk.Context, pair asset.Pair, window time.Duration,
+) (price sdk.Dec, err error) {
 	snapshots := k.PriceSnapshots.Iterate(
 		ctx,
 		collections.PairRange[asset.Pair, time.Time]{}.
 			Prefix(pair).
 			StartInclusive(
-				ctx.BlockTime().Add(-1*params.TwapLookbackWindow)).
+				ctx.BlockTime().Add(-1*window)).
 			EndInclusive(
 				ctx.BlockTime()),
 	).Values()
@@ -189,18 +216,23 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec
 
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
-	k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{ExchangeRate: price, CreatedBlock: uint64(ctx.BlockHeight())})
+	k.setDatedPrice(ctx, pair, types.DatedPrice{ExchangeRate: price, CreatedBlock: uint64(ctx.BlockHeight())})
+}
+
+// setDatedPrice sets the dated price for a pair as well as the price snapshot.
+func (k Keeper) setDatedPrice(ctx sdk.Context, pair asset.Pair, datedPrice types.DatedPrice) {
+	k.ExchangeRates.Insert(ctx, pair, datedPrice)
 
 	key := collections.Join(pair, ctx.BlockTime())
 	timestampMs := ctx.BlockTime().UnixMilli()
 	k.PriceSnapshots.Insert(ctx, key, types.PriceSnapshot{
 		Pair:        pair,
-		Price:       price,
+		Price:       datedPrice.ExchangeRate,
 		TimestampMs: timestampMs,
 	})
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventPriceUpdate{
 		Pair:        pair.String(),
-		Price:       price,
+		Price:       datedPrice.ExchangeRate,
 		TimestampMs: timestampMs,
 	}); err != nil {
 		ctx.Logger().Error("failed to emit OraclePriceUpdate", "pair", pair, "error", err)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the "snapshot_retention", "max_change_ratio", and oracle
+// rewards params, which did not exist in consensus version 1, and prunes the
+// price snapshots that are older than the retention window.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.SnapshotRetention == 0 {
+		params.SnapshotRetention = types.DefaultSnapshotRetention
+		if params.SnapshotRetention < params.TwapLookbackWindow {
+			params.SnapshotRetention = params.TwapLookbackWindow
+		}
+	}
+	if params.MaxChangeRatio.IsNil() {
+		params.MaxChangeRatio = types.DefaultParams().MaxChangeRatio
+	}
+	if params.MinFundRewards == nil {
+		params.MinFundRewards = types.DefaultMinFundRewards
+	}
+	if params.MaxRewardsEntries == 0 {
+		params.MaxRewardsEntries = types.DefaultMaxRewardsEntries
+	}
+	if params.FeeRewardsVotePeriods == 0 {
+		params.FeeRewardsVotePeriods = types.DefaultFeeRewardsVotePeriods
+	}
+	m.keeper.Params.Set(ctx, params)
+
+	pruned := m.keeper.PrunePriceSnapshots(ctx, params.SnapshotRetention)
+	m.keeper.Logger(ctx).Info("pruned oracle price snapshots", "count", pruned)
+	return nil
+}
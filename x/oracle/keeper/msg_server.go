@@ -136,6 +136,66 @@ func (ms msgServer) AggregateExchangeRateVote(
 	return &types.MsgAggregateExchangeRateVoteResponse{}, err
 }
 
+// AggregateExchangeRateDirectVote: Records an aggregate exchange rate vote for
+// the current vote period without a prior prevote. Each validator can submit a
+// single direct vote per vote period.
+func (ms msgServer) AggregateExchangeRateDirectVote(
+	goCtx context.Context, msg *types.MsgAggregateExchangeRateDirectVote,
+) (msgResp *types.MsgAggregateExchangeRateDirectVoteResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.DirectVoteEnabled {
+		return nil, types.ErrDirectVoteDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.Keeper.Votes.Get(ctx, valAddr); err == nil {
+		return nil, sdkerrors.Wrap(types.ErrExistingVote, msg.Validator)
+	}
+
+	// Slice of (Pair, ExchangeRate) tuples.
+	exchangeRateTuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidCoins, err.Error())
+	}
+
+	// Check all pairs are in the vote target
+	for _, tuple := range exchangeRateTuples {
+		if !ms.IsWhitelistedPair(ctx, tuple.Pair) {
+			return nil, sdkerrors.Wrap(types.ErrUnknownPair, tuple.Pair.String())
+		}
+	}
+
+	ms.Keeper.Votes.Insert(
+		ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr),
+	)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAggregateVote{
+		Validator: msg.Validator,
+		Feeder:    msg.Feeder,
+		Prices:    exchangeRateTuples,
+	})
+
+	return &types.MsgAggregateExchangeRateDirectVoteResponse{}, err
+}
+
 func (ms msgServer) DelegateFeedConsent(
 	goCtx context.Context, msg *types.MsgDelegateFeedConsent,
 ) (*types.MsgDelegateFeedConsentResponse, error) {
@@ -185,3 +245,37 @@ func (ms msgServer) EditOracleParams(
 	}
 	return resp, err
 }
+
+// FundOracleRewards: gRPC tx msg for funding the rewards of oracle voters.
+// Anyone may fund rewards.
+func (ms msgServer) FundOracleRewards(
+	goCtx context.Context, msg *types.MsgFundOracleRewards,
+) (*types.MsgFundOracleRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := ms.FundRewards(ctx, sender, msg.Rewards, msg.VotePeriods)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgFundOracleRewardsResponse{Rewards: rewards}, nil
+}
+
+// ResetCircuitBreaker: gRPC tx msg for resuming price updates of a pair halted
+// by the circuit breaker.
+// [SUDO] Only callable by sudoers.
+func (ms msgServer) ResetCircuitBreaker(
+	goCtx context.Context, msg *types.MsgResetCircuitBreaker,
+) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.Sudo().ResetCircuitBreaker(ctx, msg.Pair, sender); err != nil {
+		return nil, err
+	}
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
@@ -192,3 +192,61 @@ func TestAggregatePrevoteVote(t *testing.T) {
 	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), aggregateExchangeRateVoteMsg)
 	require.NoError(t, err)
 }
+
+func TestAggregateDirectVote(t *testing.T) {
+	input, msgServer := Setup(t)
+
+	exchangeRates := types.ExchangeRateTuples{
+		{
+			Pair:         asset.Registry.Pair(denoms.ATOM, denoms.USD),
+			ExchangeRate: math.LegacyMustNewDecFromStr("1000.23"),
+		},
+		{
+			Pair:         asset.Registry.Pair(denoms.BTC, denoms.USD),
+			ExchangeRate: math.LegacyMustNewDecFromStr("0.27"),
+		},
+	}
+	exchangeRatesStr, err := exchangeRates.ToString()
+	require.NoError(t, err)
+
+	unintendedExchangeRates := types.ExchangeRateTuples{
+		{
+			Pair:         "BTC:CNY",
+			ExchangeRate: math.LegacyMustNewDecFromStr("0.27"),
+		},
+	}
+	unintendedExchangeRatesStr, err := unintendedExchangeRates.ToString()
+	require.NoError(t, err)
+
+	// Direct votes are disabled by default
+	directVoteMsg := types.NewMsgAggregateExchangeRateDirectVote(exchangeRatesStr, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateDirectVote(sdk.WrapSDKContext(input.Ctx), directVoteMsg)
+	require.ErrorIs(t, err, types.ErrDirectVoteDisabled)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.DirectVoteEnabled = true
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// Unauthorized feeder
+	directVoteMsg = types.NewMsgAggregateExchangeRateDirectVote(exchangeRatesStr, Addrs[1], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateDirectVote(sdk.WrapSDKContext(input.Ctx), directVoteMsg)
+	require.ErrorIs(t, err, types.ErrNoVotingPermission)
+
+	// Unintended denom vote
+	directVoteMsg = types.NewMsgAggregateExchangeRateDirectVote(unintendedExchangeRatesStr, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateDirectVote(sdk.WrapSDKContext(input.Ctx), directVoteMsg)
+	require.ErrorIs(t, err, types.ErrUnknownPair)
+
+	// Valid direct vote without a prevote
+	directVoteMsg = types.NewMsgAggregateExchangeRateDirectVote(exchangeRatesStr, Addrs[0], ValAddrs[0])
+	_, err = msgServer.AggregateExchangeRateDirectVote(sdk.WrapSDKContext(input.Ctx), directVoteMsg)
+	require.NoError(t, err)
+	vote, err := input.OracleKeeper.Votes.Get(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, exchangeRates, vote.ExchangeRateTuples)
+
+	// A second vote in the same vote period fails
+	_, err = msgServer.AggregateExchangeRateDirectVote(sdk.WrapSDKContext(input.Ctx), directVoteMsg)
+	require.ErrorIs(t, err, types.ErrExistingVote)
+}
@@ -29,6 +29,7 @@ func TestParams(t *testing.T) {
 	slashWindow := uint64(1000)
 	minValidPerWindow := math.LegacyNewDecWithPrec(1, 4)
 	minFeeRatio := math.LegacyNewDecWithPrec(1, 2)
+	maxChangeRatio := math.LegacyNewDecWithPrec(1, 1)
 	whitelist := []asset.Pair{
 		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
@@ -45,6 +46,7 @@ func TestParams(t *testing.T) {
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
 		ValidatorFeeRatio: minFeeRatio,
+		MaxChangeRatio:    maxChangeRatio,
 	}
 	input.OracleKeeper.Params.Set(input.Ctx, newParams)
 
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// PerformanceHistoryWindows is the number of slash windows, counting the
+// current one, for which the performance of validators is kept.
+const PerformanceHistoryWindows = 30
+
+// slashWindowIndex returns the index of the slash window of the block. Slash
+// windows end at the blocks where types.IsPeriodLastBlock holds.
+func slashWindowIndex(ctx sdk.Context, slashWindow uint64) uint64 {
+	return uint64(ctx.BlockHeight()) / slashWindow
+}
+
+// recordPerformances adds the performances of a vote period to the
+// performance history of the validators in the current slash window.
+func (k Keeper) recordPerformances(
+	ctx sdk.Context, slashWindow uint64, validatorPerformances types.ValidatorPerformances,
+) {
+	window := slashWindowIndex(ctx, slashWindow)
+
+	// Iterate through sorted keys for deterministic ordering.
+	valAddrs := make([]string, 0, len(validatorPerformances))
+	for valAddr := range validatorPerformances {
+		valAddrs = append(valAddrs, valAddr)
+	}
+	sort.Strings(valAddrs)
+
+	for _, valAddr := range valAddrs {
+		performance := validatorPerformances[valAddr]
+		key := collections.Join(performance.ValAddress, window)
+		record := k.PerformanceHistory.GetOr(ctx, key, types.WindowPerformance{
+			Validator: performance.ValAddress.String(),
+			Window:    window,
+		})
+		record.VotePeriods++
+		record.WinCount += performance.WinCount
+		record.AbstainCount += performance.AbstainCount
+		record.MissCount += performance.MissCount
+		record.RewardWeight += performance.RewardWeight
+		k.PerformanceHistory.Insert(ctx, key, record)
+	}
+}
+
+// prunePerformanceHistory deletes the performances of the slash windows that
+// fall out of the last PerformanceHistoryWindows windows, the current window
+// included.
+func (k Keeper) prunePerformanceHistory(ctx sdk.Context, slashWindow uint64) {
+	window := slashWindowIndex(ctx, slashWindow)
+	if window < PerformanceHistoryWindows {
+		return
+	}
+	cutoff := window - PerformanceHistoryWindows + 1
+	rng := collections.PairRange[sdk.ValAddress, uint64]{}
+	for _, key := range k.PerformanceHistory.Iterate(ctx, rng).Keys() {
+		if key.K2() < cutoff {
+			_ = k.PerformanceHistory.Delete(ctx, key)
+		}
+	}
+}
+
+// GetPerformanceHistory returns the performance of a validator in the given
+// number of slash windows counting back from the current one, newest first.
+// Zero windows returns every window that is kept. Windows in which the
+// validator was not bonded are left out.
+func (k Keeper) GetPerformanceHistory(
+	ctx sdk.Context, valAddr sdk.ValAddress, windows uint64,
+) (performances []types.WindowPerformance) {
+	performances = []types.WindowPerformance{}
+	if windows == 0 || windows > PerformanceHistoryWindows {
+		windows = PerformanceHistoryWindows
+	}
+	current := slashWindowIndex(ctx, k.SlashWindow(ctx))
+	rng := collections.PairRange[sdk.ValAddress, uint64]{}.Prefix(valAddr).Descending()
+	for _, performance := range k.PerformanceHistory.Iterate(ctx, rng).Values() {
+		if performance.Window+windows <= current {
+			break
+		}
+		performances = append(performances, performance)
+	}
+	return performances
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestPerformanceHistory(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	querier := NewQuerier(fixture.OracleKeeper)
+
+	voteAndTally := func(ctx sdk.Context) {
+		for i := 0; i < 4; i++ {
+			MakeAggregatePrevoteAndVote(t, fixture, msgServer, ctx.BlockHeight(), types.ExchangeRateTuples{
+				{Pair: btcUsd, ExchangeRate: testExchangeRate},
+			}, i)
+		}
+		fixture.OracleKeeper.UpdateExchangeRates(ctx)
+	}
+
+	t.Log("vote periods of a slash window add up")
+	voteAndTally(fixture.Ctx)
+	voteAndTally(fixture.Ctx)
+	numPairs := int64(len(fixture.OracleKeeper.GetWhitelistedPairs(fixture.Ctx)))
+	history := fixture.OracleKeeper.GetPerformanceHistory(fixture.Ctx, ValAddrs[0], 0)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(0), history[0].Window)
+	require.Equal(t, uint64(2), history[0].VotePeriods)
+	require.Equal(t, int64(2), history[0].WinCount)
+	require.Equal(t, 2*(numPairs-1), history[0].AbstainCount)
+	require.Positive(t, history[0].RewardWeight)
+
+	history = fixture.OracleKeeper.GetPerformanceHistory(fixture.Ctx, ValAddrs[4], 0)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(0), history[0].WinCount)
+	require.Equal(t, 2*numPairs, history[0].AbstainCount)
+
+	t.Log("windows are returned newest first")
+	nextWindowCtx := fixture.Ctx.WithBlockHeight(150)
+	voteAndTally(nextWindowCtx)
+	res, err := querier.ValidatorPerformance(sdk.WrapSDKContext(nextWindowCtx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Performances, 2)
+	require.Equal(t, uint64(1), res.Performances[0].Window)
+	require.Equal(t, uint64(0), res.Performances[1].Window)
+
+	res, err = querier.ValidatorPerformance(sdk.WrapSDKContext(nextWindowCtx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+		Windows:       1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Performances, 1)
+	require.Equal(t, uint64(1), res.Performances[0].Window)
+
+	t.Log("windows older than the history are pruned")
+	lastKeptWindowCtx := fixture.Ctx.WithBlockHeight(100*PerformanceHistoryWindows + 99)
+	fixture.OracleKeeper.SlashAndResetMissCounters(lastKeptWindowCtx)
+	history = fixture.OracleKeeper.GetPerformanceHistory(nextWindowCtx, ValAddrs[0], 0)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(1), history[0].Window)
+}
+
+func TestSlashPreview(t *testing.T) {
+	input := CreateTestFixture(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+	for i := 0; i < 2; i++ {
+		_, err := sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(ValAddrs[i], ValPubKeys[i], amt))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+	votePeriodsPerWindow := input.OracleKeeper.votePeriodsPerWindow(input.Ctx)
+	minValidVotes := uint64(input.OracleKeeper.MinValidPerWindow(input.Ctx).
+		MulInt64(int64(votePeriodsPerWindow)).Ceil().TruncateInt64())
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, ValAddrs[0], votePeriodsPerWindow-minValidVotes+1)
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, ValAddrs[1], votePeriodsPerWindow-minValidVotes)
+
+	res, err := NewQuerier(input.OracleKeeper).SlashPreview(
+		sdk.WrapSDKContext(input.Ctx), &types.QuerySlashPreviewRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	require.Equal(t, ValAddrs[0].String(), res.Slashes[0].Validator)
+	require.Equal(t, votePeriodsPerWindow-minValidVotes+1, res.Slashes[0].MissCounter)
+	require.True(t, res.Slashes[0].ValidVoteRate.LT(input.OracleKeeper.MinValidPerWindow(input.Ctx)))
+	require.Equal(t, input.OracleKeeper.SlashFraction(input.Ctx), res.SlashFraction)
+	require.Equal(t, int64(input.OracleKeeper.SlashWindow(input.Ctx)-1), res.WindowEndHeight)
+
+	t.Log("the previewed validators are the ones slashed")
+	input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	validator, _ := input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
+	require.True(t, validator.IsJailed())
+	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[1])
+	require.False(t, validator.IsJailed())
+	require.Empty(t, input.OracleKeeper.SlashPreview(input.Ctx))
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// MaxOhlcCandles is the largest number of intervals an Ohlc query may span.
+const MaxOhlcCandles = 1_000
+
+// GetPriceHistory returns a page of the price snapshots of a pair taken
+// within [start, end], oldest first. A zero start or end leaves that side of
+// the range open.
+func (k Keeper) GetPriceHistory(
+	ctx sdk.Context, pair asset.Pair, start, end time.Time, pageReq *sdkquery.PageRequest,
+) (snapshots []types.PriceSnapshot, pageRes *sdkquery.PageResponse, err error) {
+	// The keys of the pair store are the timestamps of its snapshots.
+	pairStore := prefix.NewStore(k.PriceSnapshots.GetStore(ctx), asset.PairKeyEncoder.Encode(pair))
+	snapshots = []types.PriceSnapshot{}
+	pageRes, err = sdkquery.FilteredPaginate(
+		pairStore, pageReq,
+		func(key, value []byte, accumulate bool) (bool, error) {
+			timestamp, err := sdk.ParseTimeBytes(key)
+			if err != nil {
+				return false, err
+			}
+			if timestamp.Before(start) || (!end.IsZero() && timestamp.After(end)) {
+				return false, nil
+			}
+			if accumulate {
+				var snapshot types.PriceSnapshot
+				if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+					return false, err
+				}
+				snapshots = append(snapshots, snapshot)
+			}
+			return true, nil
+		},
+	)
+	return snapshots, pageRes, err
+}
+
+// GetCandles buckets the price snapshots of a pair taken within [start, end)
+// into consecutive intervals that begin at start. Only the intervals that
+// have snapshots get a candle.
+func (k Keeper) GetCandles(
+	ctx sdk.Context, pair asset.Pair, interval time.Duration, start, end time.Time,
+) (candles []types.Candle) {
+	candles = []types.Candle{}
+	snapshots := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(start).
+			EndExclusive(end),
+	).KeyValues()
+
+	for _, kv := range snapshots {
+		candleStart := start.Add(kv.Key.K2().Sub(start) / interval * interval)
+		price := kv.Value.Price
+		if n := len(candles); n > 0 && candles[n-1].StartTime.Equal(candleStart) {
+			candle := &candles[n-1]
+			if price.GT(candle.High) {
+				candle.High = price
+			}
+			if price.LT(candle.Low) {
+				candle.Low = price
+			}
+			candle.Close = price
+			candle.NumSnapshots++
+			continue
+		}
+		candles = append(candles, types.Candle{
+			StartTime:    candleStart,
+			Open:         price,
+			High:         price,
+			Low:          price,
+			Close:        price,
+			NumSnapshots: 1,
+		})
+	}
+	return candles
+}
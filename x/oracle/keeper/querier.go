@@ -9,6 +9,7 @@ import (
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -39,7 +40,8 @@ func (q querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// ExchangeRate queries exchange rate of a pair
+// ExchangeRate queries exchange rate of a pair, along with whether the price
+// is stale or halted by the circuit breaker.
 func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -50,12 +52,21 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	exchangeRate, err := q.Keeper.GetExchangeRate(ctx, req.Pair)
+	exchangeRate, err := q.Keeper.ExchangeRates.Get(ctx, req.Pair)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: exchangeRate.ExchangeRate,
+		IsStale:      IsPriceStale(exchangeRate, params.VotePeriod, ctx.BlockHeight()),
+		IsHalted:     q.Keeper.HaltedPairs.Has(ctx, req.Pair),
+	}, nil
 }
 
 /*
@@ -67,7 +78,8 @@ If there's only one snapshot, then this function returns the price from that sin
 Returns -1 if there's no price.
 */
 func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRateRequest) (response *types.QueryExchangeRateResponse, err error) {
-	if _, err = q.ExchangeRate(c, req); err != nil {
+	current, err := q.ExchangeRate(c, req)
+	if err != nil {
 		return
 	}
 
@@ -76,7 +88,11 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	if err != nil {
 		return &types.QueryExchangeRateResponse{}, err
 	}
-	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: twap,
+		IsStale:      current.IsStale,
+		IsHalted:     current.IsHalted,
+	}, nil
 }
 
 // ExchangeRates queries exchange rates of all pairs
@@ -192,3 +208,186 @@ func (q querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteR
 func (q querier) AggregateVotes(c context.Context, _ *types.QueryAggregateVotesRequest) (*types.QueryAggregateVotesResponse, error) {
 	return &types.QueryAggregateVotesResponse{AggregateVotes: q.Keeper.Votes.Iterate(sdk.UnwrapSDKContext(c), collections.Range[sdk.ValAddress]{}).Values()}, nil
 }
+
+// Rewards queries the active rewards entries and the balance of the oracle fee
+// pool.
+func (q querier) Rewards(c context.Context, _ *types.QueryRewardsRequest) (*types.QueryRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	feePool := q.Keeper.AccountKeeper.GetModuleAddress(types.FeePoolModuleAccount)
+	return &types.QueryRewardsResponse{
+		Rewards: q.Keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		FeePool: q.Keeper.bankKeeper.GetAllBalances(ctx, feePool),
+	}, nil
+}
+
+// PriceHistory queries a page of the price snapshots of a pair taken within a
+// time range
+func (q querier) PriceHistory(
+	c context.Context, req *types.QueryPriceHistoryRequest,
+) (*types.QueryPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !req.EndTime.IsZero() && req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time is before start time")
+	}
+	pageReq, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	snapshots, pageRes, err := q.Keeper.GetPriceHistory(ctx, req.Pair, req.StartTime, req.EndTime, pageReq)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPriceHistoryResponse{PriceSnapshots: snapshots, Pagination: pageRes}, nil
+}
+
+// TwapOverWindow queries the time-weighted average price of a pair over a
+// lookback window chosen by the caller
+func (q querier) TwapOverWindow(
+	c context.Context, req *types.QueryTwapOverWindowRequest,
+) (*types.QueryTwapOverWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.SnapshotRetention > 0 && req.Window > params.SnapshotRetention {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"window %s exceeds the snapshot retention %s", req.Window, params.SnapshotRetention)
+	}
+
+	twap, err := q.Keeper.GetExchangeRateTwapOverWindow(ctx, req.Pair, req.Window)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTwapOverWindowResponse{Twap: twap}, nil
+}
+
+// Ohlc queries the open, high, low, and close prices of a pair in consecutive
+// intervals of a time range
+func (q querier) Ohlc(c context.Context, req *types.QueryOhlcRequest) (*types.QueryOhlcResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Interval <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	start, end := req.StartTime, req.EndTime
+	if end.IsZero() {
+		end = ctx.BlockTime()
+	}
+	if start.IsZero() {
+		params, err := q.Keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		start = types.SnapshotRetentionCutoff(end, params.SnapshotRetention)
+	}
+	if !start.Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start time must be before end time")
+	}
+	span := end.Sub(start)
+	numCandles := span / req.Interval
+	if span%req.Interval != 0 {
+		numCandles++
+	}
+	if numCandles > MaxOhlcCandles {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"time range spans %d intervals, more than the maximum of %d", numCandles, MaxOhlcCandles)
+	}
+
+	return &types.QueryOhlcResponse{
+		Candles: q.Keeper.GetCandles(ctx, req.Pair, req.Interval, start, end),
+	}, nil
+}
+
+// PairParams queries the ballot params in effect for a pair, or for every
+// whitelisted pair
+func (q querier) PairParams(
+	c context.Context, req *types.QueryPairParamsRequest,
+) (*types.QueryPairParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := params.Whitelist
+	if req.Pair != "" {
+		pair, err := asset.TryNewPair(req.Pair)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		pairs = []asset.Pair{pair}
+	}
+
+	pairParams := make([]types.PairParams, len(pairs))
+	for i, pair := range pairs {
+		pairParams[i] = params.ForPair(pair)
+	}
+	return &types.QueryPairParamsResponse{PairParams: pairParams}, nil
+}
+
+// ValidatorPerformance queries the performance of a validator in the latest
+// slash windows, newest first.
+func (q querier) ValidatorPerformance(
+	c context.Context, req *types.QueryValidatorPerformanceRequest,
+) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorPerformanceResponse{
+		Performances: q.Keeper.GetPerformanceHistory(ctx, valAddr, req.Windows),
+	}, nil
+}
+
+// SlashPreview queries the validators that would be slashed if the current
+// slash window ended now.
+func (q querier) SlashPreview(
+	c context.Context, _ *types.QuerySlashPreviewRequest,
+) (*types.QuerySlashPreviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	window := slashWindowIndex(ctx, params.SlashWindow)
+	return &types.QuerySlashPreviewResponse{
+		Slashes:         q.Keeper.SlashPreview(ctx),
+		WindowEndHeight: int64((window+1)*params.SlashWindow - 1),
+		SlashFraction:   params.SlashFraction,
+	}, nil
+}
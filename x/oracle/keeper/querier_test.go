@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	testutilevents "github.com/NibiruChain/nibiru/x/common/testutil"
@@ -363,3 +364,136 @@ func TestQueryVoteTargets(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, voteTargets, res.VoteTargets)
 }
+
+func TestQueryPriceHistory(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	start := input.Ctx.BlockTime()
+	for i := 0; i < 5; i++ {
+		ctx := input.Ctx.WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		input.OracleKeeper.SetPrice(ctx, pair, math.LegacyNewDec(int64(100+i)))
+	}
+	input.OracleKeeper.SetPrice(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), math.LegacyNewDec(1))
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+
+	t.Log("time range is inclusive on both ends")
+	res, err := querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+		Pair:      pair,
+		StartTime: start.Add(time.Minute),
+		EndTime:   start.Add(3 * time.Minute),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.PriceSnapshots, 3)
+	require.Equal(t, math.LegacyNewDec(101), res.PriceSnapshots[0].Price)
+	require.Equal(t, math.LegacyNewDec(103), res.PriceSnapshots[2].Price)
+
+	t.Log("paginate over an open-ended time range")
+	var prices []math.LegacyDec
+	pageReq := &sdkquery.PageRequest{Limit: 2}
+	for {
+		res, err = querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+			Pair: pair, Pagination: pageReq,
+		})
+		require.NoError(t, err)
+		for _, snapshot := range res.PriceSnapshots {
+			prices = append(prices, snapshot.Price)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	require.Len(t, prices, 5)
+	require.Equal(t, math.LegacyNewDec(104), prices[4])
+
+	t.Log("invalid requests")
+	_, err = querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{
+		Pair: pair, StartTime: start.Add(time.Minute), EndTime: start,
+	})
+	require.Error(t, err)
+	_, err = querier.PriceHistory(goCtx, &types.QueryPriceHistoryRequest{Pair: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryTwapOverWindow(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	start := input.Ctx.BlockTime()
+	input.OracleKeeper.SetPrice(input.Ctx, pair, math.LegacyNewDec(100))
+	input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(start.Add(time.Hour)), pair, math.LegacyNewDec(200))
+	goCtx := sdk.WrapSDKContext(input.Ctx.WithBlockTime(start.Add(2 * time.Hour)))
+
+	res, err := querier.TwapOverWindow(goCtx, &types.QueryTwapOverWindowRequest{Pair: pair, Window: 2 * time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(150), res.Twap)
+
+	res, err = querier.TwapOverWindow(goCtx, &types.QueryTwapOverWindowRequest{Pair: pair, Window: time.Hour})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(200), res.Twap)
+
+	_, err = querier.TwapOverWindow(goCtx, &types.QueryTwapOverWindowRequest{Pair: pair})
+	require.Error(t, err)
+	_, err = querier.TwapOverWindow(goCtx, &types.QueryTwapOverWindowRequest{
+		Pair: pair, Window: types.DefaultSnapshotRetention + time.Second,
+	})
+	require.ErrorContains(t, err, "exceeds the snapshot retention")
+
+	// A zero retention keeps every snapshot, so any window is allowed.
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.SnapshotRetention = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	res, err = querier.TwapOverWindow(goCtx, &types.QueryTwapOverWindowRequest{
+		Pair: pair, Window: types.DefaultSnapshotRetention + time.Second,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(150), res.Twap)
+}
+
+func TestQueryOhlc(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	start := input.Ctx.BlockTime().Truncate(time.Hour)
+	for i, price := range []int64{10, 14, 9, 12, 20, 18} {
+		// two candles of three snapshots, then a gap of an hour
+		offset := time.Duration(i%3)*10*time.Minute + time.Duration(i/3)*2*time.Hour
+		input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(start.Add(offset)), pair, math.LegacyNewDec(price))
+	}
+	goCtx := sdk.WrapSDKContext(input.Ctx.WithBlockTime(start.Add(3 * time.Hour)))
+
+	res, err := querier.Ohlc(goCtx, &types.QueryOhlcRequest{
+		Pair: pair, Interval: time.Hour, StartTime: start,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Candle{
+		{
+			StartTime: start, Open: math.LegacyNewDec(10), High: math.LegacyNewDec(14),
+			Low: math.LegacyNewDec(9), Close: math.LegacyNewDec(9), NumSnapshots: 3,
+		},
+		{
+			StartTime: start.Add(2 * time.Hour), Open: math.LegacyNewDec(12), High: math.LegacyNewDec(20),
+			Low: math.LegacyNewDec(12), Close: math.LegacyNewDec(18), NumSnapshots: 3,
+		},
+	}, res.Candles)
+
+	t.Log("the time range defaults to the snapshot retention window")
+	res, err = querier.Ohlc(goCtx, &types.QueryOhlcRequest{Pair: pair, Interval: 24 * time.Hour})
+	require.NoError(t, err)
+	require.Len(t, res.Candles, 1)
+	require.Equal(t, uint64(6), res.Candles[0].NumSnapshots)
+
+	t.Log("invalid requests")
+	_, err = querier.Ohlc(goCtx, &types.QueryOhlcRequest{Pair: pair, StartTime: start})
+	require.Error(t, err)
+	_, err = querier.Ohlc(goCtx, &types.QueryOhlcRequest{
+		Pair: pair, Interval: time.Second, StartTime: start,
+	})
+	require.ErrorContains(t, err, "more than the maximum")
+	_, err = querier.Ohlc(goCtx, &types.QueryOhlcRequest{
+		Pair: pair, Interval: time.Hour, StartTime: start.Add(4 * time.Hour),
+	})
+	require.Error(t, err)
+}
@@ -3,27 +3,152 @@ package keeper
 import (
 	"github.com/NibiruChain/collections"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
-func (k Keeper) AllocateRewards(ctx sdk.Context, funderModule string, totalCoins sdk.Coins, votePeriods uint64) error {
-	votePeriodCoins := make(sdk.Coins, len(totalCoins))
-	for i, coin := range totalCoins {
-		newCoin := sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(votePeriods)))
-		votePeriodCoins[i] = newCoin
+// AllocateRewards creates a rewards entry that pays out "totalCoins" to the
+// oracle voters in equal parts over the next "votePeriods" vote periods. Only
+// the part of "totalCoins" that splits evenly over the vote periods is taken
+// from the funder module.
+func (k Keeper) AllocateRewards(ctx sdk.Context, funderModule string, totalCoins sdk.Coins, votePeriods uint64) (types.Rewards, error) {
+	rewards, fundedCoins, err := k.newRewards(ctx, totalCoins, votePeriods)
+	if err != nil {
+		return rewards, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, funderModule, types.ModuleName, fundedCoins); err != nil {
+		return rewards, err
 	}
+	k.insertRewards(ctx, k.AccountKeeper.GetModuleAddress(funderModule), rewards, fundedCoins)
+	return rewards, nil
+}
 
-	id := k.RewardsID.Next(ctx)
-	k.Rewards.Insert(ctx, id, types.Rewards{
-		Id:          id,
+// FundRewards is the same as AllocateRewards, except that the coins are taken
+// from the account of "funder". Each coin must be of a denom in the
+// "MinFundRewards" param and pay out at least its minimum per vote period, and
+// there must be fewer than "MaxRewardsEntries" active rewards entries.
+func (k Keeper) FundRewards(ctx sdk.Context, funder sdk.AccAddress, totalCoins sdk.Coins, votePeriods uint64) (types.Rewards, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Rewards{}, err
+	}
+	rewards, fundedCoins, err := k.newRewards(ctx, totalCoins, votePeriods)
+	if err != nil {
+		return rewards, err
+	}
+	for _, coin := range totalCoins {
+		minAmount := params.MinFundRewards.AmountOf(coin.Denom)
+		if minAmount.IsZero() {
+			return rewards, sdkerrors.Wrap(types.ErrRewardsDenomNotAllowed, coin.Denom)
+		}
+		if sdk.Coins(rewards.Coins).AmountOf(coin.Denom).LT(minAmount) {
+			return rewards, sdkerrors.Wrapf(types.ErrRewardsTooSmall,
+				"%s over %d vote periods is less than %s%s per vote period",
+				coin, votePeriods, minAmount, coin.Denom)
+		}
+	}
+	activeEntries := uint64(len(k.Rewards.Iterate(ctx, collections.Range[uint64]{}).Keys()))
+	if activeEntries >= params.MaxRewardsEntries {
+		return rewards, sdkerrors.Wrapf(types.ErrTooManyRewards,
+			"%d active entries, the max is %d", activeEntries, params.MaxRewardsEntries)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, fundedCoins); err != nil {
+		return rewards, err
+	}
+	k.insertRewards(ctx, funder, rewards, fundedCoins)
+	return rewards, nil
+}
+
+// newRewards splits "totalCoins" over "votePeriods" and returns the rewards
+// entry along with the coins it pays out in total. Any remainder of the split
+// is left out.
+func (k Keeper) newRewards(
+	ctx sdk.Context, totalCoins sdk.Coins, votePeriods uint64,
+) (rewards types.Rewards, fundedCoins sdk.Coins, err error) {
+	if votePeriods == 0 {
+		return rewards, nil, sdkerrors.Wrap(types.ErrRewardsTooSmall, "vote periods must be positive")
+	}
+	votePeriodCoins := sdk.NewCoins()
+	for _, coin := range totalCoins {
+		votePeriodCoins = votePeriodCoins.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.Quo(math.NewIntFromUint64(votePeriods))),
+		)
+	}
+	if votePeriodCoins.IsZero() {
+		return rewards, nil, sdkerrors.Wrapf(types.ErrRewardsTooSmall,
+			"%s over %d vote periods", totalCoins, votePeriods)
+	}
+
+	for _, coin := range votePeriodCoins {
+		fundedCoins = fundedCoins.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.Mul(math.NewIntFromUint64(votePeriods))),
+		)
+	}
+	return types.Rewards{
+		Id:          k.RewardsID.Next(ctx),
 		VotePeriods: votePeriods,
 		Coins:       votePeriodCoins,
+	}, fundedCoins, nil
+}
+
+func (k Keeper) insertRewards(
+	ctx sdk.Context, funder sdk.AccAddress, rewards types.Rewards, fundedCoins sdk.Coins,
+) {
+	k.Rewards.Insert(ctx, rewards.Id, rewards)
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventFundRewards{
+		Funder:  funder.String(),
+		Rewards: rewards,
+		Total:   fundedCoins,
 	})
+}
+
+// RecordBlockStartFees records the balances of the fee collector at the start
+// of the block, once x/distribution has paid out the fees of the previous block
+// and x/inflation has minted into it.
+func (k Keeper) RecordBlockStartFees(ctx sdk.Context) {
+	feeCollector := k.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if feeCollector == nil {
+		return
+	}
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, feeCollector) {
+		k.BlockStartFees.Insert(ctx, balance.Denom, balance.Amount)
+	}
+}
 
-	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, funderModule, types.ModuleName, totalCoins)
+// CollectValidatorFees moves the "ValidatorFeeRatio" share of the transaction
+// fees of the block into the oracle fee pool. The fees are what the fee
+// collector gained since "RecordBlockStartFees", so coins that reached it in
+// other ways, such as staking inflation, are left alone. It runs at the end of
+// every block, before x/distribution pays out the fees of the block to the
+// stakers at the start of the next one.
+func (k Keeper) CollectValidatorFees(ctx sdk.Context, params types.Params) {
+	feeCollector := k.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if feeCollector == nil {
+		return
+	}
+	validatorFees := sdk.NewCoins()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, feeCollector) {
+		fee := balance.Amount.Sub(k.BlockStartFees.GetOr(ctx, balance.Denom, math.ZeroInt()))
+		if !fee.IsPositive() {
+			continue
+		}
+		validatorFees = validatorFees.Add(
+			sdk.NewCoin(balance.Denom, params.ValidatorFeeRatio.MulInt(fee).TruncateInt()),
+		)
+	}
+	if validatorFees.IsZero() {
+		return
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx, authtypes.FeeCollectorName, types.FeePoolModuleAccount, validatorFees,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("Failed to collect validator fees", "err", err)
+	}
 }
 
 // rewardWinners gives out a portion of spread fees collected in the
@@ -7,11 +7,14 @@ import (
 	"github.com/NibiruChain/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -68,3 +71,128 @@ func TestKeeperRewardsDistributionMultiVotePeriods(t *testing.T) {
 	// assert that there are no rewards instances
 	require.Empty(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Keys())
 }
+
+func TestFundOracleRewards(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	goCtx := sdk.WrapSDKContext(fixture.Ctx)
+	funder := Addrs[0]
+	queryServer := NewQuerier(fixture.OracleKeeper)
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.MinFundRewards = sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NIBI, 100), sdk.NewInt64Coin(denoms.USDC, 1),
+	)
+	params.MaxRewardsEntries = 2
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	t.Log("sad: rewards too small to split over the vote periods")
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 9)), 10,
+	))
+	require.ErrorIs(t, err, types.ErrRewardsTooSmall)
+
+	t.Log("sad: rewards below the minimum per vote period")
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 999)), 10,
+	))
+	require.ErrorIs(t, err, types.ErrRewardsTooSmall)
+
+	t.Log("sad: denom that cannot fund rewards")
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1000), sdk.NewInt64Coin("nonexistent", 1000)), 10,
+	))
+	require.ErrorIs(t, err, types.ErrRewardsDenomNotAllowed)
+
+	t.Log("sad: funder lacks the coins")
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.USDC, 100)), 10,
+	))
+	require.ErrorContains(t, err, "insufficient funds")
+
+	t.Log("happy: only the part that splits evenly is taken from the funder")
+	balanceBefore := fixture.BankKeeper.GetBalance(fixture.Ctx, funder, denoms.NIBI)
+	resp, err := msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1005)), 10,
+	))
+	require.NoError(t, err)
+	wantRewards := types.Rewards{
+		Id:          resp.Rewards.Id,
+		VotePeriods: 10,
+		Coins:       sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100)),
+	}
+	require.Equal(t, wantRewards, resp.Rewards)
+	require.Equal(t,
+		balanceBefore.SubAmount(math.NewInt(1000)),
+		fixture.BankKeeper.GetBalance(fixture.Ctx, funder, denoms.NIBI),
+	)
+	testutil.RequireContainsTypedEvent(t, fixture.Ctx, &types.EventFundRewards{
+		Funder:  funder.String(),
+		Rewards: wantRewards,
+		Total:   sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1000)),
+	})
+
+	t.Log("happy: query the active rewards and their remaining vote periods")
+	fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx)
+	queryResp, err := queryServer.Rewards(goCtx, &types.QueryRewardsRequest{})
+	require.NoError(t, err)
+	require.Len(t, queryResp.Rewards, 1)
+	require.EqualValues(t, 9, queryResp.Rewards[0].VotePeriods)
+	require.True(t, queryResp.FeePool.IsZero())
+
+	t.Log("sad: no more entries than \"max_rewards_entries\"")
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1000)), 10,
+	))
+	require.NoError(t, err)
+	_, err = msgServer.FundOracleRewards(goCtx, types.NewMsgFundOracleRewards(
+		funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1000)), 10,
+	))
+	require.ErrorIs(t, err, types.ErrTooManyRewards)
+}
+
+func TestFeesFundOracleRewards(t *testing.T) {
+	fixture, _ := Setup(t)
+	hooks := fixture.OracleKeeper.Hooks()
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.ValidatorFeeRatio = math.LegacyNewDecWithPrec(1, 1) // 10%
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	fundFeeCollector := func(coins sdk.Coins) {
+		require.NoError(t, fixture.BankKeeper.MintCoins(fixture.Ctx, faucetAccountName, coins))
+		require.NoError(t, fixture.BankKeeper.SendCoinsFromModuleToModule(
+			fixture.Ctx, faucetAccountName, authtypes.FeeCollectorName, coins,
+		))
+	}
+
+	// Coins in the fee collector at the start of the block, such as staking
+	// inflation, are not fees of the block.
+	fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 7_000_000)))
+	fixture.OracleKeeper.RecordBlockStartFees(fixture.Ctx)
+	fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000_000), sdk.NewInt64Coin(denoms.USDC, 5)))
+
+	t.Log("the fee ratio of the fees of the block is moved to the fee pool every block")
+	fixture.OracleKeeper.CollectValidatorFees(fixture.Ctx, params)
+	feePool := fixture.AccountKeeper.GetModuleAddress(types.FeePoolModuleAccount)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100_000)),
+		fixture.BankKeeper.GetAllBalances(fixture.Ctx, feePool),
+	)
+
+	t.Log("the fee pool is left alone at the end of other epochs")
+	hooks.AfterEpochEnd(fixture.Ctx, epochstypes.WeekEpochID, 1)
+	require.Empty(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Values())
+
+	t.Log("the fee pool is paid out over the next day at the end of the day epoch")
+	hooks.AfterEpochEnd(fixture.Ctx, epochstypes.DayEpochID, 1)
+	rewards := fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Values()
+	require.Len(t, rewards, 1)
+	require.Equal(t, params.FeeRewardsVotePeriods, rewards[0].VotePeriods)
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewCoin(denoms.NIBI, math.NewInt(100_000).QuoRaw(int64(rewards[0].VotePeriods)))),
+		sdk.Coins(rewards[0].Coins),
+	)
+	require.True(t, fixture.BankKeeper.GetAllBalances(fixture.Ctx, feePool).IsAllLT(
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, int64(rewards[0].VotePeriods))),
+	))
+}
@@ -5,6 +5,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 // SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
@@ -12,12 +14,7 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	// slash_window / vote_period
-	votePeriodsPerWindow := uint64(
-		math.LegacyNewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
-			TruncateInt64(),
-	)
+	votePeriodsPerWindow := k.votePeriodsPerWindow(ctx)
 	minValidPerWindow := k.MinValidPerWindow(ctx)
 	slashFraction := k.SlashFraction(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
@@ -25,10 +22,7 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	for _, mc := range k.MissCounters.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
 		operator := mc.Key
 		missCounter := mc.Value
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
-		validVoteRate := math.LegacyNewDecFromInt(
-			math.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+		validVoteRate := validVoteRate(missCounter, votePeriodsPerWindow)
 
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
 		if validVoteRate.LT(minValidPerWindow) {
@@ -53,4 +47,48 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 			k.Logger(ctx).Error("fail to delete miss counter", "operator", operator.String(), "error", err)
 		}
 	}
+
+	k.prunePerformanceHistory(ctx, k.SlashWindow(ctx))
+}
+
+// SlashPreview returns the validators that SlashAndResetMissCounters would
+// slash and jail if the current slash window ended now.
+func (k Keeper) SlashPreview(ctx sdk.Context) (slashes []types.SlashPreview) {
+	slashes = []types.SlashPreview{}
+	votePeriodsPerWindow := k.votePeriodsPerWindow(ctx)
+	minValidPerWindow := k.MinValidPerWindow(ctx)
+
+	for _, mc := range k.MissCounters.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
+		validVoteRate := validVoteRate(mc.Value, votePeriodsPerWindow)
+		if !validVoteRate.LT(minValidPerWindow) {
+			continue
+		}
+		validator := k.StakingKeeper.Validator(ctx, mc.Key)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+		slashes = append(slashes, types.SlashPreview{
+			Validator:     mc.Key.String(),
+			MissCounter:   mc.Value,
+			ValidVoteRate: validVoteRate,
+		})
+	}
+	return slashes
+}
+
+// votePeriodsPerWindow returns slash_window / vote_period.
+func (k Keeper) votePeriodsPerWindow(ctx sdk.Context) uint64 {
+	return uint64(
+		math.LegacyNewDec(int64(k.SlashWindow(ctx))).
+			QuoInt64(int64(k.VotePeriod(ctx))).
+			TruncateInt64(),
+	)
+}
+
+// validVoteRate calculates the valid vote rate;
+// (votePeriodsPerWindow - missCounter) / votePeriodsPerWindow
+func validVoteRate(missCounter, votePeriodsPerWindow uint64) sdk.Dec {
+	return math.LegacyNewDecFromInt(
+		math.NewInt(int64(votePeriodsPerWindow - missCounter))).
+		QuoInt64(int64(votePeriodsPerWindow))
 }
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// maxSnapshotTime is later than the timestamp of any price snapshot. It is
+// used to seek past all of the snapshots of a pair.
+var maxSnapshotTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// PrunePriceSnapshots deletes the price snapshots of every pair that are older
+// than the retention window, counted back from the block time. Returns the
+// number of snapshots deleted.
+func (k Keeper) PrunePriceSnapshots(ctx sdk.Context, retention time.Duration) (pruned int) {
+	cutoff := types.SnapshotRetentionCutoff(ctx.BlockTime(), retention)
+	if cutoff.IsZero() {
+		return 0
+	}
+
+	for _, pair := range k.snapshotPairs(ctx) {
+		keys := k.PriceSnapshots.Iterate(
+			ctx,
+			collections.PairRange[asset.Pair, time.Time]{}.
+				Prefix(pair).
+				EndExclusive(cutoff),
+		).Keys()
+		for _, key := range keys {
+			_ = k.PriceSnapshots.Delete(ctx, key)
+		}
+		pruned += len(keys)
+	}
+	return pruned
+}
+
+// RetainedPriceSnapshots returns the price snapshots of every pair that are
+// within the retention window.
+func (k Keeper) RetainedPriceSnapshots(
+	ctx sdk.Context, retention time.Duration,
+) (snapshots []types.PriceSnapshot) {
+	snapshots = []types.PriceSnapshot{}
+	cutoff := types.SnapshotRetentionCutoff(ctx.BlockTime(), retention)
+	for _, pair := range k.snapshotPairs(ctx) {
+		snapshots = append(snapshots, k.PriceSnapshots.Iterate(
+			ctx,
+			collections.PairRange[asset.Pair, time.Time]{}.
+				Prefix(pair).
+				StartInclusive(cutoff),
+		).Values()...)
+	}
+	return snapshots
+}
+
+// snapshotPairs returns the distinct pairs that have price snapshots. Rather
+// than reading every snapshot, it seeks past the snapshots of each pair it
+// finds.
+func (k Keeper) snapshotPairs(ctx sdk.Context) (pairs []asset.Pair) {
+	rng := collections.Range[collections.Pair[asset.Pair, time.Time]]{}
+	for {
+		iter := k.PriceSnapshots.Iterate(ctx, rng)
+		if !iter.Valid() {
+			iter.Close()
+			return pairs
+		}
+		pair := iter.Key().K1()
+		iter.Close()
+
+		pairs = append(pairs, pair)
+		rng = rng.StartExclusive(collections.Join(pair, maxSnapshotTime))
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestPrunePriceSnapshots(t *testing.T) {
+	fixture, _ := Setup(t)
+	blockTime := fixture.Ctx.BlockTime()
+	pairs := []asset.Pair{
+		asset.NewPair(denoms.BTC, denoms.USD),
+		asset.NewPair(denoms.ETH, denoms.USD),
+		asset.NewPair(denoms.NIBI, denoms.USD),
+	}
+	for i := 0; i < 10; i++ {
+		ctx := fixture.Ctx.WithBlockTime(blockTime.Add(-time.Duration(i) * time.Hour))
+		for _, pair := range pairs {
+			fixture.OracleKeeper.SetPrice(ctx, pair, math.LegacyNewDec(int64(i+1)))
+		}
+	}
+	countSnapshots := func(pair asset.Pair) int {
+		return len(fixture.OracleKeeper.PriceSnapshots.Iterate(
+			fixture.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+		).Keys())
+	}
+
+	t.Log("zero retention keeps every snapshot")
+	require.Zero(t, fixture.OracleKeeper.PrunePriceSnapshots(fixture.Ctx, 0))
+
+	t.Log("snapshots older than the retention window are pruned for every pair")
+	pruned := fixture.OracleKeeper.PrunePriceSnapshots(fixture.Ctx, 4*time.Hour)
+	require.Equal(t, 5*len(pairs), pruned)
+	for _, pair := range pairs {
+		require.Equal(t, 5, countSnapshots(pair), pair)
+	}
+	require.Len(t, fixture.OracleKeeper.RetainedPriceSnapshots(fixture.Ctx, 2*time.Hour), 3*len(pairs))
+
+	t.Log("the TWAP is unaffected by pruning outside of its lookback window")
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.TwapLookbackWindow = 2 * time.Hour
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+	twapBefore, err := fixture.OracleKeeper.GetExchangeRateTwap(fixture.Ctx, pairs[0])
+	require.NoError(t, err)
+	fixture.OracleKeeper.PrunePriceSnapshots(fixture.Ctx, params.TwapLookbackWindow)
+	twapAfter, err := fixture.OracleKeeper.GetExchangeRateTwap(fixture.Ctx, pairs[0])
+	require.NoError(t, err)
+	require.Equal(t, twapBefore, twapAfter)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	fixture, _ := Setup(t)
+	pair := asset.NewPair(denoms.BTC, denoms.USD)
+	blockTime := fixture.Ctx.BlockTime()
+	for _, age := range []time.Duration{30 * 24 * time.Hour, time.Hour} {
+		ctx := fixture.Ctx.WithBlockTime(blockTime.Add(-age))
+		fixture.OracleKeeper.SetPrice(ctx, pair, math.LegacyOneDec())
+	}
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.SnapshotRetention = 0
+	params.MinFundRewards = nil
+	params.MaxRewardsEntries = 0
+	params.FeeRewardsVotePeriods = 0
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	require.NoError(t, NewMigrator(fixture.OracleKeeper).Migrate1to2(fixture.Ctx))
+
+	params, err = fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSnapshotRetention, params.SnapshotRetention)
+	require.Equal(t, types.DefaultMinFundRewards, params.MinFundRewards)
+	require.EqualValues(t, types.DefaultMaxRewardsEntries, params.MaxRewardsEntries)
+	require.EqualValues(t, types.DefaultFeeRewardsVotePeriods, params.FeeRewardsVotePeriods)
+	snapshots := fixture.OracleKeeper.PriceSnapshots.Iterate(
+		fixture.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair),
+	).Values()
+	require.Len(t, snapshots, 1)
+	require.Equal(t, blockTime.Add(-time.Hour).UnixMilli(), snapshots[0].TimestampMs)
+}
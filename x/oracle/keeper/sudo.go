@@ -30,7 +30,7 @@ func (k sudoExtension) EditOracleParams(
 	ctx sdk.Context, newParams oracletypes.MsgEditOracleParams,
 	sender sdk.AccAddress,
 ) (paramsAfter oracletypes.Params, err error) {
-	if err := k.sudoKeeper.CheckPermissions(sender, ctx); err != nil {
+	if err := k.sudoKeeper.CheckMsgPermissions(sender, ctx, sdk.MsgTypeURL(&newParams)); err != nil {
 		return paramsAfter, err
 	}
 
@@ -44,6 +44,30 @@ func (k sudoExtension) EditOracleParams(
 	return paramsAfter, paramsAfter.Validate()
 }
 
+// ------------------------------------------------------------------
+// Admin.ResetCircuitBreaker
+
+// ResetCircuitBreaker resumes price updates for a pair halted by the circuit
+// breaker. The next tally of the pair is compared against its last price,
+// if that price has not expired.
+func (k sudoExtension) ResetCircuitBreaker(
+	ctx sdk.Context, pair asset.Pair, sender sdk.AccAddress,
+) error {
+	if err := k.sudoKeeper.CheckMsgPermissions(
+		sender, ctx, sdk.MsgTypeURL(&oracletypes.MsgResetCircuitBreaker{}),
+	); err != nil {
+		return err
+	}
+	if !k.HaltedPairs.Has(ctx, pair) {
+		return oracletypes.ErrPairNotHalted.Wrap(pair.String())
+	}
+	k.HaltedPairs.Delete(ctx, pair)
+	return ctx.EventManager().EmitTypedEvent(&oracletypes.EventCircuitBreakerReset{
+		Pair:   pair.String(),
+		Sender: sender.String(),
+	})
+}
+
 // MergeOracleParams: Takes the given oracle params and merges them into the
 // existing partial params, keeping any existing values that are not set in the
 // partial.
@@ -96,5 +120,25 @@ func MergeOracleParams(
 		oracleParams.ValidatorFeeRatio = *partial.ValidatorFeeRatio
 	}
 
+	if partial.DerivedPairs != nil {
+		oracleParams.DerivedPairs = partial.DerivedPairs
+	}
+
+	if partial.PairParams != nil {
+		oracleParams.PairParams = partial.PairParams
+	}
+
+	if partial.SnapshotRetention != nil {
+		oracleParams.SnapshotRetention = time.Duration(partial.SnapshotRetention.Int64())
+	}
+
+	if partial.MaxChangeRatio != nil {
+		oracleParams.MaxChangeRatio = *partial.MaxChangeRatio
+	}
+
+	if partial.CircuitBreakerAction != oracletypes.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED {
+		oracleParams.CircuitBreakerAction = partial.CircuitBreakerAction
+	}
+
 	return oracleParams
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
@@ -39,6 +40,7 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 	twapLookbackWindow := math.NewInt(int64(time.Second * 30))
 	minVoters := math.NewInt(2)
 	validatorFeeRatio := math.LegacyMustNewDecFromStr("0.7")
+	maxChangeRatio := math.LegacyMustNewDecFromStr("0.1")
 	msgEditParams := oracletypes.MsgEditOracleParams{
 		VotePeriod:         &votePeriod,
 		VoteThreshold:      &voteThreshold,
@@ -50,13 +52,18 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 		TwapLookbackWindow: &twapLookbackWindow,
 		MinVoters:          &minVoters,
 		ValidatorFeeRatio:  &validatorFeeRatio,
+		PairParams: []oracletypes.PairParams{
+			{Pair: asset.MustNewPair("sol:usdc"), MinVoters: 3},
+		},
+		MaxChangeRatio:       &maxChangeRatio,
+		CircuitBreakerAction: oracletypes.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT,
 	}
 
 	s.T().Log("Params before MUST NOT be equal to default")
 	defaultParams := oracletypes.DefaultParams()
 	currParams, err := nibiru.OracleKeeper.Params.Get(ctx)
 	s.NoError(err)
-	s.Equal(currParams, defaultParams,
+	s.True(currParams.Equal(defaultParams),
 		"Current params should be eqaul to defaults")
 	partialParams := msgEditParams
 	fullParams := oraclekeeper.MergeOracleParams(partialParams, defaultParams)
@@ -93,3 +100,38 @@ func (s *SuiteOracleSudo) TestEditOracleParams() {
 	s.Require().Error(err)
 	s.ErrorContains(err, "oracle parameter SlashWindow must be greater")
 }
+
+// TestResetCircuitBreaker tests the business logic for
+// "oraclekeeper.Keeper.Sudo().ResetCircuitBreaker"
+func (s *SuiteOracleSudo) TestResetCircuitBreaker() {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	oracleMsgServer := oraclekeeper.NewMsgServerImpl(nibiru.OracleKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	pair := asset.MustNewPair("ubtc:uusd")
+	okSender := testapp.DefaultSudoRoot()
+
+	s.T().Log("Resetting a pair that is not halted MUST fail")
+	_, err := oracleMsgServer.ResetCircuitBreaker(goCtx, &oracletypes.MsgResetCircuitBreaker{
+		Sender: okSender.String(),
+		Pair:   pair,
+	})
+	s.ErrorIs(err, oracletypes.ErrPairNotHalted)
+
+	nibiru.OracleKeeper.HaltedPairs.Insert(ctx, pair)
+
+	s.T().Log("Resetting from a non-sudoer MUST fail")
+	_, err = oracleMsgServer.ResetCircuitBreaker(goCtx, &oracletypes.MsgResetCircuitBreaker{
+		Sender: testutil.AccAddress().String(),
+		Pair:   pair,
+	})
+	s.Error(err)
+	s.True(nibiru.OracleKeeper.HaltedPairs.Has(ctx, pair))
+
+	s.T().Log("Resetting from a sudoer resumes the pair")
+	_, err = oracleMsgServer.ResetCircuitBreaker(goCtx, &oracletypes.MsgResetCircuitBreaker{
+		Sender: okSender.String(),
+		Pair:   pair,
+	})
+	s.Require().NoError(err)
+	s.False(nibiru.OracleKeeper.HaltedPairs.Has(ctx, pair))
+}
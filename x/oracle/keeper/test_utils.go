@@ -140,6 +140,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
+	tKeyOracle := sdk.NewTransientStoreKey(types.TStoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
@@ -158,6 +159,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 	ms.MountStoreWithDB(tKeyParams, storetypes.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyOracle, storetypes.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyStaking, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
 
@@ -178,6 +180,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		distrtypes.ModuleName:          nil,
 		types.ModuleName:               nil,
+		types.FeePoolModuleAccount:     nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -255,6 +258,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 	keeper := NewKeeper(
 		appCodec,
 		keyOracle,
+		tKeyOracle,
 		accountKeeper,
 		bankKeeper,
 		distrKeeper,
@@ -307,7 +311,8 @@ func FundAccount(input TestFixture, addr sdk.AccAddress, amounts sdk.Coins) erro
 
 func AllocateRewards(t *testing.T, input TestFixture, rewards sdk.Coins, votePeriods uint64) {
 	require.NoError(t, input.BankKeeper.MintCoins(input.Ctx, faucetAccountName, rewards))
-	require.NoError(t, input.OracleKeeper.AllocateRewards(input.Ctx, faucetAccountName, rewards, votePeriods))
+	_, err := input.OracleKeeper.AllocateRewards(input.Ctx, faucetAccountName, rewards, votePeriods)
+	require.NoError(t, err)
 }
 
 var (
@@ -19,15 +19,19 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) types.ValidatorPerformances
 
 	pairVotes := k.getPairVotes(ctx, validatorPerformances, whitelistedPairs)
 
+	params, _ := k.Params.Get(ctx)
+	previousPrices := k.previousPrices(ctx, params, pairVotes)
 	k.clearExchangeRates(ctx, pairVotes)
-	k.tallyVotesAndUpdatePrices(ctx, pairVotes, validatorPerformances)
+	k.tallyVotesAndUpdatePrices(ctx, params, pairVotes, validatorPerformances, previousPrices)
+
+	k.updateDerivedPrices(ctx, params, pairVotes)
 
 	k.incrementMissCounters(ctx, whitelistedPairs, validatorPerformances)
 	k.incrementAbstainsByOmission(ctx, len(whitelistedPairs), validatorPerformances)
 
 	k.rewardWinners(ctx, validatorPerformances)
+	k.recordPerformances(ctx, params.SlashWindow, validatorPerformances)
 
-	params, _ := k.Params.Get(ctx)
 	k.clearVotesAndPrevotes(ctx, params.VotePeriod)
 	k.refreshWhitelist(ctx, params.Whitelist, whitelistedPairs)
 
@@ -79,16 +83,42 @@ func (k Keeper) incrementAbstainsByOmission(
 }
 
 // tallyVotesAndUpdatePrices processes the votes and updates the ExchangeRates based on the results.
+// Each pair is tallied with its own reward band, see types.Params.ForPair.
+//
+// Prices of halted pairs are left as they are. Prices with a previous price
+// go through the circuit breaker; a rejected price restores the previous one,
+// stamped with the current block. Otherwise the previous price would expire
+// while votes keep getting rejected, and the next vote would be set without
+// going through the circuit breaker. The restored price keeps the block of
+// its last accepted vote, so it still goes stale and stops backing derived
+// prices.
 func (k Keeper) tallyVotesAndUpdatePrices(
 	ctx sdk.Context,
+	params types.Params,
 	pairVotes map[asset.Pair]types.ExchangeRateVotes,
 	validatorPerformances types.ValidatorPerformances,
+	previousPrices map[asset.Pair]types.DatedPrice,
 ) {
-	rewardBand := k.RewardBand(ctx)
 	// Iterate through sorted keys for deterministic ordering.
 	orderedPairVotes := omap.OrderedMap_Pair[types.ExchangeRateVotes](pairVotes)
 	for pair := range orderedPairVotes.Range() {
-		exchangeRate := Tally(pairVotes[pair], rewardBand, validatorPerformances)
+		pairParams := params.ForPair(pair)
+		exchangeRate := Tally(pairVotes[pair], *pairParams.RewardBand, validatorPerformances)
+		if k.HaltedPairs.Has(ctx, pair) {
+			continue
+		}
+		if previous, ok := previousPrices[pair]; ok {
+			var accepted bool
+			exchangeRate, accepted = k.applyCircuitBreaker(ctx, pairParams, previous, exchangeRate)
+			if !accepted {
+				k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{
+					ExchangeRate:  previous.ExchangeRate,
+					CreatedBlock:  uint64(ctx.BlockHeight()),
+					AcceptedBlock: previous.LastAcceptedBlock(),
+				})
+				continue
+			}
+		}
 		k.SetPrice(ctx, pair, exchangeRate)
 	}
 }
@@ -107,14 +137,17 @@ func (k Keeper) getPairVotes(
 }
 
 // clearExchangeRates removes all exchange rates from the state
-// We remove the price for pair with expired prices or valid votes
+// We remove the price for pair with expired prices or valid votes. The
+// prices of halted pairs are only removed once they expire.
 func (k Keeper) clearExchangeRates(ctx sdk.Context, pairVotes map[asset.Pair]types.ExchangeRateVotes) {
 	params, _ := k.Params.Get(ctx)
 
 	for _, key := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
 		_, isValid := pairVotes[key]
+		isValid = isValid && !k.HaltedPairs.Has(ctx, key)
 		previousExchangeRate, _ := k.ExchangeRates.Get(ctx, key)
-		isExpired := previousExchangeRate.CreatedBlock+params.ExpirationBlocks <= uint64(ctx.BlockHeight())
+		expirationBlocks := params.ForPair(key).ExpirationBlocks
+		isExpired := previousExchangeRate.CreatedBlock+expirationBlocks <= uint64(ctx.BlockHeight())
 
 		if isValid || isExpired {
 			err := k.ExchangeRates.Delete(ctx, key)
@@ -489,3 +489,76 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.EqualValues(t, 1, perf.AbstainCount)
 	assert.EqualValues(t, 0, perf.MissCount)
 }
+
+func TestPairParamsOverrides(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	btcUsd := asset.Registry.Pair(denoms.BTC, denoms.USD)
+	usdcUsd := asset.Registry.Pair(denoms.USDC, denoms.USD)
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{btcUsd, usdcUsd}
+	wideBand := sdkmath.LegacyNewDecWithPrec(5, 1)
+	params.PairParams = []types.PairParams{
+		{Pair: btcUsd, RewardBand: &wideBand, ExpirationBlocks: 100},
+		{Pair: usdcUsd, MinVoters: 5},
+	}
+	require.NoError(t, params.Validate())
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+	for _, p := range fixture.OracleKeeper.WhitelistedPairs.Iterate(fixture.Ctx, collections.Range[asset.Pair]{}).Keys() {
+		fixture.OracleKeeper.WhitelistedPairs.Delete(fixture.Ctx, p)
+	}
+	fixture.OracleKeeper.WhitelistedPairs.Insert(fixture.Ctx, btcUsd)
+	fixture.OracleKeeper.WhitelistedPairs.Insert(fixture.Ctx, usdcUsd)
+
+	t.Log("votes are tallied with the reward band and min voters of each pair")
+	for i := 0; i < 4; i++ {
+		btcRate := testExchangeRate
+		if i == 0 {
+			// outside of the global reward band, inside of the one of the pair
+			btcRate = testExchangeRate.Mul(sdkmath.LegacyNewDecWithPrec(9, 1))
+		}
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+			{Pair: btcUsd, ExchangeRate: btcRate},
+			{Pair: usdcUsd, ExchangeRate: sdkmath.LegacyOneDec()},
+		}, i)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, btcUsd)
+	require.NoError(t, err)
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, usdcUsd)
+	require.Error(t, err, "4 voters are fewer than the min voters of the pair")
+	require.Equal(t, uint64(0), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[0], 0))
+
+	t.Log("prices expire after the expiration blocks of their pair")
+	fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(1), btcUsd, testExchangeRate)
+	fixture.OracleKeeper.SetPrice(fixture.Ctx.WithBlockHeight(1), usdcUsd, sdkmath.LegacyOneDec())
+	fixture.OracleKeeper.clearExchangeRates(
+		fixture.Ctx.WithBlockHeight(1+100), map[asset.Pair]types.ExchangeRateVotes{},
+	)
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, btcUsd)
+	require.Error(t, err)
+	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, usdcUsd)
+	require.NoError(t, err)
+
+	t.Log("query the params in effect for each pair")
+	res, err := NewQuerier(fixture.OracleKeeper).PairParams(
+		sdk.WrapSDKContext(fixture.Ctx), &types.QueryPairParamsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.PairParams{
+		{
+			Pair: btcUsd, VoteThreshold: &params.VoteThreshold, RewardBand: &wideBand,
+			MinVoters: params.MinVoters, ExpirationBlocks: 100,
+			MaxChangeRatio:       &params.MaxChangeRatio,
+			CircuitBreakerAction: types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+		},
+		{
+			Pair: usdcUsd, VoteThreshold: &params.VoteThreshold, RewardBand: &params.RewardBand,
+			MinVoters: 5, ExpirationBlocks: params.ExpirationBlocks,
+			MaxChangeRatio:       &params.MaxChangeRatio,
+			CircuitBreakerAction: types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP,
+		},
+	}, res.PairParams)
+}
@@ -107,6 +107,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:     slashFraction,
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
+			SnapshotRetention: types.DefaultSnapshotRetention,
 		},
 		[]types.ExchangeRateTuple{
 			{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD), ExchangeRate: math.LegacyNewDec(20_000)},
@@ -117,6 +118,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]types.Rewards{},
+		[]types.PriceSnapshot{},
+		[]asset.Pair{},
+		[]types.WindowPerformance{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
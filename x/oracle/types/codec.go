@@ -13,7 +13,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
+	cdc.RegisterConcrete(&MsgAggregateExchangeRateDirectVote{}, "oracle/MsgAggregateExchangeRateDirectVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgFundOracleRewards{}, "oracle/MsgFundOracleRewards", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "oracle/MsgResetCircuitBreaker", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -22,6 +25,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgAggregateExchangeRateDirectVote{},
+		&MsgFundOracleRewards{},
+		&MsgResetCircuitBreaker{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
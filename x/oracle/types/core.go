@@ -6,3 +6,12 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func IsPeriodLastBlock(ctx sdk.Context, blocksPerPeriod uint64) bool {
 	return ((uint64)(ctx.BlockHeight())+1)%blocksPerPeriod == 0
 }
+
+// LastAcceptedBlock returns the block of the last vote that set the price.
+// Staleness and expiration count from it.
+func (p DatedPrice) LastAcceptedBlock() uint64 {
+	if p.AcceptedBlock == 0 {
+		return p.CreatedBlock
+	}
+	return p.AcceptedBlock
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// NewDerivedPair returns a DerivedPair for "pair". See the DerivedPair docs for
+// the meaning of "via".
+func NewDerivedPair(pair asset.Pair, via string) DerivedPair {
+	return DerivedPair{Pair: pair, Via: via}
+}
+
+// Validate performs basic validation on the derived pair.
+func (p DerivedPair) Validate() error {
+	if err := p.Pair.Validate(); err != nil {
+		return err
+	}
+	if p.Via == "" {
+		return nil
+	}
+	if p.Via == p.Pair.BaseDenom() || p.Via == p.Pair.QuoteDenom() {
+		return fmt.Errorf("via denom of derived pair %s must differ from its base and quote", p.Pair)
+	}
+	for _, leg := range p.Legs() {
+		if _, err := asset.TryNewPair(leg.String()); err != nil {
+			return fmt.Errorf("invalid via denom of derived pair %s: %w", p.Pair, err)
+		}
+	}
+	return nil
+}
+
+// Legs returns the pairs from whose prices the price of the derived pair is
+// computed: the inverse pair "C:A", or the pairs "A:B" and "B:C".
+func (p DerivedPair) Legs() []asset.Pair {
+	if p.Via == "" {
+		return []asset.Pair{p.Pair.Inverse()}
+	}
+	return []asset.Pair{
+		asset.NewPair(p.Pair.BaseDenom(), p.Via),
+		asset.NewPair(p.Via, p.Pair.QuoteDenom()),
+	}
+}
@@ -35,4 +35,10 @@ var (
 	ErrNoAggregateVote        = registerError("no aggregate vote")
 	ErrUnknownPair            = registerError("unknown pair")
 	ErrNoValidTWAP            = registerError("TWA price not found")
+	ErrDirectVoteDisabled     = registerError("direct votes are disabled")
+	ErrExistingVote           = registerError("validator already voted in this vote period")
+	ErrRewardsTooSmall        = registerError("rewards too small to pay out over the vote periods")
+	ErrPairNotHalted          = registerError("pair is not halted by the circuit breaker")
+	ErrRewardsDenomNotAllowed = registerError("denom cannot fund oracle rewards")
+	ErrTooManyRewards         = registerError("too many active oracle rewards entries")
 )
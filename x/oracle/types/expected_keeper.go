@@ -43,13 +43,14 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	// only used for simulation
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 type SudoKeeper interface {
-	// CheckPermissions Checks if a contract is contained within the set of sudo
-	// contracts defined in the x/sudo module. These smart contracts are able to
-	// execute certain permissioned functions.
-	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
+	// CheckMsgPermissions Checks if an address may execute the
+	// sudo-permissioned message with the given type URL, either as a sudoer
+	// or through a permission scope granted in the x/sudo module.
+	CheckMsgPermissions(sender sdk.AccAddress, ctx sdk.Context, msgTypeUrl string) error
 }
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 )
@@ -16,6 +18,9 @@ func NewGenesisState(
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	pairs []asset.Pair,
 	rewards []Rewards,
+	priceSnapshots []PriceSnapshot,
+	haltedPairs []asset.Pair,
+	performanceHistory []WindowPerformance,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -26,6 +31,9 @@ func NewGenesisState(
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		Pairs:                         pairs,
 		Rewards:                       rewards,
+		PriceSnapshots:                priceSnapshots,
+		HaltedPairs:                   haltedPairs,
+		PerformanceHistory:            performanceHistory,
 	}
 }
 
@@ -39,11 +47,44 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
-		[]Rewards{})
+		[]Rewards{},
+		[]PriceSnapshot{},
+		[]asset.Pair{},
+		[]WindowPerformance{})
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
+	for _, snapshot := range data.PriceSnapshots {
+		if err := snapshot.Pair.Validate(); err != nil {
+			return fmt.Errorf("invalid price snapshot pair: %w", err)
+		}
+		if !snapshot.Price.IsPositive() {
+			return fmt.Errorf("price snapshot of %s must be positive, is %s", snapshot.Pair, snapshot.Price)
+		}
+	}
+	for _, pair := range data.HaltedPairs {
+		if err := pair.Validate(); err != nil {
+			return fmt.Errorf("invalid halted pair: %w", err)
+		}
+	}
+	type windowKey struct {
+		validator string
+		window    uint64
+	}
+	seenWindows := make(map[windowKey]struct{})
+	for _, performance := range data.PerformanceHistory {
+		if _, err := sdk.ValAddressFromBech32(performance.Validator); err != nil {
+			return fmt.Errorf("invalid performance history validator: %w", err)
+		}
+		key := windowKey{validator: performance.Validator, window: performance.Window}
+		if _, ok := seenWindows[key]; ok {
+			return fmt.Errorf(
+				"duplicate performance history of validator %s in window %d",
+				performance.Validator, performance.Window)
+		}
+		seenWindows[key] = struct{}{}
+	}
 	return data.Params.Validate()
 }
 
@@ -35,6 +35,12 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	// Price snapshots within the snapshot retention window of the params.
+	PriceSnapshots []PriceSnapshot `protobuf:"bytes,9,rep,name=price_snapshots,json=priceSnapshots,proto3" json:"price_snapshots"`
+	// Pairs halted by the circuit breaker.
+	HaltedPairs []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,10,rep,name=halted_pairs,json=haltedPairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"halted_pairs"`
+	// Performances of the validators in the recent slash windows.
+	PerformanceHistory []WindowPerformance `protobuf:"bytes,11,rep,name=performance_history,json=performanceHistory,proto3" json:"performance_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +125,20 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetPriceSnapshots() []PriceSnapshot {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPerformanceHistory() []WindowPerformance {
+	if m != nil {
+		return m.PerformanceHistory
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +257,47 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x94, 0x74, 0x78, 0x2f, 0xd6, 0x40, 0x36, 0x31, 0x22,
-	0x04, 0x2a, 0xbb, 0xa4, 0x82, 0xd0, 0xcb, 0xa6, 0x5a, 0xbd, 0x51, 0xc3, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0xc9, 0x66, 0x20, 0xbb, 0xb3, 0xcc, 0x99, 0xc4, 0x7a, 0xe1, 0x77, 0xf0, 0x73, 0xf8,
-	0x49, 0x7a, 0x59, 0xbc, 0x12, 0x2f, 0xaa, 0x24, 0x5f, 0x44, 0x76, 0x66, 0xdb, 0xc4, 0x6c, 0xab,
-	0xde, 0x85, 0xf3, 0xfc, 0xce, 0xf3, 0x9c, 0x09, 0x4f, 0x42, 0x9c, 0x84, 0x0f, 0xb8, 0x9c, 0x78,
-	0x42, 0xb2, 0x60, 0x0c, 0xde, 0xb4, 0xe3, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0,
-	0x55, 0xa3, 0xbb, 0x46, 0x77, 0xa7, 0x9d, 0xda, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93,
-	0xe1, 0x6a, 0xf5, 0x82, 0x4f, 0xbe, 0x61, 0x64, 0x27, 0x10, 0x18, 0x0b, 0xf4, 0x06, 0x0c, 0x33,
-	0x71, 0x00, 0x8a, 0x75, 0xbc, 0x40, 0xf0, 0xc4, 0xe8, 0xad, 0xaf, 0x9b, 0x64, 0xf7, 0xa9, 0x09,
-	0x7e, 0xa5, 0x98, 0x02, 0xfa, 0x88, 0x6c, 0xa5, 0x4c, 0xb2, 0x18, 0x6d, 0xab, 0x69, 0xb5, 0xcb,
-	0x87, 0xb6, 0xbb, 0x7a, 0x88, 0xdb, 0xd3, 0x7a, 0x77, 0xe3, 0xfc, 0xb2, 0x51, 0xf2, 0x73, 0x9a,
-	0xbe, 0x25, 0x74, 0x08, 0x10, 0x82, 0xec, 0x87, 0x30, 0x86, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0xd7,
-	0x9a, 0xeb, 0xed, 0xf2, 0x61, 0xab, 0xe8, 0x71, 0xaa, 0xd9, 0xc7, 0xd7, 0x68, 0xee, 0xb6, 0x3f,
-	0x5c, 0x99, 0x23, 0x1d, 0x92, 0x3d, 0x38, 0x0b, 0x46, 0x2c, 0x89, 0xa0, 0x2f, 0x99, 0x02, 0xb4,
-	0xd7, 0xb5, 0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0x93, 0x74, 0x0c, 0xdd,
-	0x5a, 0xe6, 0xfa, 0xe5, 0x47, 0x83, 0x16, 0x24, 0xf4, 0x2b, 0xb0, 0x34, 0x43, 0xfa, 0x8c, 0x54,
-	0x62, 0x8e, 0xd8, 0x0f, 0xc4, 0x24, 0x51, 0x20, 0xd1, 0xde, 0xd0, 0x31, 0xf5, 0x62, 0xcc, 0x73,
-	0x8e, 0x78, 0x62, 0xa8, 0xfc, 0xec, 0xdd, 0x78, 0x31, 0x42, 0xfa, 0x89, 0x34, 0x59, 0x14, 0xc9,
-	0xec, 0x05, 0xd0, 0xff, 0xed, 0xf6, 0x7e, 0x2a, 0x61, 0x2a, 0xb2, 0x37, 0x6c, 0x6a, 0x73, 0xb7,
-	0x68, 0x7e, 0x7c, 0xb5, 0xb9, 0x7c, 0x71, 0xcf, 0xac, 0xe5, 0x69, 0x75, 0xf6, 0x07, 0x06, 0xa9,
-	0x22, 0xf5, 0xdb, 0xe2, 0x4d, 0xf6, 0x96, 0xce, 0x3e, 0xf8, 0xc7, 0xec, 0x37, 0x8b, 0xe0, 0x1a,
-	0xbb, 0x0d, 0x40, 0xfa, 0x92, 0x6c, 0xa6, 0x8c, 0x4b, 0xb4, 0xb7, 0x9b, 0xeb, 0xed, 0x9d, 0xee,
-	0x51, 0xb6, 0xf0, 0xfd, 0xb2, 0xd1, 0x89, 0xb8, 0x1a, 0x4d, 0x06, 0x6e, 0x20, 0x62, 0xef, 0x85,
-	0xce, 0x3b, 0x19, 0x31, 0x9e, 0x78, 0x79, 0x6b, 0xcf, 0xbc, 0x40, 0xc4, 0xb1, 0x48, 0x3c, 0x86,
-	0x08, 0xca, 0xed, 0x31, 0x2e, 0x7d, 0xe3, 0x43, 0x8f, 0xc8, 0xb6, 0x84, 0x0f, 0x4c, 0x86, 0x68,
-	0xff, 0xa7, 0x0f, 0xbe, 0x53, 0x3c, 0xd8, 0x37, 0x40, 0x7e, 0xde, 0x15, 0xdf, 0x1a, 0x92, 0xea,
-	0x6a, 0xbf, 0xe8, 0x7d, 0xb2, 0x97, 0xf7, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xfd, 0xde, 0xf1, 0x2b,
-	0x66, 0x7a, 0x6c, 0x86, 0xf4, 0x80, 0xec, 0x4f, 0xd9, 0x98, 0x87, 0x4c, 0x89, 0x05, 0xb9, 0xa6,
-	0xc9, 0xea, 0xb5, 0x90, 0xc3, 0xad, 0xf7, 0xa4, 0xbc, 0xd4, 0x85, 0x9b, 0x77, 0xad, 0x9b, 0x77,
-	0xe9, 0x5d, 0xb2, 0xbb, 0x5c, 0x37, 0x9d, 0xb1, 0xe1, 0x97, 0x97, 0x8a, 0xd4, 0x3d, 0x3d, 0x9f,
-	0x39, 0xd6, 0xc5, 0xcc, 0xb1, 0x7e, 0xce, 0x1c, 0xeb, 0xf3, 0xdc, 0x29, 0x5d, 0xcc, 0x9d, 0xd2,
-	0xb7, 0xb9, 0x53, 0x7a, 0xf7, 0xe0, 0x6f, 0xdf, 0x6a, 0xfe, 0x6f, 0xa0, 0x3e, 0xa6, 0x80, 0x83,
-	0x2d, 0xfd, 0x53, 0x7f, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x56, 0x94, 0xe7, 0x7b, 0x73, 0x04,
-	0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6a, 0x13, 0x4f,
+	0x14, 0x4f, 0xfa, 0xf9, 0xef, 0x24, 0xed, 0xbf, 0x1d, 0xbd, 0x58, 0x83, 0xd9, 0xc4, 0x88, 0x50,
+	0xa8, 0xec, 0x92, 0x0a, 0x42, 0x2f, 0x9b, 0x6a, 0xed, 0x8d, 0x35, 0x6c, 0xc5, 0x42, 0x51, 0x96,
+	0xc9, 0xee, 0x64, 0x33, 0x90, 0xdd, 0x59, 0xe6, 0x4c, 0xd2, 0xf6, 0xc2, 0x77, 0xf0, 0x39, 0x7c,
+	0x92, 0x5e, 0xf6, 0x52, 0x44, 0xaa, 0xb4, 0x2f, 0x22, 0x3b, 0x33, 0x6d, 0xd6, 0x6e, 0xa3, 0x82,
+	0x77, 0xe1, 0xfc, 0x3e, 0x4f, 0x98, 0xb3, 0xc8, 0x4e, 0x58, 0x8f, 0x89, 0x91, 0xcb, 0x05, 0x09,
+	0x86, 0xd4, 0x1d, 0xb7, 0xdd, 0x88, 0x26, 0x14, 0x18, 0x38, 0xa9, 0xe0, 0x92, 0xe3, 0x55, 0x8d,
+	0x3b, 0x1a, 0x77, 0xc6, 0xed, 0xda, 0xfd, 0x88, 0x47, 0x5c, 0x81, 0x6e, 0xf6, 0x4b, 0xf3, 0x6a,
+	0xf5, 0x82, 0x8f, 0x51, 0x68, 0xf8, 0x61, 0x01, 0x06, 0x49, 0xe4, 0x35, 0x6a, 0x07, 0x1c, 0x62,
+	0x0e, 0x6e, 0x8f, 0x40, 0x86, 0xf5, 0xa8, 0x24, 0x6d, 0x37, 0xe0, 0x2c, 0xd1, 0x78, 0xeb, 0xdb,
+	0x22, 0xaa, 0xbe, 0xd2, 0xb5, 0x0e, 0x32, 0x19, 0x7e, 0x8e, 0x16, 0x52, 0x22, 0x48, 0x0c, 0x56,
+	0xb9, 0x59, 0x5e, 0xaf, 0x6c, 0x5a, 0xce, 0xed, 0x9a, 0x4e, 0x57, 0xe1, 0x9d, 0xb9, 0xb3, 0x8b,
+	0x46, 0xc9, 0x33, 0x6c, 0x7c, 0x88, 0x70, 0x9f, 0xd2, 0x90, 0x0a, 0x3f, 0xa4, 0x43, 0x1a, 0x11,
+	0xc9, 0x78, 0x02, 0xd6, 0x4c, 0x73, 0x76, 0xbd, 0xb2, 0xd9, 0x2a, 0x7a, 0xec, 0x2a, 0xee, 0x8b,
+	0x1b, 0xaa, 0x71, 0x5b, 0xeb, 0xdf, 0x9a, 0x03, 0xee, 0xa3, 0x15, 0x7a, 0x12, 0x0c, 0x48, 0x12,
+	0x51, 0x5f, 0x10, 0x49, 0xc1, 0x9a, 0x55, 0xa6, 0x8f, 0x8b, 0xa6, 0x2f, 0x0d, 0xcf, 0x23, 0x92,
+	0xbe, 0x1d, 0xa5, 0x43, 0xda, 0xa9, 0x65, 0xae, 0x9f, 0xbf, 0x37, 0x70, 0x01, 0x02, 0x6f, 0x99,
+	0xe6, 0x66, 0x80, 0xf7, 0xd0, 0x72, 0xcc, 0x00, 0xfc, 0x80, 0x8f, 0x12, 0x49, 0x05, 0x58, 0x73,
+	0x2a, 0xa6, 0x5e, 0x8c, 0x79, 0xcd, 0x00, 0x76, 0x34, 0xcb, 0xd4, 0xae, 0xc6, 0x93, 0x11, 0xe0,
+	0x8f, 0xa8, 0x49, 0xa2, 0x48, 0x64, 0x1b, 0x50, 0xff, 0x97, 0xee, 0x7e, 0x2a, 0xe8, 0x98, 0x67,
+	0x3b, 0xcc, 0x2b, 0x73, 0xa7, 0x68, 0xbe, 0x7d, 0xad, 0xcc, 0x37, 0xee, 0x6a, 0x99, 0x49, 0xab,
+	0x93, 0xdf, 0x70, 0x00, 0x4b, 0x54, 0x9f, 0x16, 0xaf, 0xb3, 0x17, 0x54, 0xf6, 0xc6, 0x5f, 0x66,
+	0xbf, 0x9b, 0x04, 0xd7, 0xc8, 0x34, 0x02, 0xe0, 0x37, 0x68, 0x3e, 0x25, 0x4c, 0x80, 0xb5, 0xd8,
+	0x9c, 0x5d, 0x5f, 0xea, 0x6c, 0x65, 0x82, 0xaf, 0x17, 0x8d, 0x76, 0xc4, 0xe4, 0x60, 0xd4, 0x73,
+	0x02, 0x1e, 0xbb, 0xfb, 0x2a, 0x6f, 0x67, 0x40, 0x58, 0xe2, 0x9a, 0x47, 0x7b, 0xe2, 0x06, 0x3c,
+	0x8e, 0x79, 0xe2, 0x12, 0x00, 0x2a, 0x9d, 0x2e, 0x61, 0xc2, 0xd3, 0x3e, 0x78, 0x0b, 0x2d, 0x0a,
+	0x7a, 0x4c, 0x44, 0x08, 0xd6, 0x7f, 0xaa, 0xf0, 0x83, 0x62, 0x61, 0x4f, 0x13, 0x4c, 0xbd, 0x6b,
+	0x3e, 0xde, 0x47, 0xff, 0xa7, 0x82, 0x05, 0xd4, 0x87, 0x84, 0xa4, 0x30, 0xe0, 0x12, 0xac, 0x25,
+	0x65, 0xd1, 0xb8, 0xe3, 0x31, 0x67, 0xc4, 0x03, 0xc3, 0x33, 0x46, 0x2b, 0x69, 0x7e, 0x08, 0xf8,
+	0x3d, 0xaa, 0x0e, 0xc8, 0x50, 0xd2, 0xd0, 0xd7, 0x2b, 0xa2, 0x7f, 0x5d, 0xb1, 0xa2, 0xed, 0xba,
+	0x6a, 0xd1, 0x23, 0x74, 0x2f, 0xa5, 0xa2, 0xcf, 0x45, 0x4c, 0x92, 0x80, 0xfa, 0x03, 0x06, 0x92,
+	0x8b, 0x53, 0xab, 0x32, 0xed, 0x95, 0x1f, 0xb2, 0x24, 0xe4, 0xc7, 0xdd, 0x89, 0xc4, 0xb4, 0xc6,
+	0x39, 0x97, 0x3d, 0x6d, 0xd2, 0xea, 0xa3, 0xd5, 0xdb, 0x97, 0x86, 0x9f, 0xa0, 0x15, 0x73, 0xa9,
+	0x24, 0x0c, 0x05, 0x05, 0x7d, 0xe9, 0x4b, 0xde, 0xb2, 0x9e, 0x6e, 0xeb, 0x21, 0xde, 0x40, 0x6b,
+	0x63, 0x32, 0x64, 0x21, 0x91, 0x7c, 0xc2, 0x9c, 0x51, 0xcc, 0xd5, 0x1b, 0xc0, 0x90, 0x5b, 0x1f,
+	0x50, 0x25, 0x77, 0x15, 0x77, 0x6b, 0xcb, 0x77, 0x6b, 0xf1, 0x23, 0x54, 0xcd, 0x1f, 0x9e, 0xca,
+	0x98, 0xf3, 0x2a, 0xb9, 0x93, 0xea, 0xec, 0x9e, 0x5d, 0xda, 0xe5, 0xf3, 0x4b, 0xbb, 0xfc, 0xe3,
+	0xd2, 0x2e, 0x7f, 0xba, 0xb2, 0x4b, 0xe7, 0x57, 0x76, 0xe9, 0xcb, 0x95, 0x5d, 0x3a, 0x7a, 0xfa,
+	0xa7, 0x3f, 0xdf, 0x7c, 0x16, 0xe5, 0x69, 0x4a, 0xa1, 0xb7, 0xa0, 0x3e, 0x7a, 0xcf, 0x7e, 0x0e,
+	0x00, 0x39, 0x7b, 0x12, 0xda, 0x9b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +320,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PerformanceHistory) > 0 {
+		for iNdEx := len(m.PerformanceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerformanceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.HaltedPairs) > 0 {
+		for iNdEx := len(m.HaltedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.HaltedPairs[iNdEx].Size()
+				i -= size
+				if _, err := m.HaltedPairs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +606,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedPairs) > 0 {
+		for _, e := range m.HaltedPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PerformanceHistory) > 0 {
+		for _, e := range m.PerformanceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +968,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.HaltedPairs = append(m.HaltedPairs, v)
+			if err := m.HaltedPairs[len(m.HaltedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerformanceHistory = append(m.PerformanceHistory, WindowPerformance{})
+			if err := m.PerformanceHistory[len(m.PerformanceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
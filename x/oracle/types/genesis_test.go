@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/app"
@@ -18,6 +19,23 @@ func TestGenesisValidation(t *testing.T) {
 	require.Error(t, types.ValidateGenesis(genState))
 }
 
+func TestGenesisValidation_PerformanceHistory(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator")).String()
+
+	genState := types.DefaultGenesisState()
+	genState.PerformanceHistory = []types.WindowPerformance{
+		{Validator: valAddr, Window: 1},
+		{Validator: valAddr, Window: 2},
+	}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.PerformanceHistory[1].Window = 1
+	require.ErrorContains(t, types.ValidateGenesis(genState), "duplicate performance history")
+
+	genState.PerformanceHistory[1].Validator = "invalid"
+	require.ErrorContains(t, types.ValidateGenesis(genState), "invalid performance history validator")
+}
+
 func TestGetGenesisStateFromAppState(t *testing.T) {
 	cdc := app.MakeEncodingConfig().Codec
 	appState := make(map[string]json.RawMessage)
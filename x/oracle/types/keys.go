@@ -7,9 +7,17 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the key of the transient store of the oracle module
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the msg router key for the oracle module
 	RouterKey = ModuleName
 
 	// QuerierRoute is the query router key for the oracle module
 	QuerierRoute = ModuleName
+
+	// FeePoolModuleAccount is the module account that collects the
+	// "ValidatorFeeRatio" share of the transaction fees until they are turned
+	// into oracle rewards at the end of the day epoch.
+	FeePoolModuleAccount = "oracle_fee_pool"
 )
@@ -13,15 +13,21 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAggregateExchangeRateDirectVote{}
 	_ sdk.Msg = &MsgEditOracleParams{}
+	_ sdk.Msg = &MsgFundOracleRewards{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
 )
 
 // oracle message types
 const (
-	TypeMsgDelegateFeedConsent          = "delegate_feeder"
-	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
-	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
-	TypeMsgEditOracleParams             = "edit_oracle_params"
+	TypeMsgDelegateFeedConsent             = "delegate_feeder"
+	TypeMsgAggregateExchangeRatePrevote    = "aggregate_exchange_rate_prevote"
+	TypeMsgAggregateExchangeRateVote       = "aggregate_exchange_rate_vote"
+	TypeMsgAggregateExchangeRateDirectVote = "aggregate_exchange_rate_direct_vote"
+	TypeMsgEditOracleParams                = "edit_oracle_params"
+	TypeMsgFundOracleRewards               = "fund_oracle_rewards"
+	TypeMsgResetCircuitBreaker             = "reset_circuit_breaker"
 )
 
 //-------------------------------------------------
@@ -126,13 +132,27 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid operator address (%s)", err)
 	}
 
-	if l := len(msg.ExchangeRates); l == 0 {
+	if err := validateExchangeRatesStr(msg.ExchangeRates); err != nil {
+		return err
+	}
+
+	if len(msg.Salt) > 4 || len(msg.Salt) < 1 {
+		return sdkerrors.Wrap(ErrInvalidSaltLength, "salt length must be [1, 4]")
+	}
+
+	return nil
+}
+
+// validateExchangeRatesStr: Stateless validation of the exchange rates string
+// of an aggregate vote.
+func validateExchangeRatesStr(exchangeRatesStr string) error {
+	if l := len(exchangeRatesStr); l == 0 {
 		return sdkerrors.Wrap(errors.ErrUnknownRequest, "must provide at least one oracle exchange rate")
 	} else if l > 4096 {
 		return sdkerrors.Wrap(errors.ErrInvalidRequest, "exchange rates string can not exceed 4096 characters")
 	}
 
-	exchangeRates, err := ParseExchangeRateTuples(msg.ExchangeRates)
+	exchangeRates, err := ParseExchangeRateTuples(exchangeRatesStr)
 	if err != nil {
 		return sdkerrors.Wrap(errors.ErrInvalidCoins, "failed to parse exchange rates string cause: "+err.Error())
 	}
@@ -143,12 +163,59 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 			return sdkerrors.Wrap(ErrInvalidExchangeRate, "overflow")
 		}
 	}
+	return nil
+}
 
-	if len(msg.Salt) > 4 || len(msg.Salt) < 1 {
-		return sdkerrors.Wrap(ErrInvalidSaltLength, "salt length must be [1, 4]")
+// ------------------------ MsgAggregateExchangeRateDirectVote ------------------------
+
+// NewMsgAggregateExchangeRateDirectVote returns a
+// MsgAggregateExchangeRateDirectVote instance
+func NewMsgAggregateExchangeRateDirectVote(
+	exchangeRates string, feeder sdk.AccAddress, validator sdk.ValAddress,
+) *MsgAggregateExchangeRateDirectVote {
+	return &MsgAggregateExchangeRateDirectVote{
+		ExchangeRates: exchangeRates,
+		Feeder:        feeder.String(),
+		Validator:     validator.String(),
 	}
+}
 
-	return nil
+// Route implements sdk.Msg
+func (msg MsgAggregateExchangeRateDirectVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregateExchangeRateDirectVote) Type() string {
+	return TypeMsgAggregateExchangeRateDirectVote
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregateExchangeRateDirectVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregateExchangeRateDirectVote) GetSigners() []sdk.AccAddress {
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{feeder}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAggregateExchangeRateDirectVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid feeder address (%s)", err)
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	return validateExchangeRatesStr(msg.ExchangeRates)
 }
 
 // ------------------------ MsgDelegateFeedConsent ------------------------
@@ -220,3 +287,68 @@ func (m MsgEditOracleParams) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ------------------------ MsgFundOracleRewards ------------------------
+
+// NewMsgFundOracleRewards creates a MsgFundOracleRewards instance
+func NewMsgFundOracleRewards(
+	sender sdk.AccAddress, rewards sdk.Coins, votePeriods uint64,
+) *MsgFundOracleRewards {
+	return &MsgFundOracleRewards{
+		Sender:      sender.String(),
+		Rewards:     rewards,
+		VotePeriods: votePeriods,
+	}
+}
+
+func (m MsgFundOracleRewards) Route() string { return RouterKey }
+func (m MsgFundOracleRewards) Type() string  { return TypeMsgFundOracleRewards }
+
+func (m MsgFundOracleRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if !m.Rewards.IsValid() || m.Rewards.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "rewards must be positive: %s", m.Rewards)
+	}
+	if m.VotePeriods == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalidRequest, "vote periods must be positive")
+	}
+	return nil
+}
+
+func (m MsgFundOracleRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFundOracleRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ------------------------ MsgResetCircuitBreaker ------------------------
+
+func (m MsgResetCircuitBreaker) Route() string { return RouterKey }
+func (m MsgResetCircuitBreaker) Type() string  { return TypeMsgResetCircuitBreaker }
+
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return m.Pair.Validate()
+}
+
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
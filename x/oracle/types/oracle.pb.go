@@ -30,6 +30,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CircuitBreakerAction is what happens to a voted price that moved by more
+// than the "max_change_ratio" from the previous price of its pair.
+type CircuitBreakerAction int32
+
+const (
+	// Falls back to the global param, or to clamping for the global param.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED CircuitBreakerAction = 0
+	// The price is clamped to the edge of the allowed band.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP CircuitBreakerAction = 1
+	// The price is not updated this vote period.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_REJECT CircuitBreakerAction = 2
+	// The price is not updated, and the pair stays halted until it is reset
+	// with MsgResetCircuitBreaker.
+	CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT CircuitBreakerAction = 3
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_ACTION_UNSPECIFIED",
+	1: "CIRCUIT_BREAKER_ACTION_CLAMP",
+	2: "CIRCUIT_BREAKER_ACTION_REJECT",
+	3: "CIRCUIT_BREAKER_ACTION_HALT",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"CIRCUIT_BREAKER_ACTION_UNSPECIFIED": 0,
+	"CIRCUIT_BREAKER_ACTION_CLAMP":       1,
+	"CIRCUIT_BREAKER_ACTION_REJECT":      2,
+	"CIRCUIT_BREAKER_ACTION_HALT":        3,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -68,6 +106,37 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                                 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// DirectVoteEnabled allows validators to submit their exchange rates with a
+	// single "MsgAggregateExchangeRateDirectVote" instead of the commit-reveal
+	// scheme of a prevote followed by a vote in the next vote period. Direct
+	// votes are placed at the top of each block proposal.
+	DirectVoteEnabled bool `protobuf:"varint,12,opt,name=direct_vote_enabled,json=directVoteEnabled,proto3" json:"direct_vote_enabled,omitempty" yaml:"direct_vote_enabled"`
+	// DerivedPairs are pairs whose prices are computed from the prices of other
+	// pairs at the end of each vote period instead of being voted on.
+	DerivedPairs []DerivedPair `protobuf:"bytes,13,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs" yaml:"derived_pairs"`
+	// Amount of time for which price snapshots are kept. Older snapshots are
+	// pruned at the end of each vote period. Must be at least the
+	// TwapLookbackWindow, or zero to keep every snapshot.
+	SnapshotRetention time.Duration `protobuf:"bytes,14,opt,name=snapshot_retention,json=snapshotRetention,proto3,stdduration" json:"snapshot_retention,omitempty" yaml:"snapshot_retention"`
+	// Overrides of the ballot params for individual pairs. Params that are not
+	// set in an override fall back to the global value.
+	PairParams []PairParams `protobuf:"bytes,15,rep,name=pair_params,json=pairParams,proto3" json:"pair_params" yaml:"pair_params"`
+	// Largest relative change of a voted price from its previous price in one
+	// vote period. Medians outside of this band trip the circuit breaker. Zero
+	// disables the circuit breaker.
+	MaxChangeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_change_ratio,json=maxChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_ratio" yaml:"max_change_ratio"`
+	// What happens to a price that trips the circuit breaker. Unspecified means
+	// clamp.
+	CircuitBreakerAction CircuitBreakerAction `protobuf:"varint,17,opt,name=circuit_breaker_action,json=circuitBreakerAction,proto3,enum=nibiru.oracle.v1.CircuitBreakerAction" json:"circuit_breaker_action,omitempty" yaml:"circuit_breaker_action"`
+	// Smallest amount of each coin that "MsgFundOracleRewards" can pay out per
+	// vote period. Only the denoms listed here can fund rewards.
+	MinFundRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=min_fund_rewards,json=minFundRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fund_rewards" yaml:"min_fund_rewards"`
+	// Largest number of rewards entries that can be active for
+	// "MsgFundOracleRewards" to add another one.
+	MaxRewardsEntries uint64 `protobuf:"varint,19,opt,name=max_rewards_entries,json=maxRewardsEntries,proto3" json:"max_rewards_entries,omitempty" yaml:"max_rewards_entries"`
+	// Number of vote periods over which the fees collected in a day epoch are
+	// paid out to the oracle voters.
+	FeeRewardsVotePeriods uint64 `protobuf:"varint,20,opt,name=fee_rewards_vote_periods,json=feeRewardsVotePeriods,proto3" json:"fee_rewards_vote_periods,omitempty" yaml:"fee_rewards_vote_periods"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +207,188 @@ func (m *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetDirectVoteEnabled() bool {
+	if m != nil {
+		return m.DirectVoteEnabled
+	}
+	return false
+}
+
+func (m *Params) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
+func (m *Params) GetSnapshotRetention() time.Duration {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetPairParams() []PairParams {
+	if m != nil {
+		return m.PairParams
+	}
+	return nil
+}
+
+func (m *Params) GetCircuitBreakerAction() CircuitBreakerAction {
+	if m != nil {
+		return m.CircuitBreakerAction
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED
+}
+
+func (m *Params) GetMinFundRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFundRewards
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRewardsEntries() uint64 {
+	if m != nil {
+		return m.MaxRewardsEntries
+	}
+	return 0
+}
+
+func (m *Params) GetFeeRewardsVotePeriods() uint64 {
+	if m != nil {
+		return m.FeeRewardsVotePeriods
+	}
+	return 0
+}
+
+// DerivedPair defines how the price of a pair "A:C" that validators don't vote
+// on is computed from the prices of other pairs.
+//
+// If "via" is empty, the price is the inverse of the price of "C:A".
+// Otherwise, the price is the product of the prices of "A:B" and "B:C", where
+// "B" is the "via" denom. The price of either leg may come from its inverse
+// pair.
+type DerivedPair struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Via  string                                            `protobuf:"bytes,2,opt,name=via,proto3" json:"via,omitempty" yaml:"via"`
+}
+
+func (m *DerivedPair) Reset()         { *m = DerivedPair{} }
+func (m *DerivedPair) String() string { return proto.CompactTextString(m) }
+func (*DerivedPair) ProtoMessage()    {}
+func (*DerivedPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{1}
+}
+func (m *DerivedPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedPair.Merge(m, src)
+}
+func (m *DerivedPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedPair proto.InternalMessageInfo
+
+func (m *DerivedPair) GetVia() string {
+	if m != nil {
+		return m.Via
+	}
+	return ""
+}
+
+// PairParams overrides the ballot params of the oracle for a single pair.
+// Unset fields fall back to the global value of the param.
+type PairParams struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Minimum share of the bonded voting power that must vote on the pair for
+	// its ballot to pass.
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold"`
+	// Maximum divergence of a rewarded vote from the weighted median.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band"`
+	// Minimum number of voters for the ballot to pass. Zero means unset.
+	MinVoters uint64 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
+	// Number of blocks after which the price of the pair expires. Zero means
+	// unset.
+	ExpirationBlocks uint64 `protobuf:"varint,5,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// Largest relative change of the price in one vote period.
+	MaxChangeRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_change_ratio,json=maxChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_ratio,omitempty" yaml:"max_change_ratio"`
+	// What happens to a price that trips the circuit breaker.
+	CircuitBreakerAction CircuitBreakerAction `protobuf:"varint,7,opt,name=circuit_breaker_action,json=circuitBreakerAction,proto3,enum=nibiru.oracle.v1.CircuitBreakerAction" json:"circuit_breaker_action,omitempty" yaml:"circuit_breaker_action"`
+}
+
+func (m *PairParams) Reset()         { *m = PairParams{} }
+func (m *PairParams) String() string { return proto.CompactTextString(m) }
+func (*PairParams) ProtoMessage()    {}
+func (*PairParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{2}
+}
+func (m *PairParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairParams.Merge(m, src)
+}
+func (m *PairParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairParams proto.InternalMessageInfo
+
+func (m *PairParams) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
+	}
+	return 0
+}
+
+func (m *PairParams) GetExpirationBlocks() uint64 {
+	if m != nil {
+		return m.ExpirationBlocks
+	}
+	return 0
+}
+
+func (m *PairParams) GetCircuitBreakerAction() CircuitBreakerAction {
+	if m != nil {
+		return m.CircuitBreakerAction
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -152,7 +403,7 @@ func (m *AggregateExchangeRatePrevote) Reset()         { *m = AggregateExchangeR
 func (m *AggregateExchangeRatePrevote) String() string { return proto.CompactTextString(m) }
 func (*AggregateExchangeRatePrevote) ProtoMessage()    {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{1}
+	return fileDescriptor_43d45df86ea09ed4, []int{3}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +443,7 @@ func (m *AggregateExchangeRateVote) Reset()         { *m = AggregateExchangeRate
 func (m *AggregateExchangeRateVote) String() string { return proto.CompactTextString(m) }
 func (*AggregateExchangeRateVote) ProtoMessage()    {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{2}
+	return fileDescriptor_43d45df86ea09ed4, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +482,7 @@ func (m *ExchangeRateTuple) Reset()         { *m = ExchangeRateTuple{} }
 func (m *ExchangeRateTuple) String() string { return proto.CompactTextString(m) }
 func (*ExchangeRateTuple) ProtoMessage()    {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{3}
+	return fileDescriptor_43d45df86ea09ed4, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,13 +514,19 @@ var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 type DatedPrice struct {
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
 	CreatedBlock uint64                                 `protobuf:"varint,2,opt,name=created_block,json=createdBlock,proto3" json:"created_block,omitempty" yaml:"created_block"`
+	// Block of the last vote that set the exchange rate, if it differs from
+	// "created_block". The circuit breaker keeps the previous exchange rate
+	// when it rejects a vote, stamped with the current block so that the next
+	// vote is still checked against it. The price is stale and expires
+	// relative to this block instead. Zero means "created_block".
+	AcceptedBlock uint64 `protobuf:"varint,3,opt,name=accepted_block,json=acceptedBlock,proto3" json:"accepted_block,omitempty" yaml:"accepted_block"`
 }
 
 func (m *DatedPrice) Reset()         { *m = DatedPrice{} }
 func (m *DatedPrice) String() string { return proto.CompactTextString(m) }
 func (*DatedPrice) ProtoMessage()    {}
 func (*DatedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{4}
+	return fileDescriptor_43d45df86ea09ed4, []int{6}
 }
 func (m *DatedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,6 +562,13 @@ func (m *DatedPrice) GetCreatedBlock() uint64 {
 	return 0
 }
 
+func (m *DatedPrice) GetAcceptedBlock() uint64 {
+	if m != nil {
+		return m.AcceptedBlock
+	}
+	return 0
+}
+
 // Rewards defines a credit object towards validators
 // which provide prices faithfully for different pairs.
 type Rewards struct {
@@ -321,7 +585,7 @@ func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{5}
+	return fileDescriptor_43d45df86ea09ed4, []int{7}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +636,10 @@ func (m *Rewards) GetCoins() []types.Coin {
 }
 
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*DerivedPair)(nil), "nibiru.oracle.v1.DerivedPair")
+	proto.RegisterType((*PairParams)(nil), "nibiru.oracle.v1.PairParams")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
@@ -383,68 +650,101 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/oracle.proto", fileDescriptor_43d45df86ea09ed4) }
 
 var fileDescriptor_43d45df86ea09ed4 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0x26, 0x4e, 0x1a, 0x8f, 0x93, 0xfe, 0x92, 0x69, 0xfa, 0x63, 0x13, 0x8a, 0x37, 0x4c,
-	0xa5, 0xca, 0x87, 0xb2, 0xab, 0x14, 0x10, 0x22, 0x12, 0x07, 0xb6, 0x21, 0x10, 0xa9, 0x20, 0x6b,
-	0x54, 0x81, 0x84, 0x90, 0xac, 0xd9, 0xdd, 0xc9, 0x7a, 0xe4, 0xdd, 0x1d, 0x6b, 0x66, 0x1d, 0x27,
-	0x12, 0xe2, 0xcc, 0xb1, 0x27, 0xc4, 0x31, 0x67, 0x6e, 0xdc, 0xf8, 0x08, 0x95, 0xb8, 0xf4, 0x88,
-	0x7a, 0xd8, 0x56, 0xc9, 0x25, 0xe2, 0xe8, 0x4f, 0x80, 0x66, 0x76, 0x1c, 0x6f, 0xb0, 0x25, 0x08,
-	0x88, 0x93, 0xf7, 0x7d, 0x9f, 0x99, 0xe7, 0x7d, 0xde, 0x3f, 0x33, 0x63, 0xf0, 0x56, 0xc6, 0x02,
-	0x26, 0x86, 0x1e, 0x17, 0x24, 0x4c, 0xa8, 0x77, 0xbc, 0x6b, 0xbe, 0xdc, 0x81, 0xe0, 0x39, 0x87,
-	0xeb, 0x25, 0xec, 0x1a, 0xe7, 0xf1, 0xee, 0xf6, 0x66, 0xcc, 0x63, 0xae, 0x41, 0x4f, 0x7d, 0x95,
-	0xeb, 0xb6, 0x5b, 0x31, 0xe7, 0x71, 0x42, 0x3d, 0x6d, 0x05, 0xc3, 0x23, 0x2f, 0x1a, 0x0a, 0x92,
-	0x33, 0x9e, 0x4d, 0xf0, 0x90, 0xcb, 0x94, 0x4b, 0x2f, 0x20, 0x52, 0x05, 0x09, 0x68, 0x4e, 0x76,
-	0xbd, 0x90, 0x33, 0x83, 0xa3, 0x5f, 0x57, 0xc0, 0x72, 0x87, 0x08, 0x92, 0x4a, 0xf8, 0x01, 0x68,
-	0x1e, 0xf3, 0x9c, 0x76, 0x07, 0x54, 0x30, 0x1e, 0xd9, 0xd6, 0x8e, 0xd5, 0xae, 0xfb, 0xff, 0x1f,
-	0x17, 0x0e, 0x3c, 0x25, 0x69, 0xb2, 0x87, 0x2a, 0x20, 0xc2, 0x40, 0x59, 0x1d, 0x6d, 0xc0, 0x0c,
-	0xdc, 0xd6, 0x58, 0xde, 0x13, 0x54, 0xf6, 0x78, 0x12, 0xd9, 0x0b, 0x3b, 0x56, 0xbb, 0xe1, 0x7f,
-	0xfa, 0xbc, 0x70, 0x6a, 0x2f, 0x0b, 0xe7, 0x41, 0xcc, 0xf2, 0xde, 0x30, 0x70, 0x43, 0x9e, 0x7a,
-	0x46, 0x4e, 0xf9, 0xf3, 0x8e, 0x8c, 0xfa, 0x5e, 0x7e, 0x3a, 0xa0, 0xd2, 0xdd, 0xa7, 0xe1, 0xb8,
-	0x70, 0xee, 0x56, 0x22, 0x5d, 0xb1, 0x21, 0xbc, 0xa6, 0x1c, 0x4f, 0x27, 0x36, 0xa4, 0xa0, 0x29,
-	0xe8, 0x88, 0x88, 0xa8, 0x1b, 0x90, 0x2c, 0xb2, 0x17, 0x75, 0xb0, 0xfd, 0x1b, 0x07, 0x33, 0x69,
-	0x55, 0xa8, 0x10, 0x06, 0xa5, 0xe5, 0x93, 0x2c, 0x82, 0x31, 0x68, 0x8c, 0x7a, 0x2c, 0xa7, 0x09,
-	0x93, 0xb9, 0x5d, 0xdf, 0x59, 0x6c, 0x37, 0xfc, 0xc3, 0x97, 0x85, 0xb3, 0x5b, 0x09, 0xf0, 0x85,
-	0x6e, 0xd2, 0xe3, 0x1e, 0x61, 0x99, 0x67, 0xfa, 0x79, 0xe2, 0x85, 0x3c, 0x4d, 0x79, 0xe6, 0x11,
-	0x29, 0x69, 0xee, 0x76, 0x08, 0x13, 0xe3, 0xc2, 0x59, 0x2f, 0x63, 0x5d, 0xf1, 0x21, 0x3c, 0xe5,
-	0x56, 0xf5, 0x93, 0x09, 0x91, 0xbd, 0xee, 0x91, 0x20, 0xa1, 0xea, 0x9d, 0xbd, 0xf4, 0xef, 0xea,
-	0x77, 0x9d, 0x0d, 0xe1, 0x35, 0xed, 0x38, 0x30, 0x36, 0xdc, 0x03, 0xab, 0xe5, 0x8a, 0x11, 0xcb,
-	0x22, 0x3e, 0xb2, 0x97, 0x75, 0xa7, 0xdf, 0x18, 0x17, 0xce, 0x9d, 0xea, 0xfe, 0x12, 0x45, 0xb8,
-	0xa9, 0xcd, 0xaf, 0xb4, 0x05, 0xbf, 0x03, 0x9b, 0x29, 0xcb, 0xba, 0xc7, 0x24, 0x61, 0x91, 0x1a,
-	0x86, 0x09, 0xc7, 0x2d, 0xad, 0xf8, 0xf3, 0x1b, 0x2b, 0x7e, 0xb3, 0x8c, 0x38, 0x8f, 0x13, 0xe1,
-	0x8d, 0x94, 0x65, 0x5f, 0x2a, 0x6f, 0x87, 0x0a, 0x13, 0xff, 0x07, 0x0b, 0x6c, 0xe6, 0x23, 0x32,
-	0xe8, 0x26, 0x9c, 0xf7, 0x03, 0x12, 0xf6, 0x27, 0x02, 0x56, 0x76, 0xac, 0x76, 0xf3, 0xd1, 0x96,
-	0x5b, 0x9e, 0x07, 0x77, 0x72, 0x1e, 0xdc, 0x7d, 0x73, 0x1e, 0xfc, 0x43, 0xa5, 0xed, 0xf7, 0xc2,
-	0x69, 0xcd, 0xdb, 0xfe, 0x90, 0xa7, 0x2c, 0xa7, 0xe9, 0x20, 0x3f, 0x9d, 0x6a, 0x9a, 0xb7, 0x0e,
-	0xfd, 0xf8, 0xca, 0xb1, 0x30, 0x54, 0xd0, 0x13, 0x83, 0x18, 0x61, 0xef, 0x01, 0xa0, 0x93, 0xe0,
-	0x39, 0x15, 0xd2, 0x6e, 0xe8, 0x92, 0xde, 0x1d, 0x17, 0xce, 0x46, 0x25, 0x41, 0x8d, 0x21, 0xdc,
-	0x50, 0x69, 0xe9, 0x6f, 0xf8, 0x2d, 0xb8, 0xa3, 0xd3, 0x26, 0x39, 0x17, 0xdd, 0x23, 0x4a, 0xbb,
-	0x5a, 0xac, 0x0d, 0x74, 0x35, 0x9f, 0xdc, 0xb8, 0x9a, 0xdb, 0xe6, 0xfc, 0xcc, 0x52, 0x22, 0xbc,
-	0x71, 0xe5, 0x3d, 0xa0, 0x14, 0x2b, 0x1f, 0x3c, 0x04, 0x1b, 0xf4, 0x64, 0xc0, 0xca, 0x02, 0x75,
-	0x83, 0x84, 0x87, 0x7d, 0x69, 0x37, 0xb5, 0xf4, 0x7b, 0xe3, 0xc2, 0xb1, 0x4b, 0xb6, 0x99, 0x25,
-	0x08, 0xaf, 0x4f, 0x7d, 0xbe, 0x76, 0xed, 0xd5, 0x2f, 0xcf, 0x1c, 0x0b, 0xfd, 0x6c, 0x81, 0x7b,
-	0x1f, 0xc7, 0xb1, 0xa0, 0x31, 0xc9, 0xe9, 0x27, 0x27, 0x61, 0x8f, 0x64, 0xb1, 0x8a, 0x45, 0x3b,
-	0x82, 0xaa, 0xec, 0xe1, 0x7d, 0x50, 0xef, 0x11, 0xd9, 0xd3, 0x97, 0x4b, 0xc3, 0xff, 0xdf, 0xb8,
-	0x70, 0x9a, 0x65, 0x10, 0xe5, 0x45, 0x58, 0x83, 0xf0, 0x01, 0x58, 0xd2, 0xa5, 0x32, 0xd7, 0xc8,
-	0xfa, 0xb8, 0x70, 0x56, 0xa7, 0x17, 0x83, 0x40, 0xb8, 0x84, 0xf5, 0x1c, 0x0f, 0x83, 0x94, 0xe5,
-	0xa5, 0x2e, 0x7d, 0x11, 0x5c, 0x9f, 0xe3, 0x0a, 0xaa, 0xe6, 0x58, 0x9b, 0x5a, 0xf0, 0xde, 0xca,
-	0xf7, 0x67, 0x4e, 0xed, 0xf2, 0xcc, 0xa9, 0xa1, 0xd7, 0x16, 0xd8, 0x9a, 0xab, 0x59, 0xb5, 0x08,
-	0x3e, 0xb3, 0xc0, 0x26, 0x35, 0x4e, 0x55, 0x49, 0xda, 0xcd, 0x87, 0x83, 0x84, 0x4a, 0xdb, 0xda,
-	0x59, 0x6c, 0x37, 0x1f, 0xdd, 0x77, 0xff, 0x7c, 0x4f, 0xbb, 0x55, 0x8a, 0xa7, 0x6a, 0xad, 0xff,
-	0xa1, 0xea, 0xe3, 0x74, 0xae, 0xe6, 0xd1, 0xa1, 0x9f, 0x5e, 0x39, 0x70, 0x66, 0xa7, 0xc4, 0x90,
-	0xce, 0xf8, 0xfe, 0x6e, 0x79, 0x2a, 0x29, 0x5e, 0x5a, 0x60, 0x63, 0x86, 0x1c, 0x7e, 0x03, 0xea,
-	0x03, 0xc2, 0x84, 0xe9, 0xc5, 0x67, 0x66, 0xd8, 0xfe, 0xd1, 0xf5, 0x66, 0x9a, 0xa8, 0xe8, 0x10,
-	0xd6, 0xac, 0xb0, 0x0f, 0xd6, 0xae, 0x25, 0x6a, 0xd4, 0x1e, 0xdc, 0x78, 0xa6, 0x37, 0xe7, 0x54,
-	0x0d, 0xe1, 0xd5, 0x6a, 0x61, 0x2a, 0xa9, 0xfe, 0x62, 0x01, 0xb0, 0x4f, 0x72, 0x1a, 0x75, 0x04,
-	0x0b, 0xe9, 0xac, 0x0a, 0xeb, 0xbf, 0x53, 0x01, 0x3f, 0x02, 0x6b, 0xa1, 0xa0, 0x2a, 0xb8, 0x19,
-	0xc8, 0x05, 0x3d, 0x90, 0xf6, 0x74, 0xfb, 0x35, 0x18, 0xe1, 0x55, 0x63, 0xeb, 0x91, 0x44, 0x12,
-	0xdc, 0xc2, 0xfa, 0xf5, 0x91, 0xf0, 0x36, 0x58, 0x60, 0xe6, 0x05, 0xc6, 0x0b, 0x2c, 0x82, 0x6f,
-	0x83, 0xd5, 0xca, 0xeb, 0x2b, 0x4b, 0x62, 0xdc, 0x9c, 0xbe, 0xc1, 0x12, 0xbe, 0x0f, 0x96, 0xd4,
-	0xb3, 0x2e, 0xed, 0x45, 0x3d, 0x98, 0x5b, 0x6e, 0x99, 0x88, 0xab, 0x1e, 0x7e, 0xd7, 0x3c, 0xfc,
-	0xee, 0x63, 0xce, 0x32, 0xbf, 0xae, 0x92, 0xc7, 0xe5, 0x6a, 0xff, 0xe0, 0xf9, 0x79, 0xcb, 0x7a,
-	0x71, 0xde, 0xb2, 0x5e, 0x9f, 0xb7, 0xac, 0x67, 0x17, 0xad, 0xda, 0x8b, 0x8b, 0x56, 0xed, 0xb7,
-	0x8b, 0x56, 0xed, 0xeb, 0x87, 0x7f, 0x35, 0x08, 0xe6, 0x9f, 0x8b, 0xae, 0x52, 0xb0, 0xac, 0x2f,
-	0xdc, 0x77, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x42, 0xc8, 0x75, 0xd7, 0x08, 0x00, 0x00,
+	// 1500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xe6, 0x57, 0x9b, 0x71, 0xe2, 0xda, 0x13, 0xb7, 0xdd, 0xa4, 0x89, 0xd7, 0x9d, 0x4a,
+	0x91, 0xf5, 0x55, 0xbf, 0xb6, 0x52, 0x40, 0x88, 0x48, 0x48, 0x64, 0x1d, 0x87, 0x9a, 0xa6, 0xc1,
+	0x1a, 0xd2, 0x22, 0x50, 0xa5, 0x65, 0xbc, 0x3b, 0xb1, 0x47, 0xb1, 0x77, 0xad, 0x9d, 0x75, 0x92,
+	0x4a, 0x08, 0xae, 0xdc, 0xa8, 0x84, 0x84, 0x38, 0x16, 0x89, 0x13, 0x37, 0xfe, 0x01, 0xce, 0x3d,
+	0xf6, 0x88, 0x7a, 0xd8, 0x56, 0xed, 0xa5, 0xe2, 0xe8, 0x23, 0x27, 0x34, 0xb3, 0xe3, 0xec, 0xa6,
+	0x76, 0x68, 0x53, 0x68, 0x4f, 0xf1, 0xbc, 0xf7, 0xe6, 0x33, 0xef, 0xbd, 0xf9, 0xbc, 0xf7, 0x66,
+	0x03, 0x96, 0x5d, 0xd6, 0x60, 0x7e, 0xaf, 0xec, 0xf9, 0xc4, 0x6e, 0xd3, 0xf2, 0xfe, 0xaa, 0xfa,
+	0x55, 0xea, 0xfa, 0x5e, 0xe0, 0xc1, 0x4c, 0xa4, 0x2e, 0x29, 0xe1, 0xfe, 0xea, 0x62, 0xae, 0xe9,
+	0x35, 0x3d, 0xa9, 0x2c, 0x8b, 0x5f, 0x91, 0xdd, 0x62, 0xbe, 0xe9, 0x79, 0xcd, 0x36, 0x2d, 0xcb,
+	0x55, 0xa3, 0xb7, 0x5b, 0x76, 0x7a, 0x3e, 0x09, 0x98, 0xe7, 0x0e, 0xf4, 0xb6, 0xc7, 0x3b, 0x1e,
+	0x2f, 0x37, 0x08, 0x17, 0x87, 0x34, 0x68, 0x40, 0x56, 0xcb, 0xb6, 0xc7, 0x94, 0x1e, 0xfd, 0x9c,
+	0x01, 0xd3, 0x75, 0xe2, 0x93, 0x0e, 0x87, 0xef, 0x83, 0xd4, 0xbe, 0x17, 0x50, 0xab, 0x4b, 0x7d,
+	0xe6, 0x39, 0xba, 0x56, 0xd0, 0x8a, 0x93, 0xe6, 0x85, 0x7e, 0x68, 0xc0, 0xbb, 0xa4, 0xd3, 0x5e,
+	0x43, 0x09, 0x25, 0xc2, 0x40, 0xac, 0xea, 0x72, 0x01, 0x5d, 0x90, 0x96, 0xba, 0xa0, 0xe5, 0x53,
+	0xde, 0xf2, 0xda, 0x8e, 0x3e, 0x5e, 0xd0, 0x8a, 0x33, 0xe6, 0xc7, 0x0f, 0x42, 0x63, 0xec, 0x51,
+	0x68, 0xac, 0x34, 0x59, 0xd0, 0xea, 0x35, 0x4a, 0xb6, 0xd7, 0x29, 0x2b, 0x77, 0xa2, 0x3f, 0xff,
+	0xe7, 0xce, 0x5e, 0x39, 0xb8, 0xdb, 0xa5, 0xbc, 0xb4, 0x41, 0xed, 0x7e, 0x68, 0x9c, 0x4f, 0x9c,
+	0x74, 0x84, 0x86, 0xf0, 0x9c, 0x10, 0xec, 0x0c, 0xd6, 0x90, 0x82, 0x94, 0x4f, 0x0f, 0x88, 0xef,
+	0x58, 0x0d, 0xe2, 0x3a, 0xfa, 0x84, 0x3c, 0x6c, 0xe3, 0xd4, 0x87, 0xa9, 0xb0, 0x12, 0x50, 0x08,
+	0x83, 0x68, 0x65, 0x12, 0xd7, 0x81, 0x4d, 0x30, 0x73, 0xd0, 0x62, 0x01, 0x6d, 0x33, 0x1e, 0xe8,
+	0x93, 0x85, 0x89, 0xe2, 0x8c, 0x59, 0x7b, 0x14, 0x1a, 0xab, 0x89, 0x03, 0xb6, 0xe5, 0x25, 0x55,
+	0x5a, 0x84, 0xb9, 0x65, 0x75, 0x9f, 0x87, 0x65, 0xdb, 0xeb, 0x74, 0x3c, 0xb7, 0x4c, 0x38, 0xa7,
+	0x41, 0xa9, 0x4e, 0x98, 0xdf, 0x0f, 0x8d, 0x4c, 0x74, 0xd6, 0x11, 0x1e, 0xc2, 0x31, 0xb6, 0xc8,
+	0x1f, 0x6f, 0x13, 0xde, 0xb2, 0x76, 0x7d, 0x62, 0x8b, 0xbb, 0xd3, 0xa7, 0xfe, 0x5d, 0xfe, 0x8e,
+	0xa3, 0x21, 0x3c, 0x27, 0x05, 0x9b, 0x6a, 0x0d, 0xd7, 0xc0, 0x6c, 0x64, 0x71, 0xc0, 0x5c, 0xc7,
+	0x3b, 0xd0, 0xa7, 0xe5, 0x4d, 0x5f, 0xec, 0x87, 0xc6, 0x7c, 0x72, 0x7f, 0xa4, 0x45, 0x38, 0x25,
+	0x97, 0x9f, 0xcb, 0x15, 0xfc, 0x06, 0xe4, 0x3a, 0xcc, 0xb5, 0xf6, 0x49, 0x9b, 0x39, 0x82, 0x0c,
+	0x03, 0x8c, 0x33, 0xd2, 0xe3, 0x9b, 0xa7, 0xf6, 0xf8, 0x52, 0x74, 0xe2, 0x28, 0x4c, 0x84, 0xb3,
+	0x1d, 0xe6, 0xde, 0x16, 0xd2, 0x3a, 0xf5, 0xd5, 0xf9, 0x3f, 0x6a, 0x20, 0x17, 0x1c, 0x90, 0xae,
+	0xd5, 0xf6, 0xbc, 0xbd, 0x06, 0xb1, 0xf7, 0x06, 0x0e, 0x9c, 0x2d, 0x68, 0xc5, 0xd4, 0xb5, 0x85,
+	0x52, 0x54, 0x0f, 0xa5, 0x41, 0x3d, 0x94, 0x36, 0x54, 0x3d, 0x98, 0x35, 0xe1, 0xdb, 0x9f, 0xa1,
+	0x91, 0x1f, 0xb5, 0xfd, 0xaa, 0xd7, 0x61, 0x01, 0xed, 0x74, 0x83, 0xbb, 0xb1, 0x4f, 0xa3, 0xec,
+	0xd0, 0x4f, 0x8f, 0x0d, 0x0d, 0x43, 0xa1, 0xda, 0x52, 0x1a, 0xe5, 0xd8, 0xbb, 0x00, 0xc8, 0x20,
+	0xbc, 0x80, 0xfa, 0x5c, 0x9f, 0x91, 0x29, 0x3d, 0xdf, 0x0f, 0x8d, 0x6c, 0x22, 0x40, 0xa9, 0x43,
+	0x78, 0x46, 0x84, 0x25, 0x7f, 0xc3, 0xaf, 0xc1, 0xbc, 0x0c, 0x9b, 0x04, 0x9e, 0x6f, 0xed, 0x52,
+	0x6a, 0x49, 0x67, 0x75, 0x20, 0xb3, 0xb9, 0x75, 0xea, 0x6c, 0x2e, 0xaa, 0xfa, 0x19, 0x86, 0x44,
+	0x38, 0x7b, 0x24, 0xdd, 0xa4, 0x14, 0x0b, 0x19, 0xac, 0x81, 0x2c, 0x3d, 0xec, 0xb2, 0x28, 0x41,
+	0x56, 0xa3, 0xed, 0xd9, 0x7b, 0x5c, 0x4f, 0x49, 0xd7, 0x97, 0xfa, 0xa1, 0xa1, 0x47, 0x68, 0x43,
+	0x26, 0x08, 0x67, 0x62, 0x99, 0x29, 0x45, 0x70, 0x1b, 0xcc, 0x3b, 0xcc, 0xa7, 0x76, 0x20, 0xa3,
+	0xb4, 0xa8, 0x4b, 0x1a, 0x6d, 0xea, 0xe8, 0xb3, 0x05, 0xad, 0x78, 0xd6, 0xcc, 0xc7, 0xae, 0x8d,
+	0x30, 0x42, 0x38, 0x1b, 0x49, 0x45, 0x4e, 0xaa, 0x91, 0x0c, 0x7e, 0x05, 0xe6, 0x1c, 0xea, 0xb3,
+	0x7d, 0xea, 0x58, 0x5d, 0xc2, 0x7c, 0xae, 0xcf, 0x15, 0x26, 0x8a, 0xa9, 0x6b, 0xcb, 0xa5, 0x17,
+	0xfb, 0x62, 0x69, 0x23, 0x32, 0x13, 0x85, 0x66, 0x2e, 0x89, 0x8c, 0xf5, 0x43, 0x23, 0xa7, 0x0e,
+	0x4b, 0x22, 0x20, 0x3c, 0xeb, 0xc4, 0xa6, 0x1c, 0x7e, 0xaf, 0x01, 0xc8, 0x5d, 0xd2, 0xe5, 0x2d,
+	0x2f, 0xb0, 0x7c, 0x1a, 0x50, 0x57, 0x96, 0x5e, 0xfa, 0x65, 0x3c, 0xaa, 0x2a, 0x1e, 0x2d, 0x0d,
+	0x6f, 0x3e, 0xc6, 0xa2, 0x05, 0x55, 0x4b, 0x43, 0x56, 0x11, 0x87, 0xb2, 0x03, 0x05, 0x1e, 0xc8,
+	0xe1, 0x17, 0x20, 0x25, 0x3c, 0xb5, 0xba, 0xb2, 0x1f, 0xeb, 0xe7, 0x64, 0xc4, 0x4b, 0xc3, 0x11,
+	0x0b, 0xff, 0xa3, 0x9e, 0x6d, 0x2e, 0xaa, 0x80, 0x55, 0x2f, 0x4b, 0x6c, 0x47, 0x18, 0x74, 0x8f,
+	0xec, 0x20, 0x07, 0x99, 0x0e, 0x39, 0xb4, 0xec, 0x16, 0x71, 0x9b, 0x03, 0x92, 0x65, 0x24, 0xc9,
+	0x6a, 0xa7, 0x26, 0xd9, 0x45, 0xc5, 0xe8, 0x17, 0xf0, 0x10, 0x4e, 0x77, 0xc8, 0x61, 0x45, 0x4a,
+	0x22, 0x7a, 0x7d, 0x0b, 0x2e, 0xd8, 0xcc, 0xb7, 0x7b, 0x2c, 0xb0, 0x1a, 0x3e, 0x25, 0x7b, 0xd4,
+	0xb7, 0x54, 0x7f, 0xcb, 0x16, 0xb4, 0x62, 0xfa, 0xda, 0xca, 0x70, 0x68, 0x95, 0xc8, 0xde, 0x8c,
+	0xcc, 0xd7, 0xa5, 0xb5, 0x79, 0xb9, 0x1f, 0x1a, 0xcb, 0xd1, 0xa1, 0xa3, 0xf1, 0x10, 0xce, 0xd9,
+	0x23, 0x36, 0xc2, 0x7b, 0x1a, 0xc8, 0x88, 0xc2, 0xdb, 0xed, 0xb9, 0x8e, 0x15, 0x75, 0x76, 0xae,
+	0x43, 0x99, 0xd6, 0x85, 0x52, 0x14, 0x5d, 0x49, 0x0c, 0xc6, 0x92, 0x1a, 0x8c, 0xa5, 0x8a, 0xc7,
+	0x5c, 0xf3, 0x86, 0xca, 0xe9, 0xc5, 0xb8, 0x72, 0x93, 0x00, 0xe8, 0xd7, 0xc7, 0x46, 0xf1, 0x15,
+	0x92, 0x25, 0xb0, 0x38, 0x4e, 0x77, 0x98, 0xbb, 0xd9, 0x73, 0x1d, 0x1c, 0x6d, 0x16, 0x75, 0x22,
+	0x12, 0xa7, 0xb0, 0x2c, 0xea, 0x06, 0x3e, 0xa3, 0x5c, 0x9f, 0x97, 0x45, 0x97, 0xa8, 0x93, 0x11,
+	0x46, 0xa2, 0x1f, 0x92, 0x43, 0x05, 0x54, 0x8d, 0x64, 0xf0, 0x0e, 0xd0, 0x65, 0x8d, 0x2b, 0xd3,
+	0xc4, 0x8c, 0xe6, 0x7a, 0x4e, 0x82, 0x5e, 0xe9, 0x87, 0x86, 0x11, 0x81, 0x9e, 0x64, 0x89, 0xf0,
+	0xf9, 0x5d, 0x4a, 0x15, 0xf2, 0xed, 0xa3, 0xc1, 0xce, 0xd7, 0x26, 0x9f, 0xdf, 0x37, 0x34, 0xf4,
+	0x83, 0x06, 0x52, 0x89, 0x2a, 0x83, 0x77, 0xc0, 0xa4, 0xa0, 0x96, 0x7c, 0x21, 0xcc, 0x98, 0xd7,
+	0x15, 0x81, 0x5e, 0x6b, 0x2e, 0xa6, 0x62, 0xde, 0x22, 0x2c, 0x51, 0x61, 0x01, 0x4c, 0xec, 0x33,
+	0xa2, 0x9e, 0x10, 0xe9, 0x7e, 0x68, 0x00, 0xd5, 0xd4, 0x18, 0x41, 0x58, 0xa8, 0x94, 0x57, 0xbf,
+	0x4f, 0x01, 0x10, 0x57, 0xc2, 0x1b, 0x76, 0xea, 0x9f, 0x9e, 0x38, 0xda, 0xdb, 0x7c, 0xe2, 0x68,
+	0xff, 0xe9, 0x13, 0xe7, 0xf8, 0xd0, 0x9a, 0x7c, 0xc5, 0xa1, 0x35, 0x72, 0x6c, 0x4c, 0xbd, 0xd6,
+	0xd8, 0x18, 0xd5, 0x97, 0xa6, 0x8f, 0xfa, 0x92, 0xf6, 0xb6, 0xfb, 0xd2, 0x99, 0xb7, 0xd2, 0x97,
+	0x14, 0x81, 0x7f, 0xd3, 0xc0, 0xd2, 0x7a, 0xb3, 0xe9, 0xd3, 0x26, 0x09, 0x68, 0xf5, 0xd0, 0x1e,
+	0x78, 0x48, 0xeb, 0x3e, 0x15, 0x59, 0x87, 0x57, 0xc0, 0x64, 0x8b, 0xf0, 0x96, 0xa2, 0xf4, 0xb9,
+	0x98, 0x99, 0x42, 0x8a, 0xb0, 0x54, 0xc2, 0x15, 0x30, 0x25, 0xaf, 0x48, 0x11, 0x32, 0xd3, 0x0f,
+	0x8d, 0xd9, 0x98, 0x62, 0x3e, 0xc2, 0x91, 0x5a, 0x3e, 0xfa, 0x7a, 0x8d, 0x8e, 0xf0, 0x51, 0xa4,
+	0x5e, 0x9f, 0x18, 0x7a, 0xf4, 0x25, 0xb4, 0xe2, 0xd1, 0x27, 0x97, 0xf2, 0x9a, 0xd6, 0xce, 0x7e,
+	0x77, 0xdf, 0x18, 0x7b, 0x7e, 0xdf, 0x18, 0x43, 0x4f, 0x34, 0xb0, 0x30, 0xd2, 0x67, 0x41, 0x0d,
+	0xd1, 0x6f, 0x73, 0xf4, 0x30, 0x4e, 0x3e, 0xb5, 0x82, 0x5e, 0xb7, 0x4d, 0xb9, 0xae, 0xc9, 0x9e,
+	0x7b, 0x65, 0x38, 0xaf, 0x49, 0x88, 0x1d, 0x61, 0x6b, 0x7e, 0xa0, 0xba, 0xef, 0xa5, 0x01, 0x8b,
+	0x86, 0xe1, 0x44, 0x07, 0x86, 0x43, 0x3b, 0x39, 0x86, 0x74, 0x48, 0xf6, 0xaa, 0xe9, 0x49, 0x84,
+	0xf8, 0x5c, 0x03, 0xd9, 0x21, 0xf0, 0x37, 0xdc, 0x5e, 0xf6, 0xc0, 0xdc, 0xb1, 0x40, 0x95, 0xb7,
+	0x9b, 0xa7, 0x9e, 0xcd, 0xb9, 0x11, 0x59, 0x43, 0x78, 0x36, 0x99, 0x98, 0x44, 0xa8, 0x7f, 0x69,
+	0x00, 0x6c, 0x90, 0x80, 0x3a, 0x75, 0x9f, 0xd9, 0x74, 0xd8, 0x0b, 0xed, 0xcd, 0x79, 0x01, 0x3f,
+	0x04, 0x73, 0xb6, 0x4f, 0xc5, 0xe1, 0x8a, 0x90, 0xe3, 0x92, 0x90, 0x7a, 0xbc, 0xfd, 0x98, 0x1a,
+	0xe1, 0x59, 0xb5, 0x96, 0x94, 0x84, 0x1f, 0x81, 0x34, 0xb1, 0x6d, 0xda, 0x8d, 0xf7, 0x47, 0x84,
+	0x5e, 0x88, 0x5b, 0xec, 0x71, 0x3d, 0xc2, 0x73, 0x03, 0x81, 0x44, 0x40, 0x1c, 0x9c, 0x19, 0x0c,
+	0xe5, 0x34, 0x18, 0x67, 0xea, 0x83, 0x17, 0x8f, 0x33, 0x07, 0x5e, 0x06, 0xb3, 0xc7, 0x06, 0xa9,
+	0x74, 0x0d, 0xa7, 0xe2, 0x4f, 0x5e, 0x0e, 0xdf, 0x03, 0x53, 0xe2, 0x2b, 0x9a, 0xeb, 0x13, 0x2f,
+	0x7b, 0x4e, 0x4c, 0x8a, 0xf4, 0xe1, 0xc8, 0xfa, 0x7f, 0xbf, 0x68, 0x20, 0x37, 0xaa, 0x97, 0xc0,
+	0x15, 0x80, 0x2a, 0x35, 0x5c, 0xb9, 0x55, 0xdb, 0xb1, 0x4c, 0x5c, 0x5d, 0xbf, 0x51, 0xc5, 0xd6,
+	0x7a, 0x65, 0xa7, 0xf6, 0xe9, 0xb6, 0x75, 0x6b, 0xfb, 0xb3, 0x7a, 0xb5, 0x52, 0xdb, 0xac, 0x55,
+	0x37, 0x32, 0x63, 0xb0, 0x00, 0x96, 0x4e, 0xb0, 0xab, 0x6c, 0xad, 0xdf, 0xac, 0x67, 0x34, 0x78,
+	0x19, 0x2c, 0x9f, 0x60, 0x81, 0xab, 0x9f, 0x54, 0x2b, 0x3b, 0x99, 0x71, 0x68, 0x80, 0x4b, 0x27,
+	0x98, 0x5c, 0x5f, 0xdf, 0xda, 0xc9, 0x4c, 0x98, 0x9b, 0x0f, 0x9e, 0xe6, 0xb5, 0x87, 0x4f, 0xf3,
+	0xda, 0x93, 0xa7, 0x79, 0xed, 0xde, 0xb3, 0xfc, 0xd8, 0xc3, 0x67, 0xf9, 0xb1, 0x3f, 0x9e, 0xe5,
+	0xc7, 0xbe, 0xbc, 0xfa, 0x32, 0xc6, 0xab, 0xff, 0x67, 0x48, 0x3a, 0x34, 0xa6, 0xe5, 0xf3, 0xf9,
+	0x9d, 0xbf, 0x07, 0x00, 0xe6, 0x4d, 0x82, 0x36, 0xed, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -504,6 +804,129 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if this.DirectVoteEnabled != that1.DirectVoteEnabled {
+		return false
+	}
+	if len(this.DerivedPairs) != len(that1.DerivedPairs) {
+		return false
+	}
+	for i := range this.DerivedPairs {
+		if !this.DerivedPairs[i].Equal(&that1.DerivedPairs[i]) {
+			return false
+		}
+	}
+	if this.SnapshotRetention != that1.SnapshotRetention {
+		return false
+	}
+	if len(this.PairParams) != len(that1.PairParams) {
+		return false
+	}
+	for i := range this.PairParams {
+		if !this.PairParams[i].Equal(&that1.PairParams[i]) {
+			return false
+		}
+	}
+	if !this.MaxChangeRatio.Equal(that1.MaxChangeRatio) {
+		return false
+	}
+	if this.CircuitBreakerAction != that1.CircuitBreakerAction {
+		return false
+	}
+	if len(this.MinFundRewards) != len(that1.MinFundRewards) {
+		return false
+	}
+	for i := range this.MinFundRewards {
+		if !this.MinFundRewards[i].Equal(&that1.MinFundRewards[i]) {
+			return false
+		}
+	}
+	if this.MaxRewardsEntries != that1.MaxRewardsEntries {
+		return false
+	}
+	if this.FeeRewardsVotePeriods != that1.FeeRewardsVotePeriods {
+		return false
+	}
+	return true
+}
+func (this *DerivedPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DerivedPair)
+	if !ok {
+		that2, ok := that.(DerivedPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Via != that1.Via {
+		return false
+	}
+	return true
+}
+func (this *PairParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairParams)
+	if !ok {
+		that2, ok := that.(PairParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if that1.VoteThreshold == nil {
+		if this.VoteThreshold != nil {
+			return false
+		}
+	} else if !this.VoteThreshold.Equal(*that1.VoteThreshold) {
+		return false
+	}
+	if that1.RewardBand == nil {
+		if this.RewardBand != nil {
+			return false
+		}
+	} else if !this.RewardBand.Equal(*that1.RewardBand) {
+		return false
+	}
+	if this.MinVoters != that1.MinVoters {
+		return false
+	}
+	if this.ExpirationBlocks != that1.ExpirationBlocks {
+		return false
+	}
+	if that1.MaxChangeRatio == nil {
+		if this.MaxChangeRatio != nil {
+			return false
+		}
+	} else if !this.MaxChangeRatio.Equal(*that1.MaxChangeRatio) {
+		return false
+	}
+	if this.CircuitBreakerAction != that1.CircuitBreakerAction {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -526,41 +949,136 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationBlocks != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+	if m.FeeRewardsVotePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FeeRewardsVotePeriods))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxRewardsEntries != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxRewardsEntries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.MinFundRewards) > 0 {
+		for iNdEx := len(m.MinFundRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFundRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.CircuitBreakerAction != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CircuitBreakerAction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
 	{
-		size := m.ValidatorFeeRatio.Size()
+		size := m.MaxChangeRatio.Size()
 		i -= size
-		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxChangeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x52
-	if m.MinVoters != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
-		i--
-		dAtA[i] = 0x48
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.PairParams) > 0 {
+		for iNdEx := len(m.PairParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SnapshotRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetention):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintOracle(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	dAtA[i] = 0x72
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.DirectVoteEnabled {
+		i--
+		if m.DirectVoteEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExpirationBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorFeeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x3a
@@ -621,6 +1139,130 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DerivedPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Via) > 0 {
+		i -= len(m.Via)
+		copy(dAtA[i:], m.Via)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Via)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreakerAction != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CircuitBreakerAction))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxChangeRatio != nil {
+		{
+			size := m.MaxChangeRatio.Size()
+			i -= size
+			if _, err := m.MaxChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpirationBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -770,6 +1412,11 @@ func (m *DatedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AcceptedBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AcceptedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CreatedBlock != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.CreatedBlock))
 		i--
@@ -882,6 +1529,87 @@ func (m *Params) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
 	}
+	if m.DirectVoteEnabled {
+		n += 2
+	}
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetention)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.PairParams) > 0 {
+		for _, e := range m.PairParams {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.MaxChangeRatio.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	if m.CircuitBreakerAction != 0 {
+		n += 2 + sovOracle(uint64(m.CircuitBreakerAction))
+	}
+	if len(m.MinFundRewards) > 0 {
+		for _, e := range m.MinFundRewards {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxRewardsEntries != 0 {
+		n += 2 + sovOracle(uint64(m.MaxRewardsEntries))
+	}
+	if m.FeeRewardsVotePeriods != 0 {
+		n += 2 + sovOracle(uint64(m.FeeRewardsVotePeriods))
+	}
+	return n
+}
+
+func (m *DerivedPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Via)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PairParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.ExpirationBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
+	}
+	if m.MaxChangeRatio != nil {
+		l = m.MaxChangeRatio.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.CircuitBreakerAction != 0 {
+		n += 1 + sovOracle(uint64(m.CircuitBreakerAction))
+	}
 	return n
 }
 
@@ -917,68 +1645,682 @@ func (m *AggregateExchangeRateVote) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	return n
-}
-
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *DatedPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.CreatedBlock != 0 {
-		n += 1 + sovOracle(uint64(m.CreatedBlock))
-	}
-	return n
-}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DatedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.CreatedBlock != 0 {
+		n += 1 + sovOracle(uint64(m.CreatedBlock))
+	}
+	if m.AcceptedBlock != 0 {
+		n += 1 + sovOracle(uint64(m.AcceptedBlock))
+	}
+	return n
+}
+
+func (m *Rewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOracle(uint64(m.Id))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriods))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.Whitelist = append(m.Whitelist, v)
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+			}
+			m.ExpirationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectVoteEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DirectVoteEnabled = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SnapshotRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairParams = append(m.PairParams, PairParams{})
+			if err := m.PairParams[len(m.PairParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAction", wireType)
+			}
+			m.CircuitBreakerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerAction |= CircuitBreakerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFundRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFundRewards = append(m.MinFundRewards, types.Coin{})
+			if err := m.MinFundRewards[len(m.MinFundRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardsEntries", wireType)
+			}
+			m.MaxRewardsEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardsEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewardsVotePeriods", wireType)
+			}
+			m.FeeRewardsVotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRewardsVotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Rewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovOracle(uint64(m.Id))
-	}
-	if m.VotePeriods != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriods))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DerivedPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,34 +2343,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivedPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivedPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
-			}
-			m.VotePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1056,13 +2379,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Via", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1090,13 +2413,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Via = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1124,15 +2495,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
-			m.Whitelist = append(m.Whitelist, v)
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1160,32 +2529,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
-			}
-			m.SlashWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,15 +2565,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
 			}
-			var msglen int
+			m.MinVoters = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1231,30 +2585,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinVoters |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
 			}
-			m.MinVoters = 0
+			m.ExpirationBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1264,14 +2604,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinVoters |= uint64(b&0x7F) << shift
+				m.ExpirationBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1299,15 +2639,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxChangeRatio = &v
+			if err := m.MaxChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAction", wireType)
 			}
-			m.ExpirationBlocks = 0
+			m.CircuitBreakerAction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1317,7 +2659,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				m.CircuitBreakerAction |= CircuitBreakerAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1792,6 +3134,25 @@ func (m *DatedPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBlock", wireType)
+			}
+			m.AcceptedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
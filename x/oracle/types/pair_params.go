@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// Validate performs basic validation on the overrides of a pair. The bounds
+// match those of the global params.
+func (pp PairParams) Validate() error {
+	if err := pp.Pair.Validate(); err != nil {
+		return err
+	}
+	if pp.VoteThreshold != nil && pp.VoteThreshold.LTE(math.LegacyNewDecWithPrec(33, 2)) {
+		return fmt.Errorf("VoteThreshold of pair %s must be greater than 33 percent", pp.Pair)
+	}
+	if pp.VoteThreshold != nil && pp.VoteThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("VoteThreshold of pair %s must be at most 1", pp.Pair)
+	}
+	if pp.RewardBand != nil && (pp.RewardBand.GT(math.LegacyOneDec()) || pp.RewardBand.IsNegative()) {
+		return fmt.Errorf("RewardBand of pair %s must be between [0, 1]", pp.Pair)
+	}
+	if pp.MaxChangeRatio != nil && !isValidMaxChangeRatio(*pp.MaxChangeRatio) {
+		return fmt.Errorf("MaxChangeRatio of pair %s must be between [0, 1)", pp.Pair)
+	}
+	if _, ok := CircuitBreakerAction_name[int32(pp.CircuitBreakerAction)]; !ok {
+		return fmt.Errorf("CircuitBreakerAction of pair %s is unknown: %d", pp.Pair, pp.CircuitBreakerAction)
+	}
+	return nil
+}
+
+// ForPair returns the ballot params in effect for a pair: the overrides of
+// the pair in "PairParams", with every unset field taken from the global
+// params. An unspecified circuit breaker action resolves to clamping.
+func (p Params) ForPair(pair asset.Pair) PairParams {
+	maxChangeRatio := p.MaxChangeRatio
+	if maxChangeRatio.IsNil() {
+		maxChangeRatio = math.LegacyZeroDec()
+	}
+	resolved := PairParams{
+		Pair:                 pair,
+		VoteThreshold:        &p.VoteThreshold,
+		RewardBand:           &p.RewardBand,
+		MinVoters:            p.MinVoters,
+		ExpirationBlocks:     p.ExpirationBlocks,
+		MaxChangeRatio:       &maxChangeRatio,
+		CircuitBreakerAction: p.CircuitBreakerAction,
+	}
+	for _, override := range p.PairParams {
+		if override.Pair != pair {
+			continue
+		}
+		if override.VoteThreshold != nil {
+			resolved.VoteThreshold = override.VoteThreshold
+		}
+		if override.RewardBand != nil {
+			resolved.RewardBand = override.RewardBand
+		}
+		if override.MinVoters != 0 {
+			resolved.MinVoters = override.MinVoters
+		}
+		if override.ExpirationBlocks != 0 {
+			resolved.ExpirationBlocks = override.ExpirationBlocks
+		}
+		if override.MaxChangeRatio != nil {
+			resolved.MaxChangeRatio = override.MaxChangeRatio
+		}
+		if override.CircuitBreakerAction != CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED {
+			resolved.CircuitBreakerAction = override.CircuitBreakerAction
+		}
+		break
+	}
+	if resolved.CircuitBreakerAction == CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED {
+		resolved.CircuitBreakerAction = CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP
+	}
+	return resolved
+}
+
+// isValidMaxChangeRatio reports whether a max change ratio is within [0, 1).
+// A ratio of 1 or more would let a price be clamped to zero.
+func isValidMaxChangeRatio(ratio math.LegacyDec) bool {
+	return !ratio.IsNegative() && ratio.LT(math.LegacyOneDec())
+}
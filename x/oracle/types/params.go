@@ -5,9 +5,11 @@ import (
 	time "time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 // Parameter keys
@@ -31,6 +33,9 @@ const (
 	DefaultSlashWindow      = 3600 // 2 hours
 	DefaultMinVoters        = 4    // minimum of 4 voters for a pair to become valid
 	DefaultExpirationBlocks = 900  // 30 minutes
+
+	DefaultMaxRewardsEntries     = 100
+	DefaultFeeRewardsVotePeriods = 24 * 60 * 60 / 2 / DefaultVotePeriod // 1 day
 )
 
 // Default parameter values
@@ -50,10 +55,12 @@ var (
 		// asset.Registry.Pair(denoms.SOL, denoms.USD),
 		// asset.Registry.Pair(denoms.ADA, denoms.USD),
 	}
-	DefaultSlashFraction      = math.LegacyNewDecWithPrec(5, 3)  // 0.5%
-	DefaultMinValidPerWindow  = math.LegacyNewDecWithPrec(69, 2) // 69%
-	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute)  // 15 minutes
-	DefaultValidatorFeeRatio  = math.LegacyNewDecWithPrec(5, 2)  // 0.05%
+	DefaultSlashFraction      = math.LegacyNewDecWithPrec(5, 3)   // 0.5%
+	DefaultMinValidPerWindow  = math.LegacyNewDecWithPrec(69, 2)  // 69%
+	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute)   // 15 minutes
+	DefaultValidatorFeeRatio  = math.LegacyNewDecWithPrec(5, 2)   // 5%
+	DefaultSnapshotRetention  = time.Duration(7 * 24 * time.Hour) // 7 days
+	DefaultMinFundRewards     = sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))
 )
 
 // DefaultParams creates default oracle module parameters
@@ -70,6 +77,15 @@ func DefaultParams() Params {
 		MinValidPerWindow:  DefaultMinValidPerWindow,
 		TwapLookbackWindow: DefaultTwapLookbackWindow,
 		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+		DerivedPairs:       []DerivedPair{},
+		SnapshotRetention:  DefaultSnapshotRetention,
+		PairParams:         []PairParams{},
+		MaxChangeRatio:     math.LegacyZeroDec(),
+		// Unspecified, so prices that trip the circuit breaker are clamped.
+		CircuitBreakerAction:  CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED,
+		MinFundRewards:        DefaultMinFundRewards,
+		MaxRewardsEntries:     DefaultMaxRewardsEntries,
+		FeeRewardsVotePeriods: DefaultFeeRewardsVotePeriods,
 	}
 }
 
@@ -107,10 +123,69 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter ValidatorFeeRatio must be between [0, 1]")
 	}
 
+	// A zero SnapshotRetention keeps every snapshot.
+	if p.SnapshotRetention < 0 ||
+		(p.SnapshotRetention > 0 && p.SnapshotRetention < p.TwapLookbackWindow) {
+		return fmt.Errorf("oracle parameter SnapshotRetention must be zero or greater than or equal with TwapLookbackWindow")
+	}
+
+	if !p.MaxChangeRatio.IsNil() && !isValidMaxChangeRatio(p.MaxChangeRatio) {
+		return fmt.Errorf("oracle parameter MaxChangeRatio must be between [0, 1)")
+	}
+
+	if _, ok := CircuitBreakerAction_name[int32(p.CircuitBreakerAction)]; !ok {
+		return fmt.Errorf("oracle parameter CircuitBreakerAction is unknown: %d", p.CircuitBreakerAction)
+	}
+
+	if err := p.MinFundRewards.Validate(); err != nil {
+		return fmt.Errorf("oracle parameter MinFundRewards invalid: %w", err)
+	}
+
+	if p.FeeRewardsVotePeriods == 0 {
+		return fmt.Errorf("oracle parameter FeeRewardsVotePeriods must be > 0")
+	}
+
+	whitelist := set.New[asset.Pair]()
 	for _, pair := range p.Whitelist {
 		if err := pair.Validate(); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
+		whitelist.Add(pair)
+	}
+
+	derivedPairs := set.New[asset.Pair]()
+	for _, derivedPair := range p.DerivedPairs {
+		if err := derivedPair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter DerivedPairs invalid: %w", err)
+		}
+		if whitelist.Has(derivedPair.Pair) {
+			return fmt.Errorf("oracle parameter DerivedPairs has whitelisted pair %s", derivedPair.Pair)
+		}
+		if derivedPairs.Has(derivedPair.Pair) {
+			return fmt.Errorf("oracle parameter DerivedPairs has duplicate pair %s", derivedPair.Pair)
+		}
+		derivedPairs.Add(derivedPair.Pair)
+	}
+
+	pairParams := set.New[asset.Pair]()
+	for _, pp := range p.PairParams {
+		if err := pp.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter PairParams invalid: %w", err)
+		}
+		if pairParams.Has(pp.Pair) {
+			return fmt.Errorf("oracle parameter PairParams has duplicate pair %s", pp.Pair)
+		}
+		pairParams.Add(pp.Pair)
 	}
 	return nil
 }
+
+// SnapshotRetentionCutoff returns the time before which price snapshots fall
+// out of the retention window. A zero retention keeps every snapshot, in
+// which case the zero time is returned.
+func SnapshotRetentionCutoff(blockTime time.Time, retention time.Duration) time.Time {
+	if retention <= 0 {
+		return time.Time{}
+	}
+	return blockTime.Add(-retention)
+}
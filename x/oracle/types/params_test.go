@@ -2,10 +2,14 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -71,6 +75,106 @@ func TestParamsEqual(t *testing.T) {
 	err = p13.Validate()
 	require.Error(t, err)
 
+	// DefaultWhitelist was modified above through p10
+	whitelist := []asset.Pair{asset.Registry.Pair(denoms.BTC, denoms.USD)}
+	btcNibi := asset.NewPair(denoms.BTC, denoms.NIBI)
+	for _, derivedPairs := range [][]types.DerivedPair{
+		{types.NewDerivedPair("invalid", "")},
+		{types.NewDerivedPair(btcNibi, "invalid:denom")},
+		{types.NewDerivedPair(btcNibi, denoms.BTC)},
+		{types.NewDerivedPair(btcNibi, denoms.USD), types.NewDerivedPair(btcNibi, "")},
+		{types.NewDerivedPair(whitelist[0], "")},
+	} {
+		p14 := types.DefaultParams()
+		p14.Whitelist = whitelist
+		p14.DerivedPairs = derivedPairs
+		require.Error(t, p14.Validate(), derivedPairs)
+	}
+
+	p15 := types.DefaultParams()
+	p15.Whitelist = whitelist
+	p15.DerivedPairs = []types.DerivedPair{
+		types.NewDerivedPair(btcNibi, denoms.USD),
+		types.NewDerivedPair(btcNibi.Inverse(), ""),
+	}
+	require.NoError(t, p15.Validate())
+
+	// snapshot retention shorter than the TWAP lookback window
+	p16 := types.DefaultParams()
+	p16.Whitelist = whitelist
+	p16.SnapshotRetention = p16.TwapLookbackWindow - time.Second
+	require.Error(t, p16.Validate())
+	p16.SnapshotRetention = p16.TwapLookbackWindow
+	require.NoError(t, p16.Validate())
+	p16.SnapshotRetention = 0 // keeps every snapshot
+	require.NoError(t, p16.Validate())
+	p16.SnapshotRetention = -time.Second
+	require.Error(t, p16.Validate())
+
+	// per-pair overrides
+	btcUsd := asset.NewPair(denoms.BTC, denoms.USD)
+	lowThreshold := math.LegacyNewDecWithPrec(3, 1)
+	negativeBand := math.LegacyNewDec(-1)
+	for _, pairParams := range [][]types.PairParams{
+		{{Pair: "invalid"}},
+		{{Pair: btcUsd, VoteThreshold: &lowThreshold}},
+		{{Pair: btcUsd, RewardBand: &negativeBand}},
+		{{Pair: btcUsd, MinVoters: 1}, {Pair: btcUsd, MinVoters: 2}},
+	} {
+		p17 := types.DefaultParams()
+		p17.Whitelist = whitelist
+		p17.PairParams = pairParams
+		require.Error(t, p17.Validate(), pairParams)
+	}
+
+	p18 := types.DefaultParams()
+	p18.Whitelist = whitelist
+	band := math.LegacyNewDecWithPrec(1, 1)
+	p18.PairParams = []types.PairParams{{Pair: btcUsd, RewardBand: &band, MinVoters: 1}}
+	require.NoError(t, p18.Validate())
+	resolved := p18.ForPair(btcUsd)
+	require.Equal(t, band, *resolved.RewardBand)
+	require.Equal(t, uint64(1), resolved.MinVoters)
+	require.Equal(t, p18.VoteThreshold, *resolved.VoteThreshold)
+	require.Equal(t, p18.ExpirationBlocks, resolved.ExpirationBlocks)
+	require.Equal(t, p18.RewardBand, *p18.ForPair(btcUsd.Inverse()).RewardBand)
+	require.Equal(t, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_CLAMP, resolved.CircuitBreakerAction)
+
+	for _, ratio := range []math.LegacyDec{math.LegacyNewDec(-1), math.LegacyOneDec()} {
+		p19 := types.DefaultParams()
+		p19.Whitelist = whitelist
+		p19.MaxChangeRatio = ratio
+		require.Error(t, p19.Validate(), ratio)
+
+		p19 = types.DefaultParams()
+		p19.Whitelist = whitelist
+		p19.PairParams = []types.PairParams{{Pair: btcUsd, MaxChangeRatio: &ratio}}
+		require.Error(t, p19.Validate(), ratio)
+	}
+
+	p20 := types.DefaultParams()
+	p20.Whitelist = whitelist
+	p20.MaxChangeRatio = math.LegacyNewDecWithPrec(1, 1)
+	p20.PairParams = []types.PairParams{
+		{Pair: btcUsd, CircuitBreakerAction: types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT},
+	}
+	require.NoError(t, p20.Validate())
+	resolved = p20.ForPair(btcUsd)
+	require.Equal(t, p20.MaxChangeRatio, *resolved.MaxChangeRatio)
+	require.Equal(t, types.CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_HALT, resolved.CircuitBreakerAction)
+	p20.CircuitBreakerAction = types.CircuitBreakerAction(42)
+	require.Error(t, p20.Validate())
+
+	// oracle rewards
+	p21 := types.DefaultParams()
+	p21.Whitelist = whitelist
+	p21.MinFundRewards = sdk.Coins{sdk.NewInt64Coin(denoms.NIBI, 0)}
+	require.Error(t, p21.Validate())
+	p21.MinFundRewards = sdk.NewCoins()
+	require.NoError(t, p21.Validate())
+	p21.FeeRewardsVotePeriods = 0
+	require.Error(t, p21.Validate())
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.String())
 }
@@ -75,35 +75,142 @@ func (m *PriceSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// The performance of a validator in the oracle over one slash window, summed
+// over the vote periods of the window.
+type WindowPerformance struct {
+	// Bech32 operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Index of the slash window: the block height divided by the slash window.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Number of vote periods recorded in the window.
+	VotePeriods  uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+	WinCount     int64  `protobuf:"varint,4,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty"`
+	AbstainCount int64  `protobuf:"varint,5,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	MissCount    int64  `protobuf:"varint,6,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// Sum of the reward weights of the validator over the vote periods.
+	RewardWeight int64 `protobuf:"varint,7,opt,name=reward_weight,json=rewardWeight,proto3" json:"reward_weight,omitempty"`
+}
+
+func (m *WindowPerformance) Reset()         { *m = WindowPerformance{} }
+func (m *WindowPerformance) String() string { return proto.CompactTextString(m) }
+func (*WindowPerformance) ProtoMessage()    {}
+func (*WindowPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{1}
+}
+func (m *WindowPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowPerformance.Merge(m, src)
+}
+func (m *WindowPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowPerformance proto.InternalMessageInfo
+
+func (m *WindowPerformance) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *WindowPerformance) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *WindowPerformance) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+func (m *WindowPerformance) GetWinCount() int64 {
+	if m != nil {
+		return m.WinCount
+	}
+	return 0
+}
+
+func (m *WindowPerformance) GetAbstainCount() int64 {
+	if m != nil {
+		return m.AbstainCount
+	}
+	return 0
+}
+
+func (m *WindowPerformance) GetMissCount() int64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *WindowPerformance) GetRewardWeight() int64 {
+	if m != nil {
+		return m.RewardWeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1.PriceSnapshot")
+	proto.RegisterType((*WindowPerformance)(nil), "nibiru.oracle.v1.WindowPerformance")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/state.proto", fileDescriptor_125e6c5a6e45c0d0) }
 
 var fileDescriptor_125e6c5a6e45c0d0 = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xf3, 0x30,
-	0x18, 0x80, 0xdb, 0x6f, 0x9f, 0x82, 0x9d, 0x82, 0x14, 0x0f, 0x63, 0xcc, 0x6c, 0xee, 0x20, 0x3b,
-	0x68, 0x42, 0xf1, 0xe6, 0x71, 0x0e, 0xf1, 0xa2, 0x8c, 0x79, 0x13, 0x41, 0xde, 0xc6, 0xd0, 0x05,
-	0x97, 0xbc, 0xa1, 0xc9, 0x86, 0xfb, 0x17, 0xfe, 0xac, 0x1d, 0x77, 0x12, 0xf1, 0x30, 0x64, 0xfb,
-	0x07, 0xfe, 0x02, 0x69, 0x53, 0x44, 0xf0, 0xe0, 0xa9, 0x25, 0x4f, 0xf2, 0x3c, 0xc9, 0x1b, 0xb5,
-	0xb4, 0x4c, 0x65, 0x3e, 0x65, 0x98, 0x03, 0x9f, 0x08, 0x36, 0x4b, 0x98, 0x75, 0xe0, 0x04, 0x35,
-	0x39, 0x3a, 0x8c, 0xf7, 0x3d, 0xa5, 0x9e, 0xd2, 0x59, 0xd2, 0x3c, 0xc8, 0x30, 0xc3, 0x12, 0xb2,
-	0xe2, 0xcf, 0xef, 0x6b, 0xb6, 0x32, 0xc4, 0x6c, 0x22, 0x18, 0x18, 0xc9, 0x40, 0x6b, 0x74, 0xe0,
-	0x24, 0x6a, 0x5b, 0xd1, 0xc3, 0x5f, 0x8d, 0xca, 0xe7, 0x31, 0xe1, 0x68, 0x15, 0x5a, 0x96, 0x82,
-	0x2d, 0x60, 0x2a, 0x1c, 0x24, 0x8c, 0xa3, 0xd4, 0x9e, 0x77, 0x5f, 0xc3, 0x68, 0x6f, 0x98, 0x4b,
-	0x2e, 0x6e, 0x35, 0x18, 0x3b, 0x46, 0x17, 0xdf, 0x47, 0xff, 0x0d, 0xc8, 0xbc, 0x11, 0x76, 0xc2,
-	0xde, 0x4e, 0xff, 0x6a, 0xb1, 0x6a, 0x07, 0xef, 0xab, 0x76, 0x92, 0x49, 0x37, 0x9e, 0xa6, 0x94,
-	0xa3, 0x62, 0x37, 0x65, 0xf1, 0x62, 0x0c, 0x52, 0xb3, 0xaa, 0xfe, 0xcc, 0x38, 0x2a, 0x85, 0x9a,
-	0x81, 0xb5, 0xc2, 0xd1, 0x21, 0xc8, 0xfc, 0x73, 0xd5, 0xae, 0xcf, 0x41, 0x4d, 0xce, 0xbb, 0x85,
-	0xae, 0x3b, 0x2a, 0xad, 0xf1, 0x20, 0xda, 0x32, 0x45, 0xae, 0xf1, 0xaf, 0xd4, 0xd3, 0x4a, 0x7f,
-	0xfc, 0x43, 0x5f, 0xdd, 0xd8, 0x7f, 0x4e, 0xed, 0xe3, 0x13, 0x73, 0x73, 0x23, 0x2c, 0x1d, 0x08,
-	0x3e, 0xf2, 0x87, 0xe3, 0xa3, 0x68, 0xd7, 0x49, 0x25, 0xac, 0x03, 0x65, 0x1e, 0x94, 0x6d, 0xd4,
-	0x3a, 0x61, 0xaf, 0x36, 0xaa, 0x7f, 0xaf, 0x5d, 0xdb, 0xfe, 0xe5, 0x62, 0x4d, 0xc2, 0xe5, 0x9a,
-	0x84, 0x1f, 0x6b, 0x12, 0xbe, 0x6c, 0x48, 0xb0, 0xdc, 0x90, 0xe0, 0x6d, 0x43, 0x82, 0xbb, 0x93,
-	0xbf, 0x9e, 0x52, 0x8d, 0xb2, 0xac, 0xa6, 0xdb, 0xe5, 0x9c, 0xce, 0xbe, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x21, 0xeb, 0xd1, 0x23, 0xcc, 0x01, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0xd6, 0x15, 0xea, 0x6e, 0x12, 0x44, 0x08, 0x45, 0xa3, 0x4b, 0x47, 0x91, 0xd0,
+	0x0e, 0x10, 0xab, 0xe2, 0xc6, 0x71, 0x9b, 0x10, 0x17, 0x50, 0x15, 0x0e, 0x93, 0x10, 0x52, 0xf5,
+	0x8f, 0x63, 0x52, 0x8b, 0xda, 0xff, 0xc8, 0x76, 0x1b, 0xf6, 0x16, 0x3c, 0xd6, 0x8e, 0x3b, 0x21,
+	0xc4, 0xa1, 0x42, 0xed, 0x1b, 0xec, 0x09, 0x50, 0x6c, 0xab, 0x20, 0x71, 0xd8, 0x29, 0xf1, 0xf7,
+	0x7d, 0xfe, 0x7d, 0xff, 0xc4, 0x26, 0x43, 0x25, 0x0a, 0xa1, 0x97, 0x14, 0x35, 0xb0, 0x05, 0xa7,
+	0xab, 0x09, 0x35, 0x16, 0x2c, 0xcf, 0x6a, 0x8d, 0x16, 0xe3, 0x87, 0xde, 0xcd, 0xbc, 0x9b, 0xad,
+	0x26, 0x47, 0x8f, 0x2b, 0xac, 0xd0, 0x99, 0xb4, 0x7d, 0xf3, 0xb9, 0xa3, 0x61, 0x85, 0x58, 0x2d,
+	0x38, 0x85, 0x5a, 0x50, 0x50, 0x0a, 0x2d, 0x58, 0x81, 0xca, 0x04, 0xf7, 0xf8, 0xbf, 0x8e, 0xc0,
+	0xf3, 0x76, 0xca, 0xd0, 0x48, 0x34, 0xb4, 0x00, 0xd3, 0x9a, 0x05, 0xb7, 0x30, 0xa1, 0x0c, 0x85,
+	0xf2, 0xfe, 0xf8, 0x47, 0x44, 0x0e, 0xa7, 0x5a, 0x30, 0xfe, 0x51, 0x41, 0x6d, 0xe6, 0x68, 0xe3,
+	0xcf, 0xa4, 0x5b, 0x83, 0xd0, 0x49, 0x74, 0x12, 0x9d, 0xf6, 0xcf, 0xde, 0x5d, 0xaf, 0x47, 0x9d,
+	0x5f, 0xeb, 0xd1, 0xa4, 0x12, 0x76, 0xbe, 0x2c, 0x32, 0x86, 0x92, 0x7e, 0x70, 0x8d, 0xe7, 0x73,
+	0x10, 0x8a, 0x86, 0xf6, 0x6f, 0x94, 0xa1, 0x94, 0xa8, 0x28, 0x18, 0xc3, 0x6d, 0x36, 0x05, 0xa1,
+	0x6f, 0xd7, 0xa3, 0xc1, 0x15, 0xc8, 0xc5, 0x9b, 0x71, 0x8b, 0x1b, 0xe7, 0x8e, 0x1a, 0x5f, 0x90,
+	0xfd, 0xba, 0xad, 0x4b, 0xee, 0x39, 0x7c, 0x16, 0xf0, 0x2f, 0xfe, 0xc1, 0x87, 0x89, 0xfd, 0xe3,
+	0x95, 0x29, 0xbf, 0x52, 0x7b, 0x55, 0x73, 0x93, 0x5d, 0x70, 0x96, 0xfb, 0xcd, 0xf1, 0x33, 0x72,
+	0x60, 0x85, 0xe4, 0xc6, 0x82, 0xac, 0x67, 0xd2, 0x24, 0x7b, 0x27, 0xd1, 0xe9, 0x5e, 0x3e, 0xd8,
+	0x69, 0xef, 0xcd, 0xf8, 0x36, 0x22, 0x8f, 0x2e, 0x85, 0x2a, 0xb1, 0x99, 0x72, 0xfd, 0x05, 0xb5,
+	0x04, 0xc5, 0x78, 0x3c, 0x24, 0xfd, 0x15, 0x2c, 0x44, 0x09, 0x16, 0xc3, 0x17, 0xe6, 0x7f, 0x85,
+	0xf8, 0x09, 0xe9, 0x35, 0x6e, 0x8b, 0x9b, 0xae, 0x9b, 0x87, 0x55, 0x5b, 0xb7, 0x42, 0xcb, 0x67,
+	0x35, 0xd7, 0x02, 0x4b, 0x5f, 0xd7, 0xcd, 0x07, 0xad, 0x36, 0xf5, 0x52, 0xfc, 0x94, 0xf4, 0x1b,
+	0xa1, 0x66, 0x0c, 0x97, 0xca, 0x26, 0x5d, 0x37, 0xce, 0x83, 0x46, 0xa8, 0xf3, 0x76, 0x1d, 0x3f,
+	0x27, 0x87, 0x50, 0x18, 0x0b, 0xbb, 0xc0, 0xbe, 0x0b, 0x1c, 0x04, 0xd1, 0x87, 0x8e, 0x09, 0x91,
+	0xc2, 0x98, 0x90, 0xe8, 0xb9, 0x44, 0xbf, 0x55, 0x76, 0x0c, 0xcd, 0x1b, 0xd0, 0xe5, 0xac, 0xe1,
+	0xa2, 0x9a, 0xdb, 0xe4, 0xbe, 0x67, 0x78, 0xf1, 0xd2, 0x69, 0x67, 0x6f, 0xaf, 0x37, 0x69, 0x74,
+	0xb3, 0x49, 0xa3, 0xdf, 0x9b, 0x34, 0xfa, 0xbe, 0x4d, 0x3b, 0x37, 0xdb, 0xb4, 0xf3, 0x73, 0x9b,
+	0x76, 0x3e, 0xbd, 0xbc, 0xeb, 0xfc, 0xc2, 0xfd, 0x71, 0xbf, 0xba, 0xe8, 0xb9, 0xcb, 0xf1, 0xfa,
+	0xcf, 0x00, 0x78, 0xf0, 0x99, 0x53, 0xc1, 0x02, 0x00, 0x00,
 }
 
 func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
@@ -154,6 +261,66 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WindowPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardWeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RewardWeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AbstainCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AbstainCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -181,6 +348,37 @@ func (m *PriceSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *WindowPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovState(uint64(m.Window))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovState(uint64(m.VotePeriods))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovState(uint64(m.WinCount))
+	}
+	if m.AbstainCount != 0 {
+		n += 1 + sovState(uint64(m.AbstainCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovState(uint64(m.MissCount))
+	}
+	if m.RewardWeight != 0 {
+		n += 1 + sovState(uint64(m.RewardWeight))
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -324,6 +522,202 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WindowPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+			}
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			m.RewardWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -199,6 +201,96 @@ func (m *MsgAggregateExchangeRateVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAggregateExchangeRateVoteResponse proto.InternalMessageInfo
 
+// MsgAggregateExchangeRateDirectVote represents a message to submit an
+// aggregate exchange rate vote in a single transaction, without the
+// commit-reveal scheme of "MsgAggregateExchangeRatePrevote" and
+// "MsgAggregateExchangeRateVote". The vote counts toward the current vote
+// period.
+type MsgAggregateExchangeRateDirectVote struct {
+	ExchangeRates string `protobuf:"bytes,1,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
+	// Feeder is the Bech32 address of the price feeder. A validator may
+	// specify multiple price feeders by delegating them consent. The validator
+	// address is also a valid feeder by default.
+	Feeder string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	// Validator is the Bech32 address to which the vote will be credited.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRateDirectVote) Reset()         { *m = MsgAggregateExchangeRateDirectVote{} }
+func (m *MsgAggregateExchangeRateDirectVote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRateDirectVote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRateDirectVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{4}
+}
+func (m *MsgAggregateExchangeRateDirectVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRateDirectVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateDirectVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRateDirectVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateDirectVote.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRateDirectVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRateDirectVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateDirectVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateDirectVote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRateDirectVoteResponse defines the
+// Msg/AggregateExchangeRateDirectVote response type.
+type MsgAggregateExchangeRateDirectVoteResponse struct {
+}
+
+func (m *MsgAggregateExchangeRateDirectVoteResponse) Reset() {
+	*m = MsgAggregateExchangeRateDirectVoteResponse{}
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAggregateExchangeRateDirectVoteResponse) ProtoMessage() {}
+func (*MsgAggregateExchangeRateDirectVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{5}
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRateDirectVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRateDirectVoteResponse.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRateDirectVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRateDirectVoteResponse proto.InternalMessageInfo
+
 // MsgDelegateFeedConsent represents a message to delegate oracle voting rights
 // to another address.
 type MsgDelegateFeedConsent struct {
@@ -210,7 +302,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{4}
+	return fileDescriptor_11e362c65eb610f4, []int{6}
 }
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +340,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{5}
+	return fileDescriptor_11e362c65eb610f4, []int{7}
 }
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,13 +388,19 @@ type MsgEditOracleParams struct {
 	MinVoters          *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_voters,json=minVoters,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_voters,omitempty"`
 	// VoteThreshold: [cosmossdk.io/math.LegacyDec] TODO:
 	ValidatorFeeRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio,omitempty"`
+	DerivedPairs      []DerivedPair                           `protobuf:"bytes,12,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs"`
+	SnapshotRetention *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=snapshot_retention,json=snapshotRetention,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"snapshot_retention,omitempty"`
+	PairParams        []PairParams                            `protobuf:"bytes,14,rep,name=pair_params,json=pairParams,proto3" json:"pair_params"`
+	MaxChangeRatio    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_change_ratio,json=maxChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_ratio,omitempty"`
+	// Unspecified keeps the current value.
+	CircuitBreakerAction CircuitBreakerAction `protobuf:"varint,16,opt,name=circuit_breaker_action,json=circuitBreakerAction,proto3,enum=nibiru.oracle.v1.CircuitBreakerAction" json:"circuit_breaker_action,omitempty"`
 }
 
 func (m *MsgEditOracleParams) Reset()         { *m = MsgEditOracleParams{} }
 func (m *MsgEditOracleParams) String() string { return proto.CompactTextString(m) }
 func (*MsgEditOracleParams) ProtoMessage()    {}
 func (*MsgEditOracleParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{6}
+	return fileDescriptor_11e362c65eb610f4, []int{8}
 }
 func (m *MsgEditOracleParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,6 +443,27 @@ func (m *MsgEditOracleParams) GetWhitelist() []string {
 	return nil
 }
 
+func (m *MsgEditOracleParams) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
+func (m *MsgEditOracleParams) GetPairParams() []PairParams {
+	if m != nil {
+		return m.PairParams
+	}
+	return nil
+}
+
+func (m *MsgEditOracleParams) GetCircuitBreakerAction() CircuitBreakerAction {
+	if m != nil {
+		return m.CircuitBreakerAction
+	}
+	return CircuitBreakerAction_CIRCUIT_BREAKER_ACTION_UNSPECIFIED
+}
+
 // MsgEditOracleParamsResponse defines the Msg/EditOracleParams response
 // type.
 type MsgEditOracleParamsResponse struct {
@@ -355,7 +474,7 @@ func (m *MsgEditOracleParamsResponse) Reset()         { *m = MsgEditOracleParams
 func (m *MsgEditOracleParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditOracleParamsResponse) ProtoMessage()    {}
 func (*MsgEditOracleParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_11e362c65eb610f4, []int{7}
+	return fileDescriptor_11e362c65eb610f4, []int{9}
 }
 func (m *MsgEditOracleParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,79 +510,309 @@ func (m *MsgEditOracleParamsResponse) GetNewParams() *Params {
 	return nil
 }
 
+// MsgFundOracleRewards: Funds the rewards of oracle voters from the account of
+// the sender.
+type MsgFundOracleRewards struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Rewards: Total coins to pay out. Only the part that splits evenly over
+	// the vote periods is taken from the sender.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// VotePeriods: Number of vote periods over which the rewards are paid out.
+	VotePeriods uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+}
+
+func (m *MsgFundOracleRewards) Reset()         { *m = MsgFundOracleRewards{} }
+func (m *MsgFundOracleRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgFundOracleRewards) ProtoMessage()    {}
+func (*MsgFundOracleRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{10}
+}
+func (m *MsgFundOracleRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundOracleRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundOracleRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundOracleRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundOracleRewards.Merge(m, src)
+}
+func (m *MsgFundOracleRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundOracleRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundOracleRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundOracleRewards proto.InternalMessageInfo
+
+func (m *MsgFundOracleRewards) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFundOracleRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *MsgFundOracleRewards) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+// MsgFundOracleRewardsResponse defines the Msg/FundOracleRewards response
+// type.
+type MsgFundOracleRewardsResponse struct {
+	// Rewards: The rewards entry created for the funds.
+	Rewards Rewards `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *MsgFundOracleRewardsResponse) Reset()         { *m = MsgFundOracleRewardsResponse{} }
+func (m *MsgFundOracleRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundOracleRewardsResponse) ProtoMessage()    {}
+func (*MsgFundOracleRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{11}
+}
+func (m *MsgFundOracleRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundOracleRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundOracleRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundOracleRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundOracleRewardsResponse.Merge(m, src)
+}
+func (m *MsgFundOracleRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundOracleRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundOracleRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundOracleRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgFundOracleRewardsResponse) GetRewards() Rewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return Rewards{}
+}
+
+// MsgResetCircuitBreaker: Resumes price updates for a pair that the circuit
+// breaker halted.
+type MsgResetCircuitBreaker struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+}
+
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{12}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgResetCircuitBreakerResponse defines the Msg/ResetCircuitBreaker response
+// type.
+type MsgResetCircuitBreakerResponse struct {
+}
+
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{13}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRateVoteResponse")
+	proto.RegisterType((*MsgAggregateExchangeRateDirectVote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRateDirectVote")
+	proto.RegisterType((*MsgAggregateExchangeRateDirectVoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRateDirectVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
 	proto.RegisterType((*MsgEditOracleParams)(nil), "nibiru.oracle.v1.MsgEditOracleParams")
 	proto.RegisterType((*MsgEditOracleParamsResponse)(nil), "nibiru.oracle.v1.MsgEditOracleParamsResponse")
+	proto.RegisterType((*MsgFundOracleRewards)(nil), "nibiru.oracle.v1.MsgFundOracleRewards")
+	proto.RegisterType((*MsgFundOracleRewardsResponse)(nil), "nibiru.oracle.v1.MsgFundOracleRewardsResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "nibiru.oracle.v1.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "nibiru.oracle.v1.MsgResetCircuitBreakerResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 915 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xd7, 0x4d, 0xba, 0x64, 0x67, 0x49, 0x9b, 0x7a, 0xd3, 0xc8, 0xd9, 0x06, 0x3b, 0xb8,
-	0x10, 0x12, 0x89, 0xb5, 0x49, 0x90, 0x40, 0xf4, 0x04, 0x69, 0x1b, 0x09, 0x89, 0xa5, 0xc1, 0x82,
-	0x20, 0x71, 0xc0, 0xcc, 0xae, 0x5f, 0x6c, 0x2b, 0x5e, 0x8f, 0x35, 0x33, 0xcd, 0xa6, 0x57, 0xc4,
-	0x01, 0x6e, 0x48, 0x3d, 0x71, 0xcb, 0x07, 0x40, 0xe2, 0x6b, 0xf4, 0x58, 0x89, 0x0b, 0xe2, 0xb0,
-	0x42, 0x09, 0x42, 0x9c, 0x38, 0xec, 0x27, 0x40, 0x33, 0x1e, 0xbb, 0xdb, 0xcd, 0xb6, 0xcd, 0xee,
-	0x29, 0xce, 0xbc, 0xff, 0xfc, 0xde, 0xff, 0x3d, 0x7b, 0xde, 0x2c, 0x5a, 0x4d, 0xe3, 0x4e, 0x4c,
-	0x1f, 0xba, 0x84, 0xe2, 0x6e, 0x02, 0xee, 0xf1, 0xb6, 0xcb, 0x4f, 0x9c, 0x8c, 0x12, 0x4e, 0xf4,
-	0xa5, 0x3c, 0xe4, 0xe4, 0x21, 0xe7, 0x78, 0xbb, 0xb9, 0x1c, 0x92, 0x90, 0xc8, 0xa0, 0x2b, 0x9e,
-	0x72, 0x5d, 0x73, 0x2d, 0x24, 0x24, 0x4c, 0xc0, 0xc5, 0x59, 0xec, 0xe2, 0x34, 0x25, 0x1c, 0xf3,
-	0x98, 0xa4, 0x4c, 0x45, 0xdf, 0xb8, 0x90, 0x40, 0xf1, 0x64, 0xd8, 0xfe, 0x4d, 0x43, 0x56, 0x9b,
-	0x85, 0x9f, 0x84, 0x21, 0x85, 0x10, 0x73, 0xb8, 0x7f, 0xd2, 0x8d, 0x70, 0x1a, 0x82, 0x87, 0x39,
-	0xec, 0x53, 0x38, 0x26, 0x1c, 0xf4, 0xdb, 0x68, 0x3e, 0xc2, 0x2c, 0x32, 0xb4, 0x75, 0x6d, 0xb3,
-	0xb6, 0x7b, 0x7d, 0x38, 0xb0, 0xea, 0x8f, 0x70, 0x2f, 0xb9, 0x63, 0x8b, 0x55, 0xdb, 0x93, 0x41,
-	0x7d, 0x0b, 0x55, 0x0f, 0x01, 0x02, 0xa0, 0xc6, 0x15, 0x29, 0xbb, 0x31, 0x1c, 0x58, 0x8b, 0xb9,
-	0x2c, 0x5f, 0xb7, 0x3d, 0x25, 0xd0, 0x77, 0x50, 0xed, 0x18, 0x27, 0x71, 0x80, 0x39, 0xa1, 0xc6,
-	0x9c, 0x54, 0x2f, 0x0f, 0x07, 0xd6, 0x52, 0xae, 0x2e, 0x43, 0xb6, 0xf7, 0x4c, 0x76, 0x67, 0xe1,
-	0xc7, 0x53, 0xab, 0xf2, 0xef, 0xa9, 0x55, 0xb1, 0xb7, 0xd0, 0x3b, 0xaf, 0x30, 0xec, 0x01, 0xcb,
-	0x48, 0xca, 0xc0, 0xfe, 0x4f, 0x43, 0x6b, 0x2f, 0xd2, 0x1e, 0xa8, 0xca, 0x18, 0x4e, 0xf8, 0xc5,
-	0xca, 0xc4, 0xaa, 0xed, 0xc9, 0xa0, 0xfe, 0x31, 0xba, 0x06, 0x6a, 0xa3, 0x4f, 0x31, 0x07, 0xa6,
-	0x2a, 0x5c, 0x1d, 0x0e, 0xac, 0x9b, 0xb9, 0xfc, 0xf9, 0xb8, 0xed, 0x2d, 0xc2, 0x48, 0x26, 0x36,
-	0xd2, 0x9b, 0xb9, 0xa9, 0x7a, 0x33, 0x3f, 0x6d, 0x6f, 0x36, 0xd0, 0x5b, 0x2f, 0xab, 0xb7, 0x6c,
-	0xcc, 0x0f, 0x1a, 0x5a, 0x69, 0xb3, 0xf0, 0x1e, 0x24, 0x52, 0xb7, 0x07, 0x10, 0xdc, 0x15, 0x81,
-	0x94, 0xeb, 0x2e, 0x5a, 0x20, 0x19, 0x50, 0x99, 0x3f, 0x6f, 0x4b, 0x63, 0x38, 0xb0, 0xae, 0xe7,
-	0xf9, 0x8b, 0x88, 0xed, 0x95, 0x22, 0xb1, 0x21, 0x50, 0x1c, 0xd5, 0x98, 0x91, 0x0d, 0x45, 0xc4,
-	0xf6, 0x4a, 0xd1, 0x88, 0xdd, 0x75, 0x64, 0x4e, 0x76, 0x51, 0x1a, 0xfd, 0xa7, 0x8a, 0x1a, 0x6d,
-	0x16, 0xde, 0x0f, 0x62, 0xfe, 0x40, 0x7e, 0xb6, 0xfb, 0x98, 0xe2, 0x1e, 0xd3, 0x57, 0x50, 0x95,
-	0x41, 0x2a, 0x3a, 0x2a, 0x3d, 0x7a, 0xea, 0x3f, 0xfd, 0x01, 0xaa, 0x8b, 0x2f, 0xc0, 0xcf, 0x80,
-	0xc6, 0x24, 0x50, 0x7e, 0x9c, 0x27, 0x03, 0x4b, 0xfb, 0x73, 0x60, 0x6d, 0x84, 0x31, 0x8f, 0x1e,
-	0x76, 0x9c, 0x2e, 0xe9, 0xb9, 0x5d, 0xc2, 0x7a, 0x84, 0xa9, 0x3f, 0x2d, 0x16, 0x1c, 0xb9, 0xfc,
-	0x51, 0x06, 0xcc, 0xf9, 0x34, 0xe5, 0x1e, 0x12, 0x88, 0x7d, 0x49, 0xd0, 0xbf, 0x42, 0xd7, 0x24,
-	0x90, 0x47, 0x14, 0x58, 0x44, 0x92, 0x40, 0xbd, 0xc2, 0x69, 0x98, 0xf7, 0xa0, 0xeb, 0x2d, 0x0a,
-	0xca, 0x97, 0x05, 0x44, 0xf8, 0xa4, 0xd0, 0xc7, 0x34, 0xf0, 0x3b, 0x38, 0x0d, 0xd4, 0x8b, 0x9e,
-	0x96, 0x89, 0x72, 0xc4, 0x2e, 0x4e, 0x03, 0xdd, 0x46, 0xb5, 0x7e, 0x14, 0x73, 0x48, 0x62, 0xc6,
-	0x8d, 0xab, 0xeb, 0x73, 0x9b, 0xb5, 0xdd, 0x79, 0x81, 0xf3, 0x9e, 0x2d, 0x8b, 0x5a, 0x58, 0x82,
-	0x59, 0xe4, 0x1f, 0x52, 0xdc, 0x15, 0x33, 0xc2, 0xa8, 0xce, 0x56, 0x8b, 0xa4, 0xec, 0x29, 0x88,
-	0xfe, 0x05, 0x7a, 0x3d, 0xc7, 0xf6, 0xe3, 0x34, 0x20, 0x7d, 0xe3, 0xb5, 0x99, 0x9a, 0x5e, 0x97,
-	0x8c, 0xaf, 0x25, 0x42, 0xf7, 0xd1, 0x72, 0x2f, 0x4e, 0x7d, 0xf9, 0x89, 0x8b, 0x77, 0x59, 0xa0,
-	0x17, 0x66, 0xf2, 0x7b, 0xa3, 0x17, 0xa7, 0x07, 0x02, 0xb5, 0x0f, 0x54, 0x25, 0xf8, 0x0e, 0x2d,
-	0xf3, 0x3e, 0xce, 0xfc, 0x84, 0x90, 0xa3, 0x0e, 0xee, 0x1e, 0x15, 0x09, 0x6a, 0x33, 0x79, 0xd7,
-	0x05, 0xeb, 0x33, 0x85, 0x52, 0x19, 0xda, 0x08, 0xc9, 0x12, 0x08, 0x07, 0xca, 0x0c, 0x34, 0x13,
-	0xb7, 0x26, 0x8c, 0x4b, 0x80, 0xfe, 0x2d, 0x6a, 0x94, 0x07, 0xde, 0x3f, 0x04, 0x39, 0x69, 0x62,
-	0x62, 0xd4, 0x67, 0x6b, 0x48, 0x89, 0xda, 0x03, 0x31, 0x1c, 0x62, 0x62, 0x1f, 0xa0, 0x5b, 0x13,
-	0xce, 0x59, 0x71, 0x0e, 0xf5, 0x0f, 0x11, 0x4a, 0xa1, 0xef, 0x67, 0x72, 0x55, 0x9e, 0xb9, 0xfa,
-	0x8e, 0xe1, 0x8c, 0x5f, 0x50, 0x8e, 0xda, 0x55, 0x4b, 0xa1, 0x9f, 0x3f, 0xee, 0xfc, 0x74, 0x15,
-	0xcd, 0xb5, 0x59, 0xa8, 0xff, 0xaa, 0xa1, 0xb5, 0x97, 0x5e, 0x32, 0xdb, 0x17, 0x69, 0xaf, 0x18,
-	0xf3, 0xcd, 0x8f, 0xa6, 0xde, 0x52, 0xce, 0x15, 0xf3, 0xfb, 0xdf, 0xff, 0x7e, 0x7c, 0xc5, 0xb0,
-	0x57, 0xdc, 0xe7, 0xaf, 0xc7, 0x4c, 0xb9, 0x39, 0xd5, 0xd0, 0xea, 0x8b, 0xaf, 0x0d, 0xe7, 0xf2,
-	0x89, 0x85, 0xbe, 0xf9, 0xc1, 0x74, 0xfa, 0xd2, 0xe5, 0x2d, 0xe9, 0xf2, 0xa6, 0xdd, 0x18, 0x73,
-	0x29, 0x2d, 0xfe, 0xa2, 0xa1, 0xc6, 0xa4, 0x01, 0xbe, 0x39, 0x31, 0xd9, 0x04, 0x65, 0xf3, 0xbd,
-	0xcb, 0x2a, 0x4b, 0x43, 0x1b, 0xd2, 0xd0, 0xba, 0x6d, 0x8e, 0x19, 0xca, 0x2f, 0xaf, 0x56, 0x31,
-	0xe2, 0xf5, 0xc7, 0x1a, 0x5a, 0xba, 0x30, 0xb3, 0xdf, 0x9e, 0x98, 0x6e, 0x5c, 0xd6, 0x6c, 0x5d,
-	0x4a, 0x56, 0x5a, 0xda, 0x92, 0x96, 0x6e, 0xdb, 0x6f, 0x8e, 0x59, 0x82, 0x20, 0xe6, 0xad, 0xfc,
-	0xb9, 0x95, 0x7f, 0xb6, 0xbb, 0x7b, 0x4f, 0xce, 0x4c, 0xed, 0xe9, 0x99, 0xa9, 0xfd, 0x75, 0x66,
-	0x6a, 0x3f, 0x9f, 0x9b, 0x95, 0xa7, 0xe7, 0x66, 0xe5, 0x8f, 0x73, 0xb3, 0xf2, 0xcd, 0xbb, 0x23,
-	0x07, 0xe7, 0x73, 0x89, 0xb9, 0x1b, 0xe1, 0x38, 0x2d, 0x90, 0x27, 0x05, 0x54, 0x1e, 0xa1, 0x4e,
-	0x55, 0xfe, 0x74, 0x7a, 0xff, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0xca, 0xa5, 0x9a, 0xbc,
-	0x09, 0x00, 0x00,
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x9b, 0x90, 0x26, 0xb3, 0x49, 0x9a, 0x38, 0x69, 0xe4, 0x6c, 0xd3, 0x75, 0xea, 0x96,
+	0x90, 0xa2, 0xae, 0xdd, 0x04, 0x04, 0x6a, 0xc5, 0x81, 0x26, 0x69, 0x04, 0x12, 0x4b, 0x83, 0x05,
+	0xe5, 0x87, 0x00, 0x33, 0x6b, 0x4f, 0xbd, 0xa3, 0xec, 0x7a, 0x56, 0x33, 0x93, 0x6c, 0x7a, 0x42,
+	0x42, 0x1c, 0x38, 0x70, 0xa8, 0xd4, 0x13, 0x27, 0x7a, 0x46, 0x48, 0x5c, 0xe1, 0x3f, 0xe8, 0x8d,
+	0x4a, 0x5c, 0x50, 0x0f, 0x0b, 0x6a, 0x39, 0x70, 0xe2, 0x90, 0x23, 0x27, 0x34, 0x3f, 0xec, 0x4d,
+	0x77, 0x9d, 0x66, 0xb3, 0x12, 0xa7, 0x38, 0xf3, 0xbe, 0xf9, 0xde, 0xf7, 0x9e, 0xc7, 0xdf, 0xbc,
+	0x05, 0x0b, 0x09, 0xae, 0x62, 0xba, 0xeb, 0x11, 0x0a, 0xc3, 0x3a, 0xf2, 0xf6, 0x56, 0x3d, 0xbe,
+	0xef, 0x36, 0x29, 0xe1, 0xc4, 0x9c, 0x56, 0x21, 0x57, 0x85, 0xdc, 0xbd, 0xd5, 0xe2, 0x5c, 0x4c,
+	0x62, 0x22, 0x83, 0x9e, 0x78, 0x52, 0xb8, 0xe2, 0x62, 0x4c, 0x48, 0x5c, 0x47, 0x1e, 0x6c, 0x62,
+	0x0f, 0x26, 0x09, 0xe1, 0x90, 0x63, 0x92, 0x30, 0x1d, 0x3d, 0xdf, 0x93, 0x40, 0xf3, 0xa9, 0x70,
+	0x29, 0x24, 0xac, 0x41, 0x98, 0x57, 0x85, 0x4c, 0x04, 0xab, 0x88, 0xc3, 0x55, 0x2f, 0x24, 0x38,
+	0x51, 0x71, 0xe7, 0x27, 0x03, 0xd8, 0x15, 0x16, 0xdf, 0x88, 0x63, 0x8a, 0x62, 0xc8, 0xd1, 0xcd,
+	0xfd, 0xb0, 0x06, 0x93, 0x18, 0xf9, 0x90, 0xa3, 0x6d, 0x8a, 0xf6, 0x08, 0x47, 0xe6, 0x45, 0x30,
+	0x52, 0x83, 0xac, 0x66, 0x19, 0x4b, 0xc6, 0xca, 0xf8, 0xfa, 0x99, 0x83, 0xb6, 0x5d, 0xb8, 0x0b,
+	0x1b, 0xf5, 0xeb, 0x8e, 0x58, 0x75, 0x7c, 0x19, 0x34, 0x2f, 0x83, 0xd1, 0x3b, 0x08, 0x45, 0x88,
+	0x5a, 0xa7, 0x24, 0x6c, 0xe6, 0xa0, 0x6d, 0x4f, 0x2a, 0x98, 0x5a, 0x77, 0x7c, 0x0d, 0x30, 0xd7,
+	0xc0, 0xf8, 0x1e, 0xac, 0xe3, 0x08, 0x72, 0x42, 0xad, 0x61, 0x89, 0x9e, 0x3b, 0x68, 0xdb, 0xd3,
+	0x0a, 0x9d, 0x85, 0x1c, 0xbf, 0x03, 0xbb, 0x3e, 0xf6, 0xcd, 0x03, 0x7b, 0xe8, 0xef, 0x07, 0xf6,
+	0x90, 0x73, 0x19, 0xbc, 0x74, 0x8c, 0x60, 0x1f, 0xb1, 0x26, 0x49, 0x18, 0x72, 0xfe, 0x31, 0xc0,
+	0xe2, 0x51, 0xd8, 0xdb, 0xba, 0x32, 0x06, 0xeb, 0xbc, 0xb7, 0x32, 0xb1, 0xea, 0xf8, 0x32, 0x68,
+	0xbe, 0x09, 0xa6, 0x90, 0xde, 0x18, 0x50, 0xc8, 0x11, 0xd3, 0x15, 0x2e, 0x1c, 0xb4, 0xed, 0xb3,
+	0x0a, 0xfe, 0x6c, 0xdc, 0xf1, 0x27, 0xd1, 0xa1, 0x4c, 0xec, 0x50, 0x6f, 0x86, 0x4f, 0xd4, 0x9b,
+	0x91, 0x93, 0xf6, 0x66, 0x19, 0x5c, 0x7a, 0x5e, 0xbd, 0x59, 0x63, 0x7e, 0x35, 0x80, 0x73, 0x14,
+	0x70, 0x13, 0x53, 0x14, 0x72, 0xd9, 0x9e, 0xde, 0xca, 0x8d, 0x81, 0x2b, 0xff, 0x9f, 0x4f, 0xc5,
+	0x15, 0xf0, 0xf2, 0xf1, 0x05, 0x65, 0xf5, 0x7f, 0x6d, 0x80, 0xf9, 0x0a, 0x8b, 0x37, 0x51, 0x5d,
+	0xa2, 0xb7, 0x10, 0x8a, 0x36, 0x44, 0x20, 0xe1, 0xa6, 0x07, 0xc6, 0x48, 0x13, 0x51, 0xa9, 0x42,
+	0x55, 0x3b, 0x7b, 0xd0, 0xb6, 0xcf, 0x28, 0x15, 0x69, 0xc4, 0xf1, 0x33, 0x90, 0xd8, 0x10, 0x69,
+	0x1e, 0xeb, 0x54, 0xf7, 0x86, 0x34, 0xe2, 0xf8, 0x19, 0xe8, 0x90, 0xe8, 0x25, 0x50, 0xca, 0x57,
+	0x91, 0x09, 0xfd, 0x16, 0x80, 0xd9, 0x0a, 0x8b, 0x6f, 0x46, 0x98, 0xdf, 0x92, 0x9f, 0xf5, 0x36,
+	0xa4, 0xb0, 0xc1, 0xcc, 0x79, 0x30, 0xca, 0x50, 0x12, 0x21, 0xad, 0xd1, 0xd7, 0xff, 0x99, 0xb7,
+	0x40, 0x41, 0x7c, 0x01, 0x41, 0x13, 0x51, 0x4c, 0x22, 0xad, 0xc7, 0x7d, 0xd8, 0xb6, 0x8d, 0xc7,
+	0x6d, 0x7b, 0x39, 0xc6, 0xbc, 0xb6, 0x5b, 0x75, 0x43, 0xd2, 0xf0, 0xb4, 0x2d, 0xa8, 0x3f, 0x65,
+	0x16, 0xed, 0x78, 0xfc, 0x6e, 0x13, 0x31, 0xf7, 0xed, 0x84, 0xfb, 0x40, 0x50, 0x6c, 0x4b, 0x06,
+	0xf3, 0x03, 0x30, 0x25, 0x09, 0x79, 0x8d, 0x22, 0x56, 0x23, 0xf5, 0xc8, 0x1a, 0x3e, 0x31, 0xe7,
+	0x26, 0x0a, 0xfd, 0x49, 0xc1, 0xf2, 0x7e, 0x4a, 0x22, 0x74, 0x52, 0xd4, 0x82, 0x34, 0x0a, 0xaa,
+	0x30, 0x89, 0xac, 0x91, 0x81, 0x38, 0x81, 0xa2, 0x58, 0x87, 0x49, 0x64, 0x3a, 0x60, 0xbc, 0x55,
+	0xc3, 0x1c, 0xd5, 0x31, 0xe3, 0xd6, 0x0b, 0x4b, 0xc3, 0x2b, 0xe3, 0xeb, 0x23, 0x82, 0xce, 0xef,
+	0x2c, 0x8b, 0x5a, 0x58, 0x1d, 0xb2, 0x5a, 0x70, 0x87, 0xc2, 0x50, 0x78, 0xa8, 0x35, 0x3a, 0x58,
+	0x2d, 0x92, 0x65, 0x4b, 0x93, 0x98, 0xef, 0x81, 0x09, 0x45, 0xdb, 0xc2, 0x49, 0x44, 0x5a, 0xd6,
+	0xe9, 0x81, 0x9a, 0x5e, 0x90, 0x1c, 0x1f, 0x4a, 0x0a, 0x33, 0x00, 0x73, 0x0d, 0x9c, 0x04, 0xf2,
+	0xa0, 0x8b, 0x77, 0x99, 0x52, 0x8f, 0x0d, 0xa4, 0x77, 0xa6, 0x81, 0x93, 0xdb, 0x82, 0x6a, 0x1b,
+	0x51, 0x9d, 0xe0, 0x0b, 0x30, 0xc7, 0x5b, 0xb0, 0x19, 0xd4, 0x09, 0xd9, 0xa9, 0xc2, 0x70, 0x27,
+	0x4d, 0x30, 0x3e, 0x90, 0x76, 0x53, 0x70, 0xbd, 0xa3, 0xa9, 0x74, 0x86, 0x0a, 0x00, 0xb2, 0x04,
+	0xc2, 0x11, 0x65, 0x16, 0x18, 0x88, 0x77, 0x5c, 0x08, 0x97, 0x04, 0xe6, 0xe7, 0x60, 0x36, 0xfb,
+	0xec, 0x83, 0x3b, 0x48, 0xfa, 0x0d, 0x26, 0x56, 0x61, 0xb0, 0x86, 0x64, 0x54, 0x5b, 0x48, 0x58,
+	0x04, 0x26, 0xe6, 0x5b, 0x60, 0x32, 0x42, 0x14, 0xef, 0xa1, 0x28, 0x68, 0x42, 0x4c, 0x99, 0x35,
+	0xb1, 0x34, 0xbc, 0x52, 0x58, 0x3b, 0xef, 0x76, 0x5f, 0xd2, 0xee, 0xa6, 0x82, 0x6d, 0x43, 0x4c,
+	0xe5, 0x11, 0x1b, 0xf2, 0x27, 0xa2, 0xce, 0x12, 0x33, 0x3f, 0x03, 0x26, 0x4b, 0x60, 0x93, 0xd5,
+	0x08, 0x0f, 0x28, 0xe2, 0x28, 0x91, 0x27, 0x6d, 0x72, 0xa0, 0x06, 0xcc, 0xa4, 0x4c, 0x7e, 0x4a,
+	0x64, 0x6e, 0x80, 0x82, 0x10, 0x18, 0x34, 0xa5, 0x11, 0x58, 0x53, 0x52, 0xe6, 0x62, 0xaf, 0x4c,
+	0x21, 0x46, 0x99, 0x85, 0x56, 0x09, 0x9a, 0xd9, 0x8a, 0xf9, 0x11, 0x98, 0x6e, 0xc0, 0xfd, 0xa0,
+	0x63, 0xdd, 0x98, 0x58, 0x67, 0x06, 0x6a, 0xe5, 0x54, 0x03, 0xee, 0x6f, 0xa4, 0x56, 0x8b, 0x89,
+	0xf9, 0x29, 0x98, 0x0f, 0x31, 0x0d, 0x77, 0x31, 0x0f, 0xaa, 0x14, 0xc1, 0x1d, 0x44, 0x03, 0xfd,
+	0xad, 0x4d, 0x2f, 0x19, 0x2b, 0x53, 0x6b, 0xcb, 0xbd, 0x4a, 0x37, 0x14, 0x7e, 0x5d, 0xc1, 0x6f,
+	0x48, 0xb4, 0x3f, 0x17, 0xe6, 0xac, 0x3a, 0xb7, 0xc1, 0xb9, 0x1c, 0x37, 0x4c, 0xdd, 0xd2, 0x7c,
+	0x1d, 0x80, 0x04, 0xb5, 0xd2, 0xd6, 0x08, 0x67, 0x2c, 0xac, 0x59, 0x79, 0xad, 0x91, 0xbb, 0xc6,
+	0x13, 0xd4, 0x52, 0x8f, 0xce, 0xcf, 0x06, 0x98, 0xab, 0xb0, 0x78, 0x6b, 0x37, 0x89, 0x14, 0xb1,
+	0x2f, 0x9d, 0xe5, 0x68, 0x9f, 0x45, 0xe0, 0xb4, 0x32, 0x1f, 0x31, 0x0c, 0x88, 0x37, 0xb0, 0xe0,
+	0xaa, 0xf6, 0xb8, 0x62, 0xd0, 0x72, 0xf5, 0xa0, 0xe5, 0x6e, 0x10, 0x9c, 0xac, 0x5f, 0x15, 0xed,
+	0xff, 0xe1, 0x0f, 0x7b, 0xa5, 0x8f, 0x96, 0x8a, 0x0d, 0xcc, 0x4f, 0xb9, 0xcd, 0x0b, 0x60, 0xe2,
+	0x90, 0x9d, 0x33, 0xe9, 0xbd, 0x23, 0x7e, 0xa1, 0xe3, 0xcf, 0xcc, 0xf9, 0x18, 0x2c, 0xe6, 0x29,
+	0xcf, 0x7a, 0x72, 0xad, 0xa3, 0x54, 0x35, 0x64, 0xa1, 0xb7, 0x21, 0x7a, 0x8f, 0x3e, 0x28, 0x29,
+	0xde, 0xf9, 0x52, 0x5e, 0x92, 0x3e, 0x62, 0x88, 0x3f, 0xfb, 0x8e, 0x8e, 0x6c, 0x4b, 0x05, 0x8c,
+	0x88, 0x53, 0xa6, 0xef, 0x9d, 0x6b, 0x82, 0xee, 0x71, 0xdb, 0x5e, 0x3d, 0x54, 0xf8, 0xbb, 0x32,
+	0xf7, 0x46, 0x0d, 0xe2, 0xc4, 0xd3, 0x93, 0xeb, 0xbe, 0x17, 0x92, 0x46, 0x83, 0x24, 0x1e, 0x64,
+	0x0c, 0x71, 0x79, 0x70, 0x7d, 0x49, 0xa3, 0xef, 0xc7, 0x1c, 0x01, 0x69, 0x75, 0x6b, 0xff, 0x8e,
+	0x81, 0xe1, 0x0a, 0x8b, 0xcd, 0x1f, 0x0d, 0xb0, 0xf8, 0xdc, 0x19, 0x76, 0xb5, 0xb7, 0xea, 0x63,
+	0xa6, 0xc8, 0xe2, 0xb5, 0x13, 0x6f, 0xc9, 0xae, 0xed, 0xd2, 0x57, 0xbf, 0xfd, 0x75, 0xff, 0x94,
+	0xe5, 0xcc, 0x7b, 0xcf, 0x4e, 0xe7, 0x4d, 0xad, 0xe6, 0x81, 0x01, 0x16, 0x8e, 0x9e, 0x4a, 0xdd,
+	0xfe, 0x13, 0x0b, 0x7c, 0xf1, 0xb5, 0x93, 0xe1, 0x33, 0x95, 0xe7, 0xa4, 0xca, 0xb3, 0xce, 0x6c,
+	0x97, 0x4a, 0x29, 0xf1, 0x17, 0x03, 0xd8, 0xc7, 0xcd, 0x87, 0xaf, 0xf6, 0x9f, 0xb8, 0xb3, 0xab,
+	0xf8, 0xc6, 0x20, 0xbb, 0x32, 0xd1, 0x8e, 0x14, 0xbd, 0xe8, 0x14, 0xbb, 0x44, 0x47, 0x12, 0x5a,
+	0x96, 0xda, 0xbf, 0x33, 0xc0, 0x6c, 0xde, 0x6c, 0xb7, 0x92, 0x9b, 0x39, 0x07, 0x59, 0xbc, 0xda,
+	0x2f, 0x32, 0xd3, 0xb5, 0x2c, 0x75, 0x2d, 0x39, 0xa5, 0x2e, 0x5d, 0x6a, 0xba, 0x2d, 0xa7, 0xd3,
+	0x9f, 0x79, 0xdf, 0x00, 0xd3, 0x3d, 0xe3, 0xdc, 0x8b, 0xb9, 0xe9, 0xba, 0x61, 0xc5, 0x72, 0x5f,
+	0xb0, 0x4c, 0xd2, 0x65, 0x29, 0xe9, 0xa2, 0x73, 0xa1, 0x4b, 0x12, 0x8a, 0x30, 0x2f, 0xab, 0xe7,
+	0xb2, 0xf2, 0x4a, 0xf3, 0x9e, 0x01, 0x66, 0x7a, 0xdd, 0x6f, 0x39, 0x37, 0x5f, 0x0f, 0xae, 0xe8,
+	0xf6, 0x87, 0xcb, 0x84, 0x5d, 0x94, 0xc2, 0xce, 0x3b, 0xe7, 0xba, 0x7b, 0xb5, 0x9b, 0x44, 0xe5,
+	0xd4, 0xfb, 0xbe, 0x37, 0xc0, 0x6c, 0x9e, 0xf7, 0xe4, 0xbf, 0xc4, 0x1c, 0x64, 0xf1, 0x6a, 0xbf,
+	0xc8, 0x4c, 0xd8, 0x15, 0x29, 0x6c, 0xd9, 0xb9, 0xd4, 0x25, 0x8c, 0x8a, 0x3d, 0x65, 0x7d, 0x25,
+	0x95, 0xf5, 0xc5, 0xb6, 0xbe, 0xf5, 0xf0, 0x49, 0xc9, 0x78, 0xf4, 0xa4, 0x64, 0xfc, 0xf9, 0xa4,
+	0x64, 0xdc, 0x7b, 0x5a, 0x1a, 0x7a, 0xf4, 0xb4, 0x34, 0xf4, 0xfb, 0xd3, 0xd2, 0xd0, 0x27, 0x57,
+	0x8e, 0x73, 0x3c, 0xcd, 0x2b, 0x4d, 0xbf, 0x3a, 0x2a, 0x7f, 0x8a, 0xbf, 0xf2, 0xdf, 0x00, 0x25,
+	0x2f, 0xb5, 0x5b, 0x2c, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,11 +833,23 @@ type MsgClient interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateDirectVote defines a method for submitting an
+	// aggregate exchange rate vote without a prevote. Only available when the
+	// "direct_vote_enabled" param is set.
+	AggregateExchangeRateDirectVote(ctx context.Context, in *MsgAggregateExchangeRateDirectVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateDirectVoteResponse, error)
 	// DelegateFeedConsent defines a method for delegating oracle voting rights
 	// to another address known as a price feeder.
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(ctx context.Context, in *MsgEditOracleParams, opts ...grpc.CallOption) (*MsgEditOracleParamsResponse, error)
+	// FundOracleRewards defines a permissionless method for funding the rewards
+	// paid to oracle voters. The coins are paid out in equal parts over the
+	// given number of vote periods.
+	FundOracleRewards(ctx context.Context, in *MsgFundOracleRewards, opts ...grpc.CallOption) (*MsgFundOracleRewardsResponse, error)
+	// ResetCircuitBreaker resumes price updates for a pair halted by the
+	// circuit breaker.
+	// [SUDO] Only callable by sudoers.
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
 }
 
 type msgClient struct {
@@ -517,6 +878,15 @@ func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggreg
 	return out, nil
 }
 
+func (c *msgClient) AggregateExchangeRateDirectVote(ctx context.Context, in *MsgAggregateExchangeRateDirectVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateDirectVoteResponse, error) {
+	out := new(MsgAggregateExchangeRateDirectVoteResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/AggregateExchangeRateDirectVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error) {
 	out := new(MsgDelegateFeedConsentResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/DelegateFeedConsent", in, out, opts...)
@@ -535,6 +905,24 @@ func (c *msgClient) EditOracleParams(ctx context.Context, in *MsgEditOracleParam
 	return out, nil
 }
 
+func (c *msgClient) FundOracleRewards(ctx context.Context, in *MsgFundOracleRewards, opts ...grpc.CallOption) (*MsgFundOracleRewardsResponse, error) {
+	out := new(MsgFundOracleRewardsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/FundOracleRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -543,11 +931,23 @@ type MsgServer interface {
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
+	// AggregateExchangeRateDirectVote defines a method for submitting an
+	// aggregate exchange rate vote without a prevote. Only available when the
+	// "direct_vote_enabled" param is set.
+	AggregateExchangeRateDirectVote(context.Context, *MsgAggregateExchangeRateDirectVote) (*MsgAggregateExchangeRateDirectVoteResponse, error)
 	// DelegateFeedConsent defines a method for delegating oracle voting rights
 	// to another address known as a price feeder.
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
 	EditOracleParams(context.Context, *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error)
+	// FundOracleRewards defines a permissionless method for funding the rewards
+	// paid to oracle voters. The coins are paid out in equal parts over the
+	// given number of vote periods.
+	FundOracleRewards(context.Context, *MsgFundOracleRewards) (*MsgFundOracleRewardsResponse, error)
+	// ResetCircuitBreaker resumes price updates for a pair halted by the
+	// circuit breaker.
+	// [SUDO] Only callable by sudoers.
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -560,12 +960,21 @@ func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context,
 func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
+func (*UnimplementedMsgServer) AggregateExchangeRateDirectVote(ctx context.Context, req *MsgAggregateExchangeRateDirectVote) (*MsgAggregateExchangeRateDirectVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateDirectVote not implemented")
+}
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
 func (*UnimplementedMsgServer) EditOracleParams(ctx context.Context, req *MsgEditOracleParams) (*MsgEditOracleParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditOracleParams not implemented")
 }
+func (*UnimplementedMsgServer) FundOracleRewards(ctx context.Context, req *MsgFundOracleRewards) (*MsgFundOracleRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundOracleRewards not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -607,6 +1016,24 @@ func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AggregateExchangeRateDirectVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRateDirectVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AggregateExchangeRateDirectVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/AggregateExchangeRateDirectVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AggregateExchangeRateDirectVote(ctx, req.(*MsgAggregateExchangeRateDirectVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDelegateFeedConsent)
 	if err := dec(in); err != nil {
@@ -643,6 +1070,42 @@ func _Msg_EditOracleParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundOracleRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundOracleRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundOracleRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/FundOracleRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundOracleRewards(ctx, req.(*MsgFundOracleRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -655,6 +1118,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
 		},
+		{
+			MethodName: "AggregateExchangeRateDirectVote",
+			Handler:    _Msg_AggregateExchangeRateDirectVote_Handler,
+		},
 		{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
@@ -663,6 +1130,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditOracleParams",
 			Handler:    _Msg_EditOracleParams_Handler,
 		},
+		{
+			MethodName: "FundOracleRewards",
+			Handler:    _Msg_FundOracleRewards_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -809,7 +1284,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
+func (m *MsgAggregateExchangeRateDirectVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -819,20 +1294,87 @@ func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegateFeedConsent) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateDirectVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAggregateExchangeRateDirectVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExchangeRates) > 0 {
+		i -= len(m.ExchangeRates)
+		copy(dAtA[i:], m.ExchangeRates)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRates)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAggregateExchangeRateDirectVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRateDirectVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRateDirectVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateFeedConsent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateFeedConsent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateFeedConsent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -889,6 +1431,65 @@ func (m *MsgEditOracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerAction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CircuitBreakerAction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxChangeRatio != nil {
+		{
+			size := m.MaxChangeRatio.Size()
+			i -= size
+			if _, err := m.MaxChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.PairParams) > 0 {
+		for iNdEx := len(m.PairParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SnapshotRetention != nil {
+		{
+			size := m.SnapshotRetention.Size()
+			i -= size
+			if _, err := m.SnapshotRetention.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ValidatorFeeRatio != nil {
 		{
 			size := m.ValidatorFeeRatio.Size()
@@ -1051,6 +1652,151 @@ func (m *MsgEditOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundOracleRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundOracleRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundOracleRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundOracleRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundOracleRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundOracleRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1126,6 +1872,36 @@ func (m *MsgAggregateExchangeRateVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAggregateExchangeRateDirectVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExchangeRates)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRateDirectVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegateFeedConsent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1204,6 +1980,29 @@ func (m *MsgEditOracleParams) Size() (n int) {
 		l = m.ValidatorFeeRatio.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SnapshotRetention != nil {
+		l = m.SnapshotRetention.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PairParams) > 0 {
+		for _, e := range m.PairParams {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxChangeRatio != nil {
+		l = m.MaxChangeRatio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CircuitBreakerAction != 0 {
+		n += 2 + sovTx(uint64(m.CircuitBreakerAction))
+	}
 	return n
 }
 
@@ -1220,20 +2019,77 @@ func (m *MsgEditOracleParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *MsgFundOracleRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovTx(uint64(m.VotePeriods))
+	}
+	return n
+}
+
+func (m *MsgFundOracleRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
@@ -1650,6 +2506,202 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAggregateExchangeRateDirectVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateDirectVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateDirectVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateDirectVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateDirectVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateDirectVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2231,14 +3283,173 @@ func (m *MsgEditOracleParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.SnapshotRetention = &v
+			if err := m.SnapshotRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairParams = append(m.PairParams, PairParams{})
+			if err := m.PairParams[len(m.PairParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxChangeRatio = &v
+			if err := m.MaxChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerAction", wireType)
+			}
+			m.CircuitBreakerAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerAction |= CircuitBreakerAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2338,6 +3549,390 @@ func (m *MsgEditOracleParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundOracleRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundOracleRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundOracleRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundOracleRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundOracleRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundOracleRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/NibiruChain/nibiru/x/sudo/types"
@@ -29,6 +30,8 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdEditSudoers(),
 		CmdChangeRoot(),
+		CmdSetTimelock(),
+		CmdCancelSudoAction(),
 	)
 
 	return txCmd
@@ -48,6 +51,8 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQuerySudoers(),
+		CmdQueryPermissions(),
+		CmdQueryPendingSudoActions(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -62,7 +67,7 @@ func CmdEditSudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "edit [edit-json]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Edit the x/sudo state (sudoers) by adding or removing contracts",
+		Short: "Edit the x/sudo state (sudoers) by adding or removing contracts or permissions",
 		Example: strings.TrimSpace(fmt.Sprintf(`
 			Example: 
 			$ %s tx sudo edit <path/to/edit.json> --from=<key_or_address> 
@@ -77,7 +82,21 @@ func CmdEditSudoers() *cobra.Command {
 			  "contracts": "..."
 			}
 
-			- Valid action types: "add_contracts", "remove_contracts"	
+			- Valid action types: "add_contracts", "remove_contracts",
+			  "grant_permissions", "revoke_permissions"
+
+			The "grant_permissions" action gives each of the contracts a scope
+			limited to certain messages:
+			{
+			  "action": "grant_permissions",
+			  "contracts": ["..."],
+			  "scope": {
+			    "msg_type_urls": ["/nibiru.oracle.v1.MsgEditOracleParams"],
+			    "expiry_height": "1000000",
+			    "rate_limit": "5",
+			    "rate_limit_window": "14400"
+			  }
+			}
 			`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -159,6 +178,89 @@ func CmdChangeRoot() *cobra.Command {
 	return cmd
 }
 
+// CmdSetTimelock is a terminal command corresponding to the SetTimelock
+// function of the sdk.Msg handler for x/sudo.
+func CmdSetTimelock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-timelock [blocks]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the number of blocks that root changes wait before they execute",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo set-timelock 100800 --from=<key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Set the timelock of the x/sudo module. While the timelock is
+			non-zero, "change-root", "add_contracts" edits, and further
+			timelock changes are queued and execute after the given number of
+			blocks. Zero disables the timelock. Should be executed by the
+			current root address.
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetTimelock{
+				Sender:         clientCtx.GetFromAddress().String(),
+				TimelockBlocks: blocks,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelSudoAction is a terminal command corresponding to the
+// CancelSudoAction function of the sdk.Msg handler for x/sudo.
+func CmdCancelSudoAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-action [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a root action queued by the timelock",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo cancel-action 3 --from=<key_or_address>
+			`, version.AppName)),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgCancelSudoAction{
+				Sender: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQuerySudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state",
@@ -188,3 +290,65 @@ func CmdQuerySudoers() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions [address]",
+		Short: "displays the permission scopes granted by root, for one address or for all",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := new(types.QueryPermissionsRequest)
+			if len(args) == 1 {
+				req.Address = args[0]
+			}
+			resp, err := queryClient.QueryPermissions(
+				cmd.Context(), req,
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPendingSudoActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-actions",
+		Short: "displays the timelock and the root actions waiting in its queue",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.PendingSudoActions(
+				cmd.Context(), new(types.QueryPendingSudoActionsRequest),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
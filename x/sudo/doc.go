@@ -15,6 +15,17 @@ administrative access to the system, allowing them to perform tasks that regular
 users cannot do, such as installing system-wide software, and modifying system
 files.
 
+Besides the sudo contracts, which may run every permissioned function, root
+can grant an address a narrow permission scope: the type URLs of the messages
+it may execute, an optional expiry height, and an optional rate limit. Modules
+check both through "Keeper.CheckMsgPermissions".
+
+Root can also turn on a timelock, a number of blocks that root changes,
+contract additions, permission grants, and timelock changes wait in a queue
+before they execute at the end of a block. Root can cancel a queued action
+during the delay, and the "PendingSudoActions" query shows the queue so that
+token holders can react before a new root takes effect.
+
 Note that this package does not provide actual system integration or execute
 commands with elevated privileges. It only offers a way to manage and verify
 permissions in a sudoers-like manner within your application.
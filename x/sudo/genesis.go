@@ -3,6 +3,8 @@ package sudo
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/sudo/keeper"
 	"github.com/NibiruChain/nibiru/x/sudo/types"
 )
@@ -13,6 +15,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 	k.Sudoers.Set(ctx, genState.Sudoers)
+	for _, permission := range genState.Permissions {
+		k.Permissions.Insert(ctx, sdk.MustAccAddressFromBech32(permission.Address), permission)
+	}
+	k.TimelockBlocks.Set(ctx, genState.TimelockBlocks)
+	for _, action := range genState.PendingActions {
+		k.PendingActions.Insert(ctx, action.Id, action)
+		if action.Id >= k.PendingActionID.Peek(ctx) {
+			k.PendingActionID.Set(ctx, action.Id+1)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -24,7 +36,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Sudoers: pbSudoers,
+		Sudoers:        pbSudoers,
+		Permissions:    k.Permissions.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values(),
+		TimelockBlocks: k.TimelockBlocks.GetOr(ctx, 0),
+		PendingActions: k.PendingActions.Iterate(ctx, collections.Range[uint64]{}).Values(),
 	}
 }
 
@@ -36,5 +51,7 @@ func DefaultGenesis() *types.GenesisState {
 			Root:      "",
 			Contracts: []string{},
 		},
+		Permissions:    []types.Permission{},
+		PendingActions: []types.PendingSudoAction{},
 	}
 }
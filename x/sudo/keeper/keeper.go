@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/NibiruChain/collections"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,17 @@ import (
 
 type Keeper struct {
 	Sudoers collections.Item[sudotypes.Sudoers]
+	// Permissions: The permission scopes granted by root, keyed by grantee.
+	Permissions collections.Map[sdk.AccAddress, sudotypes.Permission]
+	// PermissionUsages: Uses of the permission scopes in their current rate
+	// limit windows, keyed by grantee.
+	PermissionUsages collections.Map[sdk.AccAddress, sudotypes.PermissionUsage]
+	// TimelockBlocks: Number of blocks that root-changing, contract-adding, and
+	// permission-granting actions wait in the queue. Zero, the default, disables the timelock.
+	TimelockBlocks collections.Item[uint64]
+	// PendingActions: The root actions queued by the timelock, keyed by ID.
+	PendingActions  collections.Map[uint64, sudotypes.PendingSudoAction]
+	PendingActionID collections.Sequence
 }
 
 func NewKeeper(
@@ -23,9 +35,24 @@ func NewKeeper(
 ) Keeper {
 	return Keeper{
 		Sudoers: collections.NewItem(storeKey, 1, SudoersValueEncoder(cdc)),
+		Permissions: collections.NewMap(
+			storeKey, 2, collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.Permission](cdc)),
+		PermissionUsages: collections.NewMap(
+			storeKey, 3, collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.PermissionUsage](cdc)),
+		TimelockBlocks: collections.NewItem(storeKey, 4, collections.Uint64ValueEncoder),
+		PendingActions: collections.NewMap(
+			storeKey, 5, collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.PendingSudoAction](cdc)),
+		PendingActionID: collections.NewSequence(storeKey, 6),
 	}
 }
 
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+sudotypes.ModuleName)
+}
+
 // Returns the root address of the sudo module.
 func (k Keeper) GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error) {
 	sudoers, err := k.Sudoers.Get(ctx)
@@ -77,6 +104,17 @@ func (k Keeper) AddContracts(
 	if err != nil {
 		return
 	}
+	if k.TimelockBlocks.GetOr(ctx, 0) > 0 {
+		action, err := k.queueAction(ctx, sudotypes.PendingSudoAction{
+			Action:    string(sudotypes.AddContracts),
+			Sender:    msg.Sender,
+			Contracts: msg.Contracts,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &sudotypes.MsgEditSudoersResponse{PendingActionId: action.Id}, nil
+	}
 	pbSudoers := Sudoers{Root: sudoersBefore.Root, Contracts: contracts}.ToPb()
 	k.Sudoers.Set(ctx, pbSudoers)
 	msgResp = new(sudotypes.MsgEditSudoersResponse)
@@ -147,3 +185,167 @@ func (k Keeper) CheckPermissions(
 	}
 	return nil
 }
+
+// CheckMsgPermissions Checks if an address may execute a sudo-permissioned
+// message with the given type URL. Root and the sudo contracts may execute any
+// of them. Any other address needs a permission scope that covers the message,
+// has not expired, and is within its rate limit. Each allowed use through a
+// permission scope counts toward its rate limit.
+func (k Keeper) CheckMsgPermissions(
+	sender sdk.AccAddress, ctx sdk.Context, msgTypeUrl string,
+) error {
+	sudoerErr := k.CheckPermissions(sender, ctx)
+	if sudoerErr == nil {
+		return nil
+	}
+
+	permission, err := k.Permissions.Get(ctx, sender)
+	if err != nil || !permission.Scope.Allows(msgTypeUrl, ctx.BlockHeight()) {
+		return fmt.Errorf("%w, and no permission scope covers %s", sudoerErr, msgTypeUrl)
+	}
+
+	scope := permission.Scope
+	if scope.RateLimit == 0 {
+		return nil
+	}
+	usage := k.PermissionUsages.GetOr(ctx, sender, sudotypes.PermissionUsage{
+		WindowStart: ctx.BlockHeight(),
+	})
+	if usage.WindowStart+int64(scope.RateLimitWindow) <= ctx.BlockHeight() {
+		usage = sudotypes.PermissionUsage{WindowStart: ctx.BlockHeight()}
+	}
+	if usage.Count >= scope.RateLimit {
+		return fmt.Errorf(
+			"%w: %s used its sudo permission %d times since block %d",
+			sudotypes.ErrRateLimited, sender, usage.Count, usage.WindowStart,
+		)
+	}
+	usage.Count++
+	k.PermissionUsages.Insert(ctx, sender, usage)
+	return nil
+}
+
+// ————————————————————————————————————————————————————————————————————————————
+// GrantPermissions
+// ————————————————————————————————————————————————————————————————————————————
+
+// GrantPermissions executes a MsgEditSudoers message with action type
+// "grant_permissions". This gives the permission scope of the message to each
+// of its contracts, replacing any scope they had before. Like the other actions
+// that widen sudo powers, it waits in the timelock queue if one is set.
+func (k Keeper) GrantPermissions(
+	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
+) (msgResp *sudotypes.MsgEditSudoersResponse, err error) {
+	if msg.RootAction() != sudotypes.GrantPermissions {
+		err = fmt.Errorf("invalid action type %s for msg grant permissions", msg.Action)
+		return
+	}
+	if msg.Scope == nil {
+		err = fmt.Errorf("action %s requires a permission scope", msg.Action)
+		return
+	}
+
+	// Read state
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	err = k.senderHasPermission(msg.Sender, pbSudoers.Root)
+	if err != nil {
+		return
+	}
+	if msg.Scope.ExpiryHeight != 0 && msg.Scope.ExpiryHeight < ctx.BlockHeight() {
+		err = sudotypes.ErrSudoers(fmt.Sprintf(
+			"expiry height %d is in the past", msg.Scope.ExpiryHeight))
+		return
+	}
+
+	// Update state
+	if k.TimelockBlocks.GetOr(ctx, 0) > 0 {
+		action, err := k.queueAction(ctx, sudotypes.PendingSudoAction{
+			Action:    string(sudotypes.GrantPermissions),
+			Sender:    msg.Sender,
+			Contracts: msg.Contracts,
+			Scope:     msg.Scope,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &sudotypes.MsgEditSudoersResponse{PendingActionId: action.Id}, nil
+	}
+	if err = k.grantPermissions(ctx, msg.Contracts, *msg.Scope); err != nil {
+		return nil, err
+	}
+	return new(sudotypes.MsgEditSudoersResponse), nil
+}
+
+// grantPermissions gives the permission scope to each of the contracts and
+// resets their rate limit usage.
+func (k Keeper) grantPermissions(
+	ctx sdk.Context, contracts []string, scope sudotypes.PermissionScope,
+) error {
+	for _, contractStr := range contracts {
+		contract, err := sdk.AccAddressFromBech32(contractStr)
+		if err != nil {
+			return err
+		}
+		permission := sudotypes.Permission{Address: contract.String(), Scope: scope}
+		k.Permissions.Insert(ctx, contract, permission)
+		_ = k.PermissionUsages.Delete(ctx, contract)
+		if err = ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdatePermission{
+			Permission: permission,
+			Action:     string(sudotypes.GrantPermissions),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ————————————————————————————————————————————————————————————————————————————
+// RevokePermissions
+// ————————————————————————————————————————————————————————————————————————————
+
+// RevokePermissions executes a MsgEditSudoers message with action type
+// "revoke_permissions". This removes the permission scopes of its contracts.
+func (k Keeper) RevokePermissions(
+	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
+) (msgResp *sudotypes.MsgEditSudoersResponse, err error) {
+	if msg.RootAction() != sudotypes.RevokePermissions {
+		err = fmt.Errorf("invalid action type %s for msg revoke permissions", msg.Action)
+		return
+	}
+
+	// Read state
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	err = k.senderHasPermission(msg.Sender, pbSudoers.Root)
+	if err != nil {
+		return
+	}
+
+	// Update state
+	for _, contractStr := range msg.Contracts {
+		contract, err := sdk.AccAddressFromBech32(contractStr)
+		if err != nil {
+			return nil, err
+		}
+		permission, err := k.Permissions.Get(ctx, contract)
+		if err != nil {
+			continue
+		}
+		_ = k.Permissions.Delete(ctx, contract)
+		_ = k.PermissionUsages.Delete(ctx, contract)
+		if err = ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdatePermission{
+			Permission: permission,
+			Action:     msg.Action,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return new(sudotypes.MsgEditSudoersResponse), nil
+}
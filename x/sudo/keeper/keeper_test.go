@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
 )
@@ -32,3 +33,46 @@ func TestCheckPermissions(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestCheckMsgPermissions(t *testing.T) {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	root := testutil.AccAddress()
+	contract := testutil.AccAddress()
+	grantee := testutil.AccAddress()
+	nibiru.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{
+		Root:      root.String(),
+		Contracts: []string{contract.String()},
+	})
+	editParams := "/nibiru.oracle.v1.MsgEditOracleParams"
+	toggleInflation := "/nibiru.inflation.v1.MsgToggleInflation"
+
+	t.Log("root and the sudo contracts may execute any message")
+	require.NoError(t, nibiru.SudoKeeper.CheckMsgPermissions(root, ctx, toggleInflation))
+	require.NoError(t, nibiru.SudoKeeper.CheckMsgPermissions(contract, ctx, toggleInflation))
+	require.ErrorIs(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, ctx, editParams), sudotypes.ErrUnauthorized)
+
+	nibiru.SudoKeeper.Permissions.Insert(ctx, grantee, sudotypes.Permission{
+		Address: grantee.String(),
+		Scope: sudotypes.PermissionScope{
+			MsgTypeUrls:     []string{editParams},
+			ExpiryHeight:    ctx.BlockHeight() + 100,
+			RateLimit:       2,
+			RateLimitWindow: 10,
+		},
+	})
+
+	t.Log("scoped permissions only cover their messages")
+	require.ErrorIs(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, ctx, toggleInflation), sudotypes.ErrUnauthorized)
+	require.ErrorIs(t, nibiru.SudoKeeper.CheckPermissions(grantee, ctx), sudotypes.ErrUnauthorized)
+
+	t.Log("scoped permissions are rate limited per window")
+	require.NoError(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, ctx, editParams))
+	require.NoError(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, ctx, editParams))
+	require.ErrorIs(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, ctx, editParams), sudotypes.ErrRateLimited)
+	nextWindowCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, nextWindowCtx, editParams))
+
+	t.Log("scoped permissions expire")
+	expiredCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 101)
+	require.ErrorIs(t, nibiru.SudoKeeper.CheckMsgPermissions(grantee, expiredCtx, editParams), sudotypes.ErrUnauthorized)
+}
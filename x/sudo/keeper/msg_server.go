@@ -24,7 +24,8 @@ func NewMsgServer(keeper Keeper) *MsgServer {
 // Ensure the interface is properly implemented at compile time
 var _ sudotypes.MsgServer = MsgServer{}
 
-// EditSudoers adds or removes sudo contracts from state.
+// EditSudoers adds or removes sudo contracts from state, or grants or revokes
+// scoped permissions.
 func (m MsgServer) EditSudoers(
 	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
 ) (*sudotypes.MsgEditSudoersResponse, error) {
@@ -33,6 +34,10 @@ func (m MsgServer) EditSudoers(
 		return m.keeper.AddContracts(goCtx, msg)
 	case sudotypes.RemoveContracts:
 		return m.keeper.RemoveContracts(goCtx, msg)
+	case sudotypes.GrantPermissions:
+		return m.keeper.GrantPermissions(goCtx, msg)
+	case sudotypes.RevokePermissions:
+		return m.keeper.RevokePermissions(goCtx, msg)
 	default:
 		return nil, fmt.Errorf("invalid action type specified on msg: %s", msg)
 	}
@@ -51,12 +56,39 @@ func (m MsgServer) ChangeRoot(ctx context.Context, msg *sudotypes.MsgChangeRoot)
 		return nil, err
 	}
 
+	if m.keeper.TimelockBlocks.GetOr(sdkContext, 0) > 0 {
+		action, err := m.keeper.queueAction(sdkContext, sudotypes.PendingSudoAction{
+			Action:  string(sudotypes.ChangeRoot),
+			Sender:  msg.Sender,
+			NewRoot: msg.NewRoot,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &sudotypes.MsgChangeRootResponse{PendingActionId: action.Id}, nil
+	}
+
 	pbSudoers.Root = msg.NewRoot
 	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
 
 	return &sudotypes.MsgChangeRootResponse{}, nil
 }
 
+// SetTimelock sets the number of blocks that root-changing,
+// contract-adding, and permission-granting actions wait before they execute.
+func (m MsgServer) SetTimelock(
+	goCtx context.Context, msg *sudotypes.MsgSetTimelock,
+) (*sudotypes.MsgSetTimelockResponse, error) {
+	return m.keeper.SetTimelock(goCtx, msg)
+}
+
+// CancelSudoAction removes a pending action from the timelock queue.
+func (m MsgServer) CancelSudoAction(
+	goCtx context.Context, msg *sudotypes.MsgCancelSudoAction,
+) (*sudotypes.MsgCancelSudoActionResponse, error) {
+	return m.keeper.CancelSudoAction(goCtx, msg)
+}
+
 func (m MsgServer) validateRootPermissions(pbSudoers sudotypes.Sudoers, msg *sudotypes.MsgChangeRoot) error {
 	root, err := sdk.AccAddressFromBech32(pbSudoers.Root)
 	if err != nil {
@@ -53,6 +53,45 @@ func TestGenesis(t *testing.T) {
 			},
 			empty: false,
 		},
+		{
+			name: "happy genesis with permissions",
+			genState: &types.GenesisState{
+				Sudoers: types.Sudoers{
+					Root:      testutil.AccAddress().String(),
+					Contracts: []string{testutil.AccAddress().String()},
+				},
+				Permissions: []types.Permission{
+					{
+						Address: testutil.AccAddress().String(),
+						Scope: types.PermissionScope{
+							MsgTypeUrls: []string{"/nibiru.oracle.v1.MsgEditOracleParams"},
+						},
+					},
+				},
+			},
+			empty: false,
+		},
+		{
+			name: "happy genesis with pending actions",
+			genState: &types.GenesisState{
+				Sudoers: types.Sudoers{
+					Root:      testutil.AccAddress().String(),
+					Contracts: []string{testutil.AccAddress().String()},
+				},
+				TimelockBlocks: 100,
+				PendingActions: []types.PendingSudoAction{
+					{
+						Id:           3,
+						Action:       string(types.ChangeRoot),
+						Sender:       testutil.AccAddress().String(),
+						NewRoot:      testutil.AccAddress().String(),
+						QueuedAt:     1,
+						ExecuteAfter: 101,
+					},
+				},
+			},
+			empty: false,
+		},
 		{
 			name:     "nil genesis (panic)",
 			genState: nil,
@@ -476,3 +515,165 @@ func TestKeeper_RemoveContracts(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServer_GrantRevokePermissions(t *testing.T) {
+	app, ctx := setup()
+	goCtx := sdk.WrapSDKContext(ctx)
+	root := testutil.AccAddress().String()
+	grantee := testutil.AccAddress()
+	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+	msgServer := keeper.NewMsgServer(app.SudoKeeper)
+	scope := types.PermissionScope{
+		MsgTypeUrls:     []string{"/nibiru.oracle.v1.MsgEditOracleParams"},
+		RateLimit:       1,
+		RateLimitWindow: 10,
+	}
+
+	t.Log("only root may grant permissions")
+	grantMsg := &types.MsgEditSudoers{
+		Action:    string(types.GrantPermissions),
+		Contracts: []string{grantee.String()},
+		Sender:    grantee.String(),
+		Scope:     &scope,
+	}
+	require.NoError(t, grantMsg.ValidateBasic())
+	_, err := msgServer.EditSudoers(goCtx, grantMsg)
+	require.Error(t, err)
+
+	grantMsg.Sender = root
+	_, err = msgServer.EditSudoers(goCtx, grantMsg)
+	require.NoError(t, err)
+
+	resp, err := keeper.NewQuerier(app.SudoKeeper).QueryPermissions(
+		goCtx, &types.QueryPermissionsRequest{Address: grantee.String()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.Permission{{Address: grantee.String(), Scope: scope}}, resp.Permissions)
+
+	t.Log("expiry heights in the past are rejected")
+	expiredScope := scope
+	expiredScope.ExpiryHeight = ctx.BlockHeight()
+	grantMsg.Scope = &expiredScope
+	_, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight()+1)), grantMsg)
+	require.Error(t, err)
+
+	t.Log("revoking removes the permission")
+	_, err = msgServer.EditSudoers(goCtx, &types.MsgEditSudoers{
+		Action:    string(types.RevokePermissions),
+		Contracts: []string{grantee.String()},
+		Sender:    root,
+	})
+	require.NoError(t, err)
+	resp, err = keeper.NewQuerier(app.SudoKeeper).QueryPermissions(goCtx, &types.QueryPermissionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Permissions)
+}
+
+func TestMsgServer_Timelock(t *testing.T) {
+	app, ctx := setup()
+	ctx = ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(ctx)
+	root := testutil.AccAddress().String()
+	newRoot := testutil.AccAddress().String()
+	contract := testutil.AccAddress().String()
+	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+	msgServer := keeper.NewMsgServer(app.SudoKeeper)
+	querier := keeper.NewQuerier(app.SudoKeeper)
+
+	t.Log("only root may set the timelock")
+	_, err := msgServer.SetTimelock(goCtx, &types.MsgSetTimelock{Sender: newRoot, TimelockBlocks: 5})
+	require.Error(t, err)
+	resp, err := msgServer.SetTimelock(goCtx, &types.MsgSetTimelock{Sender: root, TimelockBlocks: 5})
+	require.NoError(t, err)
+	require.Zero(t, resp.PendingActionId)
+	require.EqualValues(t, 5, app.SudoKeeper.TimelockBlocks.GetOr(ctx, 0))
+
+	t.Log("root changes and contract additions are queued")
+	rootResp, err := msgServer.ChangeRoot(goCtx, &types.MsgChangeRoot{Sender: root, NewRoot: newRoot})
+	require.NoError(t, err)
+	editResp, err := msgServer.EditSudoers(goCtx, &types.MsgEditSudoers{
+		Action:    string(types.AddContracts),
+		Contracts: []string{contract},
+		Sender:    root,
+	})
+	require.NoError(t, err)
+	timelockResp, err := msgServer.SetTimelock(goCtx, &types.MsgSetTimelock{Sender: root, TimelockBlocks: 0})
+	require.NoError(t, err)
+
+	pending, err := querier.PendingSudoActions(goCtx, &types.QueryPendingSudoActionsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, pending.TimelockBlocks)
+	require.Len(t, pending.Actions, 3)
+	require.Equal(t, rootResp.PendingActionId, pending.Actions[0].Id)
+	require.Equal(t, string(types.ChangeRoot), pending.Actions[0].Action)
+	require.Equal(t, int64(15), pending.Actions[0].ExecuteAfter)
+	sudoers, _ := app.SudoKeeper.Sudoers.Get(ctx)
+	require.Equal(t, root, sudoers.Root)
+	require.Empty(t, sudoers.Contracts)
+
+	t.Log("only root may cancel, and only pending actions")
+	_, err = msgServer.CancelSudoAction(goCtx, &types.MsgCancelSudoAction{Sender: newRoot, Id: timelockResp.PendingActionId})
+	require.Error(t, err)
+	_, err = msgServer.CancelSudoAction(goCtx, &types.MsgCancelSudoAction{Sender: root, Id: timelockResp.PendingActionId})
+	require.NoError(t, err)
+	_, err = msgServer.CancelSudoAction(goCtx, &types.MsgCancelSudoAction{Sender: root, Id: timelockResp.PendingActionId})
+	require.ErrorIs(t, err, types.ErrNoSuchAction)
+
+	t.Log("actions wait for the timelock to pass")
+	app.SudoKeeper.ExecutePendingActions(ctx.WithBlockHeight(14))
+	pending, err = querier.PendingSudoActions(goCtx, &types.QueryPendingSudoActionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.Actions, 2)
+
+	t.Log("due actions execute in order, and actions whose sender lost root fail")
+	app.SudoKeeper.ExecutePendingActions(ctx.WithBlockHeight(15))
+	sudoers, _ = app.SudoKeeper.Sudoers.Get(ctx)
+	require.Equal(t, newRoot, sudoers.Root)
+	require.Empty(t, sudoers.Contracts)
+	require.EqualValues(t, 5, app.SudoKeeper.TimelockBlocks.GetOr(ctx, 0))
+	pending, err = querier.PendingSudoActions(goCtx, &types.QueryPendingSudoActionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Actions)
+	require.NotZero(t, editResp.PendingActionId)
+}
+
+func TestMsgServer_TimelockGrantPermissions(t *testing.T) {
+	app, ctx := setup()
+	ctx = ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(ctx)
+	root := testutil.AccAddress().String()
+	grantee := testutil.AccAddress()
+	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+	app.SudoKeeper.TimelockBlocks.Set(ctx, 5)
+	msgServer := keeper.NewMsgServer(app.SudoKeeper)
+	scope := types.PermissionScope{
+		MsgTypeUrls: []string{"/nibiru.oracle.v1.MsgEditOracleParams"},
+	}
+
+	t.Log("permission grants are queued")
+	resp, err := msgServer.EditSudoers(goCtx, &types.MsgEditSudoers{
+		Action:    string(types.GrantPermissions),
+		Contracts: []string{grantee.String()},
+		Sender:    root,
+		Scope:     &scope,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, resp.PendingActionId)
+	action, err := app.SudoKeeper.PendingActions.Get(ctx, resp.PendingActionId)
+	require.NoError(t, err)
+	require.NoError(t, action.Validate())
+	require.Equal(t, &scope, action.Scope)
+	_, err = app.SudoKeeper.Permissions.Get(ctx, grantee)
+	require.Error(t, err)
+	require.Error(t, app.SudoKeeper.CheckMsgPermissions(grantee, ctx, scope.MsgTypeUrls[0]))
+
+	t.Log("the grant takes effect once the timelock passes")
+	app.SudoKeeper.ExecutePendingActions(ctx.WithBlockHeight(14))
+	_, err = app.SudoKeeper.Permissions.Get(ctx, grantee)
+	require.Error(t, err)
+	app.SudoKeeper.ExecutePendingActions(ctx.WithBlockHeight(15))
+	permission, err := app.SudoKeeper.Permissions.Get(ctx, grantee)
+	require.NoError(t, err)
+	require.Equal(t, scope, permission.Scope)
+	require.NoError(t, app.SudoKeeper.CheckMsgPermissions(grantee, ctx, scope.MsgTypeUrls[0]))
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/sudo/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,3 +38,44 @@ func (q Querier) QuerySudoers(
 		Sudoers: sudoers,
 	}, err
 }
+
+func (q Querier) QueryPermissions(
+	goCtx context.Context,
+	req *types.QueryPermissionsRequest,
+) (resp *types.QueryPermissionsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Address == "" {
+		return &types.QueryPermissionsResponse{
+			Permissions: q.keeper.Permissions.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values(),
+		}, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	permissions := []types.Permission{}
+	if permission, err := q.keeper.Permissions.Get(ctx, addr); err == nil {
+		permissions = append(permissions, permission)
+	}
+	return &types.QueryPermissionsResponse{Permissions: permissions}, nil
+}
+
+func (q Querier) PendingSudoActions(
+	goCtx context.Context,
+	req *types.QueryPendingSudoActionsRequest,
+) (resp *types.QueryPendingSudoActionsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryPendingSudoActionsResponse{
+		TimelockBlocks: q.keeper.TimelockBlocks.GetOr(ctx, 0),
+		Actions:        q.keeper.PendingActions.Iterate(ctx, collections.Range[uint64]{}).Values(),
+	}, nil
+}
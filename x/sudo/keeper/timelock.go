@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+// queueAction adds a root action to the timelock queue. The action executes
+// once the current timelock has passed.
+func (k Keeper) queueAction(
+	ctx sdk.Context, action sudotypes.PendingSudoAction,
+) (sudotypes.PendingSudoAction, error) {
+	action.Id = k.PendingActionID.Next(ctx)
+	action.QueuedAt = ctx.BlockHeight()
+	action.ExecuteAfter = ctx.BlockHeight() + int64(k.TimelockBlocks.GetOr(ctx, 0))
+	k.PendingActions.Insert(ctx, action.Id, action)
+	return action, ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoActionQueued{
+		Action: action,
+	})
+}
+
+// ————————————————————————————————————————————————————————————————————————————
+// SetTimelock
+// ————————————————————————————————————————————————————————————————————————————
+
+// SetTimelock executes a MsgSetTimelock message. If a timelock is already in
+// place, the change is queued behind it so that the timelock cannot be lifted
+// without notice.
+func (k Keeper) SetTimelock(
+	goCtx context.Context, msg *sudotypes.MsgSetTimelock,
+) (msgResp *sudotypes.MsgSetTimelockResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	err = k.senderHasPermission(msg.Sender, pbSudoers.Root)
+	if err != nil {
+		return
+	}
+
+	if k.TimelockBlocks.GetOr(ctx, 0) > 0 {
+		action, err := k.queueAction(ctx, sudotypes.PendingSudoAction{
+			Action:         string(sudotypes.SetTimelock),
+			Sender:         msg.Sender,
+			TimelockBlocks: msg.TimelockBlocks,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &sudotypes.MsgSetTimelockResponse{PendingActionId: action.Id}, nil
+	}
+
+	k.TimelockBlocks.Set(ctx, msg.TimelockBlocks)
+	return new(sudotypes.MsgSetTimelockResponse), nil
+}
+
+// ————————————————————————————————————————————————————————————————————————————
+// CancelSudoAction
+// ————————————————————————————————————————————————————————————————————————————
+
+// CancelSudoAction executes a MsgCancelSudoAction message. This removes a
+// pending action from the timelock queue before it executes.
+func (k Keeper) CancelSudoAction(
+	goCtx context.Context, msg *sudotypes.MsgCancelSudoAction,
+) (msgResp *sudotypes.MsgCancelSudoActionResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	err = k.senderHasPermission(msg.Sender, pbSudoers.Root)
+	if err != nil {
+		return
+	}
+
+	action, err := k.PendingActions.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %d", sudotypes.ErrNoSuchAction, msg.Id)
+	}
+	_ = k.PendingActions.Delete(ctx, msg.Id)
+	return new(sudotypes.MsgCancelSudoActionResponse),
+		ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoActionCancelled{
+			Action: action,
+		})
+}
+
+// ————————————————————————————————————————————————————————————————————————————
+// ExecutePendingActions
+// ————————————————————————————————————————————————————————————————————————————
+
+// ExecutePendingActions runs the queued actions whose timelock has passed, in
+// the order they were queued, and removes them from the queue. An action only
+// executes if its sender is still root. The state changes of an action that
+// fails are discarded.
+func (k Keeper) ExecutePendingActions(ctx sdk.Context) {
+	for _, action := range k.PendingActions.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if action.ExecuteAfter > ctx.BlockHeight() {
+			continue
+		}
+		_ = k.PendingActions.Delete(ctx, action.Id)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		errMsg := ""
+		if err := k.executeAction(cacheCtx, action); err != nil {
+			errMsg = err.Error()
+			k.Logger(ctx).Error("failed to execute pending sudo action",
+				"id", action.Id, "action", action.Action, "error", err)
+		} else {
+			writeCache()
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoActionExecuted{
+			Action: action,
+			Error:  errMsg,
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit EventSudoActionExecuted",
+				"id", action.Id, "error", err)
+		}
+	}
+}
+
+func (k Keeper) executeAction(ctx sdk.Context, action sudotypes.PendingSudoAction) error {
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.senderHasPermission(action.Sender, pbSudoers.Root); err != nil {
+		return err
+	}
+
+	switch sudotypes.RootAction(action.Action) {
+	case sudotypes.ChangeRoot:
+		if _, err := sdk.AccAddressFromBech32(action.NewRoot); err != nil {
+			return err
+		}
+		pbSudoers.Root = action.NewRoot
+	case sudotypes.AddContracts:
+		sudoers := SudoersFromPb(pbSudoers)
+		contracts, err := sudoers.AddContracts(action.Contracts)
+		if err != nil {
+			return err
+		}
+		pbSudoers = Sudoers{Root: sudoers.Root, Contracts: contracts}.ToPb()
+	case sudotypes.GrantPermissions:
+		if action.Scope == nil {
+			return fmt.Errorf("pending action %d has no permission scope", action.Id)
+		}
+		return k.grantPermissions(ctx, action.Contracts, *action.Scope)
+	case sudotypes.SetTimelock:
+		k.TimelockBlocks.Set(ctx, action.TimelockBlocks)
+		return nil
+	default:
+		return fmt.Errorf("invalid pending action type %s", action.Action)
+	}
+
+	k.Sudoers.Set(ctx, pbSudoers)
+	return ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdateSudoers{
+		Sudoers: pbSudoers,
+		Action:  action.Action,
+	})
+}
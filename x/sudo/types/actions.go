@@ -5,12 +5,22 @@ import "github.com/NibiruChain/nibiru/x/common/set"
 type RootAction string
 
 const (
-	AddContracts    RootAction = "add_contracts"
-	RemoveContracts RootAction = "remove_contracts"
+	AddContracts      RootAction = "add_contracts"
+	RemoveContracts   RootAction = "remove_contracts"
+	GrantPermissions  RootAction = "grant_permissions"
+	RevokePermissions RootAction = "revoke_permissions"
+)
+
+// Actions that the timelock may queue besides "add_contracts".
+const (
+	ChangeRoot  RootAction = "change_root"
+	SetTimelock RootAction = "set_timelock"
 )
 
 // RootActions set[string]: The set of all root actions.
 var RootActions = set.New[RootAction](
 	AddContracts,
 	RemoveContracts,
+	GrantPermissions,
+	RevokePermissions,
 )
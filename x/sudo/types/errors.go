@@ -10,6 +10,8 @@ var (
 	ErrUnauthorized = sdkerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
 	errGenesis      = sdkerrors.Register(ModuleName, 3, "sudo genesis error")
 	errSudoers      = sdkerrors.Register(ModuleName, 4, "sudoers error")
+	ErrRateLimited  = sdkerrors.Register(ModuleName, 5, "sudo permission rate limit reached")
+	ErrNoSuchAction = sdkerrors.Register(ModuleName, 6, "no such pending sudo action")
 )
 
 func ErrGenesis(errMsg string) error {
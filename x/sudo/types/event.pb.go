@@ -78,30 +78,241 @@ func (m *EventUpdateSudoers) GetAction() string {
 	return ""
 }
 
+// EventUpdatePermission: ABCI event emitted when root grants or revokes the
+// permission scope of an address with "MsgEditSudoers".
+type EventUpdatePermission struct {
+	Permission Permission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
+	// Action is the type of update that occured to the permission
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *EventUpdatePermission) Reset()         { *m = EventUpdatePermission{} }
+func (m *EventUpdatePermission) String() string { return proto.CompactTextString(m) }
+func (*EventUpdatePermission) ProtoMessage()    {}
+func (*EventUpdatePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{1}
+}
+func (m *EventUpdatePermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdatePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdatePermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdatePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdatePermission.Merge(m, src)
+}
+func (m *EventUpdatePermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdatePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdatePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdatePermission proto.InternalMessageInfo
+
+func (m *EventUpdatePermission) GetPermission() Permission {
+	if m != nil {
+		return m.Permission
+	}
+	return Permission{}
+}
+
+func (m *EventUpdatePermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+// EventSudoActionQueued: ABCI event emitted when the timelock queues a root
+// action.
+type EventSudoActionQueued struct {
+	Action PendingSudoAction `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+}
+
+func (m *EventSudoActionQueued) Reset()         { *m = EventSudoActionQueued{} }
+func (m *EventSudoActionQueued) String() string { return proto.CompactTextString(m) }
+func (*EventSudoActionQueued) ProtoMessage()    {}
+func (*EventSudoActionQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{2}
+}
+func (m *EventSudoActionQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSudoActionQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSudoActionQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSudoActionQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSudoActionQueued.Merge(m, src)
+}
+func (m *EventSudoActionQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSudoActionQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSudoActionQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSudoActionQueued proto.InternalMessageInfo
+
+func (m *EventSudoActionQueued) GetAction() PendingSudoAction {
+	if m != nil {
+		return m.Action
+	}
+	return PendingSudoAction{}
+}
+
+// EventSudoActionCancelled: ABCI event emitted when root cancels a pending
+// action.
+type EventSudoActionCancelled struct {
+	Action PendingSudoAction `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+}
+
+func (m *EventSudoActionCancelled) Reset()         { *m = EventSudoActionCancelled{} }
+func (m *EventSudoActionCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventSudoActionCancelled) ProtoMessage()    {}
+func (*EventSudoActionCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{3}
+}
+func (m *EventSudoActionCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSudoActionCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSudoActionCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSudoActionCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSudoActionCancelled.Merge(m, src)
+}
+func (m *EventSudoActionCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSudoActionCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSudoActionCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSudoActionCancelled proto.InternalMessageInfo
+
+func (m *EventSudoActionCancelled) GetAction() PendingSudoAction {
+	if m != nil {
+		return m.Action
+	}
+	return PendingSudoAction{}
+}
+
+// EventSudoActionExecuted: ABCI event emitted when a pending action executes.
+type EventSudoActionExecuted struct {
+	Action PendingSudoAction `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+	// Error: Reason the action failed to execute, if it did.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventSudoActionExecuted) Reset()         { *m = EventSudoActionExecuted{} }
+func (m *EventSudoActionExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventSudoActionExecuted) ProtoMessage()    {}
+func (*EventSudoActionExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{4}
+}
+func (m *EventSudoActionExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSudoActionExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSudoActionExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSudoActionExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSudoActionExecuted.Merge(m, src)
+}
+func (m *EventSudoActionExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSudoActionExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSudoActionExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSudoActionExecuted proto.InternalMessageInfo
+
+func (m *EventSudoActionExecuted) GetAction() PendingSudoAction {
+	if m != nil {
+		return m.Action
+	}
+	return PendingSudoAction{}
+}
+
+func (m *EventSudoActionExecuted) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateSudoers)(nil), "nibiru.sudo.v1.EventUpdateSudoers")
+	proto.RegisterType((*EventUpdatePermission)(nil), "nibiru.sudo.v1.EventUpdatePermission")
+	proto.RegisterType((*EventSudoActionQueued)(nil), "nibiru.sudo.v1.EventSudoActionQueued")
+	proto.RegisterType((*EventSudoActionCancelled)(nil), "nibiru.sudo.v1.EventSudoActionCancelled")
+	proto.RegisterType((*EventSudoActionExecuted)(nil), "nibiru.sudo.v1.EventSudoActionExecuted")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/event.proto", fileDescriptor_7e6085948b018986) }
 
 var fileDescriptor_7e6085948b018986 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x52, 0x2a, 0x97, 0x90, 0x2b, 0xc8, 0xba, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0xe0, 0xd2, 0x94,
-	0xfc, 0xd4, 0xa2, 0x62, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x08, 0x53, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5c, 0x0f, 0xd5, 0x1d, 0x7a, 0x50, 0x95, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04,
-	0xc1, 0x54, 0x0b, 0x89, 0x71, 0xb1, 0x25, 0x26, 0x83, 0xec, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0,
-	0x0c, 0x82, 0xf2, 0x9c, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x0f, 0x6c, 0x87, 0x73,
-	0x46, 0x62, 0x66, 0x9e, 0x3e, 0xd4, 0xcd, 0x15, 0x10, 0x57, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0xdd, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcb, 0xb3, 0x68, 0xee, 0x31, 0x01,
-	0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x13, 0xd1, 0x8a, 0x2b, 0x78, 0x08, 0xd5, 0x96, 0x20, 0xb1, 0xf6, 0x54, 0x3c, 0x64,
+	0xa9, 0x1e, 0x3c, 0xaa, 0xad, 0xbd, 0x8a, 0x56, 0x04, 0xd1, 0xd3, 0x36, 0x19, 0xd2, 0x85, 0x76,
+	0x37, 0xee, 0x9f, 0x52, 0xdf, 0xc2, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x22, 0xed, 0x8b, 0x48, 0xb2,
+	0xdb, 0x3f, 0x46, 0xbc, 0xf5, 0x36, 0xb3, 0xdf, 0x37, 0xdf, 0x6f, 0x58, 0x06, 0xf9, 0x8c, 0xf6,
+	0xa8, 0xd0, 0x58, 0xea, 0x98, 0xe3, 0x51, 0x13, 0xc3, 0x08, 0x98, 0x0a, 0x53, 0xc1, 0x15, 0xf7,
+	0x0e, 0x8c, 0x16, 0x66, 0x5a, 0x38, 0x6a, 0xfa, 0xe5, 0x84, 0x27, 0x3c, 0x97, 0x70, 0x56, 0x19,
+	0x97, 0x7f, 0x9c, 0x70, 0x9e, 0x0c, 0x00, 0x93, 0x94, 0x62, 0xc2, 0x18, 0x57, 0x44, 0x51, 0xce,
+	0xa4, 0x55, 0x8b, 0xf9, 0x52, 0x11, 0x05, 0x46, 0xab, 0x03, 0xf2, 0x3a, 0x19, 0xee, 0x29, 0x8d,
+	0x89, 0x82, 0x47, 0x1d, 0x73, 0x10, 0xd2, 0xbb, 0x44, 0xbb, 0xd2, 0x94, 0x55, 0xb7, 0xe6, 0x36,
+	0xf6, 0xcf, 0x2b, 0xe1, 0xef, 0x3d, 0x42, 0xeb, 0x6c, 0x6d, 0x4f, 0xbe, 0x4e, 0x9c, 0xee, 0xc2,
+	0xed, 0x1d, 0xa1, 0x12, 0x89, 0x32, 0x76, 0x75, 0xab, 0xe6, 0x36, 0xf6, 0xba, 0xb6, 0xab, 0xbf,
+	0xa1, 0xc3, 0x35, 0xcc, 0x3d, 0x88, 0x21, 0x95, 0x92, 0x72, 0xe6, 0x5d, 0x23, 0x94, 0x2e, 0x3b,
+	0x0b, 0xf3, 0x8b, 0xb0, 0x95, 0xdf, 0xf2, 0xd6, 0x66, 0xfe, 0x45, 0x3e, 0x5b, 0x64, 0xb6, 0xe9,
+	0x4d, 0xfe, 0xf4, 0xa0, 0x41, 0x43, 0xec, 0x5d, 0x2d, 0x07, 0x0c, 0xee, 0xf4, 0x2f, 0x8e, 0xc5,
+	0x94, 0x25, 0xab, 0x41, 0x4b, 0x5d, 0x24, 0xbf, 0xa2, 0x6a, 0x21, 0xb9, 0x4d, 0x58, 0x04, 0x83,
+	0xc1, 0x26, 0xc2, 0x53, 0x54, 0x29, 0x84, 0x77, 0xc6, 0x10, 0x69, 0xb5, 0x81, 0x6c, 0xaf, 0x8c,
+	0x76, 0x40, 0x08, 0x2e, 0xec, 0x4f, 0x99, 0xa6, 0x75, 0x3b, 0x99, 0x05, 0xee, 0x74, 0x16, 0xb8,
+	0xdf, 0xb3, 0xc0, 0xfd, 0x98, 0x07, 0xce, 0x74, 0x1e, 0x38, 0x9f, 0xf3, 0xc0, 0x79, 0x39, 0x4b,
+	0xa8, 0xea, 0xeb, 0x5e, 0x18, 0xf1, 0x21, 0xbe, 0xcb, 0x51, 0xed, 0x3e, 0xa1, 0x0c, 0xdb, 0x7b,
+	0x1a, 0x9b, 0x8b, 0x52, 0xef, 0x29, 0xc8, 0x5e, 0x29, 0xbf, 0xa7, 0x8b, 0x9f, 0x01, 0x00, 0xbf,
+	0x2f, 0x4f, 0x62, 0xcd, 0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateSudoers) Marshal() (dAtA []byte, err error) {
@@ -111,72 +322,666 @@ func (m *EventUpdateSudoers) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventUpdateSudoers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateSudoers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdatePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdatePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdatePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSudoActionQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSudoActionQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSudoActionQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSudoActionCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSudoActionCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSudoActionCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSudoActionExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSudoActionExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSudoActionExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateSudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdatePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permission.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSudoActionQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Action.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventSudoActionCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Action.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventSudoActionExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Action.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateSudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdatePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdatePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdatePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventUpdateSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x12
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	{
-		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return nil
+}
+func (m *EventSudoActionQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSudoActionQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSudoActionQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventUpdateSudoers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *EventSudoActionCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSudoActionCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSudoActionCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
+func (m *EventSudoActionExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -199,15 +1004,15 @@ func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateSudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSudoActionExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSudoActionExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -234,13 +1039,13 @@ func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -268,7 +1073,7 @@ func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
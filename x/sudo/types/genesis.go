@@ -2,8 +2,11 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 func (gen *GenesisState) Validate() error {
@@ -12,6 +15,26 @@ func (gen *GenesisState) Validate() error {
 	} else if err := gen.Sudoers.Validate(); err != nil {
 		return ErrGenesis(err.Error())
 	}
+	addrs := set.New[string]()
+	for _, permission := range gen.Permissions {
+		if err := permission.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+		if addrs.Has(permission.Address) {
+			return ErrGenesis("duplicate permission for address " + permission.Address)
+		}
+		addrs.Add(permission.Address)
+	}
+	ids := set.New[uint64]()
+	for _, action := range gen.PendingActions {
+		if err := action.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+		if ids.Has(action.Id) {
+			return ErrGenesis(fmt.Sprintf("duplicate pending action id %d", action.Id))
+		}
+		ids.Add(action.Id)
+	}
 	return nil
 }
 
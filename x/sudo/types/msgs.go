@@ -10,6 +10,8 @@ import (
 var (
 	_ legacytx.LegacyMsg = &MsgEditSudoers{}
 	_ legacytx.LegacyMsg = &MsgChangeRoot{}
+	_ legacytx.LegacyMsg = &MsgSetTimelock{}
+	_ legacytx.LegacyMsg = &MsgCancelSudoAction{}
 )
 
 // MsgEditSudoers
@@ -32,6 +34,15 @@ func (m MsgEditSudoers) ValidateBasic() error {
 		)
 	}
 
+	if m.RootAction() == GrantPermissions {
+		if m.Scope == nil {
+			return fmt.Errorf("action %s requires a permission scope", m.Action)
+		}
+		if err := m.Scope.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -91,3 +102,55 @@ func (msg MsgChangeRoot) Type() string { return "change_root" }
 func (m MsgChangeRoot) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// MsgSetTimelock
+
+func (m MsgSetTimelock) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgSetTimelock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgSetTimelock) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgSetTimelock) Type() string { return "set_timelock" }
+
+// GetSignBytes Implements Msg.
+func (m MsgSetTimelock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgCancelSudoAction
+
+func (m MsgCancelSudoAction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgCancelSudoAction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgCancelSudoAction) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgCancelSudoAction) Type() string { return "cancel_sudo_action" }
+
+// GetSignBytes Implements Msg.
+func (m MsgCancelSudoAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
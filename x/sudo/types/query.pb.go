@@ -110,33 +110,227 @@ func (m *QuerySudoersResponse) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+type QueryPermissionsRequest struct {
+	// Address: Optional address to filter by.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPermissionsRequest) Reset()         { *m = QueryPermissionsRequest{} }
+func (m *QueryPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsRequest) ProtoMessage()    {}
+func (*QueryPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{2}
+}
+func (m *QueryPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsRequest.Merge(m, src)
+}
+func (m *QueryPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsRequest proto.InternalMessageInfo
+
+func (m *QueryPermissionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryPermissionsResponse struct {
+	Permissions []Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *QueryPermissionsResponse) Reset()         { *m = QueryPermissionsResponse{} }
+func (m *QueryPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsResponse) ProtoMessage()    {}
+func (*QueryPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{3}
+}
+func (m *QueryPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsResponse.Merge(m, src)
+}
+func (m *QueryPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryPermissionsResponse) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type QueryPendingSudoActionsRequest struct {
+}
+
+func (m *QueryPendingSudoActionsRequest) Reset()         { *m = QueryPendingSudoActionsRequest{} }
+func (m *QueryPendingSudoActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSudoActionsRequest) ProtoMessage()    {}
+func (*QueryPendingSudoActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{4}
+}
+func (m *QueryPendingSudoActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSudoActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSudoActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSudoActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSudoActionsRequest.Merge(m, src)
+}
+func (m *QueryPendingSudoActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSudoActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSudoActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSudoActionsRequest proto.InternalMessageInfo
+
+type QueryPendingSudoActionsResponse struct {
+	// TimelockBlocks: The current timelock in blocks.
+	TimelockBlocks uint64              `protobuf:"varint,1,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+	Actions        []PendingSudoAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *QueryPendingSudoActionsResponse) Reset()         { *m = QueryPendingSudoActionsResponse{} }
+func (m *QueryPendingSudoActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSudoActionsResponse) ProtoMessage()    {}
+func (*QueryPendingSudoActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{5}
+}
+func (m *QueryPendingSudoActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSudoActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSudoActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSudoActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSudoActionsResponse.Merge(m, src)
+}
+func (m *QueryPendingSudoActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSudoActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSudoActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSudoActionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSudoActionsResponse) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
+}
+
+func (m *QueryPendingSudoActionsResponse) GetActions() []PendingSudoAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySudoersRequest)(nil), "nibiru.sudo.v1.QuerySudoersRequest")
 	proto.RegisterType((*QuerySudoersResponse)(nil), "nibiru.sudo.v1.QuerySudoersResponse")
+	proto.RegisterType((*QueryPermissionsRequest)(nil), "nibiru.sudo.v1.QueryPermissionsRequest")
+	proto.RegisterType((*QueryPermissionsResponse)(nil), "nibiru.sudo.v1.QueryPermissionsResponse")
+	proto.RegisterType((*QueryPendingSudoActionsRequest)(nil), "nibiru.sudo.v1.QueryPendingSudoActionsRequest")
+	proto.RegisterType((*QueryPendingSudoActionsResponse)(nil), "nibiru.sudo.v1.QueryPendingSudoActionsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/query.proto", fileDescriptor_3c5c8e03d8d77d77) }
 
 var fileDescriptor_3c5c8e03d8d77d77 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x92, 0x28, 0x97, 0x70, 0x20, 0xc8, 0xba, 0xe0, 0xd2, 0x94, 0xfc, 0xd4, 0xa2, 0xe2, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x7f, 0x2e, 0x11, 0x54, 0xe1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb8,
-	0x1e, 0xaa, 0x03, 0xf5, 0xa0, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xa9, 0x36,
-	0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x28, 0x54, 0xce, 0xc5, 0x83, 0x6c, 0xb4, 0x90, 0x32, 0xba,
-	0x09, 0x58, 0xdc, 0x23, 0xa5, 0x82, 0x5f, 0x11, 0xc4, 0x75, 0x4a, 0x32, 0x4d, 0x97, 0x9f, 0x4c,
-	0x66, 0x12, 0x13, 0x12, 0xd1, 0x47, 0xf6, 0x31, 0xd4, 0x09, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x07, 0xd6, 0xe9, 0x9c, 0x91, 0x98, 0x99, 0x07, 0x33, 0xa5, 0x02, 0x62, 0x4e,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xdc, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x97, 0xc1, 0x8d, 0xe0, 0xb5, 0x01, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x6d, 0x21, 0xe2, 0x0d, 0x2a, 0xe8, 0x08, 0xd4, 0xb2, 0xa2, 0x6b, 0x30, 0x95,
+	0x1a, 0x31, 0xf8, 0xd4, 0x74, 0x60, 0x6e, 0x60, 0xe6, 0x4f, 0xd8, 0x18, 0xa8, 0x9c, 0xf8, 0xe4,
+	0x9e, 0x68, 0xee, 0x5c, 0xdf, 0xb9, 0xd0, 0x95, 0x85, 0x09, 0x09, 0x89, 0x91, 0x2f, 0xc0, 0x47,
+	0xe9, 0x58, 0x89, 0x85, 0x09, 0xa1, 0x84, 0x0f, 0x82, 0x7c, 0x77, 0x56, 0x1d, 0xbb, 0x81, 0x2e,
+	0x96, 0xfd, 0xbe, 0xef, 0xf3, 0xbc, 0xbf, 0xf3, 0x63, 0x83, 0x2f, 0xf8, 0x84, 0x67, 0x39, 0x55,
+	0x79, 0x2c, 0xe9, 0xe9, 0x1e, 0x3d, 0xc9, 0x59, 0x76, 0x16, 0xa6, 0x99, 0xd4, 0x12, 0x6f, 0xda,
+	0x5e, 0x58, 0xf4, 0xc2, 0xd3, 0x3d, 0xbf, 0x9b, 0xc8, 0x44, 0x9a, 0x16, 0x2d, 0xee, 0xec, 0x94,
+	0xdf, 0x4b, 0xa4, 0x4c, 0x8e, 0x19, 0x8d, 0x52, 0x4e, 0x23, 0x21, 0xa4, 0x8e, 0x34, 0x97, 0x42,
+	0xb9, 0x6e, 0xdd, 0x5f, 0xe9, 0x48, 0x33, 0xdb, 0x0b, 0xee, 0xc3, 0xbd, 0x57, 0xc5, 0xba, 0xd7,
+	0x79, 0x2c, 0x59, 0xa6, 0xc6, 0xec, 0x24, 0x67, 0x4a, 0x07, 0x2f, 0xa0, 0xbb, 0x5c, 0x56, 0xa9,
+	0x14, 0x8a, 0xe1, 0x27, 0xd0, 0x56, 0xb6, 0xe4, 0xa1, 0x3e, 0x1a, 0x74, 0x86, 0x5b, 0xe1, 0x32,
+	0x60, 0xe8, 0x14, 0xa3, 0x8d, 0xf3, 0x5f, 0xdb, 0xad, 0x71, 0x39, 0x1d, 0xec, 0xc3, 0x96, 0x31,
+	0x7c, 0xc9, 0xb2, 0x19, 0x57, 0xaa, 0xa0, 0x73, 0xbb, 0xb0, 0x07, 0xed, 0x28, 0x8e, 0x33, 0xa6,
+	0xac, 0xe7, 0xad, 0x71, 0xf9, 0x18, 0xbc, 0x05, 0xaf, 0x29, 0x72, 0x24, 0x23, 0xe8, 0xa4, 0x97,
+	0x65, 0x0f, 0xf5, 0xd7, 0x07, 0x9d, 0xa1, 0x5f, 0xa7, 0xb9, 0x54, 0x3a, 0xa0, 0xaa, 0x28, 0xe8,
+	0x03, 0x71, 0xfe, 0x22, 0xe6, 0x22, 0x29, 0xd0, 0x0f, 0xa6, 0xba, 0xc2, 0x16, 0x7c, 0x46, 0xb0,
+	0xbd, 0x72, 0xc4, 0x91, 0xec, 0xc2, 0x1d, 0xcd, 0x67, 0xec, 0x58, 0x4e, 0xdf, 0x1d, 0x4e, 0x8a,
+	0xab, 0x3d, 0xc7, 0xc6, 0x78, 0xb3, 0x2c, 0x8f, 0x4c, 0x15, 0x1f, 0x40, 0x3b, 0xb2, 0x5a, 0x6f,
+	0xcd, 0xe0, 0x3e, 0x6c, 0xe2, 0xd6, 0xb6, 0x94, 0xaf, 0xd1, 0xe9, 0x86, 0xdf, 0xd7, 0xe1, 0x86,
+	0xe1, 0xc1, 0xef, 0xe1, 0x76, 0x35, 0x21, 0xfc, 0xa8, 0xee, 0x75, 0x45, 0xac, 0xfe, 0xce, 0xbf,
+	0x87, 0xec, 0x81, 0x82, 0xde, 0xc7, 0x1f, 0x7f, 0xbe, 0xae, 0x3d, 0xc0, 0x5d, 0x5a, 0xfd, 0x70,
+	0x5c, 0x92, 0xf8, 0x13, 0x82, 0xbb, 0xf5, 0x54, 0xf0, 0xee, 0x95, 0xc6, 0xcd, 0xb0, 0xfd, 0xc1,
+	0xff, 0x07, 0x1d, 0x45, 0xdf, 0x50, 0xf8, 0xd8, 0x5b, 0xa2, 0xa8, 0xc4, 0x87, 0xbf, 0x21, 0xc0,
+	0xcd, 0x5c, 0x70, 0xb8, 0x62, 0xc5, 0x8a, 0x8c, 0x7d, 0x7a, 0xed, 0x79, 0x47, 0xb6, 0x63, 0xc8,
+	0x08, 0xee, 0xd5, 0xc8, 0x8c, 0xe0, 0xd0, 0x45, 0x35, 0x7a, 0x76, 0x3e, 0x27, 0xe8, 0x62, 0x4e,
+	0xd0, 0xef, 0x39, 0x41, 0x5f, 0x16, 0xa4, 0x75, 0xb1, 0x20, 0xad, 0x9f, 0x0b, 0xd2, 0x7a, 0xf3,
+	0x38, 0xe1, 0xfa, 0x28, 0x9f, 0x84, 0x53, 0x39, 0xa3, 0xcf, 0x8d, 0xc3, 0xd3, 0xa3, 0x88, 0x8b,
+	0xd2, 0xed, 0x83, 0xf5, 0xd3, 0x67, 0x29, 0x53, 0x93, 0x9b, 0xe6, 0x37, 0xdd, 0xff, 0x3b, 0x00,
+	0xc2, 0xae, 0xee, 0x0c, 0x24, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +346,11 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	QuerySudoers(ctx context.Context, in *QuerySudoersRequest, opts ...grpc.CallOption) (*QuerySudoersResponse, error)
+	// QueryPermissions: The permission scopes granted by root, for a single
+	// address or for every address.
+	QueryPermissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
+	// PendingSudoActions: The root actions queued by the timelock.
+	PendingSudoActions(ctx context.Context, in *QueryPendingSudoActionsRequest, opts ...grpc.CallOption) (*QueryPendingSudoActionsResponse, error)
 }
 
 type queryClient struct {
@@ -171,9 +370,32 @@ func (c *queryClient) QuerySudoers(ctx context.Context, in *QuerySudoersRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryPermissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSudoActions(ctx context.Context, in *QueryPendingSudoActionsRequest, opts ...grpc.CallOption) (*QueryPendingSudoActionsResponse, error) {
+	out := new(QueryPendingSudoActionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/PendingSudoActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error)
+	// QueryPermissions: The permission scopes granted by root, for a single
+	// address or for every address.
+	QueryPermissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
+	// PendingSudoActions: The root actions queued by the timelock.
+	PendingSudoActions(context.Context, *QueryPendingSudoActionsRequest) (*QueryPendingSudoActionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -183,6 +405,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) QuerySudoers(ctx context.Context, req *QuerySudoersRequest) (*QuerySudoersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySudoers not implemented")
 }
+func (*UnimplementedQueryServer) QueryPermissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPermissions not implemented")
+}
+func (*UnimplementedQueryServer) PendingSudoActions(ctx context.Context, req *QueryPendingSudoActionsRequest) (*QueryPendingSudoActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSudoActions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -206,6 +434,42 @@ func _Query_QuerySudoers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPermissions(ctx, req.(*QueryPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSudoActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSudoActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSudoActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/PendingSudoActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSudoActions(ctx, req.(*QueryPendingSudoActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -214,6 +478,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySudoers",
 			Handler:    _Query_QuerySudoers_Handler,
 		},
+		{
+			MethodName: "QueryPermissions",
+			Handler:    _Query_QueryPermissions_Handler,
+		},
+		{
+			MethodName: "PendingSudoActions",
+			Handler:    _Query_PendingSudoActions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/query.proto",
@@ -275,6 +547,138 @@ func (m *QuerySudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSudoActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSudoActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSudoActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSudoActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSudoActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSudoActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -306,11 +710,66 @@ func (m *QuerySudoersResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingSudoActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingSudoActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.TimelockBlocks))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -445,6 +904,325 @@ func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSudoActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSudoActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSudoActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSudoActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSudoActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSudoActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, PendingSudoAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 func (sudo Sudoers) Validate() error {
@@ -16,7 +21,88 @@ func (sudo Sudoers) Validate() error {
 	return nil
 }
 
+func (scope PermissionScope) Validate() error {
+	if len(scope.MsgTypeUrls) == 0 {
+		return ErrSudoers("permission scope must have at least one msg type url")
+	}
+	typeUrls := set.New[string]()
+	for _, typeUrl := range scope.MsgTypeUrls {
+		if !strings.HasPrefix(typeUrl, "/") {
+			return ErrSudoers(fmt.Sprintf("msg type url must start with \"/\": %s", typeUrl))
+		}
+		if typeUrls.Has(typeUrl) {
+			return ErrSudoers("duplicate msg type url: " + typeUrl)
+		}
+		typeUrls.Add(typeUrl)
+	}
+	if scope.ExpiryHeight < 0 {
+		return ErrSudoers("expiry height must not be negative")
+	}
+	if scope.RateLimit > 0 && scope.RateLimitWindow == 0 {
+		return ErrSudoers("rate limit requires a rate limit window")
+	}
+	return nil
+}
+
+// Allows returns true if the scope covers the msg type url and has not
+// expired at the block height.
+func (scope PermissionScope) Allows(msgTypeUrl string, blockHeight int64) bool {
+	if scope.ExpiryHeight != 0 && blockHeight > scope.ExpiryHeight {
+		return false
+	}
+	for _, typeUrl := range scope.MsgTypeUrls {
+		if typeUrl == msgTypeUrl {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Permission) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return ErrSudoers("permission addr: " + err.Error())
+	}
+	return p.Scope.Validate()
+}
+
 type SudoersJson struct {
 	Root      string   `json:"root"`
 	Contracts []string `json:"contracts"`
 }
+
+func (action PendingSudoAction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(action.Sender); err != nil {
+		return ErrSudoers("pending action sender: " + err.Error())
+	}
+	switch RootAction(action.Action) {
+	case ChangeRoot:
+		if _, err := sdk.AccAddressFromBech32(action.NewRoot); err != nil {
+			return ErrSudoers("pending action new root: " + err.Error())
+		}
+	case AddContracts:
+		for _, contract := range action.Contracts {
+			if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+				return ErrSudoers("pending action contract: " + err.Error())
+			}
+		}
+	case GrantPermissions:
+		if action.Scope == nil {
+			return ErrSudoers("pending action requires a permission scope")
+		}
+		if err := action.Scope.Validate(); err != nil {
+			return err
+		}
+		for _, contract := range action.Contracts {
+			if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+				return ErrSudoers("pending action contract: " + err.Error())
+			}
+		}
+	case SetTimelock:
+	default:
+		return ErrSudoers("invalid pending action type " + action.Action)
+	}
+	if action.ExecuteAfter < action.QueuedAt {
+		return ErrSudoers("pending action executes before it was queued")
+	}
+	return nil
+}
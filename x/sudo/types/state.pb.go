@@ -78,16 +78,330 @@ func (m *Sudoers) GetContracts() []string {
 	return nil
 }
 
+// PermissionScope: A narrow set of sudo powers that root grants to an address,
+// as opposed to the full powers of the "Sudoers.Contracts".
+type PermissionScope struct {
+	// MsgTypeUrls: Type URLs of the messages the address may execute with sudo
+	// permissions. Ex. "/nibiru.oracle.v1.MsgEditOracleParams".
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// ExpiryHeight: Last block height at which the scope can be used. Zero
+	// never expires.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// RateLimit: Maximum number of uses of the scope in each window of
+	// "rate_limit_window" blocks. Zero means no limit.
+	RateLimit uint64 `protobuf:"varint,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// RateLimitWindow: Length of the rate limit window in blocks.
+	RateLimitWindow uint64 `protobuf:"varint,4,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty"`
+}
+
+func (m *PermissionScope) Reset()         { *m = PermissionScope{} }
+func (m *PermissionScope) String() string { return proto.CompactTextString(m) }
+func (*PermissionScope) ProtoMessage()    {}
+func (*PermissionScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{1}
+}
+func (m *PermissionScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionScope.Merge(m, src)
+}
+func (m *PermissionScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionScope proto.InternalMessageInfo
+
+func (m *PermissionScope) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *PermissionScope) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PermissionScope) GetRateLimit() uint64 {
+	if m != nil {
+		return m.RateLimit
+	}
+	return 0
+}
+
+func (m *PermissionScope) GetRateLimitWindow() uint64 {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+// Permission: The permission scope granted to an address.
+type Permission struct {
+	Address string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Scope   PermissionScope `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{2}
+}
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
+}
+func (m *Permission) XXX_Size() int {
+	return m.Size()
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Permission) GetScope() PermissionScope {
+	if m != nil {
+		return m.Scope
+	}
+	return PermissionScope{}
+}
+
+// PermissionUsage: Number of uses of a permission scope in the current rate
+// limit window.
+type PermissionUsage struct {
+	// WindowStart: Block height at which the current window started.
+	WindowStart int64  `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Count       uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *PermissionUsage) Reset()         { *m = PermissionUsage{} }
+func (m *PermissionUsage) String() string { return proto.CompactTextString(m) }
+func (*PermissionUsage) ProtoMessage()    {}
+func (*PermissionUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{3}
+}
+func (m *PermissionUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionUsage.Merge(m, src)
+}
+func (m *PermissionUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionUsage proto.InternalMessageInfo
+
+func (m *PermissionUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *PermissionUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// PendingSudoAction: A root action queued by the timelock. It executes at the
+// end of the first block at or after "execute_after", unless root cancels it
+// before then.
+type PendingSudoAction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Action: Identifier for the type of action. One of "change_root",
+	//   "add_contracts", "grant_permissions", or "set_timelock".
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// Sender: The root address that queued the action.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// NewRoot: Payload of the "change_root" action.
+	NewRoot string `protobuf:"bytes,4,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	// Contracts: Payload of the "add_contracts" and "grant_permissions"
+	//   actions.
+	Contracts []string `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// TimelockBlocks: Payload of the "set_timelock" action.
+	TimelockBlocks uint64 `protobuf:"varint,6,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+	// QueuedAt: Block height at which the action was queued.
+	QueuedAt int64 `protobuf:"varint,7,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	// ExecuteAfter: Block height from which the action executes.
+	ExecuteAfter int64 `protobuf:"varint,8,opt,name=execute_after,json=executeAfter,proto3" json:"execute_after,omitempty"`
+	// Scope: Payload of the "grant_permissions" action.
+	Scope *PermissionScope `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (m *PendingSudoAction) Reset()         { *m = PendingSudoAction{} }
+func (m *PendingSudoAction) String() string { return proto.CompactTextString(m) }
+func (*PendingSudoAction) ProtoMessage()    {}
+func (*PendingSudoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{4}
+}
+func (m *PendingSudoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSudoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSudoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSudoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSudoAction.Merge(m, src)
+}
+func (m *PendingSudoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSudoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSudoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSudoAction proto.InternalMessageInfo
+
+func (m *PendingSudoAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingSudoAction) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PendingSudoAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingSudoAction) GetNewRoot() string {
+	if m != nil {
+		return m.NewRoot
+	}
+	return ""
+}
+
+func (m *PendingSudoAction) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *PendingSudoAction) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
+}
+
+func (m *PendingSudoAction) GetQueuedAt() int64 {
+	if m != nil {
+		return m.QueuedAt
+	}
+	return 0
+}
+
+func (m *PendingSudoAction) GetExecuteAfter() int64 {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return 0
+}
+
+func (m *PendingSudoAction) GetScope() *PermissionScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 // GenesisState: State for migrations and genesis for the x/sudo module.
 type GenesisState struct {
 	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+	// Permissions: Scoped permissions granted by root.
+	Permissions []Permission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
+	// TimelockBlocks: Number of blocks that root-changing, contract-adding, and
+	// permission-granting actions wait in the queue before they execute. Zero
+	// disables the timelock.
+	TimelockBlocks uint64 `protobuf:"varint,3,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+	// PendingActions: Root actions queued by the timelock.
+	PendingActions []PendingSudoAction `protobuf:"bytes,4,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b462ff6aaf658cf, []int{1}
+	return fileDescriptor_4b462ff6aaf658cf, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,32 +437,80 @@ func (m *GenesisState) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+func (m *GenesisState) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingActions() []PendingSudoAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
+	proto.RegisterType((*PermissionScope)(nil), "nibiru.sudo.v1.PermissionScope")
+	proto.RegisterType((*Permission)(nil), "nibiru.sudo.v1.Permission")
+	proto.RegisterType((*PermissionUsage)(nil), "nibiru.sudo.v1.PermissionUsage")
+	proto.RegisterType((*PendingSudoAction)(nil), "nibiru.sudo.v1.PendingSudoAction")
 	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
 
 var fileDescriptor_4b462ff6aaf658cf = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x1c, 0xc5, 0xef, 0xb4, 0x58, 0x2e, 0x8a, 0x43, 0x10, 0x2c, 0x47, 0x89, 0xa5, 0x53, 0x71, 0x48,
-	0xa8, 0x0e, 0x0e, 0x6e, 0x55, 0xe8, 0xe6, 0x70, 0xdd, 0xdc, 0x72, 0xd7, 0x90, 0x06, 0x34, 0xff,
-	0x23, 0xf9, 0x5f, 0xd1, 0x6f, 0xe1, 0xc7, 0xea, 0xd8, 0xd1, 0x49, 0xe4, 0xee, 0x8b, 0xc8, 0x25,
-	0x15, 0x71, 0x7b, 0xe4, 0xf7, 0x78, 0x79, 0xff, 0x47, 0x72, 0x6b, 0x4a, 0xe3, 0x1a, 0xe1, 0x9b,
-	0x35, 0x88, 0xed, 0x5c, 0x78, 0x94, 0xa8, 0x78, 0xed, 0x00, 0x81, 0x9e, 0x47, 0xc6, 0x7b, 0xc6,
-	0xb7, 0xf3, 0xfc, 0x42, 0x83, 0x86, 0x80, 0x44, 0xaf, 0xa2, 0x2b, 0x1f, 0x6b, 0x00, 0xfd, 0xa2,
-	0x84, 0xac, 0x8d, 0x90, 0xd6, 0x02, 0x4a, 0x34, 0x60, 0x7d, 0xa4, 0xd3, 0x7b, 0x32, 0x5c, 0x35,
-	0x6b, 0x50, 0xce, 0x53, 0x4a, 0x06, 0x0e, 0x00, 0x47, 0xe9, 0x24, 0x9d, 0x65, 0x45, 0xd0, 0x74,
-	0x4c, 0xb2, 0x0a, 0x2c, 0x3a, 0x59, 0xa1, 0x1f, 0x1d, 0x4d, 0x8e, 0x67, 0x59, 0xf1, 0xf7, 0x30,
-	0x5d, 0x92, 0xb3, 0xa5, 0xb2, 0xca, 0x1b, 0xbf, 0xea, 0x6b, 0xd1, 0x3b, 0x32, 0xf4, 0x31, 0x2c,
-	0x84, 0x9c, 0xde, 0x5c, 0xf2, 0xff, 0x15, 0xf9, 0xe1, 0xaf, 0xc5, 0x60, 0xf7, 0x75, 0x95, 0x14,
-	0xbf, 0xee, 0xc5, 0xe3, 0xae, 0x65, 0xe9, 0xbe, 0x65, 0xe9, 0x77, 0xcb, 0xd2, 0x8f, 0x8e, 0x25,
-	0xfb, 0x8e, 0x25, 0x9f, 0x1d, 0x4b, 0x9e, 0xaf, 0xb5, 0xc1, 0x4d, 0x53, 0xf2, 0x0a, 0x5e, 0xc5,
-	0x53, 0xc8, 0x7a, 0xd8, 0x48, 0x63, 0xc5, 0x61, 0x96, 0xb7, 0x38, 0x0c, 0xbe, 0xd7, 0xca, 0x97,
-	0x27, 0xe1, 0xa4, 0xdb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x57, 0x7e, 0xb3, 0x34, 0x01,
-	0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x13, 0xb7, 0xa9, 0x27, 0x6d, 0xa2, 0xae, 0x2a, 0x30, 0xa1, 0xa4, 0x69, 0x38, 0x10,
+	0xf5, 0x10, 0xab, 0x45, 0x88, 0x43, 0x4f, 0x0d, 0x48, 0x20, 0x84, 0x50, 0xe5, 0x50, 0x21, 0x71,
+	0xb1, 0x36, 0xf6, 0xe2, 0xac, 0x48, 0x76, 0xcd, 0xee, 0xba, 0x69, 0x7f, 0x80, 0x33, 0x3f, 0xc1,
+	0x95, 0xef, 0xe8, 0xb1, 0x47, 0x4e, 0x08, 0xb5, 0x3f, 0x82, 0x76, 0xd7, 0x6e, 0x4b, 0x5a, 0x24,
+	0x2e, 0x96, 0xe7, 0xcd, 0xcc, 0xce, 0xcc, 0x7b, 0xb3, 0x0b, 0x6d, 0x46, 0xc7, 0x54, 0xe4, 0x81,
+	0xcc, 0x13, 0x1e, 0x1c, 0xef, 0x06, 0x52, 0x61, 0x45, 0x06, 0x99, 0xe0, 0x8a, 0xa3, 0xa6, 0xf5,
+	0x0d, 0xb4, 0x6f, 0x70, 0xbc, 0xdb, 0xde, 0x48, 0x79, 0xca, 0x8d, 0x2b, 0xd0, 0x7f, 0x36, 0xaa,
+	0xbd, 0x99, 0x72, 0x9e, 0x4e, 0x49, 0x80, 0x33, 0x1a, 0x60, 0xc6, 0xb8, 0xc2, 0x8a, 0x72, 0x26,
+	0xad, 0xb7, 0xb7, 0x0f, 0xf5, 0x51, 0x9e, 0x70, 0x22, 0x24, 0x42, 0xe0, 0x0a, 0xce, 0x95, 0xef,
+	0x74, 0x9d, 0xbe, 0x17, 0x9a, 0x7f, 0xb4, 0x09, 0x5e, 0xcc, 0x99, 0x12, 0x38, 0x56, 0xd2, 0xaf,
+	0x76, 0x6b, 0x7d, 0x2f, 0xbc, 0x06, 0x7a, 0xdf, 0x1d, 0x68, 0x1d, 0x12, 0x31, 0xa3, 0x52, 0x52,
+	0xce, 0x46, 0x31, 0xcf, 0x08, 0xea, 0xc1, 0xda, 0x4c, 0xa6, 0x91, 0x3a, 0xcd, 0x48, 0x94, 0x8b,
+	0xa9, 0xf4, 0x1d, 0x93, 0xd5, 0x98, 0xc9, 0xf4, 0xfd, 0x69, 0x46, 0x8e, 0xc4, 0x54, 0xa2, 0xc7,
+	0xb0, 0x46, 0x4e, 0x32, 0x2a, 0x4e, 0xa3, 0x09, 0xa1, 0xe9, 0x44, 0xf9, 0xd5, 0xae, 0xd3, 0xaf,
+	0x85, 0xab, 0x16, 0x7c, 0x6d, 0x30, 0xf4, 0x08, 0x40, 0x60, 0x45, 0xa2, 0x29, 0x9d, 0x51, 0xe5,
+	0xd7, 0xba, 0x4e, 0xdf, 0x0d, 0x3d, 0x8d, 0xbc, 0xd5, 0x00, 0xda, 0x81, 0xf5, 0x6b, 0x77, 0x34,
+	0xa7, 0x2c, 0xe1, 0x73, 0xdf, 0x35, 0x51, 0xad, 0xab, 0xa8, 0x0f, 0x06, 0xee, 0xc5, 0x00, 0xd7,
+	0x6d, 0x22, 0x1f, 0xea, 0x38, 0x49, 0x04, 0x91, 0xb2, 0x18, 0xb5, 0x34, 0xd1, 0x3e, 0x2c, 0x49,
+	0x3d, 0x84, 0xe9, 0xa7, 0xb1, 0xb7, 0x35, 0xf8, 0x9b, 0xe0, 0xc1, 0xc2, 0xac, 0x43, 0xf7, 0xec,
+	0xd7, 0x56, 0x25, 0xb4, 0x39, 0xbd, 0x37, 0x37, 0xb9, 0x38, 0x92, 0x38, 0x25, 0x68, 0x1b, 0x56,
+	0x6d, 0x63, 0x91, 0x54, 0x58, 0x58, 0x66, 0x6b, 0x61, 0xc3, 0x62, 0x23, 0x0d, 0xa1, 0x0d, 0x58,
+	0x8a, 0x79, 0xce, 0x2c, 0x05, 0x6e, 0x68, 0x8d, 0xde, 0x8f, 0x2a, 0xac, 0x1f, 0x12, 0x96, 0x50,
+	0x96, 0x6a, 0x75, 0x0e, 0x62, 0x2d, 0x19, 0x6a, 0x42, 0x95, 0x26, 0xe6, 0x10, 0x37, 0xac, 0xd2,
+	0x04, 0xdd, 0x83, 0x65, 0x6c, 0x3c, 0x26, 0xd9, 0x0b, 0x0b, 0x4b, 0xe3, 0x92, 0xb0, 0x84, 0x08,
+	0xc3, 0x9a, 0x17, 0x16, 0x16, 0x7a, 0x00, 0x2b, 0x8c, 0xcc, 0x23, 0x23, 0xb2, 0x6b, 0x27, 0x67,
+	0x64, 0x1e, 0xde, 0xd2, 0x79, 0x69, 0x41, 0x67, 0xf4, 0x04, 0x5a, 0x8a, 0xce, 0xc8, 0x94, 0xc7,
+	0x9f, 0xa3, 0xb1, 0xfe, 0x4a, 0x7f, 0xd9, 0x74, 0xd1, 0x2c, 0xe1, 0xa1, 0x41, 0xd1, 0x43, 0xf0,
+	0xbe, 0xe4, 0x24, 0x27, 0x49, 0x84, 0x95, 0x5f, 0x37, 0xd3, 0xae, 0x58, 0xe0, 0x40, 0x59, 0xd5,
+	0x49, 0x9c, 0x2b, 0x12, 0xe1, 0x4f, 0x8a, 0x08, 0x7f, 0xa5, 0x54, 0xdd, 0x80, 0x07, 0x1a, 0x43,
+	0xcf, 0x4a, 0x09, 0xbc, 0xff, 0x92, 0xa0, 0x24, 0xff, 0x6b, 0x15, 0x56, 0x5f, 0x11, 0x46, 0x24,
+	0x95, 0x23, 0x7d, 0x43, 0xd0, 0x73, 0xa8, 0x4b, 0xbb, 0xd7, 0x86, 0xb0, 0xc6, 0xde, 0xfd, 0xc5,
+	0x93, 0x8a, 0xb5, 0x2f, 0x44, 0x2c, 0xa3, 0xd1, 0x10, 0x1a, 0xd9, 0x55, 0x0d, 0xbb, 0xf3, 0x8d,
+	0xbd, 0xf6, 0xbf, 0xdb, 0x28, 0xf2, 0x6f, 0x26, 0xdd, 0xc5, 0x57, 0xed, 0x4e, 0xbe, 0x0e, 0xa1,
+	0x95, 0x59, 0x99, 0x23, 0xab, 0x9d, 0xf4, 0x5d, 0x53, 0x70, 0xfb, 0x76, 0xc1, 0x85, 0x6d, 0x28,
+	0xea, 0x36, 0x8b, 0x7c, 0x0b, 0xca, 0xe1, 0xcb, 0xb3, 0x8b, 0x8e, 0x73, 0x7e, 0xd1, 0x71, 0x7e,
+	0x5f, 0x74, 0x9c, 0x6f, 0x97, 0x9d, 0xca, 0xf9, 0x65, 0xa7, 0xf2, 0xf3, 0xb2, 0x53, 0xf9, 0xb8,
+	0x93, 0x52, 0x35, 0xc9, 0xc7, 0x83, 0x98, 0xcf, 0x82, 0x77, 0xe6, 0xf0, 0x17, 0x13, 0x4c, 0x59,
+	0x50, 0x3c, 0x30, 0x27, 0xf6, 0x89, 0xd1, 0xf7, 0x56, 0x8e, 0x97, 0xcd, 0xe3, 0xf0, 0xf4, 0xcf,
+	0x00, 0x1c, 0x85, 0x8b, 0xf0, 0x7e, 0x04, 0x00, 0x00,
 }
 
 func (m *Sudoers) Marshal() (dAtA []byte, err error) {
@@ -190,7 +552,7 @@ func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *PermissionScope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -200,18 +562,65 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PermissionScope) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PermissionScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitWindow != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RateLimitWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RateLimit != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RateLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Permission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Permission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -219,58 +628,817 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PermissionUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Sudoers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PermissionUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
+	if m.WindowStart != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSudoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSudoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSudoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExecuteAfter != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExecuteAfter))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QueuedAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.QueuedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PermissionScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovState(uint64(m.ExpiryHeight))
+	}
+	if m.RateLimit != 0 {
+		n += 1 + sovState(uint64(m.RateLimit))
+	}
+	if m.RateLimitWindow != 0 {
+		n += 1 + sovState(uint64(m.RateLimitWindow))
+	}
+	return n
+}
+
+func (m *Permission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Scope.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *PermissionUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovState(uint64(m.WindowStart))
+	}
+	if m.Count != 0 {
+		n += 1 + sovState(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *PendingSudoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovState(uint64(m.TimelockBlocks))
+	}
+	if m.QueuedAt != 0 {
+		n += 1 + sovState(uint64(m.QueuedAt))
+	}
+	if m.ExecuteAfter != 0 {
+		n += 1 + sovState(uint64(m.ExecuteAfter))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovState(uint64(m.TimelockBlocks))
+	}
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			m.RateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			m.RateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Permission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Sudoers) Unmarshal(dAtA []byte) error {
+func (m *PendingSudoAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -293,15 +1461,34 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSudoAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSudoAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -329,9 +1516,73 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
@@ -363,6 +1614,99 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			}
 			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
+			}
+			m.QueuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			m.ExecuteAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &PermissionScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -446,6 +1790,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingSudoAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -39,6 +39,9 @@ type MsgEditSudoers struct {
 	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	// Sender: Address for the signer of the transaction.
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Scope: The permission scope given to each of the "contracts" with the
+	//   "grant_permissions" action.
+	Scope *PermissionScope `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
 func (m *MsgEditSudoers) Reset()         { *m = MsgEditSudoers{} }
@@ -95,8 +98,17 @@ func (m *MsgEditSudoers) GetSender() string {
 	return ""
 }
 
+func (m *MsgEditSudoers) GetScope() *PermissionScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
 // MsgEditSudoersResponse indicates the successful execution of MsgEditSudeors.
 type MsgEditSudoersResponse struct {
+	// PendingActionId: ID of the queued action if the timelock queued the edit.
+	PendingActionId uint64 `protobuf:"varint,1,opt,name=pending_action_id,json=pendingActionId,proto3" json:"pending_action_id,omitempty"`
 }
 
 func (m *MsgEditSudoersResponse) Reset()         { *m = MsgEditSudoersResponse{} }
@@ -132,6 +144,13 @@ func (m *MsgEditSudoersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditSudoersResponse proto.InternalMessageInfo
 
+func (m *MsgEditSudoersResponse) GetPendingActionId() uint64 {
+	if m != nil {
+		return m.PendingActionId
+	}
+	return 0
+}
+
 // MsgChangeRoot: Msg to update the "Sudoers" state.
 type MsgChangeRoot struct {
 	// Sender: Address for the signer of the transaction.
@@ -189,6 +208,9 @@ func (m *MsgChangeRoot) GetNewRoot() string {
 
 // MsgChangeRootResponse indicates the successful execution of MsgChangeRoot.
 type MsgChangeRootResponse struct {
+	// PendingActionId: ID of the queued action if the timelock queued the root
+	// change.
+	PendingActionId uint64 `protobuf:"varint,1,opt,name=pending_action_id,json=pendingActionId,proto3" json:"pending_action_id,omitempty"`
 }
 
 func (m *MsgChangeRootResponse) Reset()         { *m = MsgChangeRootResponse{} }
@@ -224,40 +246,261 @@ func (m *MsgChangeRootResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRootResponse proto.InternalMessageInfo
 
+func (m *MsgChangeRootResponse) GetPendingActionId() uint64 {
+	if m != nil {
+		return m.PendingActionId
+	}
+	return 0
+}
+
+// MsgSetTimelock: Msg to set the timelock of root actions.
+type MsgSetTimelock struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// TimelockBlocks: Number of blocks that root-changing, contract-adding, and
+	//   permission-granting actions wait before they execute. Zero disables the
+	//   timelock.
+	TimelockBlocks uint64 `protobuf:"varint,2,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+}
+
+func (m *MsgSetTimelock) Reset()         { *m = MsgSetTimelock{} }
+func (m *MsgSetTimelock) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTimelock) ProtoMessage()    {}
+func (*MsgSetTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{4}
+}
+func (m *MsgSetTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTimelock.Merge(m, src)
+}
+func (m *MsgSetTimelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTimelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTimelock proto.InternalMessageInfo
+
+func (m *MsgSetTimelock) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetTimelock) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
+}
+
+// MsgSetTimelockResponse indicates the successful execution of
+// MsgSetTimelock.
+type MsgSetTimelockResponse struct {
+	// PendingActionId: ID of the queued action if the current timelock queued
+	// the change.
+	PendingActionId uint64 `protobuf:"varint,1,opt,name=pending_action_id,json=pendingActionId,proto3" json:"pending_action_id,omitempty"`
+}
+
+func (m *MsgSetTimelockResponse) Reset()         { *m = MsgSetTimelockResponse{} }
+func (m *MsgSetTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTimelockResponse) ProtoMessage()    {}
+func (*MsgSetTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{5}
+}
+func (m *MsgSetTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTimelockResponse.Merge(m, src)
+}
+func (m *MsgSetTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTimelockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTimelockResponse proto.InternalMessageInfo
+
+func (m *MsgSetTimelockResponse) GetPendingActionId() uint64 {
+	if m != nil {
+		return m.PendingActionId
+	}
+	return 0
+}
+
+// MsgCancelSudoAction: Msg to cancel a pending root action.
+type MsgCancelSudoAction struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id: ID of the pending action.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelSudoAction) Reset()         { *m = MsgCancelSudoAction{} }
+func (m *MsgCancelSudoAction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSudoAction) ProtoMessage()    {}
+func (*MsgCancelSudoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{6}
+}
+func (m *MsgCancelSudoAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSudoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSudoAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSudoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSudoAction.Merge(m, src)
+}
+func (m *MsgCancelSudoAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSudoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSudoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSudoAction proto.InternalMessageInfo
+
+func (m *MsgCancelSudoAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelSudoAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelSudoActionResponse indicates the successful execution of
+// MsgCancelSudoAction.
+type MsgCancelSudoActionResponse struct {
+}
+
+func (m *MsgCancelSudoActionResponse) Reset()         { *m = MsgCancelSudoActionResponse{} }
+func (m *MsgCancelSudoActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSudoActionResponse) ProtoMessage()    {}
+func (*MsgCancelSudoActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{7}
+}
+func (m *MsgCancelSudoActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSudoActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSudoActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSudoActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSudoActionResponse.Merge(m, src)
+}
+func (m *MsgCancelSudoActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSudoActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSudoActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSudoActionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
 	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
 	proto.RegisterType((*MsgChangeRoot)(nil), "nibiru.sudo.v1.MsgChangeRoot")
 	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
+	proto.RegisterType((*MsgSetTimelock)(nil), "nibiru.sudo.v1.MsgSetTimelock")
+	proto.RegisterType((*MsgSetTimelockResponse)(nil), "nibiru.sudo.v1.MsgSetTimelockResponse")
+	proto.RegisterType((*MsgCancelSudoAction)(nil), "nibiru.sudo.v1.MsgCancelSudoAction")
+	proto.RegisterType((*MsgCancelSudoActionResponse)(nil), "nibiru.sudo.v1.MsgCancelSudoActionResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
 
 var fileDescriptor_a610e3c1609cdcbc = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0xea, 0x40,
-	0x18, 0x86, 0x29, 0x24, 0x9c, 0xc3, 0x9c, 0x1c, 0x16, 0x8d, 0x42, 0xa9, 0xd8, 0x60, 0x13, 0x0d,
-	0x71, 0xd1, 0x09, 0x7a, 0x07, 0xa0, 0x4b, 0x5c, 0xd4, 0x9d, 0x0b, 0xc9, 0xd0, 0x4e, 0x86, 0x49,
-	0x74, 0xbe, 0xa6, 0x33, 0x05, 0xdc, 0x7a, 0x05, 0x26, 0xde, 0x94, 0x4b, 0x12, 0x37, 0x2e, 0x0d,
-	0x78, 0x0b, 0xee, 0x4d, 0xa7, 0xfc, 0xb4, 0x89, 0x61, 0xd7, 0xe9, 0xf3, 0xbd, 0xcf, 0x3b, 0x33,
-	0x2d, 0x6a, 0x0a, 0x3e, 0xe6, 0x71, 0x82, 0x65, 0x12, 0x02, 0x9e, 0xf6, 0xb0, 0x9a, 0x7b, 0x51,
-	0x0c, 0x0a, 0xcc, 0x7a, 0x06, 0xbc, 0x14, 0x78, 0xd3, 0x9e, 0x7d, 0xc0, 0x80, 0x81, 0x46, 0x38,
-	0x7d, 0xca, 0xa6, 0xec, 0x36, 0x03, 0x60, 0x0f, 0x14, 0x93, 0x88, 0x63, 0x22, 0x04, 0x28, 0xa2,
-	0x38, 0x08, 0x99, 0x51, 0xf7, 0x1e, 0xd5, 0x87, 0x92, 0x5d, 0x87, 0x5c, 0xdd, 0x26, 0x21, 0xd0,
-	0x58, 0x9a, 0x0d, 0x54, 0x25, 0x41, 0x3a, 0x62, 0x19, 0x1d, 0xa3, 0x5b, 0xf3, 0xd7, 0x2b, 0xb3,
-	0x8d, 0x6a, 0x01, 0x08, 0x15, 0x93, 0x40, 0x49, 0xab, 0xdc, 0xa9, 0x74, 0x6b, 0xfe, 0xee, 0x45,
-	0x9a, 0x92, 0x54, 0x84, 0x34, 0xb6, 0x2a, 0x59, 0x2a, 0x5b, 0xb9, 0x16, 0x6a, 0x14, 0xfd, 0x3e,
-	0x95, 0x11, 0x08, 0x49, 0xdd, 0x3e, 0xfa, 0x3f, 0x94, 0x6c, 0x30, 0x21, 0x82, 0x51, 0x1f, 0x40,
-	0xe5, 0x14, 0x46, 0x5e, 0x61, 0xb6, 0xd0, 0x5f, 0x41, 0x67, 0xa3, 0x18, 0x40, 0x59, 0x65, 0x4d,
-	0xfe, 0x08, 0x3a, 0x4b, 0x23, 0x6e, 0x13, 0x1d, 0x16, 0x1c, 0x1b, 0xf9, 0xc5, 0xb7, 0x81, 0x2a,
-	0x43, 0xc9, 0xcc, 0x39, 0xfa, 0x97, 0x3f, 0x9b, 0xe3, 0x15, 0xaf, 0xcc, 0x2b, 0xee, 0xcd, 0x3e,
-	0xdb, 0xcf, 0xb7, 0x7b, 0x3f, 0x79, 0x7e, 0xff, 0x7a, 0x2d, 0x1f, 0xb9, 0x2d, 0x9c, 0xff, 0x36,
-	0x34, 0xe4, 0x6a, 0x24, 0xd7, 0x55, 0x0a, 0xa1, 0xdc, 0xd9, 0x8e, 0x7f, 0x11, 0xef, 0xb0, 0x7d,
-	0xba, 0x17, 0x6f, 0x6b, 0x3b, 0xba, 0xd6, 0x76, 0xad, 0x42, 0x6d, 0xa0, 0x07, 0xf5, 0xfd, 0xf4,
-	0xaf, 0xde, 0x96, 0x8e, 0xb1, 0x58, 0x3a, 0xc6, 0xe7, 0xd2, 0x31, 0x5e, 0x56, 0x4e, 0x69, 0xb1,
-	0x72, 0x4a, 0x1f, 0x2b, 0xa7, 0x74, 0x77, 0xce, 0xb8, 0x9a, 0x24, 0x63, 0x2f, 0x80, 0x47, 0x7c,
-	0xa3, 0xd3, 0x83, 0x09, 0xe1, 0x62, 0x63, 0x9a, 0x67, 0x2e, 0xf5, 0x14, 0x51, 0x39, 0xae, 0xea,
-	0x7f, 0xe3, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x5c, 0x76, 0xe7, 0x7a, 0x02, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x50, 0xc8, 0x55, 0xa4, 0x60, 0xa0, 0xb8, 0x6e, 0xeb, 0xa6, 0x46, 0xb4, 0x51,
+	0x91, 0x6c, 0xb5, 0x88, 0x91, 0x81, 0xa4, 0x0c, 0x0c, 0x41, 0xe0, 0x30, 0xb1, 0x58, 0x8e, 0x7d,
+	0xba, 0x9c, 0x48, 0xee, 0x59, 0xbe, 0x4b, 0x1b, 0x56, 0x76, 0x24, 0x24, 0xc4, 0xff, 0xc4, 0x58,
+	0x89, 0x85, 0x11, 0x25, 0xfc, 0x1f, 0xa0, 0x3b, 0x3b, 0xad, 0x1d, 0xd2, 0x50, 0x75, 0x89, 0x72,
+	0xef, 0xfb, 0xde, 0xf7, 0xbe, 0xf7, 0x43, 0x46, 0x0f, 0x19, 0xed, 0xd1, 0x64, 0xe4, 0xf2, 0x51,
+	0x04, 0xee, 0xc9, 0xa1, 0x2b, 0xc6, 0x4e, 0x9c, 0x80, 0x00, 0xbd, 0x9e, 0x02, 0x8e, 0x04, 0x9c,
+	0x93, 0x43, 0xf3, 0x3e, 0x01, 0x02, 0x0a, 0x72, 0xe5, 0xbf, 0x94, 0x65, 0x6e, 0x11, 0x00, 0x32,
+	0xc0, 0x6e, 0x10, 0x53, 0x37, 0x60, 0x0c, 0x44, 0x20, 0x28, 0x30, 0x9e, 0xa1, 0xe6, 0x9c, 0x38,
+	0x17, 0x81, 0xc0, 0x29, 0x66, 0x7f, 0xd3, 0x50, 0xbd, 0xc3, 0xc9, 0xcb, 0x88, 0x8a, 0xee, 0x28,
+	0x02, 0x9c, 0x70, 0x7d, 0x1d, 0xad, 0x04, 0xa1, 0xcc, 0x37, 0xb4, 0x86, 0xd6, 0xac, 0x79, 0xd9,
+	0x4b, 0xdf, 0x42, 0xb5, 0x10, 0x98, 0x48, 0x82, 0x50, 0x70, 0xa3, 0xdc, 0xa8, 0x34, 0x6b, 0xde,
+	0x45, 0x40, 0x66, 0x71, 0xcc, 0x22, 0x9c, 0x18, 0x95, 0x34, 0x2b, 0x7d, 0xe9, 0xcf, 0xd0, 0x0d,
+	0x1e, 0x42, 0x8c, 0x8d, 0x6a, 0x43, 0x6b, 0xae, 0x1e, 0xed, 0x38, 0xc5, 0x86, 0x9c, 0x37, 0x38,
+	0x19, 0x52, 0xce, 0x29, 0xb0, 0xae, 0xa4, 0x79, 0x29, 0xdb, 0x3e, 0x46, 0xeb, 0x45, 0x5b, 0x1e,
+	0xe6, 0x31, 0x30, 0x8e, 0xf5, 0x03, 0x74, 0x37, 0xc6, 0x2c, 0xa2, 0x8c, 0xf8, 0xa9, 0x31, 0x9f,
+	0x46, 0xca, 0x69, 0xd5, 0x5b, 0xcb, 0x80, 0x17, 0x2a, 0xfe, 0x2a, 0xb2, 0x5b, 0xe8, 0x76, 0x87,
+	0x93, 0x76, 0x3f, 0x60, 0x04, 0x7b, 0x00, 0x22, 0xe7, 0x52, 0x2b, 0xb8, 0xdc, 0x40, 0xb7, 0x18,
+	0x3e, 0xf5, 0x13, 0x00, 0x61, 0x94, 0x15, 0x72, 0x93, 0xe1, 0x53, 0x99, 0x62, 0xb7, 0xd1, 0x83,
+	0x82, 0xc6, 0xb5, 0x8c, 0xbc, 0x55, 0x53, 0xee, 0x62, 0xf1, 0x8e, 0x0e, 0xf1, 0x00, 0xc2, 0x0f,
+	0x97, 0x3a, 0xd9, 0x47, 0x6b, 0x22, 0xe3, 0xf8, 0x3d, 0xf9, 0xcb, 0x95, 0xa1, 0xaa, 0x57, 0x9f,
+	0x85, 0x5b, 0x2a, 0x9a, 0x4d, 0x28, 0x27, 0x79, 0x2d, 0x63, 0xcf, 0xd1, 0x3d, 0xd9, 0x5d, 0xc0,
+	0x42, 0x3c, 0x90, 0x93, 0x4e, 0x81, 0x4b, 0xdd, 0xd5, 0x51, 0x99, 0x46, 0x99, 0xa1, 0x32, 0x8d,
+	0xec, 0x6d, 0xb4, 0xb9, 0x20, 0x7d, 0xe6, 0xe4, 0xe8, 0x4f, 0x05, 0x55, 0x3a, 0x9c, 0xe8, 0x63,
+	0xb4, 0x9a, 0xbf, 0x30, 0x6b, 0xfe, 0x08, 0x8a, 0xab, 0x36, 0xf7, 0x96, 0xe3, 0x33, 0x79, 0x7b,
+	0xf7, 0xd3, 0x8f, 0xdf, 0x5f, 0xcb, 0x9b, 0xf6, 0x86, 0x9b, 0xbf, 0x70, 0x1c, 0x51, 0xe1, 0xf3,
+	0xac, 0x94, 0x40, 0x28, 0xb7, 0xfe, 0xed, 0x05, 0xc2, 0x17, 0xb0, 0xf9, 0x78, 0x29, 0x7c, 0x5e,
+	0xb6, 0xa1, 0xca, 0x9a, 0xb6, 0x51, 0x28, 0x1b, 0x2a, 0xa2, 0x3a, 0x21, 0xd9, 0x6f, 0x7e, 0xd7,
+	0x8b, 0xfa, 0xcd, 0xe1, 0xe6, 0xde, 0x72, 0xfc, 0x3f, 0xfd, 0x72, 0x2c, 0xfc, 0xd9, 0x6d, 0xe8,
+	0x9f, 0x35, 0x74, 0xe7, 0x9f, 0x6d, 0x3e, 0x5a, 0xd4, 0xd7, 0x1c, 0xc9, 0x7c, 0x72, 0x05, 0xd2,
+	0xb9, 0x93, 0x7d, 0xe5, 0x64, 0xd7, 0xde, 0x29, 0x8e, 0x40, 0xd1, 0xd5, 0xec, 0xb3, 0xcb, 0x6b,
+	0x1d, 0x7f, 0x9f, 0x58, 0xda, 0xd9, 0xc4, 0xd2, 0x7e, 0x4d, 0x2c, 0xed, 0xcb, 0xd4, 0x2a, 0x9d,
+	0x4d, 0xad, 0xd2, 0xcf, 0xa9, 0x55, 0x7a, 0x7f, 0x40, 0xa8, 0xe8, 0x8f, 0x7a, 0x4e, 0x08, 0x43,
+	0xf7, 0xb5, 0x12, 0x69, 0xf7, 0x03, 0xca, 0x66, 0x82, 0xe3, 0x54, 0x52, 0x7c, 0x8c, 0x31, 0xef,
+	0xad, 0xa8, 0x8f, 0xd5, 0xd3, 0xbf, 0x03, 0x00, 0xce, 0x99, 0x81, 0x13, 0x27, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +518,11 @@ type MsgClient interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
 	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// SetTimelock sets the number of blocks that root-changing,
+	// contract-adding, and permission-granting actions wait before they execute.
+	SetTimelock(ctx context.Context, in *MsgSetTimelock, opts ...grpc.CallOption) (*MsgSetTimelockResponse, error)
+	// CancelSudoAction removes a pending action from the timelock queue.
+	CancelSudoAction(ctx context.Context, in *MsgCancelSudoAction, opts ...grpc.CallOption) (*MsgCancelSudoActionResponse, error)
 }
 
 type msgClient struct {
@@ -303,11 +551,34 @@ func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetTimelock(ctx context.Context, in *MsgSetTimelock, opts ...grpc.CallOption) (*MsgSetTimelockResponse, error) {
+	out := new(MsgSetTimelockResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/SetTimelock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSudoAction(ctx context.Context, in *MsgCancelSudoAction, opts ...grpc.CallOption) (*MsgCancelSudoActionResponse, error) {
+	out := new(MsgCancelSudoActionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelSudoAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
 	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// SetTimelock sets the number of blocks that root-changing,
+	// contract-adding, and permission-granting actions wait before they execute.
+	SetTimelock(context.Context, *MsgSetTimelock) (*MsgSetTimelockResponse, error)
+	// CancelSudoAction removes a pending action from the timelock queue.
+	CancelSudoAction(context.Context, *MsgCancelSudoAction) (*MsgCancelSudoActionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -320,6 +591,12 @@ func (*UnimplementedMsgServer) EditSudoers(ctx context.Context, req *MsgEditSudo
 func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoot) (*MsgChangeRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
 }
+func (*UnimplementedMsgServer) SetTimelock(ctx context.Context, req *MsgSetTimelock) (*MsgSetTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimelock not implemented")
+}
+func (*UnimplementedMsgServer) CancelSudoAction(ctx context.Context, req *MsgCancelSudoAction) (*MsgCancelSudoActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSudoAction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -361,6 +638,42 @@ func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/SetTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTimelock(ctx, req.(*MsgSetTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSudoAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSudoAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSudoAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelSudoAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSudoAction(ctx, req.(*MsgCancelSudoAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -373,6 +686,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeRoot",
 			Handler:    _Msg_ChangeRoot_Handler,
 		},
+		{
+			MethodName: "SetTimelock",
+			Handler:    _Msg_SetTimelock_Handler,
+		},
+		{
+			MethodName: "CancelSudoAction",
+			Handler:    _Msg_CancelSudoAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/tx.proto",
@@ -398,6 +719,18 @@ func (m *MsgEditSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Scope != nil {
+		{
+			size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -444,6 +777,11 @@ func (m *MsgEditSudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.PendingActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingActionId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -504,70 +842,259 @@ func (m *MsgChangeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingActionId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEditSudoers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditSudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChangeRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PendingActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSudoAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSudoAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSudoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSudoActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSudoActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSudoActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEditSudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != nil {
+		l = m.Scope.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditSudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingActionId))
+	}
+	return n
+}
+
+func (m *MsgChangeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
 func (m *MsgChangeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingActionId))
+	}
+	return n
+}
+
+func (m *MsgSetTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimelockBlocks))
+	}
+	return n
+}
+
+func (m *MsgSetTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingActionId))
+	}
+	return n
+}
+
+func (m *MsgCancelSudoAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSudoActionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -707,6 +1234,42 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scope == nil {
+				m.Scope = &PermissionScope{}
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -757,6 +1320,25 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActionId", wireType)
+			}
+			m.PendingActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -921,6 +1503,346 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActionId", wireType)
+			}
+			m.PendingActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTimelockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTimelockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActionId", wireType)
+			}
+			m.PendingActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSudoAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSudoAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSudoAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSudoActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSudoActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSudoActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
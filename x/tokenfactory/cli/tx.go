@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -31,6 +32,11 @@ func NewTxCmd() *cobra.Command {
 		CmdMint(),
 		CmdBurn(),
 		CmdBurnNative(),
+		CmdSetDenomMintLimits(),
+		CmdFreeze(),
+		CmdPause(),
+		CmdClawback(),
+		CmdDisableFeatures(),
 		// CmdModifyDenomMetadata(), // CosmWasm only
 	)
 
@@ -40,9 +46,14 @@ func NewTxCmd() *cobra.Command {
 // CmdCreateDenom broadcast MsgCreateDenom
 func CmdCreateDenom() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-denom [subdenom] [flags]",
+		Use:   "create-denom [subdenom] [--freezable] [--pausable] [--clawback] [flags]",
 		Short: `Create a denom of the form "tf/{creator}/{subdenom}"`,
-		Args:  cobra.ExactArgs(1),
+		Long: heredoc.Doc(`
+			Create a denom of the form "tf/{creator}/{subdenom}".
+			The --freezable, --pausable, and --clawback flags enable opt-in
+			compliance features. They can only be enabled at creation.`,
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -61,15 +72,44 @@ func CmdCreateDenom() *cobra.Command {
 				Sender:   clientCtx.GetFromAddress().String(),
 				Subdenom: args[0],
 			}
+			features, err := readDenomFeatureFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if !features.IsEmpty() {
+				msg.Features = &features
+			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
 		},
 	}
 
+	addDenomFeatureFlags(cmd, "Enable")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// addDenomFeatureFlags: Adds a boolean flag for each denom compliance feature.
+func addDenomFeatureFlags(cmd *cobra.Command, verb string) {
+	cmd.Flags().Bool("freezable", false, verb+" freezing addresses")
+	cmd.Flags().Bool("pausable", false, verb+" pausing all transfers")
+	cmd.Flags().Bool("clawback", false, verb+" admin clawbacks")
+}
+
+// readDenomFeatureFlags: Reads the flags added by addDenomFeatureFlags.
+func readDenomFeatureFlags(cmd *cobra.Command) (features types.DenomFeatures, err error) {
+	for flag, feature := range map[string]*bool{
+		"freezable": &features.Freezable,
+		"pausable":  &features.Pausable,
+		"clawback":  &features.Clawback,
+	} {
+		if *feature, err = cmd.Flags().GetBool(flag); err != nil {
+			return features, err
+		}
+	}
+	return features, nil
+}
+
 // CmdChangeAdmin: Broadcasts MsgChangeAdmin
 func CmdChangeAdmin() *cobra.Command {
 	cmd := &cobra.Command{
@@ -160,6 +200,250 @@ func CmdMint() *cobra.Command {
 	return cmd
 }
 
+// CmdSetDenomMintLimits: Broadcast MsgSetDenomMintLimits
+func CmdSetDenomMintLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-limits [denom] [--max-supply] [--mint-limit-per-window] [--mint-window-blocks] [flags]",
+		Short: "Set the supply cap and mint rate limit of a denom.",
+		Long: heredoc.Doc(`
+			Set the supply cap and mint rate limit of a denom.
+			Tx signer must be the denom admin.
+			A limit that is omitted or zero is not set. Limits that are already
+			set can only be tightened, so provide their current values to keep them.
+
+			$ nibid tx tokenfactory set-mint-limits tf/nibi1.../mytoken \
+			  --max-supply 1000000 --mint-limit-per-window 1000 --mint-window-blocks 14400`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			limits := types.DefaultDenomMintLimits()
+			for flag, amount := range map[string]*math.Int{
+				"max-supply":            &limits.MaxSupply,
+				"mint-limit-per-window": &limits.MintLimitPerWindow,
+			} {
+				amountStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				parsed, ok := math.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid --%s amount: %s", flag, amountStr)
+				}
+				*amount = parsed
+			}
+			limits.MintWindowBlocks, err = cmd.Flags().GetUint64("mint-window-blocks")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetDenomMintLimits{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Denom:      args[0],
+				MintLimits: limits,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+
+	cmd.Flags().String("max-supply", "0", "Upper bound on the total supply of the denom")
+	cmd.Flags().String("mint-limit-per-window", "0", "Upper bound on the amount minted within one mint window")
+	cmd.Flags().Uint64("mint-window-blocks", 0, "Length of a mint window in blocks")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFreeze: Broadcast MsgSetFrozen
+func CmdFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address] [--unfreeze] [flags]",
+		Short: "Freeze an address for a freezable denom.",
+		Long: heredoc.Doc(`
+			Freeze an address for a freezable denom. A frozen address can
+			neither send nor receive the denom.
+			Tx signer must be the denom admin.
+			Use --unfreeze to unfreeze the address.`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			unfreeze, err := cmd.Flags().GetBool("unfreeze")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFrozen{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Denom:   args[0],
+				Address: args[1],
+				Frozen:  !unfreeze,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+
+	cmd.Flags().Bool("unfreeze", false, "Unfreeze the address instead")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdPause: Broadcast MsgSetPaused
+func CmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom] [--unpause] [flags]",
+		Short: "Pause all transfers of a pausable denom.",
+		Long: heredoc.Doc(`
+			Pause all transfers of a pausable denom.
+			Tx signer must be the denom admin.
+			Use --unpause to resume transfers.`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			unpause, err := cmd.Flags().GetBool("unpause")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPaused{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+				Paused: !unpause,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+
+	cmd.Flags().Bool("unpause", false, "Resume transfers instead")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdClawback: Broadcast MsgClawback
+func CmdClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [coin] [clawback-from] [--clawback-to] [flags]",
+		Short: "Claw back tokens from an address.",
+		Long: heredoc.Doc(`
+			Claw back tokens of a denom with the clawback feature from an address.
+			Tx signer must be the denom admin.
+			If no --clawback-to address is provided, it defaults to the sender.`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			clawbackTo, err := cmd.Flags().GetString("clawback-to")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClawback{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Coin:         coin,
+				ClawbackFrom: args[1],
+				ClawbackTo:   clawbackTo,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+
+	cmd.Flags().String("clawback-to", "", "Address that receives the tokens")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDisableFeatures: Broadcast MsgDisableFeatures
+func CmdDisableFeatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-features [denom] [--freezable] [--pausable] [--clawback] [flags]",
+		Short: "Permanently disable compliance features of a denom.",
+		Long: heredoc.Doc(`
+			Permanently disable compliance features of a denom.
+			Tx signer must be the denom admin.
+			Disabled features cannot be enabled again.`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txFactory = txFactory.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			disable, err := readDenomFeatureFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDisableFeatures{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Denom:   args[0],
+				Disable: disable,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txFactory, msg)
+		},
+	}
+
+	addDenomFeatureFlags(cmd, "Disable")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdBurn: Broadcast MsgBurn
 func CmdBurn() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/x/tokenfactory/types"
+)
+
+// clawbackCtxKey: Context key that marks a transfer as an admin clawback.
+type clawbackCtxKey struct{}
+
+// withClawback: Returns a context under which the transfers skip the
+// compliance checks of BeforeSend.
+func withClawback(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(clawbackCtxKey{}, true)
+}
+
+func isClawback(ctx sdk.Context) bool {
+	isClawback, _ := ctx.Value(clawbackCtxKey{}).(bool)
+	return isClawback
+}
+
+// isModuleAccount: Returns true if the address holds a module account.
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if addr.Empty() {
+		return false
+	}
+	_, isModuleAcc := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return isModuleAcc
+}
+
+// isProtectedAddr: Returns true for module accounts and addresses blocked by
+// the x/bank keeper. The chain moves their coins in the begin and end
+// blockers, such as the fee payouts of x/distribution and the deposit refunds
+// of x/gov, where a failed transfer would halt it. So their coins can be
+// neither frozen nor clawed back, and their transfers skip the compliance
+// checks.
+func (k Keeper) isProtectedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.bankKeeper.BlockedAddr(addr) || k.isModuleAccount(ctx, addr)
+}
+
+// checkCompliance: Returns an error if the token factory denom is paused or
+// if any of the addresses is frozen for it. Empty addresses are skipped.
+func (k Keeper) checkCompliance(
+	ctx sdk.Context, denom string, addrs ...sdk.AccAddress,
+) error {
+	if k.Store.IsPaused(ctx, denom) {
+		return types.ErrDenomPaused.Wrap(denom)
+	}
+	for _, addr := range addrs {
+		if !addr.Empty() && k.Store.IsFrozen(ctx, denom, addr) {
+			return types.ErrAddressFrozen.Wrapf(
+				"address (%s), denom (%s)", addr, denom)
+		}
+	}
+	return nil
+}
+
+// BeforeSend: Bank send hook that enforces the compliance features of token
+// factory denoms. It rejects transfers of paused denoms and transfers from or
+// to addresses frozen for a denom. Admin clawbacks and transfers out of module
+// accounts and blocked addresses are exempt (see isProtectedAddr), so users
+// can still move paused coins into a module but never block the module from
+// paying them out. An empty address stands for the missing counterparty of a
+// multi-send input or output. Because it runs inside the x/bank keeper, it
+// applies to every transfer, including IBC transfers and FunToken conversions.
+func (k Keeper) BeforeSend(
+	ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins,
+) error {
+	if isClawback(ctx) {
+		return nil
+	}
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, "tf/") {
+			continue
+		}
+		// Only look up the sender for transfers of token factory denoms.
+		if k.isProtectedAddr(ctx, from) {
+			return nil
+		}
+		if err := k.checkCompliance(ctx, coin.Denom, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
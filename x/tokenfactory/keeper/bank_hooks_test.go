@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/tokenfactory/types"
+)
+
+func (s *TestSuite) TestComplianceFeatures() {
+	_, addrs := testutil.PrivKeyAddressPairs(3)
+	admin, alice, bob := addrs[0], addrs[1], addrs[2]
+	denom := types.TFDenom{Creator: admin.String(), Subdenom: "rwa"}.Denom().String()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	setup := func(features types.DenomFeatures) {
+		s.SetupTest()
+		s.Require().NoError(s.HandleMsg(&types.MsgCreateDenom{
+			Sender:   admin.String(),
+			Subdenom: "rwa",
+			Features: &features,
+		}))
+		s.Require().NoError(s.HandleMsg(&types.MsgMint{
+			Sender: admin.String(),
+			Coin:   sdk.NewInt64Coin(denom, 1_000),
+			MintTo: alice.String(),
+		}))
+	}
+	allFeatures := types.DenomFeatures{Freezable: true, Pausable: true, Clawback: true}
+	bankSend := func(from, to sdk.AccAddress, amt sdk.Coins) error {
+		handler := s.app.MsgServiceRouter().Handler(&banktypes.MsgSend{})
+		_, err := handler(s.ctx, banktypes.NewMsgSend(from, to, amt))
+		return err
+	}
+
+	s.Run("features are disabled by default", func() {
+		setup(types.DenomFeatures{})
+		s.ErrorContains(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}), types.ErrFeatureDisabled.Error())
+		s.ErrorContains(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}), types.ErrFeatureDisabled.Error())
+		s.ErrorContains(s.HandleMsg(&types.MsgClawback{
+			Sender: admin.String(), Coin: sdk.NewInt64Coin(denom, 1), ClawbackFrom: alice.String(),
+		}), types.ErrFeatureDisabled.Error())
+	})
+
+	s.Run("only the admin can use the features", func() {
+		setup(allFeatures)
+		s.ErrorContains(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: alice.String(), Denom: denom, Address: bob.String(), Frozen: true,
+		}), types.ErrUnauthorized.Error())
+		s.ErrorContains(s.HandleMsg(&types.MsgDisableFeatures{
+			Sender: alice.String(), Denom: denom, Disable: allFeatures,
+		}), types.ErrUnauthorized.Error())
+	})
+
+	s.Run("frozen addresses can neither send nor receive", func() {
+		setup(allFeatures)
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}))
+		s.True(s.app.TokenFactoryKeeper.Store.IsFrozen(s.ctx, denom, alice))
+
+		s.ErrorContains(bankSend(alice, bob, coins(1)), types.ErrAddressFrozen.Error())
+		s.ErrorContains(
+			s.app.BankKeeper.SendCoins(s.ctx, admin, alice, coins(1)),
+			types.ErrAddressFrozen.Error(),
+		)
+		s.ErrorContains(s.HandleMsg(&types.MsgMint{
+			Sender: admin.String(), Coin: sdk.NewInt64Coin(denom, 1), MintTo: alice.String(),
+		}), types.ErrAddressFrozen.Error())
+
+		s.T().Log("Other denoms are not affected")
+		s.NoError(testapp.FundAccount(s.app.BankKeeper, s.ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("unibi", 5))))
+		s.NoError(bankSend(alice, bob, sdk.NewCoins(sdk.NewInt64Coin("unibi", 5))))
+
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: false,
+		}))
+		s.NoError(bankSend(alice, bob, coins(1)))
+	})
+
+	s.Run("paused denoms cannot be transferred", func() {
+		setup(allFeatures)
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}))
+		s.ErrorContains(bankSend(alice, bob, coins(1)), types.ErrDenomPaused.Error())
+		s.ErrorContains(
+			s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, alice, types.ModuleName, coins(1)),
+			types.ErrDenomPaused.Error(),
+		)
+
+		resp, err := s.app.TokenFactoryKeeper.QueryDenomInfo(s.ctx, denom)
+		s.Require().NoError(err)
+		s.True(resp.Paused)
+		s.Equal(allFeatures, resp.Features)
+
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: false,
+		}))
+		s.NoError(bankSend(alice, bob, coins(1)))
+	})
+
+	s.Run("clawback ignores frozen addresses and pauses", func() {
+		setup(allFeatures)
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}))
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}))
+
+		s.Require().NoError(s.HandleMsg(&types.MsgClawback{
+			Sender:       admin.String(),
+			Coin:         sdk.NewInt64Coin(denom, 400),
+			ClawbackFrom: alice.String(),
+		}))
+		s.Equal(math.NewInt(600), s.app.BankKeeper.GetBalance(s.ctx, alice, denom).Amount)
+		s.Equal(math.NewInt(400), s.app.BankKeeper.GetBalance(s.ctx, admin, denom).Amount)
+
+		s.T().Log("The exemption only applies to the clawback itself")
+		s.ErrorContains(bankSend(admin, bob, coins(1)), types.ErrDenomPaused.Error())
+	})
+
+	s.Run("module accounts can be neither frozen nor clawed back", func() {
+		setup(allFeatures)
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		s.Require().NoError(
+			s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, alice, authtypes.FeeCollectorName, coins(100)),
+		)
+		s.ErrorContains(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: feeCollector.String(), Frozen: true,
+		}), types.ErrBlockedAddress.Error())
+		s.ErrorContains(s.HandleMsg(&types.MsgClawback{
+			Sender:       admin.String(),
+			Coin:         sdk.NewInt64Coin(denom, 100),
+			ClawbackFrom: feeCollector.String(),
+		}), types.ErrBlockedAddress.Error())
+		s.Equal(math.NewInt(100), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom).Amount)
+	})
+
+	s.Run("paused fee denoms do not halt the chain", func() {
+		setup(allFeatures)
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		s.Require().NoError(
+			s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, alice, authtypes.FeeCollectorName, coins(100)),
+		)
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}))
+
+		s.T().Log("x/distribution moves the fees of the block in BeginBlock")
+		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+		s.NotPanics(func() {
+			s.app.BeginBlocker(s.ctx, abci.RequestBeginBlock{Header: s.ctx.BlockHeader()})
+		})
+		s.True(s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom).IsZero())
+
+		s.T().Log("Transfers out of module accounts are not checked")
+		s.NoError(
+			s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, distrtypes.ModuleName, bob, coins(1)),
+		)
+		s.T().Log("Transfers into module accounts are still checked")
+		s.ErrorContains(
+			s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, bob, authtypes.FeeCollectorName, coins(1)),
+			types.ErrDenomPaused.Error(),
+		)
+	})
+
+	s.Run("paused gov deposits do not halt the chain", func() {
+		setup(allFeatures)
+		govParams := s.app.GovKeeper.GetParams(s.ctx)
+		minDeposit := sdk.NewCoins(govParams.MinDeposit...)
+		s.Require().NoError(testapp.FundAccount(s.app.BankKeeper, s.ctx, alice, minDeposit))
+		proposal, err := s.app.GovKeeper.SubmitProposal(s.ctx, nil, "", "title", "summary", alice)
+		s.Require().NoError(err)
+		_, err = s.app.GovKeeper.AddDeposit(s.ctx, proposal.Id, alice, minDeposit.Add(coins(1)...))
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}))
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}))
+
+		s.T().Log("x/gov refunds the deposits in EndBlock when the voting period ends")
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(*govParams.VotingPeriod + time.Second))
+		s.NotPanics(func() {
+			gov.EndBlocker(s.ctx, &s.app.GovKeeper)
+		})
+		s.Equal(math.NewInt(1_000), s.app.BankKeeper.GetBalance(s.ctx, alice, denom).Amount)
+	})
+
+	s.Run("multi-sends check each input against its own coins", func() {
+		setup(allFeatures)
+		s.Require().NoError(s.HandleMsg(&types.MsgMint{
+			Sender: admin.String(), Coin: sdk.NewInt64Coin(denom, 10), MintTo: bob.String(),
+		}))
+		unibi := sdk.NewCoins(sdk.NewInt64Coin("unibi", 5))
+		s.Require().NoError(testapp.FundAccount(s.app.BankKeeper, s.ctx, alice, unibi))
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}))
+
+		receiver := testutil.AccAddress()
+		inputs := []banktypes.Input{
+			banktypes.NewInput(alice, unibi),
+			banktypes.NewInput(bob, coins(10)),
+		}
+		outputs := []banktypes.Output{banktypes.NewOutput(receiver, unibi.Add(coins(10)...))}
+		s.NoError(s.app.BankKeeper.InputOutputCoins(s.ctx, inputs, outputs))
+
+		inputs = []banktypes.Input{banktypes.NewInput(alice, coins(1))}
+		outputs = []banktypes.Output{banktypes.NewOutput(receiver, coins(1))}
+		s.ErrorContains(
+			s.app.BankKeeper.InputOutputCoins(s.ctx, inputs, outputs),
+			types.ErrAddressFrozen.Error(),
+		)
+	})
+
+	s.Run("disabled features cannot be used and lift their restrictions", func() {
+		setup(allFeatures)
+		s.Require().NoError(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}))
+		s.Require().NoError(s.HandleMsg(&types.MsgSetPaused{
+			Sender: admin.String(), Denom: denom, Paused: true,
+		}))
+
+		s.Require().NoError(s.HandleMsg(&types.MsgDisableFeatures{
+			Sender:  admin.String(),
+			Denom:   denom,
+			Disable: types.DenomFeatures{Freezable: true, Pausable: true},
+		}))
+		s.False(s.app.TokenFactoryKeeper.Store.IsFrozen(s.ctx, denom, alice))
+		s.False(s.app.TokenFactoryKeeper.Store.IsPaused(s.ctx, denom))
+		s.NoError(bankSend(alice, bob, coins(1)))
+
+		authData, err := s.app.TokenFactoryKeeper.Store.GetDenomAuthorityMetadata(s.ctx, denom)
+		s.Require().NoError(err)
+		s.Equal(types.DenomFeatures{Clawback: true}, authData.Features)
+		s.ErrorContains(s.HandleMsg(&types.MsgSetFrozen{
+			Sender: admin.String(), Denom: denom, Address: alice.String(), Frozen: true,
+		}), types.ErrFeatureDisabled.Error())
+
+		s.T().Log("Changing the admin keeps the features")
+		s.Require().NoError(s.HandleMsg(&types.MsgChangeAdmin{
+			Sender: admin.String(), Denom: denom, NewAdmin: bob.String(),
+		}))
+		s.Require().NoError(s.HandleMsg(&types.MsgClawback{
+			Sender:       bob.String(),
+			Coin:         sdk.NewInt64Coin(denom, 1),
+			ClawbackFrom: alice.String(),
+		}))
+	})
+}
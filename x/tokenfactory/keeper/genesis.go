@@ -41,9 +41,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		denomStr := denom.Denom().String()
+		var frozenAddrs []string
+		for _, addr := range k.Store.GetFrozenAddresses(ctx, denomStr) {
+			frozenAddrs = append(frozenAddrs, addr.String())
+		}
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom.Denom().String(),
+			Denom:             denomStr,
 			AuthorityMetadata: authorityMetadata,
+			MintLimits:        k.Store.GetDenomMintLimits(ctx, denomStr),
+			MintWindow:        k.Store.GetDenomMintWindow(ctx, denomStr),
+			Paused:            k.Store.IsPaused(ctx, denomStr),
+			FrozenAddresses:   frozenAddrs,
 		})
 	}
 
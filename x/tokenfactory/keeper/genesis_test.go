@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/tokenfactory/types"
 )
@@ -47,6 +49,53 @@ func (s *TestSuite) TestGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name: "genesis with denom mint limits",
+			genesis: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: randomTFDenom(),
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: testutil.AccAddress().String(),
+						},
+						MintLimits: types.DenomMintLimits{
+							MaxSupply:          math.NewInt(1_000),
+							MintLimitPerWindow: math.NewInt(100),
+							MintWindowBlocks:   10,
+						},
+						MintWindow: types.DenomMintWindow{
+							StartHeight: 1,
+							Minted:      math.NewInt(50),
+						},
+					},
+				},
+			},
+			expPanic: false,
+		},
+		{
+			name: "genesis with compliance features",
+			genesis: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: randomTFDenom(),
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: testutil.AccAddress().String(),
+							Features: types.DenomFeatures{
+								Freezable: true,
+								Pausable:  true,
+							},
+						},
+						MintLimits:      types.DefaultDenomMintLimits(),
+						MintWindow:      types.DefaultDenomMintWindow(),
+						Paused:          true,
+						FrozenAddresses: []string{testutil.AccAddress().String()},
+					},
+				},
+			},
+			expPanic: false,
+		},
 		// {}, // Invalid test case
 	}
 
@@ -69,6 +118,12 @@ func (s *TestSuite) TestGenesis() {
 
 				gen := s.app.TokenFactoryKeeper.ExportGenesis(s.ctx)
 				s.NoError(gen.Validate())
+
+				for _, genDenom := range tc.genesis.FactoryDenoms {
+					if genDenom.MintLimits.IsSet() || genDenom.Paused {
+						s.Require().Contains(gen.FactoryDenoms, genDenom)
+					}
+				}
 			}
 		})
 	}
@@ -66,8 +66,12 @@ func (k Keeper) QueryDenomInfo(
 
 	bankMetadata, _ := k.bankKeeper.GetDenomMetaData(ctx, denom)
 	return &types.QueryDenomInfoResponse{
-		Admin:    tfMetadata.Admin,
-		Metadata: bankMetadata,
+		Admin:      tfMetadata.Admin,
+		Metadata:   bankMetadata,
+		MintLimits: k.Store.GetDenomMintLimits(ctx, denom),
+		MintWindow: k.Store.GetDenomMintWindow(ctx, denom),
+		Features:   tfMetadata.Features,
+		Paused:     k.Store.IsPaused(ctx, denom),
 	}, err
 }
 
@@ -58,6 +58,26 @@ func NewKeeper(
 				collections.StringKeyEncoder,
 				collections.ProtoValueEncoder[tftypes.DenomAuthorityMetadata](cdc),
 			),
+			mintLimits: collections.NewMap[storePKType, tftypes.DenomMintLimits](
+				storeKey, tftypes.KeyPrefixDenomMintLimits,
+				collections.StringKeyEncoder,
+				collections.ProtoValueEncoder[tftypes.DenomMintLimits](cdc),
+			),
+			mintWindows: collections.NewMap[storePKType, tftypes.DenomMintWindow](
+				storeKey, tftypes.KeyPrefixDenomMintWindow,
+				collections.StringKeyEncoder,
+				collections.ProtoValueEncoder[tftypes.DenomMintWindow](cdc),
+			),
+			paused: collections.NewKeySet[storePKType](
+				storeKey, tftypes.KeyPrefixDenomPaused,
+				collections.StringKeyEncoder,
+			),
+			frozen: collections.NewKeySet[collections.Pair[storePKType, sdk.AccAddress]](
+				storeKey, tftypes.KeyPrefixFrozenAddress,
+				collections.PairKeyEncoder(
+					collections.StringKeyEncoder, collections.AccAddressKeyEncoder,
+				),
+			),
 			bankKeeper: bk,
 		},
 		cdc:                 cdc,
@@ -63,6 +63,16 @@ func (s *TestSuite) HandleMsg(txMsg sdk.Msg) (err error) {
 		_, err = s.app.TokenFactoryKeeper.SetDenomMetadata(goCtx, txMsg)
 	case *tftypes.MsgBurnNative:
 		_, err = s.app.TokenFactoryKeeper.BurnNative(goCtx, txMsg)
+	case *tftypes.MsgSetDenomMintLimits:
+		_, err = s.app.TokenFactoryKeeper.SetDenomMintLimits(goCtx, txMsg)
+	case *tftypes.MsgSetFrozen:
+		_, err = s.app.TokenFactoryKeeper.SetFrozen(goCtx, txMsg)
+	case *tftypes.MsgSetPaused:
+		_, err = s.app.TokenFactoryKeeper.SetPaused(goCtx, txMsg)
+	case *tftypes.MsgClawback:
+		_, err = s.app.TokenFactoryKeeper.Clawback(goCtx, txMsg)
+	case *tftypes.MsgDisableFeatures:
+		_, err = s.app.TokenFactoryKeeper.DisableFeatures(goCtx, txMsg)
 	default:
 		err = fmt.Errorf("unknown message type: %t", txMsg)
 	}
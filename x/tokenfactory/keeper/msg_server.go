@@ -30,7 +30,11 @@ func (k Keeper) CreateDenom(
 		Creator:  txMsg.Sender,
 		Subdenom: txMsg.Subdenom,
 	}
-	err = k.Store.InsertDenom(ctx, denom)
+	var features types.DenomFeatures
+	if txMsg.Features != nil {
+		features = *txMsg.Features
+	}
+	err = k.Store.InsertDenom(ctx, denom, features)
 	if err != nil {
 		return resp, err
 	}
@@ -132,14 +136,14 @@ func (k Keeper) Mint(
 	}
 
 	return &types.MsgMintResponse{
-			MintTo: txMsg.MintTo,
-		}, ctx.EventManager().EmitTypedEvent(
-			&types.EventMint{
-				Coin:   txMsg.Coin,
-				ToAddr: txMsg.MintTo,
-				Caller: txMsg.Sender,
-			},
-		)
+		MintTo: txMsg.MintTo,
+	}, ctx.EventManager().EmitTypedEvent(
+		&types.EventMint{
+			Coin:   txMsg.Coin,
+			ToAddr: txMsg.MintTo,
+			Caller: txMsg.Sender,
+		},
+	)
 }
 
 func (k Keeper) mint(
@@ -149,6 +153,10 @@ func (k Keeper) mint(
 		return err
 	}
 
+	if err := k.Store.consumeMintAllowance(ctx, coin); err != nil {
+		return err
+	}
+
 	coins := sdk.NewCoins(coin)
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	if err != nil {
@@ -164,6 +172,10 @@ func (k Keeper) mint(
 		return types.ErrBlockedAddress.Wrapf(
 			"failed to mint to %s", mintToAddr)
 	}
+	// Transfers out of the module skip BeforeSend, so check the recipient here.
+	if err := k.checkCompliance(ctx, coin.Denom, mintToAddr); err != nil {
+		return err
+	}
 
 	return k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, mintToAddr, coins,
@@ -275,6 +287,207 @@ func (k Keeper) SetDenomMetadata(
 		})
 }
 
+// SetDenomMintLimits: Message handler for the abci.Msg: MsgSetDenomMintLimits
+func (k Keeper) SetDenomMintLimits(
+	goCtx context.Context, txMsg *types.MsgSetDenomMintLimits,
+) (resp *types.MsgSetDenomMintLimitsResponse, err error) {
+	if txMsg == nil {
+		return resp, errNilMsg
+	}
+	if err := txMsg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := k.Store.GetAdmin(ctx, txMsg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if txMsg.Sender != admin {
+		return resp, types.ErrUnauthorized.Wrapf(
+			"sender (%s), admin (%s)", txMsg.Sender, admin,
+		)
+	}
+
+	if err := k.Store.SetDenomMintLimits(
+		ctx, txMsg.Denom, txMsg.MintLimits,
+	); err != nil {
+		return resp, err
+	}
+
+	return &types.MsgSetDenomMintLimitsResponse{}, ctx.EventManager().
+		EmitTypedEvent(&types.EventSetDenomMintLimits{
+			Denom:      txMsg.Denom,
+			MintLimits: txMsg.MintLimits,
+			Caller:     txMsg.Sender,
+		})
+}
+
+// SetFrozen: Message handler for the abci.Msg: MsgSetFrozen
+func (k Keeper) SetFrozen(
+	goCtx context.Context, txMsg *types.MsgSetFrozen,
+) (resp *types.MsgSetFrozenResponse, err error) {
+	if txMsg == nil {
+		return resp, errNilMsg
+	}
+	if err := txMsg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authData, err := k.authorizeAdmin(ctx, txMsg.Denom, txMsg.Sender)
+	if err != nil {
+		return resp, err
+	}
+	if !authData.Features.Freezable {
+		return resp, types.ErrFeatureDisabled.Wrapf(
+			"denom %s is not freezable", txMsg.Denom)
+	}
+
+	addr := sdk.MustAccAddressFromBech32(txMsg.Address)
+	if txMsg.Frozen && k.isProtectedAddr(ctx, addr) {
+		return resp, types.ErrBlockedAddress.Wrapf(
+			"failed to freeze %s", addr)
+	}
+	k.Store.SetFrozen(ctx, txMsg.Denom, addr, txMsg.Frozen)
+
+	return &types.MsgSetFrozenResponse{}, ctx.EventManager().
+		EmitTypedEvent(&types.EventSetFrozen{
+			Denom:   txMsg.Denom,
+			Address: txMsg.Address,
+			Frozen:  txMsg.Frozen,
+			Caller:  txMsg.Sender,
+		})
+}
+
+// SetPaused: Message handler for the abci.Msg: MsgSetPaused
+func (k Keeper) SetPaused(
+	goCtx context.Context, txMsg *types.MsgSetPaused,
+) (resp *types.MsgSetPausedResponse, err error) {
+	if txMsg == nil {
+		return resp, errNilMsg
+	}
+	if err := txMsg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authData, err := k.authorizeAdmin(ctx, txMsg.Denom, txMsg.Sender)
+	if err != nil {
+		return resp, err
+	}
+	if !authData.Features.Pausable {
+		return resp, types.ErrFeatureDisabled.Wrapf(
+			"denom %s is not pausable", txMsg.Denom)
+	}
+
+	k.Store.SetPaused(ctx, txMsg.Denom, txMsg.Paused)
+
+	return &types.MsgSetPausedResponse{}, ctx.EventManager().
+		EmitTypedEvent(&types.EventSetPaused{
+			Denom:  txMsg.Denom,
+			Paused: txMsg.Paused,
+			Caller: txMsg.Sender,
+		})
+}
+
+// Clawback: Message handler for the abci.Msg: MsgClawback
+func (k Keeper) Clawback(
+	goCtx context.Context, txMsg *types.MsgClawback,
+) (resp *types.MsgClawbackResponse, err error) {
+	if txMsg == nil {
+		return resp, errNilMsg
+	}
+	if err := txMsg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authData, err := k.authorizeAdmin(ctx, txMsg.Coin.Denom, txMsg.Sender)
+	if err != nil {
+		return resp, err
+	}
+	if !authData.Features.Clawback {
+		return resp, types.ErrFeatureDisabled.Wrapf(
+			"denom %s does not have the clawback feature", txMsg.Coin.Denom)
+	}
+
+	if txMsg.ClawbackTo == "" {
+		txMsg.ClawbackTo = txMsg.Sender
+	}
+	fromAddr := sdk.MustAccAddressFromBech32(txMsg.ClawbackFrom)
+	toAddr := sdk.MustAccAddressFromBech32(txMsg.ClawbackTo)
+	if k.isProtectedAddr(ctx, fromAddr) {
+		return resp, types.ErrBlockedAddress.Wrapf(
+			"failed to claw back from %s", fromAddr)
+	}
+	if k.bankKeeper.BlockedAddr(toAddr) {
+		return resp, types.ErrBlockedAddress.Wrapf(
+			"failed to claw back to %s", toAddr)
+	}
+
+	if err := k.bankKeeper.SendCoins(
+		withClawback(ctx), fromAddr, toAddr, sdk.NewCoins(txMsg.Coin),
+	); err != nil {
+		return resp, err
+	}
+
+	return &types.MsgClawbackResponse{}, ctx.EventManager().
+		EmitTypedEvent(&types.EventClawback{
+			Coin:     txMsg.Coin,
+			FromAddr: txMsg.ClawbackFrom,
+			ToAddr:   txMsg.ClawbackTo,
+			Caller:   txMsg.Sender,
+		})
+}
+
+// DisableFeatures: Message handler for the abci.Msg: MsgDisableFeatures
+func (k Keeper) DisableFeatures(
+	goCtx context.Context, txMsg *types.MsgDisableFeatures,
+) (resp *types.MsgDisableFeaturesResponse, err error) {
+	if txMsg == nil {
+		return resp, errNilMsg
+	}
+	if err := txMsg.ValidateBasic(); err != nil {
+		return resp, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.authorizeAdmin(ctx, txMsg.Denom, txMsg.Sender); err != nil {
+		return resp, err
+	}
+
+	if err := k.Store.DisableFeatures(ctx, txMsg.Denom, txMsg.Disable); err != nil {
+		return resp, err
+	}
+
+	return &types.MsgDisableFeaturesResponse{}, ctx.EventManager().
+		EmitTypedEvent(&types.EventDisableFeatures{
+			Denom:    txMsg.Denom,
+			Disabled: txMsg.Disable,
+			Caller:   txMsg.Sender,
+		})
+}
+
+// authorizeAdmin: Returns the authority metadata of the denom if the sender
+// is its admin.
+func (k Keeper) authorizeAdmin(
+	ctx sdk.Context, denom string, sender string,
+) (authData types.DenomAuthorityMetadata, err error) {
+	authData, err = k.Store.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return authData, err
+	}
+	if sender != authData.Admin {
+		return authData, types.ErrUnauthorized.Wrapf(
+			"sender (%s), admin (%s)", sender, authData.Admin,
+		)
+	}
+	return authData, nil
+}
+
 func (k Keeper) BurnNative(
 	goCtx context.Context, msg *types.MsgBurnNative,
 ) (resp *types.MsgBurnNativeResponse, err error) {
@@ -724,3 +724,168 @@ func (s *TestSuite) TestBurnNative() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSetDenomMintLimits() {
+	_, addrs := testutil.PrivKeyAddressPairs(4)
+	denom := types.TFDenom{
+		Creator:  addrs[0].String(),
+		Subdenom: "nusd",
+	}.Denom().String()
+	createDenom := &types.MsgCreateDenom{
+		Sender:   addrs[0].String(),
+		Subdenom: "nusd",
+	}
+	mint := func(amount int64) *types.MsgMint {
+		return &types.MsgMint{
+			Sender: addrs[0].String(),
+			Coin:   sdk.NewInt64Coin(denom, amount),
+		}
+	}
+	setLimits := func(
+		sender string, maxSupply, mintLimit int64, windowBlocks uint64,
+	) *types.MsgSetDenomMintLimits {
+		return &types.MsgSetDenomMintLimits{
+			Sender: sender,
+			Denom:  denom,
+			MintLimits: types.DenomMintLimits{
+				MaxSupply:          math.NewInt(maxSupply),
+				MintLimitPerWindow: math.NewInt(mintLimit),
+				MintWindowBlocks:   windowBlocks,
+			},
+		}
+	}
+
+	testCases := []TestCaseTx{
+		{
+			Name:      "happy: mint up to the max supply",
+			SetupMsgs: []sdk.Msg{createDenom, mint(400)},
+			TestMsgs: []TestMsgElem{
+				{TestMsg: setLimits(addrs[0].String(), 1_000, 0, 0), WantErr: ""},
+				{TestMsg: mint(600), WantErr: ""},
+				{TestMsg: mint(1), WantErr: "max_supply (1000) would be exceeded"},
+			},
+			PostHook: func(ctx sdk.Context, bapp *app.NibiruApp) {
+				s.Equal(
+					math.NewInt(1_000), bapp.BankKeeper.GetSupply(ctx, denom).Amount,
+				)
+				resp, err := bapp.TokenFactoryKeeper.QueryDenomInfo(ctx, denom)
+				s.NoError(err)
+				s.Equal(math.NewInt(1_000), resp.MintLimits.MaxSupply)
+				s.False(resp.MintLimits.HasMintLimit())
+			},
+		},
+
+		{
+			Name:      "happy: limits can be tightened",
+			SetupMsgs: []sdk.Msg{createDenom, setLimits(addrs[0].String(), 1_000, 100, 10)},
+			TestMsgs: []TestMsgElem{
+				{TestMsg: setLimits(addrs[0].String(), 500, 50, 20), WantErr: ""},
+			},
+			PostHook: func(ctx sdk.Context, bapp *app.NibiruApp) {
+				limits := bapp.TokenFactoryKeeper.Store.GetDenomMintLimits(ctx, denom)
+				s.Equal(math.NewInt(500), limits.MaxSupply)
+				s.Equal(math.NewInt(50), limits.MintLimitPerWindow)
+				s.EqualValues(20, limits.MintWindowBlocks)
+			},
+		},
+
+		{
+			Name:      "sad: limits cannot be loosened",
+			SetupMsgs: []sdk.Msg{createDenom, setLimits(addrs[0].String(), 1_000, 100, 10)},
+			TestMsgs: []TestMsgElem{
+				{
+					TestMsg: setLimits(addrs[0].String(), 1_001, 100, 10),
+					WantErr: types.ErrMintLimitsLoosened.Error(),
+				},
+				{
+					TestMsg: setLimits(addrs[0].String(), 0, 100, 10),
+					WantErr: types.ErrMintLimitsLoosened.Error(),
+				},
+				{
+					TestMsg: setLimits(addrs[0].String(), 1_000, 101, 10),
+					WantErr: types.ErrMintLimitsLoosened.Error(),
+				},
+				{
+					TestMsg: setLimits(addrs[0].String(), 1_000, 100, 9),
+					WantErr: types.ErrMintLimitsLoosened.Error(),
+				},
+			},
+		},
+
+		{
+			Name:      "sad: max supply below the current supply",
+			SetupMsgs: []sdk.Msg{createDenom, mint(400)},
+			TestMsgs: []TestMsgElem{
+				{
+					TestMsg: setLimits(addrs[0].String(), 399, 0, 0),
+					WantErr: "is below the current supply",
+				},
+			},
+		},
+
+		{
+			Name:      "sad: sender is not the admin",
+			SetupMsgs: []sdk.Msg{createDenom},
+			TestMsgs: []TestMsgElem{
+				{
+					TestMsg: setLimits(addrs[1].String(), 1_000, 0, 0),
+					WantErr: types.ErrUnauthorized.Error(),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.Name, func() {
+			s.SetupTest()
+			tc.RunTest(s)
+		})
+	}
+}
+
+func (s *TestSuite) TestMintLimitPerWindow() {
+	_, addrs := testutil.PrivKeyAddressPairs(1)
+	admin := addrs[0].String()
+	denom := types.TFDenom{Creator: admin, Subdenom: "nusd"}.Denom().String()
+	mint := func(amount int64) error {
+		return s.HandleMsg(&types.MsgMint{
+			Sender: admin,
+			Coin:   sdk.NewInt64Coin(denom, amount),
+		})
+	}
+
+	s.SetupTest()
+	s.ctx = s.ctx.WithBlockHeight(100)
+	s.Require().NoError(s.HandleMsg(&types.MsgCreateDenom{
+		Sender: admin, Subdenom: "nusd",
+	}))
+	s.Require().NoError(s.HandleMsg(&types.MsgSetDenomMintLimits{
+		Sender: admin,
+		Denom:  denom,
+		MintLimits: types.DenomMintLimits{
+			MintLimitPerWindow: math.NewInt(100),
+			MintWindowBlocks:   10,
+		},
+	}))
+
+	s.T().Log("The first mint opens a window at the current block")
+	s.NoError(mint(60))
+	s.ctx = s.ctx.WithBlockHeight(109)
+	s.NoError(mint(40))
+	s.ErrorContains(mint(1), "mint_limit_per_window (100) would be exceeded")
+
+	resp, err := s.querier.DenomInfo(s.GoCtx(), &types.QueryDenomInfoRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.EqualValues(100, resp.MintWindow.StartHeight)
+	s.Equal(math.NewInt(100), resp.MintWindow.Minted)
+
+	s.T().Log("A mint after the window ends opens a new window")
+	s.ctx = s.ctx.WithBlockHeight(115)
+	s.NoError(mint(100))
+	s.ErrorContains(mint(1), types.ErrMintLimitExceeded.Error())
+
+	window := s.app.TokenFactoryKeeper.Store.GetDenomMintWindow(s.ctx, denom)
+	s.EqualValues(115, window.StartHeight)
+	s.Equal(math.NewInt(100), window.Minted)
+	s.Equal(math.NewInt(200), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount)
+}
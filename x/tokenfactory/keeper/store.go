@@ -24,11 +24,23 @@ type StoreAPI struct {
 	ModuleParams collections.Item[tftypes.ModuleParams]
 	creator      collections.KeySet[storePKType]
 	denomAdmins  collections.Map[storePKType, tftypes.DenomAuthorityMetadata]
-	bankKeeper   tftypes.BankKeeper
+	// mintLimits: Supply cap and mint rate limit of each denom that has any
+	// limits set.
+	mintLimits collections.Map[storePKType, tftypes.DenomMintLimits]
+	// mintWindows: Amount of each rate limited denom minted within its most
+	// recent mint window.
+	mintWindows collections.Map[storePKType, tftypes.DenomMintWindow]
+	// paused: Denoms whose transfers are paused.
+	paused collections.KeySet[storePKType]
+	// frozen: Addresses that are frozen for a denom, keyed by (denom, address).
+	frozen     collections.KeySet[collections.Pair[storePKType, sdk.AccAddress]]
+	bankKeeper tftypes.BankKeeper
 }
 
+// InsertDenom: Registers a token factory denom with its creator as the admin
+// and the given compliance features enabled.
 func (api StoreAPI) InsertDenom(
-	ctx sdk.Context, denom tftypes.TFDenom,
+	ctx sdk.Context, denom tftypes.TFDenom, features tftypes.DenomFeatures,
 ) error {
 	if err := denom.Validate(); err != nil {
 		return err
@@ -40,28 +52,28 @@ func (api StoreAPI) InsertDenom(
 		return tftypes.ErrDenomAlreadyRegistered.Wrap(key.String())
 	}
 
-	admin := denom.Creator
-	api.unsafeInsertDenom(ctx, denom, admin)
+	authData := tftypes.DenomAuthorityMetadata{
+		Admin:    denom.Creator,
+		Features: features,
+	}
+	api.unsafeInsertDenom(ctx, denom, authData)
 
 	api.bankKeeper.SetDenomMetaData(ctx, denom.DefaultBankMetadata())
-	api.denomAdmins.Insert(ctx, key.String(), tftypes.DenomAuthorityMetadata{
-		Admin: admin,
-	})
+	api.denomAdmins.Insert(ctx, key.String(), authData)
 	return nil
 }
 
-// unsafeInsertDenom: Adds a token factory denom to state with the given admin.
+// unsafeInsertDenom: Adds a token factory denom to state with the given
+// authority metadata.
 // NOTE: unsafe → assumes pre-validated inputs
 func (api StoreAPI) unsafeInsertDenom(
-	ctx sdk.Context, denom tftypes.TFDenom, admin string,
+	ctx sdk.Context, denom tftypes.TFDenom, authData tftypes.DenomAuthorityMetadata,
 ) {
 	denomStr := denom.Denom()
 	api.Denoms.Insert(ctx, denomStr.String(), denom)
 	api.creator.Insert(ctx, denom.Creator)
 	api.bankKeeper.SetDenomMetaData(ctx, denom.DefaultBankMetadata())
-	api.denomAdmins.Insert(ctx, denomStr.String(), tftypes.DenomAuthorityMetadata{
-		Admin: admin,
-	})
+	api.denomAdmins.Insert(ctx, denomStr.String(), authData)
 	_ = ctx.EventManager().EmitTypedEvent(&tftypes.EventCreateDenom{
 		Denom:   denomStr.String(),
 		Creator: denom.Creator,
@@ -76,8 +88,20 @@ func (api StoreAPI) unsafeGenesisInsertDenom(
 	ctx sdk.Context, genDenom tftypes.GenesisDenom,
 ) {
 	denom := tftypes.DenomStr(genDenom.Denom).MustToStruct()
-	admin := genDenom.AuthorityMetadata.Admin
-	api.unsafeInsertDenom(ctx, denom, admin)
+	api.unsafeInsertDenom(ctx, denom, genDenom.AuthorityMetadata)
+	if genDenom.MintLimits.IsSet() {
+		api.mintLimits.Insert(ctx, genDenom.Denom, genDenom.MintLimits)
+	}
+	if minted := genDenom.MintWindow.Minted; !minted.IsNil() && minted.IsPositive() {
+		api.mintWindows.Insert(ctx, genDenom.Denom, genDenom.MintWindow)
+	}
+	if genDenom.Paused {
+		api.paused.Insert(ctx, genDenom.Denom)
+	}
+	for _, addr := range genDenom.FrozenAddresses {
+		api.frozen.Insert(ctx, collections.Join(
+			genDenom.Denom, sdk.MustAccAddressFromBech32(addr)))
+	}
 }
 
 // HasDenom: True if the denom has already been registered.
@@ -114,6 +138,154 @@ func (api StoreAPI) GetAdmin(
 	return metadata.Admin, nil
 }
 
+// GetDenomMintLimits returns the supply cap and mint rate limit of a denom.
+// Zero values mean that the corresponding limit is not set.
+func (api StoreAPI) GetDenomMintLimits(
+	ctx sdk.Context, denom string,
+) tftypes.DenomMintLimits {
+	return api.mintLimits.GetOr(ctx, denom, tftypes.DefaultDenomMintLimits())
+}
+
+// GetDenomMintWindow returns the amount of a denom minted within its most
+// recent mint window.
+func (api StoreAPI) GetDenomMintWindow(
+	ctx sdk.Context, denom string,
+) tftypes.DenomMintWindow {
+	return api.mintWindows.GetOr(ctx, denom, tftypes.DefaultDenomMintWindow())
+}
+
+// SetDenomMintLimits: Sets the supply cap and mint rate limit of a denom.
+// Limits that are already set can only be tightened, and the supply cap
+// cannot be below the current supply of the denom.
+func (api StoreAPI) SetDenomMintLimits(
+	ctx sdk.Context, denom string, limits tftypes.DenomMintLimits,
+) error {
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+	if err := api.GetDenomMintLimits(ctx, denom).ValidateUpdate(limits); err != nil {
+		return err
+	}
+	if limits.HasMaxSupply() {
+		supply := api.bankKeeper.GetSupply(ctx, denom).Amount
+		if supply.GT(limits.MaxSupply) {
+			return tftypes.ErrInvalidMintLimits.Wrapf(
+				"max_supply (%s) is below the current supply (%s)",
+				limits.MaxSupply, supply,
+			)
+		}
+	}
+	api.mintLimits.Insert(ctx, denom, limits)
+	return nil
+}
+
+// consumeMintAllowance: Checks that minting the coin respects the supply cap
+// and mint rate limit of its denom, and adds the amount to the current mint
+// window of the denom.
+func (api StoreAPI) consumeMintAllowance(ctx sdk.Context, coin sdk.Coin) error {
+	limits := api.GetDenomMintLimits(ctx, coin.Denom)
+	if limits.HasMaxSupply() {
+		supply := api.bankKeeper.GetSupply(ctx, coin.Denom).Amount
+		if supply.Add(coin.Amount).GT(limits.MaxSupply) {
+			return tftypes.ErrMintLimitExceeded.Wrapf(
+				"max_supply (%s) would be exceeded: supply (%s), mint amount (%s)",
+				limits.MaxSupply, supply, coin.Amount,
+			)
+		}
+	}
+
+	if !limits.HasMintLimit() {
+		return nil
+	}
+	window := api.GetDenomMintWindow(ctx, coin.Denom)
+	if window.HasEnded(ctx.BlockHeight(), limits.MintWindowBlocks) {
+		window = tftypes.DefaultDenomMintWindow()
+		window.StartHeight = ctx.BlockHeight()
+	}
+	minted := window.Minted.Add(coin.Amount)
+	if minted.GT(limits.MintLimitPerWindow) {
+		return tftypes.ErrMintLimitExceeded.Wrapf(
+			"mint_limit_per_window (%s) would be exceeded: minted in window starting at height %d (%s), mint amount (%s)",
+			limits.MintLimitPerWindow, window.StartHeight, window.Minted, coin.Amount,
+		)
+	}
+	window.Minted = minted
+	api.mintWindows.Insert(ctx, coin.Denom, window)
+	return nil
+}
+
+// IsPaused: True if transfers of the denom are paused.
+func (api StoreAPI) IsPaused(ctx sdk.Context, denom string) bool {
+	return api.paused.Has(ctx, denom)
+}
+
+// IsFrozen: True if the address is frozen for the denom.
+func (api StoreAPI) IsFrozen(
+	ctx sdk.Context, denom string, addr sdk.AccAddress,
+) bool {
+	return api.frozen.Has(ctx, collections.Join(denom, addr))
+}
+
+// GetFrozenAddresses returns the addresses that are frozen for a denom.
+func (api StoreAPI) GetFrozenAddresses(
+	ctx sdk.Context, denom string,
+) (addrs []sdk.AccAddress) {
+	keys := api.frozen.Iterate(
+		ctx, collections.PairRange[storePKType, sdk.AccAddress]{}.Prefix(denom),
+	).Keys()
+	for _, key := range keys {
+		addrs = append(addrs, key.K2())
+	}
+	return addrs
+}
+
+// SetPaused: Pauses or resumes transfers of the denom.
+// NOTE: The caller checks that the denom is pausable.
+func (api StoreAPI) SetPaused(ctx sdk.Context, denom string, paused bool) {
+	if paused {
+		api.paused.Insert(ctx, denom)
+		return
+	}
+	api.paused.Delete(ctx, denom)
+}
+
+// SetFrozen: Freezes or unfreezes an address for the denom.
+// NOTE: The caller checks that the denom is freezable.
+func (api StoreAPI) SetFrozen(
+	ctx sdk.Context, denom string, addr sdk.AccAddress, frozen bool,
+) {
+	key := collections.Join(denom, addr)
+	if frozen {
+		api.frozen.Insert(ctx, key)
+		return
+	}
+	api.frozen.Delete(ctx, key)
+}
+
+// DisableFeatures: Permanently disables compliance features of a denom.
+// Disabling "freezable" unfreezes all addresses, and disabling "pausable"
+// resumes transfers.
+func (api StoreAPI) DisableFeatures(
+	ctx sdk.Context, denom string, disable tftypes.DenomFeatures,
+) error {
+	authData, err := api.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	authData.Features = authData.Features.Without(disable)
+	api.denomAdmins.Insert(ctx, denom, authData)
+
+	if !authData.Features.Freezable {
+		for _, addr := range api.GetFrozenAddresses(ctx, denom) {
+			api.SetFrozen(ctx, denom, addr, false)
+		}
+	}
+	if !authData.Features.Pausable {
+		api.SetPaused(ctx, denom, false)
+	}
+	return nil
+}
+
 // ---------------------------------------------
 // StoreAPI - Under the hood
 // ---------------------------------------------
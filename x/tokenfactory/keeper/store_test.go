@@ -42,7 +42,7 @@ func (s *TestSuite) TestStoreWrite() {
 	s.Run("insert to state", func() {
 		// inserting should succeed
 		for _, tfdenom := range tfdenoms {
-			s.Require().NoError(api.InsertDenom(s.ctx, tfdenom))
+			s.Require().NoError(api.InsertDenom(s.ctx, tfdenom, tftypes.DenomFeatures{}))
 		}
 
 		allDenoms := api.Denoms.Iterate(
@@ -60,6 +60,6 @@ func (s *TestSuite) TestStoreWrite() {
 	s.Run("inserting invalid denom should fail", func() {
 		blankDenom := tftypes.TFDenom{}
 		s.Error(blankDenom.Validate())
-		s.Error(api.InsertDenom(s.ctx, blankDenom))
+		s.Error(api.InsertDenom(s.ctx, blankDenom, tftypes.DenomFeatures{}))
 	})
 }
@@ -36,6 +36,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgBurnNative{},
 		&MsgSetDenomMetadata{},
+		&MsgSetDenomMintLimits{},
+		&MsgSetFrozen{},
+		&MsgSetPaused{},
+		&MsgClawback{},
+		&MsgDisableFeatures{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -50,6 +55,11 @@ func TX_MSG_TYPE_URLS() []string {
 		"/nibiru.tokenfactory.v1.MsgBurn",
 		"/nibiru.tokenfactory.v1.MsgBurnNative",
 		"/nibiru.tokenfactory.v1.MsgSetDenomMetadata",
+		"/nibiru.tokenfactory.v1.MsgSetDenomMintLimits",
+		"/nibiru.tokenfactory.v1.MsgSetFrozen",
+		"/nibiru.tokenfactory.v1.MsgSetPaused",
+		"/nibiru.tokenfactory.v1.MsgClawback",
+		"/nibiru.tokenfactory.v1.MsgDisableFeatures",
 	}
 }
 
@@ -67,6 +77,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 		{&MsgMint{}, "nibiru/tokenfactory/mint"},
 		{&MsgBurn{}, "nibiru/tokenfactory/burn"},
 		{&MsgSetDenomMetadata{}, "nibiru/tokenfactory/set-denom-metadata"},
+		{&MsgSetDenomMintLimits{}, "nibiru/tokenfactory/set-denom-mint-limits"},
+		{&MsgSetFrozen{}, "nibiru/tokenfactory/set-frozen"},
+		{&MsgSetPaused{}, "nibiru/tokenfactory/set-paused"},
+		{&MsgClawback{}, "nibiru/tokenfactory/clawback"},
+		{&MsgDisableFeatures{}, "nibiru/tokenfactory/disable-features"},
 	} {
 		cdc.RegisterConcrete(ele.MsgType, ele.Name, nil)
 	}
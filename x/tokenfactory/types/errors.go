@@ -27,4 +27,20 @@ var (
 	// ErrBlockedAddress: error when the x/bank keeper has an address
 	// blocked.
 	ErrBlockedAddress = registerError("blocked address")
+	// ErrInvalidMintLimits: error when denom mint limits fail validation.
+	ErrInvalidMintLimits = registerError("invalid denom mint limits")
+	// ErrMintLimitsLoosened: error when an update would loosen denom mint
+	// limits that are already set.
+	ErrMintLimitsLoosened = registerError("denom mint limits can only be tightened")
+	// ErrMintLimitExceeded: error when a mint would exceed the supply cap or
+	// the mint rate limit of a denom.
+	ErrMintLimitExceeded = registerError("mint exceeds denom mint limits")
+	// ErrFeatureDisabled: error when the admin uses a compliance feature that
+	// is not enabled for the denom.
+	ErrFeatureDisabled = registerError("denom feature is not enabled")
+	// ErrDenomPaused: error when transferring a denom whose transfers are
+	// paused.
+	ErrDenomPaused = registerError("denom transfers are paused")
+	// ErrAddressFrozen: error when a frozen address sends or receives a denom.
+	ErrAddressFrozen = registerError("address is frozen for denom")
 )
@@ -24,4 +24,8 @@ const (
 	KeyPrefixModuleParams
 	KeyPrefixDenomAdmin
 	KeyPrefixCreatorIndexer
+	KeyPrefixDenomMintLimits
+	KeyPrefixDenomMintWindow
+	KeyPrefixDenomPaused
+	KeyPrefixFrozenAddress
 )
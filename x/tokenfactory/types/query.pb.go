@@ -255,6 +255,15 @@ type QueryDenomInfoResponse struct {
 	// Metadata: Official x/bank metadata for the denom. All token factory denoms
 	// are standard, native assets.
 	Metadata types.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	// MintLimits: Supply cap and mint rate limit of the denom.
+	MintLimits DenomMintLimits `protobuf:"bytes,3,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits"`
+	// MintWindow: Amount of the denom minted within its most recent mint
+	// window.
+	MintWindow DenomMintWindow `protobuf:"bytes,4,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window"`
+	// Features: Compliance features that are enabled for the denom.
+	Features DenomFeatures `protobuf:"bytes,5,opt,name=features,proto3" json:"features"`
+	// Paused: Whether transfers of the denom are paused.
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *QueryDenomInfoResponse) Reset()         { *m = QueryDenomInfoResponse{} }
@@ -304,6 +313,34 @@ func (m *QueryDenomInfoResponse) GetMetadata() types.Metadata {
 	return types.Metadata{}
 }
 
+func (m *QueryDenomInfoResponse) GetMintLimits() DenomMintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return DenomMintLimits{}
+}
+
+func (m *QueryDenomInfoResponse) GetMintWindow() DenomMintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return DenomMintWindow{}
+}
+
+func (m *QueryDenomInfoResponse) GetFeatures() DenomFeatures {
+	if m != nil {
+		return m.Features
+	}
+	return DenomFeatures{}
+}
+
+func (m *QueryDenomInfoResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.tokenfactory.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.tokenfactory.v1.QueryParamsResponse")
@@ -318,38 +355,44 @@ func init() {
 }
 
 var fileDescriptor_b7d8bbc34d6c2a91 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xe3, 0x96, 0x1c, 0xc4, 0x6c, 0x26, 0x44, 0x51, 0x04, 0x47, 0x64, 0x21, 0x14, 0x05,
-	0x62, 0x93, 0xf0, 0x01, 0x90, 0x02, 0x0b, 0x12, 0x45, 0x90, 0x0d, 0x36, 0x5f, 0xe2, 0x5c, 0x4f,
-	0xed, 0xf9, 0x5d, 0xcf, 0xbe, 0x88, 0xa8, 0xea, 0xc2, 0xc6, 0x86, 0xd4, 0x9d, 0xcf, 0x53, 0x31,
-	0x55, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83, 0xa0, 0xda, 0xbe, 0xd0, 0x00, 0x21, 0xdd, 0xfc, 0xec,
-	0x9f, 0xdf, 0xff, 0xef, 0xf7, 0xbf, 0xc3, 0x54, 0x25, 0x51, 0x92, 0x17, 0xdc, 0xc0, 0x81, 0x54,
-	0x53, 0x31, 0x36, 0x90, 0xcf, 0xf9, 0xac, 0xcf, 0x8f, 0x0a, 0x99, 0xcf, 0x59, 0x96, 0x83, 0x01,
-	0xd2, 0x70, 0x0c, 0xbb, 0xcc, 0xb0, 0x59, 0xbf, 0x55, 0x8f, 0x21, 0x06, 0x8b, 0xf0, 0x8b, 0x95,
-	0xa3, 0x5b, 0x77, 0x62, 0x80, 0xf8, 0x50, 0x72, 0x91, 0x25, 0x5c, 0x28, 0x05, 0x46, 0x98, 0x04,
-	0x94, 0xf6, 0xa7, 0xe1, 0x18, 0x74, 0x0a, 0x9a, 0x47, 0x42, 0x1d, 0xf0, 0x59, 0x3f, 0x92, 0x46,
-	0xf4, 0x6d, 0xe1, 0xcf, 0x37, 0xf9, 0xd1, 0x46, 0x18, 0xe9, 0x18, 0x5a, 0xc7, 0xe4, 0xcd, 0x85,
-	0xbd, 0xd7, 0x22, 0x17, 0xa9, 0x1e, 0xc9, 0xa3, 0x42, 0x6a, 0x43, 0xdf, 0xe2, 0x5b, 0x6b, 0xbb,
-	0x3a, 0x03, 0xa5, 0x25, 0x19, 0xe2, 0x20, 0xb3, 0x3b, 0x4d, 0xd4, 0x46, 0x9d, 0x9b, 0x83, 0xfb,
-	0xec, 0xdf, 0xaf, 0x61, 0x7b, 0x30, 0x29, 0x0e, 0xa5, 0xbb, 0x3d, 0xbc, 0x76, 0xf6, 0xfd, 0x5e,
-	0x65, 0xe4, 0x6f, 0x52, 0xe6, 0x05, 0x9f, 0x4b, 0x05, 0x2b, 0x41, 0xd2, 0xc4, 0xd7, 0xc7, 0xb9,
-	0x14, 0x06, 0x72, 0xdb, 0xba, 0x36, 0x2a, 0x4b, 0xda, 0xf3, 0x56, 0x4a, 0xde, 0x5b, 0x69, 0xe0,
-	0x60, 0x62, 0x77, 0x9a, 0xa8, 0xbd, 0xdb, 0xa9, 0x8d, 0x7c, 0x45, 0x7b, 0xf8, 0xf6, 0x6f, 0xfc,
-	0x85, 0x9a, 0x42, 0xa9, 0x50, 0xc7, 0x55, 0x8b, 0xf8, 0xfe, 0xae, 0xa0, 0x80, 0x1b, 0x7f, 0xe2,
-	0x5e, 0xa0, 0x8e, 0xab, 0x62, 0x92, 0x26, 0xaa, 0xe4, 0x6d, 0x41, 0x9e, 0xe2, 0x1b, 0xa9, 0x34,
-	0x62, 0x22, 0x8c, 0x68, 0xee, 0xd8, 0x19, 0xdc, 0x65, 0x2e, 0x05, 0x66, 0x07, 0xef, 0x53, 0x60,
-	0x7b, 0x1e, 0xf2, 0x8f, 0x5f, 0x5d, 0x1a, 0x7c, 0xd9, 0xc5, 0x55, 0xab, 0x48, 0x3e, 0x22, 0x1c,
-	0xb8, 0x09, 0x91, 0xee, 0xa6, 0x39, 0xfe, 0x1d, 0x4d, 0xeb, 0xe1, 0x95, 0x58, 0xf7, 0x08, 0xfa,
-	0xe0, 0xc3, 0xd7, 0x9f, 0xa7, 0x3b, 0x6d, 0x12, 0xf2, 0x0d, 0x9f, 0x82, 0x0b, 0x85, 0x9c, 0x22,
-	0x1c, 0xb8, 0x01, 0x6f, 0xf1, 0xb2, 0x96, 0xda, 0x16, 0x2f, 0xeb, 0x89, 0xd1, 0xc7, 0xd6, 0x4b,
-	0x97, 0x74, 0x36, 0x79, 0x71, 0x09, 0xf2, 0x63, 0x9f, 0xfc, 0x09, 0xf9, 0x8c, 0x70, 0x6d, 0x15,
-	0x0c, 0xe9, 0x6d, 0x17, 0xbb, 0x94, 0x77, 0x8b, 0x5d, 0x15, 0xf7, 0xf6, 0x06, 0xd6, 0xde, 0x23,
-	0xd2, 0xfd, 0xaf, 0xbd, 0x5e, 0xa2, 0xa6, 0xc0, 0x8f, 0xed, 0xfa, 0x64, 0xf8, 0xf2, 0x6c, 0x11,
-	0xa2, 0xf3, 0x45, 0x88, 0x7e, 0x2c, 0x42, 0xf4, 0x69, 0x19, 0x56, 0xce, 0x97, 0x61, 0xe5, 0xdb,
-	0x32, 0xac, 0xbc, 0x1b, 0xc4, 0x89, 0xd9, 0x2f, 0x22, 0x36, 0x86, 0x94, 0xbf, 0xb2, 0xfd, 0x9e,
-	0xed, 0x8b, 0x44, 0x95, 0xbd, 0xdf, 0xaf, 0x77, 0x37, 0xf3, 0x4c, 0xea, 0x28, 0xb0, 0x7f, 0xe4,
-	0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xed, 0x27, 0xd8, 0x56, 0x47, 0x04, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0x12, 0x41,
+	0x14, 0x67, 0xa1, 0xac, 0x30, 0xbd, 0x4d, 0x91, 0x6c, 0x88, 0xae, 0x64, 0xe3, 0x1f, 0x42, 0x65,
+	0x47, 0xf0, 0x03, 0x98, 0xa0, 0xd1, 0x98, 0x94, 0x46, 0xb9, 0x18, 0xbd, 0x98, 0x81, 0x1d, 0xe8,
+	0xa4, 0xec, 0xcc, 0x76, 0x67, 0x96, 0x4a, 0x9a, 0x5e, 0xbc, 0x79, 0x33, 0xe9, 0xdd, 0xcf, 0xd3,
+	0x78, 0x6a, 0xe2, 0xc5, 0x93, 0x31, 0xe0, 0x07, 0xf0, 0x23, 0x18, 0x66, 0x66, 0x11, 0xb4, 0xb4,
+	0xdc, 0xe6, 0xbd, 0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x7b, 0xbf, 0x5d, 0xe0, 0x31, 0xda, 0xa3, 0x71,
+	0x82, 0x24, 0x3f, 0x24, 0x6c, 0x80, 0xfb, 0x92, 0xc7, 0x13, 0x34, 0x6e, 0xa2, 0xa3, 0x84, 0xc4,
+	0x13, 0x3f, 0x8a, 0xb9, 0xe4, 0xb0, 0xac, 0x31, 0xfe, 0x32, 0xc6, 0x1f, 0x37, 0x2b, 0xa5, 0x21,
+	0x1f, 0x72, 0x05, 0x41, 0xf3, 0x93, 0x46, 0x57, 0x6e, 0x0d, 0x39, 0x1f, 0x8e, 0x08, 0xc2, 0x11,
+	0x45, 0x98, 0x31, 0x2e, 0xb1, 0xa4, 0x9c, 0x09, 0x73, 0xeb, 0xf6, 0xb9, 0x08, 0xb9, 0x40, 0x3d,
+	0xcc, 0x0e, 0xd1, 0xb8, 0xd9, 0x23, 0x12, 0x37, 0x55, 0x60, 0xee, 0xd7, 0xe9, 0x11, 0x12, 0x4b,
+	0xa2, 0x31, 0x5e, 0x09, 0xc0, 0xd7, 0x73, 0x79, 0xaf, 0x70, 0x8c, 0x43, 0xd1, 0x25, 0x47, 0x09,
+	0x11, 0xd2, 0x7b, 0x0b, 0x76, 0x56, 0xb2, 0x22, 0xe2, 0x4c, 0x10, 0xd8, 0x06, 0x76, 0xa4, 0x32,
+	0x8e, 0x55, 0xb5, 0x6a, 0xdb, 0xad, 0xbb, 0xfe, 0xe5, 0xaf, 0xf1, 0x3b, 0x3c, 0x48, 0x46, 0x44,
+	0x57, 0xb7, 0xb7, 0xce, 0x7f, 0xdc, 0xc9, 0x74, 0x4d, 0xa5, 0xe7, 0x9b, 0x86, 0xcf, 0x08, 0xe3,
+	0x8b, 0x86, 0xd0, 0x01, 0x37, 0xfa, 0x31, 0xc1, 0x92, 0xc7, 0x8a, 0xba, 0xd8, 0x4d, 0x43, 0xaf,
+	0x01, 0x76, 0x56, 0xf0, 0x46, 0x4a, 0x19, 0xd8, 0x81, 0xca, 0x38, 0x56, 0x35, 0x57, 0x2b, 0x76,
+	0x4d, 0xe4, 0x35, 0xc0, 0xcd, 0xbf, 0xf0, 0x97, 0x6c, 0xc0, 0xd3, 0x0e, 0x25, 0x90, 0x57, 0x10,
+	0xc3, 0xaf, 0x03, 0xef, 0x77, 0x16, 0x94, 0xff, 0xc5, 0x9b, 0x0e, 0x25, 0x90, 0xc7, 0x41, 0x48,
+	0x59, 0x5a, 0xa0, 0x02, 0xf8, 0x04, 0x14, 0x42, 0x22, 0x71, 0x80, 0x25, 0x76, 0xb2, 0x6a, 0x08,
+	0xb7, 0x7d, 0xbd, 0x06, 0x5f, 0x4d, 0xde, 0xac, 0xc1, 0xef, 0x18, 0x90, 0x79, 0xfd, 0xa2, 0x08,
+	0xee, 0x83, 0xed, 0x90, 0x32, 0xf9, 0x7e, 0x44, 0x43, 0x2a, 0x85, 0x93, 0x53, 0x1c, 0x0f, 0xd6,
+	0x0d, 0x52, 0xc9, 0xea, 0x50, 0x26, 0xf7, 0x14, 0xdc, 0xb0, 0x81, 0x70, 0x91, 0x59, 0xf0, 0x1d,
+	0x53, 0x16, 0xf0, 0x63, 0x67, 0x6b, 0x43, 0xbe, 0x37, 0x0a, 0xbe, 0xcc, 0xa7, 0x33, 0xf0, 0x05,
+	0x28, 0x0c, 0x08, 0x96, 0x49, 0x4c, 0x84, 0x93, 0x57, 0x64, 0xf7, 0xae, 0x24, 0x7b, 0x6e, 0xc0,
+	0xe9, 0x43, 0xd3, 0xe2, 0xf9, 0x86, 0x22, 0x9c, 0x08, 0x12, 0x38, 0x76, 0xd5, 0xaa, 0x15, 0xba,
+	0x26, 0x6a, 0x7d, 0xcd, 0x81, 0xbc, 0x1a, 0x39, 0xfc, 0x64, 0x01, 0x5b, 0x7b, 0x04, 0xd6, 0xd7,
+	0xf5, 0xf8, 0xdf, 0x9c, 0x95, 0xdd, 0x8d, 0xb0, 0x7a, 0x8b, 0xde, 0xfd, 0x8f, 0xdf, 0x7e, 0x9d,
+	0x65, 0xab, 0xd0, 0x45, 0x6b, 0x3e, 0x06, 0x6d, 0x4b, 0x78, 0x66, 0x01, 0x5b, 0x5b, 0xec, 0x1a,
+	0x2d, 0x2b, 0xbe, 0xad, 0xec, 0x6e, 0x84, 0x35, 0x5a, 0x1e, 0x29, 0x2d, 0x75, 0x58, 0x5b, 0xa7,
+	0x45, 0x7b, 0x18, 0x9d, 0x18, 0xef, 0x9f, 0xc2, 0x2f, 0x16, 0x28, 0x2e, 0x9c, 0x09, 0x1b, 0xd7,
+	0x37, 0x5b, 0x72, 0x7c, 0xc5, 0xdf, 0x14, 0x6e, 0xe4, 0xb5, 0x94, 0xbc, 0x87, 0xb0, 0x7e, 0xa5,
+	0xbc, 0x06, 0x65, 0x03, 0x8e, 0x4e, 0xd4, 0xf9, 0xb4, 0xbd, 0x77, 0x3e, 0x75, 0xad, 0x8b, 0xa9,
+	0x6b, 0xfd, 0x9c, 0xba, 0xd6, 0xe7, 0x99, 0x9b, 0xb9, 0x98, 0xb9, 0x99, 0xef, 0x33, 0x37, 0xf3,
+	0xae, 0x35, 0xa4, 0xf2, 0x20, 0xe9, 0xf9, 0x7d, 0x1e, 0xa2, 0x7d, 0xc5, 0xf7, 0xf4, 0x00, 0x53,
+	0x96, 0x72, 0x7f, 0x58, 0x65, 0x97, 0x93, 0x88, 0x88, 0x9e, 0xad, 0xfe, 0x49, 0x8f, 0xff, 0x0c,
+	0x00, 0x72, 0x49, 0xf6, 0x78, 0x49, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -678,6 +721,46 @@ func (m *QueryDenomInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Features.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -782,6 +865,15 @@ func (m *QueryDenomInfoResponse) Size() (n int) {
 	}
 	l = m.Metadata.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintLimits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintWindow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Features.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -1264,6 +1356,125 @@ func (m *QueryDenomInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Features.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
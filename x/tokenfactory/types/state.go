@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 // ----------------------------------------------------
@@ -83,7 +87,35 @@ func (denomStr DenomStr) Validate() error {
 func (denomStr DenomStr) String() string { return string(denomStr) }
 
 func (genDenom GenesisDenom) Validate() error {
-	return DenomStr(genDenom.Denom).Validate()
+	if err := DenomStr(genDenom.Denom).Validate(); err != nil {
+		return err
+	}
+	if err := genDenom.MintLimits.Validate(); err != nil {
+		return err
+	}
+	if err := genDenom.MintWindow.Validate(); err != nil {
+		return err
+	}
+
+	features := genDenom.AuthorityMetadata.Features
+	if genDenom.Paused && !features.Pausable {
+		return ErrFeatureDisabled.Wrapf("denom %s is paused but not pausable", genDenom.Denom)
+	}
+	if len(genDenom.FrozenAddresses) > 0 && !features.Freezable {
+		return ErrFeatureDisabled.Wrapf(
+			"denom %s has frozen addresses but is not freezable", genDenom.Denom)
+	}
+	seenAddrs := set.New[string]()
+	for _, addr := range genDenom.FrozenAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("frozen address (%s): %w", addr, err)
+		}
+		if seenAddrs.Has(addr) {
+			return fmt.Errorf("duplicate frozen address: %s", addr)
+		}
+		seenAddrs.Add(addr)
+	}
+	return nil
 }
 
 func (denomStr DenomStr) ToStruct() (res TFDenom, err error) {
@@ -110,3 +142,129 @@ func (denomStr DenomStr) MustToStruct() TFDenom {
 	out, _ := denomStr.ToStruct()
 	return out
 }
+
+// ----------------------------------------------------
+// DenomMintLimits functions
+// ----------------------------------------------------
+
+// DefaultDenomMintLimits: Mint limits of a denom that has none set.
+func DefaultDenomMintLimits() DenomMintLimits {
+	return DenomMintLimits{
+		MaxSupply:          math.ZeroInt(),
+		MintLimitPerWindow: math.ZeroInt(),
+		MintWindowBlocks:   0,
+	}
+}
+
+// HasMaxSupply: True if the supply of the denom is capped.
+func (limits DenomMintLimits) HasMaxSupply() bool {
+	return !limits.MaxSupply.IsNil() && limits.MaxSupply.IsPositive()
+}
+
+// HasMintLimit: True if the amount minted per mint window is limited.
+func (limits DenomMintLimits) HasMintLimit() bool {
+	return !limits.MintLimitPerWindow.IsNil() && limits.MintLimitPerWindow.IsPositive()
+}
+
+// IsSet: True if any of the limits is set.
+func (limits DenomMintLimits) IsSet() bool {
+	return limits.HasMaxSupply() || limits.HasMintLimit()
+}
+
+func (limits DenomMintLimits) Validate() error {
+	if !limits.MaxSupply.IsNil() && limits.MaxSupply.IsNegative() {
+		return ErrInvalidMintLimits.Wrapf(
+			"max_supply cannot be negative: %s", limits.MaxSupply)
+	}
+	if !limits.MintLimitPerWindow.IsNil() && limits.MintLimitPerWindow.IsNegative() {
+		return ErrInvalidMintLimits.Wrapf(
+			"mint_limit_per_window cannot be negative: %s", limits.MintLimitPerWindow)
+	}
+	if limits.HasMintLimit() != (limits.MintWindowBlocks > 0) {
+		return ErrInvalidMintLimits.Wrap(
+			"mint_window_blocks must be set if and only if mint_limit_per_window is set")
+	}
+	return nil
+}
+
+// ValidateUpdate: Returns an error if replacing the limits with "newLimits"
+// would loosen any limit that is already set. A supply cap or mint limit
+// cannot be removed or increased, and a mint window cannot be shortened.
+func (limits DenomMintLimits) ValidateUpdate(newLimits DenomMintLimits) error {
+	if limits.HasMaxSupply() {
+		if !newLimits.HasMaxSupply() || newLimits.MaxSupply.GT(limits.MaxSupply) {
+			return ErrMintLimitsLoosened.Wrapf(
+				"max_supply cannot be removed or increased: current (%s), new (%s)",
+				limits.MaxSupply, newLimits.MaxSupply,
+			)
+		}
+	}
+	if limits.HasMintLimit() {
+		if !newLimits.HasMintLimit() || newLimits.MintLimitPerWindow.GT(limits.MintLimitPerWindow) {
+			return ErrMintLimitsLoosened.Wrapf(
+				"mint_limit_per_window cannot be removed or increased: current (%s), new (%s)",
+				limits.MintLimitPerWindow, newLimits.MintLimitPerWindow,
+			)
+		}
+		if newLimits.MintWindowBlocks < limits.MintWindowBlocks {
+			return ErrMintLimitsLoosened.Wrapf(
+				"mint_window_blocks cannot decrease: current (%d), new (%d)",
+				limits.MintWindowBlocks, newLimits.MintWindowBlocks,
+			)
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------
+// DenomMintWindow functions
+// ----------------------------------------------------
+
+// DefaultDenomMintWindow: Mint window of a denom that has not been minted
+// under a mint rate limit.
+func DefaultDenomMintWindow() DenomMintWindow {
+	return DenomMintWindow{
+		StartHeight: 0,
+		Minted:      math.ZeroInt(),
+	}
+}
+
+// HasEnded: True if a mint window of "windowBlocks" blocks no longer covers
+// the given block height. A window that starts after the block height, as
+// happens after a chain restarts from an exported genesis, has also ended.
+func (window DenomMintWindow) HasEnded(blockHeight int64, windowBlocks uint64) bool {
+	if blockHeight < window.StartHeight {
+		return true
+	}
+	return uint64(blockHeight-window.StartHeight) >= windowBlocks
+}
+
+func (window DenomMintWindow) Validate() error {
+	if window.StartHeight < 0 {
+		return ErrInvalidMintLimits.Wrapf(
+			"mint window start_height cannot be negative: %d", window.StartHeight)
+	}
+	if !window.Minted.IsNil() && window.Minted.IsNegative() {
+		return ErrInvalidMintLimits.Wrapf(
+			"mint window minted amount cannot be negative: %s", window.Minted)
+	}
+	return nil
+}
+
+// ----------------------------------------------------
+// DenomFeatures functions
+// ----------------------------------------------------
+
+// IsEmpty: True if none of the features is set.
+func (features DenomFeatures) IsEmpty() bool {
+	return !features.Freezable && !features.Pausable && !features.Clawback
+}
+
+// Without: Returns the features with those set in "disable" turned off.
+func (features DenomFeatures) Without(disable DenomFeatures) DenomFeatures {
+	return DenomFeatures{
+		Freezable: features.Freezable && !disable.Freezable,
+		Pausable:  features.Pausable && !disable.Pausable,
+		Clawback:  features.Clawback && !disable.Clawback,
+	}
+}
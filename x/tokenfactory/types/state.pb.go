@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,8 @@ type DenomAuthorityMetadata struct {
 	// Admin: Bech32 address of the admin for the tokefactory denom. Can be empty
 	// for no admin.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Features: Opt-in compliance features that the admin can use.
+	Features DenomFeatures `protobuf:"bytes,2,opt,name=features,proto3" json:"features" yaml:"features"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -74,6 +77,187 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetFeatures() DenomFeatures {
+	if m != nil {
+		return m.Features
+	}
+	return DenomFeatures{}
+}
+
+// DenomFeatures: Opt-in compliance features of a token factory denom, meant
+// for issuers of regulated assets. The features are chosen when the denom is
+// created. The admin can disable them later, but a disabled feature can never
+// be enabled again.
+type DenomFeatures struct {
+	// Freezable: The admin can freeze addresses. A frozen address can neither
+	// send nor receive the denom.
+	Freezable bool `protobuf:"varint,1,opt,name=freezable,proto3" json:"freezable,omitempty" yaml:"freezable"`
+	// Pausable: The admin can pause all transfers of the denom.
+	Pausable bool `protobuf:"varint,2,opt,name=pausable,proto3" json:"pausable,omitempty" yaml:"pausable"`
+	// Clawback: The admin can move the denom out of any address, including
+	// frozen addresses and while transfers are paused.
+	Clawback bool `protobuf:"varint,3,opt,name=clawback,proto3" json:"clawback,omitempty" yaml:"clawback"`
+}
+
+func (m *DenomFeatures) Reset()         { *m = DenomFeatures{} }
+func (m *DenomFeatures) String() string { return proto.CompactTextString(m) }
+func (*DenomFeatures) ProtoMessage()    {}
+func (*DenomFeatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452ec984f7eef90f, []int{1}
+}
+func (m *DenomFeatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFeatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFeatures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFeatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFeatures.Merge(m, src)
+}
+func (m *DenomFeatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFeatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFeatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFeatures proto.InternalMessageInfo
+
+func (m *DenomFeatures) GetFreezable() bool {
+	if m != nil {
+		return m.Freezable
+	}
+	return false
+}
+
+func (m *DenomFeatures) GetPausable() bool {
+	if m != nil {
+		return m.Pausable
+	}
+	return false
+}
+
+func (m *DenomFeatures) GetClawback() bool {
+	if m != nil {
+		return m.Clawback
+	}
+	return false
+}
+
+// DenomMintLimits: Optional limits on the minting of a token factory denom. A
+// zero value means that the corresponding limit is not set. The admin of the
+// denom can only tighten the limits, which lets token issuers make credible
+// commitments about the supply of their tokens.
+type DenomMintLimits struct {
+	// MaxSupply: Upper bound on the total supply of the denom.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// MintLimitPerWindow: Upper bound on the amount of the denom that can be
+	// minted within one mint window.
+	MintLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=mint_limit_per_window,json=mintLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"mint_limit_per_window" yaml:"mint_limit_per_window"`
+	// MintWindowBlocks: Length of a mint window in blocks. A new mint window
+	// starts with the first mint after the previous window ends. Required when
+	// "mint_limit_per_window" is set.
+	MintWindowBlocks uint64 `protobuf:"varint,3,opt,name=mint_window_blocks,json=mintWindowBlocks,proto3" json:"mint_window_blocks,omitempty" yaml:"mint_window_blocks"`
+}
+
+func (m *DenomMintLimits) Reset()         { *m = DenomMintLimits{} }
+func (m *DenomMintLimits) String() string { return proto.CompactTextString(m) }
+func (*DenomMintLimits) ProtoMessage()    {}
+func (*DenomMintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452ec984f7eef90f, []int{2}
+}
+func (m *DenomMintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMintLimits.Merge(m, src)
+}
+func (m *DenomMintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMintLimits proto.InternalMessageInfo
+
+func (m *DenomMintLimits) GetMintWindowBlocks() uint64 {
+	if m != nil {
+		return m.MintWindowBlocks
+	}
+	return 0
+}
+
+// DenomMintWindow: Amount of a token factory denom minted within its most
+// recent mint window.
+type DenomMintWindow struct {
+	// StartHeight: Block height at which the mint window starts.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// Minted: Amount minted since "start_height".
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted" yaml:"minted"`
+}
+
+func (m *DenomMintWindow) Reset()         { *m = DenomMintWindow{} }
+func (m *DenomMintWindow) String() string { return proto.CompactTextString(m) }
+func (*DenomMintWindow) ProtoMessage()    {}
+func (*DenomMintWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452ec984f7eef90f, []int{3}
+}
+func (m *DenomMintWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMintWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMintWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMintWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMintWindow.Merge(m, src)
+}
+func (m *DenomMintWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMintWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMintWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMintWindow proto.InternalMessageInfo
+
+func (m *DenomMintWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 // ModuleParams defines the parameters for the tokenfactory module.
 //
 // ### On Denom Creation Costs
@@ -111,7 +295,7 @@ func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
 func (m *ModuleParams) String() string { return proto.CompactTextString(m) }
 func (*ModuleParams) ProtoMessage()    {}
 func (*ModuleParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_452ec984f7eef90f, []int{1}
+	return fileDescriptor_452ec984f7eef90f, []int{4}
 }
 func (m *ModuleParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +345,7 @@ func (m *TFDenom) Reset()         { *m = TFDenom{} }
 func (m *TFDenom) String() string { return proto.CompactTextString(m) }
 func (*TFDenom) ProtoMessage()    {}
 func (*TFDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_452ec984f7eef90f, []int{2}
+	return fileDescriptor_452ec984f7eef90f, []int{5}
 }
 func (m *TFDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +398,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_452ec984f7eef90f, []int{3}
+	return fileDescriptor_452ec984f7eef90f, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,13 +445,19 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	MintLimits        DenomMintLimits        `protobuf:"bytes,3,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits" yaml:"mint_limits"`
+	MintWindow        DenomMintWindow        `protobuf:"bytes,4,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window" yaml:"mint_window"`
+	// Paused: Whether transfers of the denom are paused.
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// FrozenAddresses: Bech32 addresses that are frozen for the denom.
+	FrozenAddresses []string `protobuf:"bytes,6,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
 func (m *GenesisDenom) String() string { return proto.CompactTextString(m) }
 func (*GenesisDenom) ProtoMessage()    {}
 func (*GenesisDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_452ec984f7eef90f, []int{4}
+	return fileDescriptor_452ec984f7eef90f, []int{7}
 }
 func (m *GenesisDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,8 +500,39 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetMintLimits() DenomMintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return DenomMintLimits{}
+}
+
+func (m *GenesisDenom) GetMintWindow() DenomMintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return DenomMintWindow{}
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "nibiru.tokenfactory.v1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomFeatures)(nil), "nibiru.tokenfactory.v1.DenomFeatures")
+	proto.RegisterType((*DenomMintLimits)(nil), "nibiru.tokenfactory.v1.DenomMintLimits")
+	proto.RegisterType((*DenomMintWindow)(nil), "nibiru.tokenfactory.v1.DenomMintWindow")
 	proto.RegisterType((*ModuleParams)(nil), "nibiru.tokenfactory.v1.ModuleParams")
 	proto.RegisterType((*TFDenom)(nil), "nibiru.tokenfactory.v1.TFDenom")
 	proto.RegisterType((*GenesisState)(nil), "nibiru.tokenfactory.v1.GenesisState")
@@ -323,38 +544,65 @@ func init() {
 }
 
 var fileDescriptor_452ec984f7eef90f = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x4b, 0xda, 0xc2, 0x35, 0x20, 0x38, 0x41, 0x49, 0x23, 0x61, 0xa7, 0x27, 0xa8, 0x98,
-	0x7c, 0x4a, 0xd8, 0xb2, 0xe1, 0x94, 0x76, 0xa1, 0x08, 0x19, 0x26, 0x16, 0xeb, 0xd9, 0x39, 0x92,
-	0x83, 0xfa, 0x2e, 0xf2, 0x9d, 0x23, 0xb2, 0xf5, 0x23, 0xf0, 0x11, 0xf8, 0x24, 0xb0, 0x76, 0xec,
-	0xc8, 0x80, 0x22, 0x94, 0x2c, 0xcc, 0xf9, 0x04, 0x28, 0x77, 0x57, 0x68, 0x69, 0xc3, 0xe6, 0xf7,
-	0x7e, 0xbf, 0xf7, 0xf3, 0xef, 0xfd, 0x39, 0x44, 0x04, 0xcf, 0x78, 0x59, 0x51, 0x2d, 0x3f, 0x32,
-	0xf1, 0x1e, 0x72, 0x2d, 0xcb, 0x09, 0x1d, 0xb7, 0xa9, 0xd2, 0xa0, 0x59, 0x34, 0x2a, 0xa5, 0x96,
-	0x78, 0xdb, 0x72, 0xa2, 0x8b, 0x9c, 0x68, 0xdc, 0x6e, 0xde, 0x1f, 0xc8, 0x81, 0x34, 0x14, 0xba,
-	0xfc, 0xb2, 0xec, 0xe6, 0x4e, 0x2e, 0x55, 0x21, 0x55, 0x6a, 0x01, 0x1b, 0x38, 0x28, 0xb0, 0x11,
-	0xcd, 0x40, 0x31, 0x3a, 0x6e, 0x67, 0x4c, 0x43, 0x9b, 0xe6, 0x92, 0x0b, 0x8b, 0x93, 0x03, 0xb4,
-	0xbd, 0xcf, 0x84, 0x2c, 0x9e, 0x57, 0x7a, 0x28, 0x4b, 0xae, 0x27, 0x47, 0x4c, 0x43, 0x1f, 0x34,
-	0xe0, 0x3d, 0xb4, 0x0e, 0xfd, 0x82, 0x8b, 0x86, 0xdf, 0xf2, 0x9f, 0xde, 0x8a, 0xef, 0x2e, 0xa6,
-	0x61, 0x7d, 0x02, 0xc5, 0x71, 0x97, 0x98, 0x34, 0x49, 0x2c, 0xdc, 0xad, 0xfd, 0xfa, 0x12, 0xfa,
-	0xa4, 0x44, 0xf5, 0x23, 0xd9, 0xaf, 0x8e, 0xd9, 0x6b, 0x28, 0xa1, 0x50, 0x38, 0x43, 0xcd, 0xfe,
-	0x52, 0x37, 0xcd, 0x4b, 0x06, 0x9a, 0x4b, 0x91, 0x0e, 0x40, 0xa5, 0xb9, 0x14, 0xaa, 0x2a, 0x98,
-	0x91, 0xac, 0xc5, 0x4f, 0x16, 0xd3, 0x70, 0xd7, 0x4a, 0xae, 0xe6, 0x92, 0xe4, 0xa1, 0x01, 0x7b,
-	0x0e, 0x3b, 0x04, 0xd5, 0x73, 0xc8, 0x0b, 0xb4, 0xf9, 0xf6, 0xc0, 0xb8, 0xc7, 0x0d, 0xb4, 0x69,
-	0x8a, 0x65, 0x69, 0xed, 0x26, 0xe7, 0x21, 0x6e, 0xa2, 0x9b, 0xaa, 0xca, 0x8c, 0x44, 0x63, 0xcd,
-	0x40, 0x7f, 0xe2, 0x6e, 0xed, 0xe4, 0x47, 0xcb, 0x23, 0x5f, 0x7d, 0x54, 0x3f, 0x64, 0x82, 0x29,
-	0xae, 0xde, 0x2c, 0x57, 0x80, 0x63, 0xb4, 0x31, 0x32, 0x5d, 0x18, 0xad, 0xad, 0xce, 0xe3, 0xe8,
-	0xfa, 0x6d, 0x44, 0x17, 0x3b, 0x8e, 0x6b, 0xa7, 0xd3, 0xd0, 0x4b, 0x5c, 0x25, 0xfe, 0x80, 0xee,
-	0x38, 0x62, 0x6a, 0xfe, 0xa5, 0x1a, 0x6b, 0xad, 0x1b, 0xff, 0xd3, 0x72, 0x0e, 0x4c, 0x3b, 0xf1,
-	0xa3, 0xa5, 0xd6, 0x62, 0x1a, 0x3e, 0xb0, 0xd3, 0xb9, 0xac, 0x44, 0x92, 0xdb, 0x2e, 0xb1, 0x6f,
-	0xe3, 0x6f, 0x7f, 0x1b, 0xb0, 0xd3, 0xd8, 0x43, 0xeb, 0xb6, 0xe1, 0x2b, 0xab, 0x33, 0x69, 0x92,
-	0x58, 0x18, 0x9f, 0xf8, 0x08, 0xc3, 0xf9, 0xe2, 0xd3, 0xc2, 0x6d, 0xde, 0x8c, 0x69, 0xab, 0x13,
-	0xad, 0x72, 0x7a, 0xfd, 0xbd, 0xc4, 0xbb, 0xce, 0xf3, 0x8e, 0x3b, 0x92, 0x2b, 0xba, 0x24, 0xb9,
-	0x07, 0xff, 0x56, 0xd9, 0xeb, 0x89, 0x5f, 0x9e, 0xce, 0x02, 0xff, 0x6c, 0x16, 0xf8, 0x3f, 0x67,
-	0x81, 0xff, 0x79, 0x1e, 0x78, 0x67, 0xf3, 0xc0, 0xfb, 0x3e, 0x0f, 0xbc, 0x77, 0x9d, 0x01, 0xd7,
-	0xc3, 0x2a, 0x8b, 0x72, 0x59, 0xd0, 0x57, 0xc6, 0x4f, 0x6f, 0x08, 0x5c, 0x50, 0xf7, 0x86, 0x3e,
-	0x5d, 0x7e, 0x45, 0x7a, 0x32, 0x62, 0x2a, 0xdb, 0x30, 0xa7, 0xfd, 0xec, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x37, 0x74, 0x40, 0xb6, 0x69, 0x03, 0x00, 0x00,
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xd9, 0xb1, 0x56, 0x76, 0x6d, 0x6f, 0xe2, 0x58, 0x51, 0x1b, 0xd1, 0x59, 0x34,
+	0xa9, 0x7b, 0x28, 0x09, 0xab, 0x37, 0xa3, 0x87, 0x86, 0x4e, 0x9d, 0x06, 0x89, 0x8b, 0x60, 0x13,
+	0xa0, 0x40, 0x2e, 0xc4, 0x92, 0x5c, 0x4b, 0x5b, 0x8b, 0x5c, 0x81, 0xbb, 0xb4, 0xad, 0x1c, 0x8a,
+	0x3c, 0x42, 0x1f, 0xa1, 0xe8, 0xad, 0xe8, 0xb5, 0xe7, 0xde, 0x0a, 0xe4, 0x18, 0xf4, 0x54, 0x14,
+	0x05, 0x51, 0xd8, 0x97, 0x9e, 0xf5, 0x00, 0x45, 0xc1, 0xdd, 0xa5, 0x7e, 0x6c, 0xc7, 0xc9, 0x4d,
+	0x33, 0xf3, 0xcd, 0x7c, 0x33, 0xa3, 0x9d, 0x8f, 0x00, 0x25, 0x2c, 0x60, 0x69, 0xe6, 0x4a, 0x7e,
+	0x48, 0x93, 0x03, 0x12, 0x4a, 0x9e, 0x0e, 0xdd, 0xa3, 0x6d, 0x57, 0x48, 0x22, 0xa9, 0x33, 0x48,
+	0xb9, 0xe4, 0xf0, 0xa6, 0xc6, 0x38, 0xd3, 0x18, 0xe7, 0x68, 0xbb, 0x75, 0xa3, 0xcb, 0xbb, 0x5c,
+	0x41, 0xdc, 0xe2, 0x97, 0x46, 0xb7, 0x6e, 0x85, 0x5c, 0xc4, 0x5c, 0xf8, 0x3a, 0xa0, 0x0d, 0x13,
+	0x6a, 0x6b, 0xcb, 0x0d, 0x88, 0xa0, 0xee, 0xd1, 0x76, 0x40, 0x25, 0xd9, 0x76, 0x43, 0xce, 0x12,
+	0x1d, 0x47, 0x3f, 0x59, 0xe0, 0xe6, 0x03, 0x9a, 0xf0, 0xf8, 0x7e, 0x26, 0x7b, 0x3c, 0x65, 0x72,
+	0xb8, 0x4f, 0x25, 0x89, 0x88, 0x24, 0xf0, 0x1e, 0x98, 0x27, 0x51, 0xcc, 0x92, 0xa6, 0xb5, 0x69,
+	0x6d, 0xd5, 0xbd, 0xd5, 0x51, 0x6e, 0x2f, 0x0d, 0x49, 0xdc, 0xdf, 0x41, 0xca, 0x8d, 0xb0, 0x0e,
+	0xc3, 0x17, 0x60, 0xf1, 0x80, 0x12, 0x99, 0xa5, 0x54, 0x34, 0xe7, 0x36, 0xad, 0xad, 0x46, 0xe7,
+	0xae, 0x73, 0x79, 0xfb, 0x8e, 0x62, 0xda, 0x33, 0x60, 0x6f, 0xe3, 0x75, 0x6e, 0x57, 0x46, 0xb9,
+	0xbd, 0xa2, 0xab, 0x96, 0x45, 0x10, 0x1e, 0xd7, 0xdb, 0xa9, 0xfd, 0xfb, 0xa3, 0x6d, 0xa1, 0x5f,
+	0x2c, 0xb0, 0x3c, 0x93, 0x0a, 0x3b, 0xa0, 0x7e, 0x90, 0x52, 0xfa, 0x92, 0x04, 0x7d, 0xaa, 0xfa,
+	0x5b, 0xf4, 0x6e, 0x8c, 0x72, 0x7b, 0xd5, 0x54, 0x2a, 0x43, 0x08, 0x4f, 0x60, 0xd0, 0x05, 0x8b,
+	0x03, 0x92, 0x09, 0x95, 0x32, 0xa7, 0x52, 0xae, 0x4f, 0xc8, 0xcb, 0x08, 0xc2, 0x63, 0x50, 0x91,
+	0x10, 0xf6, 0xc9, 0x71, 0x40, 0xc2, 0xc3, 0x66, 0xf5, 0x7c, 0x42, 0x19, 0x41, 0x78, 0x0c, 0x32,
+	0xdd, 0xfe, 0x3e, 0x07, 0x56, 0x54, 0xb7, 0xfb, 0x2c, 0x91, 0x4f, 0x58, 0xcc, 0xa4, 0x80, 0x3e,
+	0x00, 0x31, 0x39, 0xf1, 0x45, 0x36, 0x18, 0xf4, 0x87, 0x66, 0xa1, 0x5f, 0x16, 0xe3, 0xff, 0x95,
+	0xdb, 0xeb, 0xfa, 0x2f, 0x12, 0xd1, 0xa1, 0xc3, 0xb8, 0x1b, 0x13, 0xd9, 0x73, 0x1e, 0x25, 0x72,
+	0x94, 0xdb, 0x6b, 0x9a, 0x69, 0x92, 0x88, 0xfe, 0xf8, 0xf5, 0x33, 0x60, 0xfe, 0xde, 0x47, 0x89,
+	0xc4, 0xf5, 0x98, 0x9c, 0x3c, 0x53, 0x11, 0xf8, 0x3d, 0x58, 0x8f, 0x59, 0x22, 0xfd, 0x7e, 0xc1,
+	0xe7, 0x0f, 0x68, 0xea, 0x1f, 0xb3, 0x24, 0xe2, 0xc7, 0x6a, 0xd2, 0xba, 0xf7, 0xf8, 0x5d, 0x5c,
+	0x1f, 0x19, 0xae, 0xcb, 0x6a, 0x9c, 0xa7, 0x85, 0x71, 0x39, 0xd8, 0x53, 0x9a, 0x7e, 0xab, 0x20,
+	0xf0, 0x31, 0x50, 0x5e, 0x93, 0xe1, 0x07, 0x7d, 0x1e, 0x1e, 0x0a, 0xb5, 0xb5, 0x9a, 0x77, 0x7b,
+	0x94, 0xdb, 0xb7, 0xa6, 0xea, 0xcf, 0x60, 0x10, 0x5e, 0x2d, 0x9c, 0xba, 0x8c, 0xa7, 0x5c, 0x66,
+	0x8f, 0x3f, 0x5b, 0x53, 0x7b, 0x34, 0x34, 0x3b, 0x60, 0x49, 0x48, 0x92, 0x4a, 0xbf, 0x47, 0x59,
+	0xb7, 0x27, 0xd5, 0x26, 0xab, 0xde, 0xc6, 0x28, 0xb7, 0xaf, 0x6b, 0x82, 0xe9, 0x28, 0xc2, 0x0d,
+	0x65, 0x7e, 0xad, 0x2c, 0xf8, 0x1c, 0x2c, 0x14, 0x4c, 0x34, 0x32, 0x3b, 0xf9, 0xe2, 0x5d, 0x3b,
+	0x59, 0x9e, 0xf4, 0x4c, 0xa3, 0xf3, 0x4b, 0x30, 0xb5, 0x4c, 0xaf, 0x29, 0x58, 0xda, 0xe7, 0x51,
+	0xd6, 0xa7, 0x4f, 0x49, 0x4a, 0x62, 0x01, 0x03, 0xd0, 0x8a, 0x8a, 0xd6, 0xfd, 0x30, 0xa5, 0x44,
+	0x32, 0x9e, 0xf8, 0x5d, 0x22, 0xfc, 0x90, 0x27, 0x22, 0x8b, 0xf5, 0x83, 0xad, 0x79, 0x77, 0x47,
+	0xb9, 0x7d, 0x47, 0x53, 0xbc, 0x1d, 0x8b, 0xf0, 0x86, 0x0a, 0xee, 0x9a, 0xd8, 0x43, 0x22, 0x76,
+	0x4d, 0xe4, 0x2b, 0x70, 0xed, 0xf9, 0x9e, 0x5a, 0x10, 0x6c, 0x82, 0x6b, 0x2a, 0x99, 0xa7, 0xfa,
+	0x6d, 0xe1, 0xd2, 0x84, 0x2d, 0xb0, 0x28, 0xb2, 0x40, 0x95, 0xd0, 0x63, 0xe3, 0xb1, 0xbd, 0x53,
+	0x7b, 0xf5, 0xf7, 0x66, 0x05, 0xfd, 0x66, 0x81, 0xa5, 0x87, 0x34, 0xa1, 0x82, 0x89, 0x67, 0x92,
+	0x48, 0x0a, 0x3d, 0xb0, 0x30, 0x50, 0x53, 0xa8, 0x5a, 0x8d, 0xce, 0xc7, 0x6f, 0xbb, 0xe6, 0xe9,
+	0x89, 0xbd, 0x5a, 0xb1, 0x4d, 0x6c, 0x32, 0xe1, 0x77, 0xe0, 0x03, 0x03, 0xf4, 0x15, 0x57, 0xa1,
+	0x0c, 0xd5, 0xab, 0x6a, 0x99, 0x0e, 0xd4, 0x38, 0xde, 0x6d, 0x23, 0x0c, 0xeb, 0xe6, 0x9c, 0x67,
+	0x2a, 0x21, 0xbc, 0x6c, 0x1c, 0x0f, 0xb4, 0xfd, 0x5f, 0x75, 0x3c, 0x80, 0xde, 0xc6, 0x3d, 0x30,
+	0xaf, 0x07, 0xbe, 0x20, 0x5c, 0xca, 0x8d, 0xb0, 0x0e, 0xc3, 0x57, 0x16, 0x80, 0xa4, 0x94, 0x3d,
+	0x3f, 0x36, 0xba, 0x67, 0x34, 0xcc, 0xb9, 0x52, 0xc3, 0x2e, 0xa8, 0xa5, 0x77, 0xc7, 0xf4, 0x6c,
+	0x1e, 0xfa, 0xc5, 0xba, 0x08, 0xaf, 0x91, 0x0b, 0x1a, 0x1b, 0x81, 0xc6, 0xe4, 0xe4, 0xf4, 0xbd,
+	0x34, 0x3a, 0x9f, 0x5c, 0x49, 0x3d, 0x51, 0x15, 0xaf, 0x65, 0x38, 0xe1, 0xf9, 0xe3, 0x15, 0x08,
+	0x83, 0x78, 0xa2, 0x3e, 0x25, 0x8b, 0x91, 0x84, 0xda, 0x7b, 0xb2, 0x98, 0x9b, 0xbc, 0x8c, 0xc5,
+	0x08, 0x83, 0x66, 0x31, 0xb7, 0xf9, 0x69, 0xf1, 0x6e, 0x32, 0x41, 0xa3, 0xe6, 0xbc, 0x12, 0xcb,
+	0xb5, 0xc9, 0x09, 0x69, 0x3f, 0xc2, 0x06, 0x00, 0xf7, 0xc0, 0xea, 0x41, 0xca, 0x5f, 0xd2, 0xc4,
+	0x27, 0x51, 0x94, 0x52, 0x21, 0xa8, 0x68, 0x2e, 0x6c, 0x56, 0xb7, 0xea, 0xde, 0x87, 0xa3, 0xdc,
+	0xde, 0x28, 0x55, 0x7c, 0x16, 0x81, 0xf0, 0x8a, 0x76, 0xdd, 0x2f, 0x3d, 0xfa, 0xf8, 0xbc, 0x27,
+	0xaf, 0x4f, 0xdb, 0xd6, 0x9b, 0xd3, 0xb6, 0xf5, 0xcf, 0x69, 0xdb, 0xfa, 0xe1, 0xac, 0x5d, 0x79,
+	0x73, 0xd6, 0xae, 0xfc, 0x79, 0xd6, 0xae, 0xbc, 0xe8, 0x74, 0x99, 0xec, 0x65, 0x81, 0x13, 0xf2,
+	0xd8, 0xfd, 0x46, 0x4d, 0xbb, 0xdb, 0x23, 0x2c, 0x71, 0xcd, 0x17, 0xf8, 0x64, 0xf6, 0x1b, 0x2c,
+	0x87, 0x03, 0x2a, 0x82, 0x05, 0xf5, 0x61, 0xfc, 0xfc, 0xff, 0x01, 0x00, 0xab, 0xa9, 0x4e, 0x5a,
+	0xa7, 0x07, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -379,6 +627,96 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if !this.Features.Equal(&that1.Features) {
+		return false
+	}
+	return true
+}
+func (this *DenomFeatures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomFeatures)
+	if !ok {
+		that2, ok := that.(DenomFeatures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Freezable != that1.Freezable {
+		return false
+	}
+	if this.Pausable != that1.Pausable {
+		return false
+	}
+	if this.Clawback != that1.Clawback {
+		return false
+	}
+	return true
+}
+func (this *DenomMintLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMintLimits)
+	if !ok {
+		that2, ok := that.(DenomMintLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.MintLimitPerWindow.Equal(that1.MintLimitPerWindow) {
+		return false
+	}
+	if this.MintWindowBlocks != that1.MintWindowBlocks {
+		return false
+	}
+	return true
+}
+func (this *DenomMintWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMintWindow)
+	if !ok {
+		that2, ok := that.(DenomMintWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if !this.Minted.Equal(that1.Minted) {
+		return false
+	}
 	return true
 }
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -406,6 +744,23 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MintLimits.Equal(&that1.MintLimits) {
+		return false
+	}
+	if !this.MintWindow.Equal(&that1.MintWindow) {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -428,6 +783,16 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Features.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -438,7 +803,7 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
+func (m *DenomFeatures) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -448,25 +813,50 @@ func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModuleParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomFeatures) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomFeatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DenomCreationGasConsume != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.DenomCreationGasConsume))
+	if m.Clawback {
+		i--
+		if m.Clawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pausable {
+		i--
+		if m.Pausable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Freezable {
+		i--
+		if m.Freezable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TFDenom) Marshal() (dAtA []byte, err error) {
+func (m *DenomMintLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -476,34 +866,45 @@ func (m *TFDenom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TFDenom) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomMintLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TFDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomMintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Subdenom) > 0 {
-		i -= len(m.Subdenom)
-		copy(dAtA[i:], m.Subdenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Subdenom)))
+	if m.MintWindowBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MintWindowBlocks))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.MintLimitPerWindow.Size()
+		i -= size
+		if _, err := m.MintLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *DenomMintWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -513,12 +914,115 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *DenomMintWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DenomMintWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DenomCreationGasConsume != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.DenomCreationGasConsume))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TFDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TFDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TFDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -570,6 +1074,45 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -611,6 +1154,56 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	l = m.Features.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *DenomFeatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Freezable {
+		n += 2
+	}
+	if m.Pausable {
+		n += 2
+	}
+	if m.Clawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomMintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MintLimitPerWindow.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.MintWindowBlocks != 0 {
+		n += 1 + sovState(uint64(m.MintWindowBlocks))
+	}
+	return n
+}
+
+func (m *DenomMintWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovState(uint64(m.StartHeight))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -657,31 +1250,406 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *GenesisDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MintLimits.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MintWindow.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Features.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomFeatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomFeatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomFeatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Freezable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pausable = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMintLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMintLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimitPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindowBlocks", wireType)
+			}
+			m.MintWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GenesisDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+func (m *DenomMintWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,15 +1672,34 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomMintWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomMintWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -740,7 +1727,9 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1157,6 +2146,124 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
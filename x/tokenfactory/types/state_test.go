@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -133,6 +134,74 @@ func TestGenesisState(t *testing.T) {
 				},
 			},
 		},
+
+		{
+			name:    "sad: paused but not pausable",
+			wantErr: types.ErrFeatureDisabled.Error(),
+			genState: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:             happyGenDenoms[0].Denom,
+						AuthorityMetadata: happyGenDenoms[0].AuthorityMetadata,
+						Paused:            true,
+					},
+				},
+			},
+		},
+
+		{
+			name:    "sad: frozen addresses but not freezable",
+			wantErr: types.ErrFeatureDisabled.Error(),
+			genState: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:             happyGenDenoms[0].Denom,
+						AuthorityMetadata: happyGenDenoms[0].AuthorityMetadata,
+						FrozenAddresses:   []string{testutil.AccAddress().String()},
+					},
+				},
+			},
+		},
+
+		{
+			name:    "sad: duplicate frozen address",
+			wantErr: "duplicate frozen address",
+			genState: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: happyGenDenoms[0].Denom,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:    happyGenDenoms[0].AuthorityMetadata.Admin,
+							Features: types.DenomFeatures{Freezable: true},
+						},
+						FrozenAddresses: []string{
+							happyGenDenoms[1].AuthorityMetadata.Admin,
+							happyGenDenoms[1].AuthorityMetadata.Admin,
+						},
+					},
+				},
+			},
+		},
+
+		{
+			name:    "sad: invalid mint limits",
+			wantErr: types.ErrInvalidMintLimits.Error(),
+			genState: types.GenesisState{
+				Params: types.DefaultModuleParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:             happyGenDenoms[0].Denom,
+						AuthorityMetadata: happyGenDenoms[0].AuthorityMetadata,
+						MintLimits: types.DenomMintLimits{
+							MintLimitPerWindow: math.NewInt(100),
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(fmt.Sprintf("%v %s", idx, tc.name), func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -145,3 +214,112 @@ func TestGenesisState(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomMintLimits_ValidateUpdate(t *testing.T) {
+	current := types.DenomMintLimits{
+		MaxSupply:          math.NewInt(1_000),
+		MintLimitPerWindow: math.NewInt(100),
+		MintWindowBlocks:   10,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		current types.DenomMintLimits
+		update  types.DenomMintLimits
+		wantErr string
+	}{
+		{
+			name:    "set limits on a denom without limits",
+			current: types.DefaultDenomMintLimits(),
+			update:  current,
+		},
+		{
+			name:    "keep the same limits",
+			current: current,
+			update:  current,
+		},
+		{
+			name:    "tighten all limits",
+			current: current,
+			update: types.DenomMintLimits{
+				MaxSupply:          math.NewInt(999),
+				MintLimitPerWindow: math.NewInt(99),
+				MintWindowBlocks:   11,
+			},
+		},
+		{
+			name:    "sad: increase max supply",
+			current: current,
+			update: types.DenomMintLimits{
+				MaxSupply:          math.NewInt(1_001),
+				MintLimitPerWindow: math.NewInt(100),
+				MintWindowBlocks:   10,
+			},
+			wantErr: "max_supply cannot be removed or increased",
+		},
+		{
+			name:    "sad: remove max supply",
+			current: current,
+			update: types.DenomMintLimits{
+				MaxSupply:          math.ZeroInt(),
+				MintLimitPerWindow: math.NewInt(100),
+				MintWindowBlocks:   10,
+			},
+			wantErr: "max_supply cannot be removed or increased",
+		},
+		{
+			name:    "sad: increase mint limit",
+			current: current,
+			update: types.DenomMintLimits{
+				MaxSupply:          math.NewInt(1_000),
+				MintLimitPerWindow: math.NewInt(101),
+				MintWindowBlocks:   10,
+			},
+			wantErr: "mint_limit_per_window cannot be removed or increased",
+		},
+		{
+			name:    "sad: remove mint limit",
+			current: current,
+			update:  types.DenomMintLimits{MaxSupply: math.NewInt(1_000)},
+			wantErr: "mint_limit_per_window cannot be removed or increased",
+		},
+		{
+			name:    "sad: shorten mint window",
+			current: current,
+			update: types.DenomMintLimits{
+				MaxSupply:          math.NewInt(1_000),
+				MintLimitPerWindow: math.NewInt(100),
+				MintWindowBlocks:   9,
+			},
+			wantErr: "mint_window_blocks cannot decrease",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.current.ValidateUpdate(tc.update)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDenomMintWindow_HasEnded(t *testing.T) {
+	window := types.DenomMintWindow{StartHeight: 100, Minted: math.NewInt(5)}
+	assert.False(t, window.HasEnded(100, 10))
+	assert.False(t, window.HasEnded(109, 10))
+	assert.True(t, window.HasEnded(110, 10))
+	assert.True(t, window.HasEnded(99, 10), "window starts after the block")
+}
+
+func TestDenomFeatures_Without(t *testing.T) {
+	all := types.DenomFeatures{Freezable: true, Pausable: true, Clawback: true}
+	assert.Equal(t, all, all.Without(types.DenomFeatures{}))
+	assert.Equal(t,
+		types.DenomFeatures{Clawback: true},
+		all.Without(types.DenomFeatures{Freezable: true, Pausable: true}),
+	)
+	assert.True(t, all.Without(all).IsEmpty())
+	assert.True(t, types.DenomFeatures{}.Without(all).IsEmpty())
+}
@@ -17,6 +17,11 @@ var (
 	_ legacytx.LegacyMsg = &MsgBurn{}
 	_ legacytx.LegacyMsg = &MsgSetDenomMetadata{}
 	_ legacytx.LegacyMsg = &MsgBurnNative{}
+	_ legacytx.LegacyMsg = &MsgSetDenomMintLimits{}
+	_ legacytx.LegacyMsg = &MsgSetFrozen{}
+	_ legacytx.LegacyMsg = &MsgSetPaused{}
+	_ legacytx.LegacyMsg = &MsgClawback{}
+	_ legacytx.LegacyMsg = &MsgDisableFeatures{}
 )
 
 // ValidateBasic performs stateless validation checks. Impl sdk.Msg.
@@ -296,3 +301,202 @@ func (m MsgBurnNative) Type() string { return "burn_native" }
 func (m MsgBurnNative) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// ----------------------------------------------------------------
+// MsgSetDenomMintLimits
+
+// ValidateBasic performs stateless validation checks. Impl sdk.Msg.
+func (m MsgSetDenomMintLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid sender (%s): %s", m.Sender, err)
+	}
+
+	if err := DenomStr(m.Denom).Validate(); err != nil {
+		return err
+	}
+
+	return m.MintLimits.Validate()
+}
+
+// GetSigners: Impl sdk.Msg.
+func (m MsgSetDenomMintLimits) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route: Impl legacytx.LegacyMsg. The mesage route must be alphanumeric or empty.
+func (m MsgSetDenomMintLimits) Route() string { return RouterKey }
+
+// Type: Impl legacytx.LegacyMsg. Returns a human-readable string for the message,
+// intended for utilization within tags
+func (m MsgSetDenomMintLimits) Type() string { return "set_denom_mint_limits" }
+
+// GetSignBytes: Get the canonical byte representation of the Msg. Impl
+// legacytx.LegacyMsg.
+func (m MsgSetDenomMintLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ----------------------------------------------------------------
+// MsgSetFrozen
+
+// ValidateBasic performs stateless validation checks. Impl sdk.Msg.
+func (m MsgSetFrozen) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid sender (%s): %s", m.Sender, err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid address (%s): %s", m.Address, err)
+	}
+
+	return DenomStr(m.Denom).Validate()
+}
+
+// GetSigners: Impl sdk.Msg.
+func (m MsgSetFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route: Impl legacytx.LegacyMsg. The mesage route must be alphanumeric or empty.
+func (m MsgSetFrozen) Route() string { return RouterKey }
+
+// Type: Impl legacytx.LegacyMsg. Returns a human-readable string for the message,
+// intended for utilization within tags
+func (m MsgSetFrozen) Type() string { return "set_frozen" }
+
+// GetSignBytes: Get the canonical byte representation of the Msg. Impl
+// legacytx.LegacyMsg.
+func (m MsgSetFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ----------------------------------------------------------------
+// MsgSetPaused
+
+// ValidateBasic performs stateless validation checks. Impl sdk.Msg.
+func (m MsgSetPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid sender (%s): %s", m.Sender, err)
+	}
+
+	return DenomStr(m.Denom).Validate()
+}
+
+// GetSigners: Impl sdk.Msg.
+func (m MsgSetPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route: Impl legacytx.LegacyMsg. The mesage route must be alphanumeric or empty.
+func (m MsgSetPaused) Route() string { return RouterKey }
+
+// Type: Impl legacytx.LegacyMsg. Returns a human-readable string for the message,
+// intended for utilization within tags
+func (m MsgSetPaused) Type() string { return "set_paused" }
+
+// GetSignBytes: Get the canonical byte representation of the Msg. Impl
+// legacytx.LegacyMsg.
+func (m MsgSetPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ----------------------------------------------------------------
+// MsgClawback
+
+// ValidateBasic performs stateless validation checks. Impl sdk.Msg.
+func (m MsgClawback) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid sender (%s): %s", m.Sender, err)
+	}
+
+	if err := validateCoin(m.Coin); err != nil {
+		return err
+	} else if err := DenomStr(m.Coin.Denom).Validate(); err != nil {
+		return err
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.ClawbackFrom)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid clawback_from (%s): %s", m.ClawbackFrom, err)
+	}
+
+	if m.ClawbackTo != "" {
+		_, err = sdk.AccAddressFromBech32(m.ClawbackTo)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf(
+				"invalid clawback_to (%s): %s", m.ClawbackTo, err)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners: Impl sdk.Msg.
+func (m MsgClawback) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route: Impl legacytx.LegacyMsg. The mesage route must be alphanumeric or empty.
+func (m MsgClawback) Route() string { return RouterKey }
+
+// Type: Impl legacytx.LegacyMsg. Returns a human-readable string for the message,
+// intended for utilization within tags
+func (m MsgClawback) Type() string { return "clawback" }
+
+// GetSignBytes: Get the canonical byte representation of the Msg. Impl
+// legacytx.LegacyMsg.
+func (m MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ----------------------------------------------------------------
+// MsgDisableFeatures
+
+// ValidateBasic performs stateless validation checks. Impl sdk.Msg.
+func (m MsgDisableFeatures) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"invalid sender (%s): %s", m.Sender, err)
+	}
+
+	if m.Disable.IsEmpty() {
+		return sdkerrors.ErrInvalidRequest.Wrap("no features to disable")
+	}
+
+	return DenomStr(m.Denom).Validate()
+}
+
+// GetSigners: Impl sdk.Msg.
+func (m MsgDisableFeatures) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Route: Impl legacytx.LegacyMsg. The mesage route must be alphanumeric or empty.
+func (m MsgDisableFeatures) Route() string { return RouterKey }
+
+// Type: Impl legacytx.LegacyMsg. Returns a human-readable string for the message,
+// intended for utilization within tags
+func (m MsgDisableFeatures) Type() string { return "disable_features" }
+
+// GetSignBytes: Get the canonical byte representation of the Msg. Impl
+// legacytx.LegacyMsg.
+func (m MsgDisableFeatures) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
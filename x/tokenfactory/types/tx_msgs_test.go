@@ -153,6 +153,32 @@ func TestTxMsgInterface(t *testing.T) {
 			Denom:    denomStr,
 			NewAdmin: testutil.AccAddress().String(),
 		},
+		&types.MsgSetDenomMintLimits{
+			Sender:     creator,
+			Denom:      denomStr,
+			MintLimits: types.DefaultDenomMintLimits(),
+		},
+		&types.MsgSetFrozen{
+			Sender:  creator,
+			Denom:   denomStr,
+			Address: testutil.AccAddress().String(),
+			Frozen:  true,
+		},
+		&types.MsgSetPaused{
+			Sender: creator,
+			Denom:  denomStr,
+			Paused: true,
+		},
+		&types.MsgClawback{
+			Sender:       creator,
+			Coin:         sdk.NewInt64Coin(denomStr, 420),
+			ClawbackFrom: testutil.AccAddress().String(),
+		},
+		&types.MsgDisableFeatures{
+			Sender:  creator,
+			Denom:   denomStr,
+			Disable: types.DenomFeatures{Pausable: true},
+		},
 	} {
 		t.Run(msg.Type(), func(t *testing.T) {
 			require.NotPanics(t, func() {
@@ -236,7 +262,7 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 				Coin:   sdk.Coin{Amount: math.NewInt(-420)},
 				MintTo: "",
 			},
-			wantErr: "invalid coin",
+			wantErr: "invalid coins",
 		},
 	} {
 		t.Run(tc.name, tc.test())
@@ -291,7 +317,7 @@ func TestMsgBurn_ValidateBasic(t *testing.T) {
 				Coin:     sdk.Coin{Amount: math.NewInt(-420)},
 				BurnFrom: "",
 			},
-			wantErr: "invalid coin",
+			wantErr: "invalid coins",
 		},
 	} {
 		t.Run(tc.name, tc.test())
@@ -364,3 +390,145 @@ func TestMsgSetDenomMetadata_ValidateBasic(t *testing.T) {
 		t.Run(tc.name, tc.test())
 	}
 }
+
+func TestMsgSetDenomMintLimits_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	denom := fmt.Sprintf("tf/%s/abc", sender)
+	for _, tc := range []ValidateBasicTest{
+		{
+			name: "happy: supply cap and mint rate limit",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender: sender,
+				Denom:  denom,
+				MintLimits: types.DenomMintLimits{
+					MaxSupply:          math.NewInt(1_000),
+					MintLimitPerWindow: math.NewInt(100),
+					MintWindowBlocks:   10,
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: supply cap only",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender:     sender,
+				Denom:      denom,
+				MintLimits: types.DenomMintLimits{MaxSupply: math.NewInt(1_000)},
+			},
+			wantErr: "",
+		},
+		{
+			name: "invalid sender",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender:     "notAnAddr",
+				Denom:      denom,
+				MintLimits: types.DefaultDenomMintLimits(),
+			},
+			wantErr: "invalid address",
+		},
+		{
+			name: "invalid denom",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender:     sender,
+				Denom:      "abc",
+				MintLimits: types.DefaultDenomMintLimits(),
+			},
+			wantErr: "denom format error",
+		},
+		{
+			name: "sad: negative max supply",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender:     sender,
+				Denom:      denom,
+				MintLimits: types.DenomMintLimits{MaxSupply: math.NewInt(-1)},
+			},
+			wantErr: "max_supply cannot be negative",
+		},
+		{
+			name: "sad: mint limit without window",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender: sender,
+				Denom:  denom,
+				MintLimits: types.DenomMintLimits{
+					MintLimitPerWindow: math.NewInt(100),
+				},
+			},
+			wantErr: "mint_window_blocks must be set",
+		},
+		{
+			name: "sad: window without mint limit",
+			msg: &types.MsgSetDenomMintLimits{
+				Sender:     sender,
+				Denom:      denom,
+				MintLimits: types.DenomMintLimits{MintWindowBlocks: 10},
+			},
+			wantErr: "mint_window_blocks must be set",
+		},
+	} {
+		t.Run(tc.name, tc.test())
+	}
+}
+
+func TestComplianceMsgs_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	holder := testutil.AccAddress().String()
+	denom := fmt.Sprintf("tf/%s/abc", sender)
+	for _, tc := range []ValidateBasicTest{
+		{
+			name: "happy: freeze",
+			msg: &types.MsgSetFrozen{
+				Sender: sender, Denom: denom, Address: holder, Frozen: true,
+			},
+			wantErr: "",
+		},
+		{
+			name: "sad: freeze invalid address",
+			msg: &types.MsgSetFrozen{
+				Sender: sender, Denom: denom, Address: "notAnAddr", Frozen: true,
+			},
+			wantErr: "invalid address",
+		},
+		{
+			name:    "sad: pause invalid denom",
+			msg:     &types.MsgSetPaused{Sender: sender, Denom: "abc", Paused: true},
+			wantErr: "denom format error",
+		},
+		{
+			name: "happy: clawback to sender",
+			msg: &types.MsgClawback{
+				Sender:       sender,
+				Coin:         sdk.NewInt64Coin(denom, 1),
+				ClawbackFrom: holder,
+			},
+			wantErr: "",
+		},
+		{
+			name: "sad: clawback invalid clawback_to",
+			msg: &types.MsgClawback{
+				Sender:       sender,
+				Coin:         sdk.NewInt64Coin(denom, 1),
+				ClawbackFrom: holder,
+				ClawbackTo:   "notAnAddr",
+			},
+			wantErr: "invalid clawback_to",
+		},
+		{
+			name: "sad: clawback zero coin",
+			msg: &types.MsgClawback{
+				Sender:       sender,
+				Coin:         sdk.NewInt64Coin(denom, 0),
+				ClawbackFrom: holder,
+			},
+			wantErr: "invalid coins",
+		},
+		{
+			name: "sad: disable no features",
+			msg: &types.MsgDisableFeatures{
+				Sender: sender, Denom: denom, Disable: types.DenomFeatures{},
+			},
+			wantErr: "no features to disable",
+		},
+	} {
+		t.Run(tc.name, tc.test())
+	}
+}